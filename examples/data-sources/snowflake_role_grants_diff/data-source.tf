@@ -0,0 +1,23 @@
+# report the difference between the desired and the actual privileges of a role
+data "snowflake_role_grants_diff" "analyst" {
+  role = "ANALYST"
+
+  desired {
+    privilege   = "USAGE"
+    object_type = "DATABASE"
+    object_name = "ANALYTICS"
+  }
+
+  desired {
+    privilege   = "USAGE"
+    object_type = "WAREHOUSE"
+    object_name = "ANALYTICS_WH"
+  }
+
+  # only compare database and warehouse grants for now
+  object_types = ["DATABASE", "WAREHOUSE"]
+}
+
+output "analyst_grants_in_sync" {
+  value = data.snowflake_role_grants_diff.analyst.in_sync
+}
@@ -0,0 +1,154 @@
+package datasources
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var privilegeGrantSchema = map[string]*schema.Schema{
+	"privilege": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The privilege, e.g. USAGE.",
+	},
+	"object_type": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The type of the object the privilege is granted on, e.g. DATABASE. Use ACCOUNT for global privileges.",
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The fully qualified name of the object the privilege is granted on. For global privileges this is the account locator.",
+	},
+}
+
+var computedPrivilegeGrantSchema = map[string]*schema.Schema{
+	"privilege": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The privilege.",
+	},
+	"object_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the object the privilege is granted on.",
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the object the privilege is granted on.",
+	},
+}
+
+var roleGrantsDiffSchema = map[string]*schema.Schema{
+	"role": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The role whose grants are compared.",
+	},
+	"desired": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The privileges the role should have.",
+		Elem: &schema.Resource{
+			Schema: privilegeGrantSchema,
+		},
+	},
+	"object_types": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Limits the comparison to grants on the given object types, so that adoption can happen one object type at a time. All object types are compared when not set.",
+	},
+	"include_ownership": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether OWNERSHIP grants are compared as well.",
+	},
+	"additions": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The desired privileges the role does not have yet.",
+		Elem: &schema.Resource{
+			Schema: computedPrivilegeGrantSchema,
+		},
+	},
+	"removals": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The privileges the role has that are not desired.",
+		Elem: &schema.Resource{
+			Schema: computedPrivilegeGrantSchema,
+		},
+	},
+	"in_sync": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the actual grants of the role match the desired ones.",
+	},
+}
+
+// RoleGrantsDiff reports the difference between the desired privileges of a role and its actual
+// grants without changing anything, e.g. for audit pipelines.
+func RoleGrantsDiff() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadRoleGrantsDiff,
+		Schema: roleGrantsDiffSchema,
+	}
+}
+
+func ReadRoleGrantsDiff(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	role := d.Get("role").(string)
+
+	actual, err := snowflake.ShowGrantsTo(db, "ROLE", role)
+	if err != nil {
+		return err
+	}
+
+	var desired []snowflake.PrivilegeGrant
+	for _, v := range d.Get("desired").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		desired = append(desired, snowflake.PrivilegeGrant{
+			Privilege:  m["privilege"].(string),
+			ObjectType: m["object_type"].(string),
+			ObjectName: m["object_name"].(string),
+		})
+	}
+	opts := snowflake.GrantsDiffOptions{
+		IncludeOwnership: d.Get("include_ownership").(bool),
+	}
+	for _, v := range d.Get("object_types").(*schema.Set).List() {
+		opts.ObjectTypes = append(opts.ObjectTypes, v.(string))
+	}
+
+	additions, removals := snowflake.DiffGrants(desired, actual, opts)
+	if err := d.Set("additions", flattenPrivilegeGrants(additions)); err != nil {
+		return err
+	}
+	if err := d.Set("removals", flattenPrivilegeGrants(removals)); err != nil {
+		return err
+	}
+	if err := d.Set("in_sync", len(additions) == 0 && len(removals) == 0); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("role_grants_diff_%s", role))
+	return nil
+}
+
+func flattenPrivilegeGrants(grants []snowflake.PrivilegeGrant) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, len(grants))
+	for i, grant := range grants {
+		flattened[i] = map[string]interface{}{
+			"privilege":   grant.Privilege,
+			"object_type": grant.ObjectType,
+			"object_name": grant.ObjectName,
+		}
+	}
+	return flattened
+}
@@ -0,0 +1,67 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_RoleGrantsDiff(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	roleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: roleGrantsDiff(databaseName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_role_grants_diff.diff", "in_sync", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_role_grants_diff.diff", "additions.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_role_grants_diff.diff", "additions.0.privilege", "MONITOR"),
+					resource.TestCheckResourceAttr("data.snowflake_role_grants_diff.diff", "removals.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func roleGrantsDiff(databaseName string, roleName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "d" {
+		name = "%[1]v"
+	}
+
+	resource snowflake_role "r" {
+		name = "%[2]v"
+	}
+
+	resource snowflake_database_grant "g" {
+		database_name = snowflake_database.d.name
+		privilege     = "USAGE"
+		roles         = [snowflake_role.r.name]
+	}
+
+	data snowflake_role_grants_diff "diff" {
+		role = snowflake_role.r.name
+
+		desired {
+			privilege   = "USAGE"
+			object_type = "DATABASE"
+			object_name = snowflake_database.d.name
+		}
+
+		desired {
+			privilege   = "MONITOR"
+			object_type = "DATABASE"
+			object_name = snowflake_database.d.name
+		}
+
+		object_types = ["DATABASE"]
+		depends_on   = [snowflake_database_grant.g]
+	}
+	`, databaseName, roleName)
+}
@@ -57,10 +57,13 @@ func EncodeSnowflakeID(attributes ...interface{}) string {
 	// is attribute already an object identifier?
 	if len(attributes) == 1 {
 		if id, ok := attributes[0].(sdk.ObjectIdentifier); ok {
-			// remove quotes and replace dots with pipes
-			parts := strings.Split(id.FullyQualifiedName(), ".")
-			for i, part := range parts {
-				parts[i] = strings.Trim(part, `"`)
+			// remove quotes and replace dots with pipes, keeping dots within quoted parts
+			parts, err := sdk.ParseIdentifierParts(id.FullyQualifiedName())
+			if err != nil {
+				parts = strings.Split(id.FullyQualifiedName(), ".")
+				for i, part := range parts {
+					parts[i] = strings.Trim(part, `"`)
+				}
 			}
 			return strings.Join(parts, IDDelimiter)
 		}
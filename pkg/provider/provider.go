@@ -305,6 +305,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_procedures":                         datasources.Procedures(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
 		"snowflake_role":                               datasources.Role(),
+		"snowflake_role_grants_diff":                   datasources.RoleGrantsDiff(),
 		"snowflake_roles":                              datasources.Roles(),
 		"snowflake_row_access_policies":                datasources.RowAccessPolicies(),
 		"snowflake_schemas":                            datasources.Schemas(),
@@ -384,7 +385,7 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not open snowflake database err = %w", err)
 	}
-	client := sdk.NewClientFromDB(db)
+	client := sdk.NewClientFromDB(db, sdk.WithRetryPolicy(sdk.DefaultRetryPolicy()))
 	sessionID, err := client.ContextFunctions.CurrentSession(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve session id err = %w", err)
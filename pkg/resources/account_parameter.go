@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,7 +39,23 @@ func AccountParameter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customizeAccountParameterDiff,
+	}
+}
+
+// accountParameterFeatures lists the account parameters that are only available in some editions.
+var accountParameterFeatures = map[string]sdk.AccountFeature{
+	"SHARE_RESTRICTIONS": sdk.AccountFeatureShareRestrictions,
+}
+
+// customizeAccountParameterDiff rejects parameters that are not supported by the edition of the account.
+func customizeAccountParameterDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	feature, ok := accountParameterFeatures[d.Get("key").(string)]
+	if !ok || !d.HasChanges("key", "value") {
+		return nil
 	}
+	return checkAccountFeature(ctx, meta, feature)
 }
 
 // CreateAccountParameter implements schema.CreateFunc.
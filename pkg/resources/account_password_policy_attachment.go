@@ -41,13 +41,13 @@ func CreateAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interfac
 	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
 
-	passwordPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("password_policy").(string)).(sdk.SchemaObjectIdentifier)
-	if !ok {
-		return fmt.Errorf("password_policy %s is not a valid password policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("password_policy"))
+	passwordPolicy, err := sdk.ParseSchemaObjectIdentifier(d.Get("password_policy").(string))
+	if err != nil {
+		return fmt.Errorf("password_policy %s is not a valid password policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`: %w", d.Get("password_policy"), err)
 	}
 	// passwordPolicy := sdk.NewAccountObjectIdentifier(d.Get("password_policy").(string))
 
-	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+	err = client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
 		Set: &sdk.AccountSet{
 			PasswordPolicy: passwordPolicy,
 		},
@@ -1,8 +1,12 @@
 package resources
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"log"
 	"strings"
+	"sync"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -31,6 +35,27 @@ func dataTypeDiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
 	return oldDT == newDT
 }
 
+// capabilityClients keeps one client per connection pool, so that the account capabilities are only
+// probed once per run instead of on every plan.
+var capabilityClients sync.Map
+
+// checkAccountFeature returns an error when the feature is not available in the edition of the
+// account the provider is connected to. Accounts whose edition cannot be determined, e.g. without
+// ORGADMIN, pass the check with a warning.
+func checkAccountFeature(ctx context.Context, meta interface{}, feature sdk.AccountFeature) error {
+	db := meta.(*sql.DB)
+	client, _ := capabilityClients.LoadOrStore(db, sdk.NewClientFromDB(db))
+	capabilities, err := client.(*sdk.Client).Capabilities.Probe(ctx)
+	if err != nil {
+		return err
+	}
+	if !capabilities.EditionKnown() {
+		log.Printf("[WARN] could not determine the edition of account %s, assuming it supports %s\n", capabilities.AccountName, feature)
+		return nil
+	}
+	return capabilities.CheckFeature(feature)
+}
+
 func ignoreTrimSpaceSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
 	return strings.TrimSpace(old) == strings.TrimSpace(new)
 }
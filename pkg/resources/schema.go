@@ -60,6 +60,35 @@ var schemaSchema = map[string]*schema.Schema{
 		ValidateFunc: validation.IntBetween(0, 90),
 	},
 	"tag": tagReferenceSchema,
+	"clone": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Creates the schema as a clone of an existing schema.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "The database of the source schema. Defaults to the database of the created schema.",
+				},
+				"schema": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The name of the source schema.",
+				},
+				"reapply_grants_to_role": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Grants the role the privileges it holds on the source schema and the objects in it on the cloned ones. Cloning a schema does not copy the grants on the schema itself.",
+				},
+			},
+		},
+	},
 }
 
 type schemaID struct {
@@ -126,6 +155,37 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
 
+	schemaID := &schemaID{
+		DatabaseName: database,
+		SchemaName:   name,
+	}
+	dataIDInput, err := schemaID.String()
+	if err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("clone"); ok {
+		if err := cloneSchema(d, db, v.([]interface{})[0].(map[string]interface{})); err != nil {
+			// the clone exists when only the grants failed, so it is tracked (and tainted) instead of orphaned
+			var grantsErr *sdk.SchemaCloneGrantsError
+			if errors.As(err, &grantsErr) {
+				d.SetId(dataIDInput)
+			}
+			return fmt.Errorf("error cloning schema %v err = %w", name, err)
+		}
+	} else if err := createSchema(d, db); err != nil {
+		return fmt.Errorf("error creating schema %v err = %w", name, err)
+	}
+
+	d.SetId(dataIDInput)
+
+	return ReadSchema(d, meta)
+}
+
+func createSchema(d *schema.ResourceData, db *sql.DB) error {
+	name := d.Get("name").(string)
+	database := d.Get("database").(string)
+
 	builder := snowflake.NewSchemaBuilder(name).WithDB(database)
 
 	// Set optionals
@@ -150,22 +210,44 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 		builder.WithTags(tags.toSnowflakeTagValues())
 	}
 
-	q := builder.Create()
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error creating schema %v err = %w", name, err)
-	}
+	return snowflake.Exec(db, builder.Create())
+}
 
-	schemaID := &schemaID{
-		DatabaseName: database,
-		SchemaName:   name,
+func cloneSchema(d *schema.ResourceData, db *sql.DB, clone map[string]interface{}) error {
+	database := d.Get("database").(string)
+	id := sdk.NewSchemaIdentifier(database, d.Get("name").(string))
+
+	sourceDatabase := clone["database"].(string)
+	if sourceDatabase == "" {
+		sourceDatabase = database
 	}
-	dataIDInput, err := schemaID.String()
-	if err != nil {
-		return err
+	opts := &sdk.CreateSchemaOptions{
+		Clone: &sdk.Clone{
+			SourceObject: sdk.NewSchemaIdentifier(sourceDatabase, clone["schema"].(string)),
+		},
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		opts.Comment = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("is_transient"); ok && v.(bool) {
+		opts.Transient = sdk.Bool(true)
+	}
+	if v, ok := d.GetOk("is_managed"); ok && v.(bool) {
+		opts.WithManagedAccess = sdk.Bool(true)
+	}
+	if v, ok := d.GetOk("data_retention_days"); ok {
+		opts.DataRetentionTimeInDays = sdk.Int(v.(int))
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		opts.Tag = getTags(v).toTagAssociations()
 	}
-	d.SetId(dataIDInput)
 
-	return ReadSchema(d, meta)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	if role := clone["reapply_grants_to_role"].(string); role != "" {
+		return client.Schemas.CloneWithGrants(ctx, id, sdk.NewAccountObjectIdentifier(role), opts)
+	}
+	return client.Schemas.Create(ctx, id, opts)
 }
 
 // ReadSchema implements schema.ReadFunc.
@@ -78,3 +78,83 @@ resource "snowflake_schema" "test" {
 }
 `, databaseName, schemaName)
 }
+
+func TestAcc_SchemaCloneWithGrants(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	sourceName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	cloneName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	roleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: schemaCloneConfig(databaseName, sourceName, cloneName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_schema.clone", "name", cloneName),
+					resource.TestCheckResourceAttr("snowflake_schema.clone", "database", databaseName),
+					resource.TestCheckResourceAttr("snowflake_schema.clone", "clone.0.schema", sourceName),
+					resource.TestCheckResourceAttr("snowflake_schema.clone", "clone.0.reapply_grants_to_role", roleName),
+					// the role holds USAGE on both the source and the clone
+					resource.TestCheckResourceAttr("data.snowflake_role_grants_diff.clone", "additions.#", "0"),
+					resource.TestCheckResourceAttr("data.snowflake_role_grants_diff.clone", "in_sync", "true"),
+				),
+			},
+		},
+	})
+}
+
+func schemaCloneConfig(databaseName string, sourceName string, cloneName string, roleName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%v"
+}
+
+resource "snowflake_schema" "source" {
+	name = "%v"
+	database = snowflake_database.test.name
+}
+
+resource "snowflake_role" "test" {
+	name = "%v"
+}
+
+resource "snowflake_schema_grant" "test" {
+	database_name = snowflake_database.test.name
+	schema_name = snowflake_schema.source.name
+	privilege = "USAGE"
+	roles = [snowflake_role.test.name]
+}
+
+resource "snowflake_schema" "clone" {
+	name = "%v"
+	database = snowflake_database.test.name
+	comment = "Terraform acceptance test"
+	clone {
+		schema = snowflake_schema.source.name
+		reapply_grants_to_role = snowflake_role.test.name
+	}
+
+	depends_on = [snowflake_schema_grant.test]
+}
+
+data "snowflake_role_grants_diff" "clone" {
+	role = snowflake_role.test.name
+
+	desired {
+		privilege   = "USAGE"
+		object_type = "SCHEMA"
+		object_name = "${snowflake_database.test.name}.${snowflake_schema.source.name}"
+	}
+
+	desired {
+		privilege   = "USAGE"
+		object_type = "SCHEMA"
+		object_name = "${snowflake_database.test.name}.${snowflake_schema.clone.name}"
+	}
+
+	object_types = ["SCHEMA"]
+}
+`, databaseName, sourceName, roleName, cloneName)
+}
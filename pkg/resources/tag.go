@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 )
 
@@ -372,6 +373,17 @@ func (t tag) toSnowflakeTagValue() snowflake.TagValue {
 	}
 }
 
+func (t tags) toTagAssociations() []sdk.TagAssociation {
+	associations := make([]sdk.TagAssociation, len(t))
+	for i, tag := range t {
+		associations[i] = sdk.TagAssociation{
+			Name:  sdk.NewSchemaObjectIdentifier(tag.database, tag.schema, tag.name),
+			Value: tag.value,
+		}
+	}
+	return associations
+}
+
 func (t tags) getNewIn(new tags) (added tags) {
 	added = tags{}
 	for _, t0 := range t {
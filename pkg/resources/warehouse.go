@@ -151,9 +151,22 @@ func Warehouse() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customizeWarehouseDiff,
 	}
 }
 
+// customizeWarehouseDiff rejects multi-cluster warehouses in accounts that do not support them.
+func customizeWarehouseDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("max_cluster_count") {
+		return nil
+	}
+	if v, ok := d.GetOk("max_cluster_count"); ok && v.(int) > 1 {
+		return checkAccountFeature(ctx, meta, sdk.AccountFeatureMultiClusterWarehouses)
+	}
+	return nil
+}
+
 // CreateWarehouse implements schema.CreateFunc.
 func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -275,145 +288,76 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
-	// Change name separately
-	if d.HasChange("name") {
-		if v, ok := d.GetOk("name"); ok {
-			newName := sdk.NewAccountObjectIdentifier(v.(string))
-			err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{
-				NewName: newName,
-			})
-			if err != nil {
-				return err
-			}
-			d.SetId(helpers.EncodeSnowflakeID(newName))
-		} else {
-			panic("name has to be set")
-		}
+	current, err := warehouseProperties(id, func(key string) interface{} {
+		v, _ := d.GetChange(key)
+		return v
+	})
+	if err != nil {
+		return err
+	}
+	desired, err := warehouseProperties(sdk.NewAccountObjectIdentifier(d.Get("name").(string)), func(key string) interface{} {
+		_, v := d.GetChange(key)
+		return v
+	})
+	if err != nil {
+		return err
 	}
 
-	// Batch SET operations and UNSET operations
-	var runSet bool
-	var runUnset bool
-	set := sdk.WarehouseSet{}
-	unset := sdk.WarehouseUnset{}
-	if d.HasChange("comment") {
-		runSet = true
-		set.Comment = sdk.String(d.Get("comment").(string))
-	}
-	if d.HasChange("warehouse_size") {
-		runSet = true
-		v := d.Get("warehouse_size")
-		size, err := sdk.ToWarehouseSize(v.(string))
-		if err != nil {
+	statements := sdk.ComputeWarehouseAlterations(current, desired)
+	// the rename comes first, the ID has to follow it even when one of the following statements fails
+	if desired.Name.Name() != id.Name() {
+		if err := client.ExecStatements(ctx, statements[:1]); err != nil {
 			return err
 		}
-		set.WarehouseSize = &size
-	}
-	if d.HasChange("max_cluster_count") {
-		if v, ok := d.GetOk("max_cluster_count"); ok {
-			runSet = true
-			set.MaxClusterCount = sdk.Int(v.(int))
-		} else {
-			runUnset = true
-			unset.MaxClusterCount = sdk.Bool(true)
-		}
+		d.SetId(helpers.EncodeSnowflakeID(desired.Name))
+		statements = statements[1:]
 	}
-	if d.HasChange("min_cluster_count") {
-		if v, ok := d.GetOk("min_cluster_count"); ok {
-			runSet = true
-			set.MinClusterCount = sdk.Int(v.(int))
-		} else {
-			runUnset = true
-			unset.MinClusterCount = sdk.Bool(true)
+	return client.ExecStatements(ctx, statements)
+}
+
+// warehouseProperties reads the alterable properties of a warehouse from either the prior or the planned state.
+// Properties with a zero value are left unset, except for those that are always set explicitly.
+func warehouseProperties(id sdk.AccountObjectIdentifier, value func(key string) interface{}) (*sdk.WarehouseProperties, error) {
+	properties := &sdk.WarehouseProperties{
+		Name:                            id,
+		Comment:                         sdk.String(value("comment").(string)),
+		StatementTimeoutInSeconds:       sdk.Int(value("statement_timeout_in_seconds").(int)),
+		StatementQueuedTimeoutInSeconds: sdk.Int(value("statement_queued_timeout_in_seconds").(int)),
+		MaxConcurrencyLevel:             sdk.Int(value("max_concurrency_level").(int)),
+		EnableQueryAcceleration:         sdk.Bool(value("enable_query_acceleration").(bool)),
+		QueryAccelerationMaxScaleFactor: sdk.Int(value("query_acceleration_max_scale_factor").(int)),
+	}
+	if v := value("warehouse_size").(string); v != "" {
+		size, err := sdk.ToWarehouseSize(v)
+		if err != nil {
+			return nil, err
 		}
+		properties.WarehouseSize = &size
 	}
-	if d.HasChange("scaling_policy") {
-		if v, ok := d.GetOk("scaling_policy"); ok {
-			runSet = true
-			scalingPolicy := sdk.ScalingPolicy(v.(string))
-			set.ScalingPolicy = &scalingPolicy
-		} else {
-			runUnset = true
-			unset.ScalingPolicy = sdk.Bool(true)
-		}
+	if v := value("max_cluster_count").(int); v != 0 {
+		properties.MaxClusterCount = sdk.Int(v)
 	}
-	if d.HasChange("auto_suspend") {
-		if v, ok := d.GetOk("auto_suspend"); ok {
-			runSet = true
-			set.AutoSuspend = sdk.Int(v.(int))
-		} else {
-			runUnset = true
-			unset.AutoSuspend = sdk.Bool(true)
-		}
+	if v := value("min_cluster_count").(int); v != 0 {
+		properties.MinClusterCount = sdk.Int(v)
 	}
-	if d.HasChange("auto_resume") {
-		if v, ok := d.GetOk("auto_resume"); ok {
-			runSet = true
-			set.AutoResume = sdk.Bool(v.(bool))
-		} else {
-			runUnset = true
-			unset.AutoResume = sdk.Bool(true)
-		}
+	if v := value("scaling_policy").(string); v != "" {
+		scalingPolicy := sdk.ScalingPolicy(v)
+		properties.ScalingPolicy = &scalingPolicy
 	}
-	if d.HasChange("resource_monitor") {
-		if v, ok := d.GetOk("resource_monitor"); ok {
-			runSet = true
-			set.ResourceMonitor = sdk.NewAccountObjectIdentifier(v.(string))
-		} else {
-			runUnset = true
-			unset.ResourceMonitor = sdk.Bool(true)
-		}
+	if v := value("auto_suspend").(int); v != 0 {
+		properties.AutoSuspend = sdk.Int(v)
 	}
-	if d.HasChange("statement_timeout_in_seconds") {
-		runSet = true
-		set.StatementTimeoutInSeconds = sdk.Int(d.Get("statement_timeout_in_seconds").(int))
-	}
-	if d.HasChange("statement_queued_timeout_in_seconds") {
-		runSet = true
-		set.StatementQueuedTimeoutInSeconds = sdk.Int(d.Get("statement_queued_timeout_in_seconds").(int))
-	}
-	if d.HasChange("max_concurrency_level") {
-		runSet = true
-		set.MaxConcurrencyLevel = sdk.Int(d.Get("max_concurrency_level").(int))
-	}
-	if d.HasChange("enable_query_acceleration") {
-		runSet = true
-		set.EnableQueryAcceleration = sdk.Bool(d.Get("enable_query_acceleration").(bool))
-	}
-	if d.HasChange("query_acceleration_max_scale_factor") {
-		runSet = true
-		set.QueryAccelerationMaxScaleFactor = sdk.Int(d.Get("query_acceleration_max_scale_factor").(int))
-	}
-	if d.HasChange("warehouse_type") {
-		if v, ok := d.GetOk("warehouse_type"); ok {
-			runSet = true
-			whType := sdk.WarehouseType(v.(string))
-			set.WarehouseType = &whType
-		} else {
-			runUnset = true
-			unset.WarehouseType = sdk.Bool(true)
-		}
+	if v := value("auto_resume").(bool); v {
+		properties.AutoResume = sdk.Bool(v)
 	}
-
-	// Apply SET and UNSET changes
-	if runSet {
-		err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{
-			Set: &set,
-		})
-		if err != nil {
-			return err
-		}
+	if v := value("resource_monitor").(string); v != "" {
+		properties.ResourceMonitor = sdk.String(v)
 	}
-	if runUnset {
-		err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{
-			Unset: &unset,
-		})
-		if err != nil {
-			return err
-		}
+	if v := value("warehouse_type").(string); v != "" {
+		whType := sdk.WarehouseType(v)
+		properties.WarehouseType = &whType
 	}
-
-	return nil
+	return properties, nil
 }
 
 // DeleteWarehouse implements schema.DeleteFunc.
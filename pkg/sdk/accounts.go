@@ -12,7 +12,9 @@ type Accounts interface {
 	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateAccountOptions) error
 	// Alter modifies an existing account
 	Alter(ctx context.Context, opts *AlterAccountOptions) error
-	// Show returns a list of accounts.
+	// Drop removes an account. The account can be restored with UNDROP ACCOUNT until the grace period ends.
+	Drop(ctx context.Context, id AccountObjectIdentifier, gracePeriodInDays int, opts *DropAccountOptions) error
+	// Show returns the accounts of the organization. It requires the ORGADMIN role.
 	Show(ctx context.Context, opts *ShowAccountOptions) ([]*Account, error)
 	// ShowByID returns an account by id
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Account, error)
@@ -32,6 +34,12 @@ var (
 	EditionBusinessCritical AccountEdition = "BUSINESS_CRITICAL"
 )
 
+var allAccountEditions = []AccountEdition{
+	EditionStandard,
+	EditionEnterprise,
+	EditionBusinessCritical,
+}
+
 type CreateAccountOptions struct {
 	create  bool                    `ddl:"static" sql:"CREATE"`  //lint:ignore U1000 This is used in the ddl tag
 	account bool                    `ddl:"static" sql:"ACCOUNT"` //lint:ignore U1000 This is used in the ddl tag
@@ -75,7 +83,7 @@ func (c *accounts) Create(ctx context.Context, id AccountObjectIdentifier, opts
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	stmt, err := structToSQL(opts)
+	stmt, err := c.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -152,12 +160,24 @@ type AccountSet struct {
 	ResourceMonitor AccountObjectIdentifier `ddl:"identifier,equals" sql:"RESOURCE_MONITOR"`
 	PasswordPolicy  SchemaObjectIdentifier  `ddl:"identifier" sql:"PASSWORD POLICY"`
 	SessionPolicy   SchemaObjectIdentifier  `ddl:"identifier" sql:"SESSION POLICY"`
-	Tag             []TagAssociation        `ddl:"keyword" sql:"TAG"`
+	PackagesPolicy  SchemaObjectIdentifier  `ddl:"identifier" sql:"PACKAGES POLICY"`
+	// Force replaces the packages policy currently set on the account, it is only valid together with PackagesPolicy.
+	Force *bool            `ddl:"keyword" sql:"FORCE"`
+	Tag   []TagAssociation `ddl:"keyword" sql:"TAG"`
 }
 
 func (opts *AccountSet) validate() error {
-	if !anyValueSet(opts.Parameters, opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
-		return fmt.Errorf("at least one of parameters, resource monitor, password policy, session policy, or tag must be set")
+	if !anyValueSet(opts.Parameters, opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.PackagesPolicy, opts.Tag) {
+		return fmt.Errorf("at least one of parameters, resource monitor, password policy, session policy, packages policy, or tag must be set")
+	}
+	if valueSet(opts.PackagesPolicy) {
+		if !everyValueNil(opts.Parameters, opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
+			return fmt.Errorf("cannot set both packages policy and parameters, resource monitor, password policy, session policy, or tag")
+		}
+		return nil
+	}
+	if valueSet(opts.Force) {
+		return fmt.Errorf("force can only be set together with packages policy")
 	}
 	if valueSet(opts.Parameters) {
 		if !everyValueNil(opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
@@ -204,12 +224,19 @@ type AccountUnset struct {
 	Parameters     *AccountLevelParametersUnset `ddl:"list,no_parentheses"`
 	PasswordPolicy *bool                        `ddl:"keyword" sql:"PASSWORD POLICY"`
 	SessionPolicy  *bool                        `ddl:"keyword" sql:"SESSION POLICY"`
+	PackagesPolicy *bool                        `ddl:"keyword" sql:"PACKAGES POLICY"`
 	Tag            []ObjectIdentifier           `ddl:"keyword" sql:"TAG"`
 }
 
 func (opts *AccountUnset) validate() error {
-	if !anyValueSet(opts.Parameters, opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
-		return fmt.Errorf("at least one of parameters, password policy, session policy, or tag must be set")
+	if !anyValueSet(opts.Parameters, opts.PasswordPolicy, opts.SessionPolicy, opts.PackagesPolicy, opts.Tag) {
+		return fmt.Errorf("at least one of parameters, password policy, session policy, packages policy, or tag must be set")
+	}
+	if valueSet(opts.PackagesPolicy) {
+		if !everyValueNil(opts.Parameters, opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
+			return fmt.Errorf("cannot unset both packages policy and parameters, password policy, session policy, or tag")
+		}
+		return nil
 	}
 	if valueSet(opts.Parameters) {
 		if !everyValueNil(opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
@@ -274,7 +301,7 @@ func (c *accounts) Alter(ctx context.Context, opts *AlterAccountOptions) error {
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := c.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -282,6 +309,44 @@ func (c *accounts) Alter(ctx context.Context, opts *AlterAccountOptions) error {
 	return err
 }
 
+type DropAccountOptions struct {
+	drop              bool                    `ddl:"static" sql:"DROP"`    //lint:ignore U1000 This is used in the ddl tag
+	account           bool                    `ddl:"static" sql:"ACCOUNT"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists          *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name              AccountObjectIdentifier `ddl:"identifier"`
+	gracePeriodInDays int                     `ddl:"parameter" sql:"GRACE_PERIOD_IN_DAYS"`
+}
+
+// minimumAccountGracePeriodInDays is the shortest grace period Snowflake accepts when dropping an account.
+const minimumAccountGracePeriodInDays = 3
+
+func (opts *DropAccountOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.gracePeriodInDays < minimumAccountGracePeriodInDays {
+		return fmt.Errorf("gracePeriodInDays must be at least %d", minimumAccountGracePeriodInDays)
+	}
+	return nil
+}
+
+func (c *accounts) Drop(ctx context.Context, id AccountObjectIdentifier, gracePeriodInDays int, opts *DropAccountOptions) error {
+	if opts == nil {
+		opts = &DropAccountOptions{}
+	}
+	opts.name = id
+	opts.gracePeriodInDays = gracePeriodInDays
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	stmt, err := c.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.exec(ctx, stmt)
+	return err
+}
+
 type ShowAccountOptions struct {
 	show     bool  `ddl:"static" sql:"SHOW"`                  //lint:ignore U1000 This is used in the ddl tag
 	accounts bool  `ddl:"static" sql:"ORGANIZATION ACCOUNTS"` //lint:ignore U1000 This is used in the ddl tag
@@ -308,6 +373,7 @@ type Account struct {
 	MarketplaceConsumerBillingEntityName string
 	MarketplaceProviderBillingEntityName string
 	OldAccountURL                        string
+	OldAccountURLSavedOn                 string
 	IsOrgAdmin                           bool
 }
 
@@ -339,13 +405,17 @@ type accountDBRow struct {
 	IsOrgAdmin                           bool           `db:"is_org_admin"`
 }
 
-func (row accountDBRow) toAccount() *Account {
+func (row accountDBRow) toAccount(strict bool) (*Account, error) {
+	edition, err := toEnum(strict, "account edition", row.Edition, allAccountEditions)
+	if err != nil {
+		return nil, err
+	}
 	acc := &Account{
 		OrganizationName:                     row.OrganizationName,
 		AccountName:                          row.AccountName,
 		RegionGroup:                          "",
 		SnowflakeRegion:                      row.SnowflakeRegion,
-		Edition:                              AccountEdition(row.Edition),
+		Edition:                              edition,
 		AccountURL:                           row.AccountURL,
 		CreatedOn:                            row.CreatedOn,
 		Comment:                              row.Comment,
@@ -365,9 +435,12 @@ func (row accountDBRow) toAccount() *Account {
 		acc.MarketplaceProviderBillingEntityName = row.MarketplaceProviderBillingEntityName.String
 	}
 	if row.RegionGroup.Valid {
-		acc.SnowflakeRegion = row.RegionGroup.String
+		acc.RegionGroup = row.RegionGroup.String
 	}
-	return acc
+	if row.AccountOldURLSavedOn.Valid {
+		acc.OldAccountURLSavedOn = row.AccountOldURLSavedOn.String
+	}
+	return acc, nil
 }
 
 func (c *accounts) Show(ctx context.Context, opts *ShowAccountOptions) ([]*Account, error) {
@@ -377,7 +450,7 @@ func (c *accounts) Show(ctx context.Context, opts *ShowAccountOptions) ([]*Accou
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := c.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -388,7 +461,10 @@ func (c *accounts) Show(ctx context.Context, opts *ShowAccountOptions) ([]*Accou
 	}
 	resultList := make([]*Account, len(dest))
 	for i, row := range dest {
-		resultList[i] = row.toAccount()
+		resultList[i], err = row.toAccount(c.client.strictEnumParsing)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return resultList, nil
@@ -396,9 +472,7 @@ func (c *accounts) Show(ctx context.Context, opts *ShowAccountOptions) ([]*Accou
 
 func (c *accounts) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Account, error) {
 	accounts, err := c.Show(ctx, &ShowAccountOptions{
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+		Like: likeExactly(id.Name()),
 	})
 	if err != nil {
 		return nil, err
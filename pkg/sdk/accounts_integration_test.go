@@ -96,6 +96,12 @@ func TestInt_AccountCreate(t *testing.T) {
 		require.NoError(t, err)
 		_, err = client.Accounts.ShowByID(ctx, newAccountID)
 		require.NoError(t, err)
+
+		// drop
+		err = client.Accounts.Drop(ctx, newAccountID, 3, &DropAccountOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+		_, err = client.Accounts.ShowByID(ctx, newAccountID)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
 	})
 }
 
@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -152,6 +153,39 @@ func TestAccountAlter(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("with set packages policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				PackagesPolicy: NewSchemaObjectIdentifier("db", "schema", "packpol"),
+				Force:          Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER ACCOUNT SET PACKAGES POLICY "db"."schema"."packpol" FORCE`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: force without packages policy", func(t *testing.T) {
+		opts := &AccountSet{
+			SessionPolicy: NewSchemaObjectIdentifier("db", "schema", "sesspol"),
+			Force:         Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("with unset packages policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Unset: &AccountUnset{
+				PackagesPolicy: Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER ACCOUNT UNSET PACKAGES POLICY`
+		assert.Equal(t, expected, actual)
+	})
+
 	t.Run("with set tag", func(t *testing.T) {
 		opts := &AlterAccountOptions{
 			Set: &AccountSet{
@@ -218,6 +252,44 @@ func TestAccountAlter(t *testing.T) {
 	})
 }
 
+func TestAccountDrop(t *testing.T) {
+	t.Run("with grace period", func(t *testing.T) {
+		opts := &DropAccountOptions{
+			IfExists:          Bool(true),
+			name:              NewAccountObjectIdentifier("myaccount"),
+			gracePeriodInDays: 7,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `DROP ACCOUNT IF EXISTS "myaccount" GRACE_PERIOD_IN_DAYS = 7`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: grace period too short", func(t *testing.T) {
+		opts := &DropAccountOptions{
+			name:              NewAccountObjectIdentifier("myaccount"),
+			gracePeriodInDays: 1,
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestAccountRow(t *testing.T) {
+	row := accountDBRow{
+		AccountName:          "myaccount",
+		RegionGroup:          sql.NullString{String: "PUBLIC", Valid: true},
+		SnowflakeRegion:      "AWS_US_WEST_2",
+		Edition:              "ENTERPRISE",
+		AccountOldURLSavedOn: sql.NullString{String: "2023-07-01 12:00:00.000 -0700", Valid: true},
+	}
+	account, err := row.toAccount(true)
+	require.NoError(t, err)
+	assert.Equal(t, "PUBLIC", account.RegionGroup)
+	assert.Equal(t, "AWS_US_WEST_2", account.SnowflakeRegion)
+	assert.Equal(t, "2023-07-01 12:00:00.000 -0700", account.OldAccountURLSavedOn)
+}
+
 func TestAccountShow(t *testing.T) {
 	t.Run("empty options", func(t *testing.T) {
 		opts := &ShowAccountOptions{}
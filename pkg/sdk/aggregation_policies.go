@@ -0,0 +1,384 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ AggregationPolicies = (*aggregationPolicies)(nil)
+
+// AggregationPolicies require queries on a table or view to aggregate the data into groups of a minimum size.
+type AggregationPolicies interface {
+	// Create creates a new aggregation policy. The body must evaluate to AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => ...).
+	Create(ctx context.Context, id SchemaObjectIdentifier, body string, opts *CreateAggregationPolicyOptions) error
+	// Alter modifies an existing aggregation policy.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAggregationPolicyOptions) error
+	// Drop removes an aggregation policy.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropAggregationPolicyOptions) error
+	// Show returns a list of aggregation policies.
+	Show(ctx context.Context, opts *ShowAggregationPolicyOptions) ([]*AggregationPolicy, error)
+	// ShowByID returns an aggregation policy by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicy, error)
+	// Describe returns the details of an aggregation policy.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicyDetails, error)
+	// SetOnObject attaches an aggregation policy to a table or view.
+	SetOnObject(ctx context.Context, id SchemaObjectIdentifier, object *PolicyObject, opts *SetAggregationPolicyOnObjectOptions) error
+	// UnsetFromObject detaches the aggregation policy from a table or view.
+	UnsetFromObject(ctx context.Context, object *PolicyObject) error
+}
+
+// aggregationPolicies implements AggregationPolicies.
+type aggregationPolicies struct {
+	client *Client
+}
+
+type CreateAggregationPolicyOptions struct {
+	create            bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace         *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	aggregationPolicy bool                   `ddl:"static" sql:"AGGREGATION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists       *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name              SchemaObjectIdentifier `ddl:"identifier"`
+	returns           bool                   `ddl:"static" sql:"AS () RETURNS AGGREGATION_CONSTRAINT"` //lint:ignore U1000 This is used in the ddl tag
+
+	// required
+	body string `ddl:"parameter,no_equals" sql:"->"`
+
+	// optional
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateAggregationPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.body == "" {
+		return errors.New("body is required")
+	}
+	return nil
+}
+
+func (v *aggregationPolicies) Create(ctx context.Context, id SchemaObjectIdentifier, body string, opts *CreateAggregationPolicyOptions) error {
+	if opts == nil {
+		opts = &CreateAggregationPolicyOptions{}
+	}
+	opts.name = id
+	opts.body = body
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterAggregationPolicyOptions struct {
+	alter             bool                   `ddl:"static" sql:"ALTER"`              //lint:ignore U1000 This is used in the ddl tag
+	aggregationPolicy bool                   `ddl:"static" sql:"AGGREGATION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists          *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name              SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	NewName  SchemaObjectIdentifier  `ddl:"identifier" sql:"RENAME TO"`
+	Set      *AggregationPolicySet   `ddl:"keyword" sql:"SET"`
+	Unset    *AggregationPolicyUnset `ddl:"keyword" sql:"UNSET"`
+	SetTag   []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterAggregationPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of NewName, Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) && !exactlyOneValueSet(opts.Set.Body, opts.Set.Comment) {
+		return errors.New("exactly one of Body or Comment must be set")
+	}
+	if valueSet(opts.Unset) && !valueSet(opts.Unset.Comment) {
+		return errors.New("Comment must be unset")
+	}
+	return nil
+}
+
+// AggregationPolicySet sets either the body or the comment, Snowflake does not accept both at once.
+type AggregationPolicySet struct {
+	Body    *string `ddl:"parameter,no_equals" sql:"BODY ->"`
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type AggregationPolicyUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *aggregationPolicies) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAggregationPolicyOptions) error {
+	if opts == nil {
+		opts = &AlterAggregationPolicyOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropAggregationPolicyOptions struct {
+	drop              bool                   `ddl:"static" sql:"DROP"`               //lint:ignore U1000 This is used in the ddl tag
+	aggregationPolicy bool                   `ddl:"static" sql:"AGGREGATION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists          *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name              SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropAggregationPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *aggregationPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropAggregationPolicyOptions) error {
+	if opts == nil {
+		opts = &DropAggregationPolicyOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowAggregationPolicyOptions struct {
+	show                bool `ddl:"static" sql:"SHOW"`                 //lint:ignore U1000 This is used in the ddl tag
+	aggregationPolicies bool `ddl:"static" sql:"AGGREGATION POLICIES"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like  *Like      `ddl:"keyword" sql:"LIKE"`
+	In    *In        `ddl:"keyword" sql:"IN"`
+	Limit *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+func (opts *ShowAggregationPolicyOptions) validate() error {
+	return nil
+}
+
+type AggregationPolicy struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Kind         string
+	Owner        string
+	Comment      string
+}
+
+func (v *AggregationPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *AggregationPolicy) ObjectType() ObjectType {
+	return ObjectTypeAggregationPolicy
+}
+
+type aggregationPolicyRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Kind         sql.NullString `db:"kind"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+func (row aggregationPolicyRow) toAggregationPolicy() *AggregationPolicy {
+	return &AggregationPolicy{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Kind:         row.Kind.String,
+		Owner:        row.Owner.String,
+		Comment:      row.Comment.String,
+	}
+}
+
+func (v *aggregationPolicies) Show(ctx context.Context, opts *ShowAggregationPolicyOptions) ([]*AggregationPolicy, error) {
+	if opts == nil {
+		opts = &ShowAggregationPolicyOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []aggregationPolicyRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*AggregationPolicy, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toAggregationPolicy()
+	}
+	return resultList, nil
+}
+
+func (v *aggregationPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicy, error) {
+	policies, err := v.Show(ctx, &ShowAggregationPolicyOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		if policy.Name == id.Name() {
+			return policy, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeAggregationPolicyOptions struct {
+	describe          bool                   `ddl:"static" sql:"DESCRIBE"`           //lint:ignore U1000 This is used in the ddl tag
+	aggregationPolicy bool                   `ddl:"static" sql:"AGGREGATION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	name              SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeAggregationPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type AggregationPolicyDetails struct {
+	Name       string
+	Signature  string
+	ReturnType string
+	Body       string
+}
+
+type aggregationPolicyDetailsRow struct {
+	Name       string `db:"name"`
+	Signature  string `db:"signature"`
+	ReturnType string `db:"return_type"`
+	Body       string `db:"body"`
+}
+
+func (v *aggregationPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicyDetails, error) {
+	opts := &describeAggregationPolicyOptions{
+		name: id,
+	}
+	dest, err := describeRow[aggregationPolicyDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &AggregationPolicyDetails{
+		Name:       dest.Name,
+		Signature:  dest.Signature,
+		ReturnType: dest.ReturnType,
+		Body:       dest.Body,
+	}, nil
+}
+
+// PolicyObject is a table or view a policy is attached to. Exactly one of Table or View must be set.
+type PolicyObject struct {
+	Table SchemaObjectIdentifier `ddl:"identifier" sql:"TABLE"`
+	View  SchemaObjectIdentifier `ddl:"identifier" sql:"VIEW"`
+}
+
+func (o *PolicyObject) validate() error {
+	if !exactlyOneValueSet(o.Table, o.View) {
+		return errors.New("exactly one of Table or View must be set")
+	}
+	return nil
+}
+
+type SetAggregationPolicyOnObjectOptions struct {
+	alter             bool                   `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	object            *PolicyObject          `ddl:"-"`
+	aggregationPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"SET AGGREGATION POLICY"`
+	// EntityKey lists the columns that identify an entity, e.g. a user, so that the minimum group size
+	// counts distinct entities instead of rows.
+	EntityKey []string `ddl:"keyword,parentheses" sql:"ENTITY KEY"`
+	// Force replaces an aggregation policy already attached to the object.
+	Force *bool `ddl:"keyword" sql:"FORCE"`
+}
+
+func (opts *SetAggregationPolicyOnObjectOptions) validate() error {
+	if !validObjectidentifier(opts.aggregationPolicy) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.object) {
+		return errors.New("object is required")
+	}
+	return opts.object.validate()
+}
+
+func (v *aggregationPolicies) SetOnObject(ctx context.Context, id SchemaObjectIdentifier, object *PolicyObject, opts *SetAggregationPolicyOnObjectOptions) error {
+	if opts == nil {
+		opts = &SetAggregationPolicyOnObjectOptions{}
+	}
+	opts.aggregationPolicy = id
+	opts.object = object
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type unsetAggregationPolicyFromObjectOptions struct {
+	alter                  bool          `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	object                 *PolicyObject `ddl:"-"`
+	unsetAggregationPolicy bool          `ddl:"static" sql:"UNSET AGGREGATION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+}
+
+func (opts *unsetAggregationPolicyFromObjectOptions) validate() error {
+	if !valueSet(opts.object) {
+		return errors.New("object is required")
+	}
+	return opts.object.validate()
+}
+
+func (v *aggregationPolicies) UnsetFromObject(ctx context.Context, object *PolicyObject) error {
+	opts := &unsetAggregationPolicyFromObjectOptions{
+		object: object,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
@@ -0,0 +1,56 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_AggregationPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	table, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err := client.AggregationPolicies.Create(ctx, id, "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)", &CreateAggregationPolicyOptions{Comment: String("some comment")})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.AggregationPolicies.Drop(ctx, id, &DropAggregationPolicyOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		policy, err := client.AggregationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, policy.ID())
+		assert.Equal(t, "some comment", policy.Comment)
+	})
+
+	t.Run("alter body and describe", func(t *testing.T) {
+		body := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 10)"
+		err := client.AggregationPolicies.Alter(ctx, id, &AlterAggregationPolicyOptions{
+			Set: &AggregationPolicySet{Body: String(body)},
+		})
+		require.NoError(t, err)
+		details, err := client.AggregationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, body, details.Body)
+		assert.Equal(t, "AGGREGATION_CONSTRAINT", details.ReturnType)
+	})
+
+	t.Run("set on and unset from table", func(t *testing.T) {
+		object := &PolicyObject{Table: table}
+		err := client.AggregationPolicies.SetOnObject(ctx, id, object, &SetAggregationPolicyOnObjectOptions{EntityKey: []string{"ID"}})
+		require.NoError(t, err)
+		err = client.AggregationPolicies.UnsetFromObject(ctx, object)
+		require.NoError(t, err)
+	})
+}
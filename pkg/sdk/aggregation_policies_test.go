@@ -0,0 +1,126 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregationPolicyCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "aggpol")
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateAggregationPolicyOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			body:        "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)",
+			Comment:     String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE AGGREGATION POLICY IF NOT EXISTS "db"."schema"."aggpol" AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5) COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateAggregationPolicyOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        id,
+			body:        "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)",
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestAggregationPolicyAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "aggpol")
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterAggregationPolicyOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("with set body", func(t *testing.T) {
+		opts := &AlterAggregationPolicyOptions{
+			name: id,
+			Set:  &AggregationPolicySet{Body: String("AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 10)")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER AGGREGATION POLICY "db"."schema"."aggpol" SET BODY -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 10)`, actual)
+	})
+
+	t.Run("with set comment", func(t *testing.T) {
+		opts := &AlterAggregationPolicyOptions{
+			name: id,
+			Set:  &AggregationPolicySet{Comment: String("some comment")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER AGGREGATION POLICY "db"."schema"."aggpol" SET COMMENT = 'some comment'`, actual)
+	})
+}
+
+func TestAggregationPolicyDrop(t *testing.T) {
+	opts := &DropAggregationPolicyOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "aggpol"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP AGGREGATION POLICY IF EXISTS "db"."schema"."aggpol"`, actual)
+}
+
+func TestAggregationPolicyShow(t *testing.T) {
+	opts := &ShowAggregationPolicyOptions{
+		Like: &Like{Pattern: String("aggpol")},
+		In:   &In{Schema: NewSchemaIdentifier("db", "schema")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW AGGREGATION POLICIES LIKE 'aggpol' IN SCHEMA "db"."schema"`, actual)
+}
+
+func TestAggregationPolicyDescribe(t *testing.T) {
+	opts := &describeAggregationPolicyOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "aggpol"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE AGGREGATION POLICY "db"."schema"."aggpol"`, actual)
+}
+
+func TestAggregationPolicyObjectAttachment(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "aggpol")
+	table := NewSchemaObjectIdentifier("db", "schema", "table")
+
+	t.Run("set on table with entity key", func(t *testing.T) {
+		opts := &SetAggregationPolicyOnObjectOptions{
+			object:            &PolicyObject{Table: table},
+			aggregationPolicy: id,
+			EntityKey:         []string{"user_id", "account_id"},
+			Force:             Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" SET AGGREGATION POLICY "db"."schema"."aggpol" ENTITY KEY (user_id, account_id) FORCE`, actual)
+	})
+
+	t.Run("unset from view", func(t *testing.T) {
+		opts := &unsetAggregationPolicyFromObjectOptions{
+			object: &PolicyObject{View: NewSchemaObjectIdentifier("db", "schema", "view")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER VIEW "db"."schema"."view" UNSET AGGREGATION POLICY`, actual)
+	})
+
+	t.Run("validation: no object", func(t *testing.T) {
+		opts := &SetAggregationPolicyOnObjectOptions{
+			object:            &PolicyObject{},
+			aggregationPolicy: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
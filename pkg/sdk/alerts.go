@@ -0,0 +1,468 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Alerts = (*alerts)(nil)
+
+// Alerts run an action whenever a condition evaluated on a schedule returns rows.
+type Alerts interface {
+	// Create creates a new alert. Without a warehouse the alert runs on serverless compute.
+	Create(ctx context.Context, id SchemaObjectIdentifier, schedule string, condition string, action string, opts *CreateAlertOptions) error
+	// Alter modifies an existing alert.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAlertOptions) error
+	// Drop removes an alert.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropAlertOptions) error
+	// Show returns a list of alerts.
+	Show(ctx context.Context, opts *ShowAlertOptions) ([]*Alert, error)
+	// ShowByID returns an alert by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Alert, error)
+	// Describe returns the details of an alert.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*Alert, error)
+	// History returns the executions of an alert, most recent first.
+	History(ctx context.Context, id SchemaObjectIdentifier, opts *AlertHistoryOptions) ([]*AlertHistoryEntry, error)
+}
+
+// alerts implements Alerts.
+type alerts struct {
+	client *Client
+}
+
+type AlertState string
+
+const (
+	AlertStateStarted   AlertState = "started"
+	AlertStateSuspended AlertState = "suspended"
+)
+
+var allAlertStates = []AlertState{
+	AlertStateStarted,
+	AlertStateSuspended,
+}
+
+type AlertCondition struct {
+	Condition []string `ddl:"keyword,parentheses" sql:"EXISTS"`
+}
+
+type CreateAlertOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	alert       bool                   `ddl:"static" sql:"ALERT"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+
+	// optional, the alert is serverless when no warehouse is set
+	Warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+
+	// required
+	schedule string `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
+
+	// optional
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+
+	// required
+	condition []AlertCondition `ddl:"keyword,parentheses" sql:"IF"`
+	action    string           `ddl:"parameter,no_equals" sql:"THEN"`
+}
+
+func (opts *CreateAlertOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.schedule == "" {
+		return errors.New("schedule is required")
+	}
+	if len(opts.condition) == 0 || len(opts.condition[0].Condition) == 0 || opts.condition[0].Condition[0] == "" {
+		return errors.New("condition is required")
+	}
+	if opts.action == "" {
+		return errors.New("action is required")
+	}
+	return nil
+}
+
+func (v *alerts) Create(ctx context.Context, id SchemaObjectIdentifier, schedule string, condition string, action string, opts *CreateAlertOptions) error {
+	if opts == nil {
+		opts = &CreateAlertOptions{}
+	}
+	opts.name = id
+	opts.schedule = schedule
+	opts.condition = []AlertCondition{{Condition: []string{condition}}}
+	opts.action = action
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlertAction string
+
+const (
+	AlertActionResume  AlertAction = "RESUME"
+	AlertActionSuspend AlertAction = "SUSPEND"
+)
+
+type AlterAlertOptions struct {
+	alter    bool                   `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	alert    bool                   `ddl:"static" sql:"ALERT"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	Action          *AlertAction `ddl:"keyword"`
+	Set             *AlertSet    `ddl:"keyword" sql:"SET"`
+	Unset           *AlertUnset  `ddl:"list,no_parentheses" sql:"UNSET"`
+	ModifyCondition []string     `ddl:"keyword,parentheses" sql:"MODIFY CONDITION EXISTS"`
+	ModifyAction    *string      `ddl:"parameter,no_equals" sql:"MODIFY ACTION"`
+}
+
+func (opts *AlterAlertOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Action, opts.Set, opts.Unset, opts.ModifyCondition, opts.ModifyAction) {
+		return errors.New("exactly one of Action, Set, Unset, ModifyCondition, ModifyAction must be set")
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.Warehouse, opts.Set.Schedule, opts.Set.Comment) {
+		return errors.New("at least one of Warehouse, Schedule, Comment must be set")
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.Warehouse, opts.Unset.Schedule, opts.Unset.Comment) {
+		return errors.New("at least one of Warehouse, Schedule, Comment must be set")
+	}
+	return nil
+}
+
+type AlertSet struct {
+	Warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	Schedule  *string                 `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
+	Comment   *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type AlertUnset struct {
+	Warehouse *bool `ddl:"keyword" sql:"WAREHOUSE"`
+	Schedule  *bool `ddl:"keyword" sql:"SCHEDULE"`
+	Comment   *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *alerts) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAlertOptions) error {
+	if opts == nil {
+		opts = &AlterAlertOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropAlertOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`  //lint:ignore U1000 This is used in the ddl tag
+	alert    bool                   `ddl:"static" sql:"ALERT"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropAlertOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *alerts) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropAlertOptions) error {
+	if opts == nil {
+		opts = &DropAlertOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowAlertOptions struct {
+	show   bool  `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Terse  *bool `ddl:"keyword" sql:"TERSE"`
+	alerts bool  `ddl:"static" sql:"ALERTS"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	In   *In   `ddl:"keyword" sql:"IN"`
+	ShowOptions
+}
+
+func (opts *ShowAlertOptions) validate() error {
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type Alert struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Owner         string
+	Comment       string
+	Warehouse     string
+	Schedule      string
+	State         AlertState
+	Condition     string
+	Action        string
+	OwnerRoleType string
+}
+
+func (v *Alert) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Alert) ObjectType() ObjectType {
+	return ObjectTypeAlert
+}
+
+// Serverless reports whether the alert runs on serverless compute.
+func (v *Alert) Serverless() bool {
+	return v.Warehouse == ""
+}
+
+// alertDBRow is used to decode the result of SHOW ALERTS and DESCRIBE ALERT.
+type alertDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Owner         sql.NullString `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	Warehouse     sql.NullString `db:"warehouse"`
+	Schedule      sql.NullString `db:"schedule"`
+	State         sql.NullString `db:"state"`
+	Condition     sql.NullString `db:"condition"`
+	Action        sql.NullString `db:"action"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+func (row alertDBRow) toAlert(strict bool) (*Alert, error) {
+	alert := &Alert{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		DatabaseName:  row.DatabaseName,
+		SchemaName:    row.SchemaName,
+		Owner:         row.Owner.String,
+		Comment:       row.Comment.String,
+		Warehouse:     row.Warehouse.String,
+		Schedule:      row.Schedule.String,
+		Condition:     row.Condition.String,
+		Action:        row.Action.String,
+		OwnerRoleType: row.OwnerRoleType.String,
+	}
+	if row.State.Valid {
+		state, err := toEnum(strict, "alert state", strings.ToLower(row.State.String), allAlertStates)
+		if err != nil {
+			return nil, err
+		}
+		alert.State = state
+	}
+	return alert, nil
+}
+
+func (v *alerts) Show(ctx context.Context, opts *ShowAlertOptions) ([]*Alert, error) {
+	if opts == nil {
+		opts = &ShowAlertOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []alertDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Alert, len(dest))
+	for i, row := range dest {
+		resultList[i], err = row.toAlert(v.client.strictEnumParsing)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resultList, nil
+}
+
+func (v *alerts) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Alert, error) {
+	alerts, err := v.Show(ctx, &ShowAlertOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, alert := range alerts {
+		if alert.Name == id.Name() {
+			return alert, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeAlertOptions struct {
+	describe bool                   `ddl:"static" sql:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	alert    bool                   `ddl:"static" sql:"ALERT"`    //lint:ignore U1000 This is used in the ddl tag
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeAlertOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *alerts) Describe(ctx context.Context, id SchemaObjectIdentifier) (*Alert, error) {
+	opts := &describeAlertOptions{
+		name: id,
+	}
+	dest, err := describeRow[alertDBRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toAlert(v.client.strictEnumParsing)
+}
+
+// AlertHistoryOptions filters the result of the INFORMATION_SCHEMA.ALERT_HISTORY table function.
+type AlertHistoryOptions struct {
+	ScheduledTimeRangeStart *time.Time
+	ScheduledTimeRangeEnd   *time.Time
+	// ResultLimit is at most 10000, Snowflake returns 100 rows by default.
+	ResultLimit *int
+}
+
+func (opts *AlertHistoryOptions) validate() error {
+	if valueSet(opts.ResultLimit) && !validateIntInRange(*opts.ResultLimit, 1, 10000) {
+		return errors.New("ResultLimit must be between 1 and 10000")
+	}
+	return nil
+}
+
+type AlertHistoryEntry struct {
+	Name             string
+	DatabaseName     string
+	SchemaName       string
+	Condition        string
+	ConditionQueryID string
+	Action           string
+	ActionQueryID    string
+	State            string
+	SQLErrorCode     string
+	SQLErrorMessage  string
+	ScheduledTime    time.Time
+	CompletedTime    time.Time
+}
+
+type alertHistoryRow struct {
+	Name             string         `db:"NAME"`
+	DatabaseName     string         `db:"DATABASE_NAME"`
+	SchemaName       string         `db:"SCHEMA_NAME"`
+	Condition        sql.NullString `db:"CONDITION"`
+	ConditionQueryID sql.NullString `db:"CONDITION_QUERY_ID"`
+	Action           sql.NullString `db:"ACTION"`
+	ActionQueryID    sql.NullString `db:"ACTION_QUERY_ID"`
+	State            sql.NullString `db:"STATE"`
+	SQLErrorCode     sql.NullString `db:"SQL_ERROR_CODE"`
+	SQLErrorMessage  sql.NullString `db:"SQL_ERROR_MESSAGE"`
+	ScheduledTime    sql.NullTime   `db:"SCHEDULED_TIME"`
+	CompletedTime    sql.NullTime   `db:"COMPLETED_TIME"`
+}
+
+func (row alertHistoryRow) toAlertHistoryEntry() *AlertHistoryEntry {
+	entry := &AlertHistoryEntry{
+		Name:             row.Name,
+		DatabaseName:     row.DatabaseName,
+		SchemaName:       row.SchemaName,
+		Condition:        row.Condition.String,
+		ConditionQueryID: row.ConditionQueryID.String,
+		Action:           row.Action.String,
+		ActionQueryID:    row.ActionQueryID.String,
+		State:            row.State.String,
+		SQLErrorCode:     row.SQLErrorCode.String,
+		SQLErrorMessage:  row.SQLErrorMessage.String,
+	}
+	if row.ScheduledTime.Valid {
+		entry.ScheduledTime = row.ScheduledTime.Time
+	}
+	if row.CompletedTime.Valid {
+		entry.CompletedTime = row.CompletedTime.Time
+	}
+	return entry
+}
+
+// alertHistorySQL builds the query for the alert history. The table function lives in the
+// information schema of the database of the alert and only returns alerts the current role can see.
+func alertHistorySQL(id SchemaObjectIdentifier, opts *AlertHistoryOptions) string {
+	args := []string{fmt.Sprintf("ALERT_NAME => '%s'", escapeStringLiteral(id.Name()))}
+	if opts.ScheduledTimeRangeStart != nil {
+		args = append(args, fmt.Sprintf("SCHEDULED_TIME_RANGE_START => TO_TIMESTAMP_LTZ('%s')", opts.ScheduledTimeRangeStart.Format(time.RFC3339)))
+	}
+	if opts.ScheduledTimeRangeEnd != nil {
+		args = append(args, fmt.Sprintf("SCHEDULED_TIME_RANGE_END => TO_TIMESTAMP_LTZ('%s')", opts.ScheduledTimeRangeEnd.Format(time.RFC3339)))
+	}
+	if opts.ResultLimit != nil {
+		args = append(args, fmt.Sprintf("RESULT_LIMIT => %d", *opts.ResultLimit))
+	}
+	database := NewAccountObjectIdentifier(id.DatabaseName())
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s.INFORMATION_SCHEMA.ALERT_HISTORY(%s)) WHERE SCHEMA_NAME = '%s' ORDER BY SCHEDULED_TIME DESC`, database.FullyQualifiedName(), strings.Join(args, ", "), escapeStringLiteral(id.SchemaName()))
+}
+
+func (v *alerts) History(ctx context.Context, id SchemaObjectIdentifier, opts *AlertHistoryOptions) ([]*AlertHistoryEntry, error) {
+	if opts == nil {
+		opts = &AlertHistoryOptions{}
+	}
+	if !validObjectidentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	dest := []alertHistoryRow{}
+	err := v.client.query(ctx, &dest, alertHistorySQL(id, opts))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*AlertHistoryEntry, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toAlertHistoryEntry()
+	}
+	return resultList, nil
+}
@@ -0,0 +1,121 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Alerts(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	warehouse, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err := client.Alerts.Create(ctx, id, "60 minutes", "SELECT 1", "SELECT 2", &CreateAlertOptions{
+		Warehouse: warehouse.ID(),
+		Comment:   String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Alerts.Drop(ctx, id, &DropAlertOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		alert, err := client.Alerts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, alert.ID())
+		assert.Equal(t, warehouse.Name, alert.Warehouse)
+		assert.Equal(t, "60 minutes", alert.Schedule)
+		assert.Equal(t, AlertStateSuspended, alert.State)
+		assert.Equal(t, "some comment", alert.Comment)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		alert, err := client.Alerts.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT 1", alert.Condition)
+		assert.Equal(t, "SELECT 2", alert.Action)
+	})
+
+	t.Run("alter: resume and suspend", func(t *testing.T) {
+		resume := AlertActionResume
+		err := client.Alerts.Alter(ctx, id, &AlterAlertOptions{Action: &resume})
+		require.NoError(t, err)
+		alert, err := client.Alerts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, AlertStateStarted, alert.State)
+
+		suspend := AlertActionSuspend
+		err = client.Alerts.Alter(ctx, id, &AlterAlertOptions{Action: &suspend})
+		require.NoError(t, err)
+		alert, err = client.Alerts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, AlertStateSuspended, alert.State)
+	})
+
+	t.Run("alter: modify condition and action", func(t *testing.T) {
+		err := client.Alerts.Alter(ctx, id, &AlterAlertOptions{ModifyCondition: []string{"SELECT 3"}})
+		require.NoError(t, err)
+		err = client.Alerts.Alter(ctx, id, &AlterAlertOptions{ModifyAction: String("SELECT 4")})
+		require.NoError(t, err)
+		alert, err := client.Alerts.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT 3", alert.Condition)
+		assert.Equal(t, "SELECT 4", alert.Action)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		err := client.Alerts.Alter(ctx, id, &AlterAlertOptions{Set: &AlertSet{Schedule: String("30 minutes")}})
+		require.NoError(t, err)
+		err = client.Alerts.Alter(ctx, id, &AlterAlertOptions{Unset: &AlertUnset{Comment: Bool(true)}})
+		require.NoError(t, err)
+		alert, err := client.Alerts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "30 minutes", alert.Schedule)
+		assert.Equal(t, "", alert.Comment)
+	})
+
+	t.Run("show in schema", func(t *testing.T) {
+		alerts, err := client.Alerts.Show(ctx, &ShowAlertOptions{In: &In{Schema: schema.ID()}})
+		require.NoError(t, err)
+		assert.Len(t, alerts, 1)
+	})
+
+	t.Run("history", func(t *testing.T) {
+		_, err := client.Alerts.History(ctx, id, &AlertHistoryOptions{ResultLimit: Int(10)})
+		require.NoError(t, err)
+	})
+
+	t.Run("serverless", func(t *testing.T) {
+		serverlessID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+		err := client.Alerts.Create(ctx, serverlessID, "60 minutes", "SELECT 1", "SELECT 2", nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Alerts.Drop(ctx, serverlessID, nil)
+			require.NoError(t, err)
+		})
+		alert, err := client.Alerts.ShowByID(ctx, serverlessID)
+		require.NoError(t, err)
+		assert.True(t, alert.Serverless())
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		dropID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+		err := client.Alerts.Create(ctx, dropID, "60 minutes", "SELECT 1", "SELECT 2", &CreateAlertOptions{Warehouse: warehouse.ID()})
+		require.NoError(t, err)
+		err = client.Alerts.Drop(ctx, dropID, nil)
+		require.NoError(t, err)
+		_, err = client.Alerts.ShowByID(ctx, dropID)
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,240 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "myalert")
+
+	t.Run("with warehouse", func(t *testing.T) {
+		opts := &CreateAlertOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			Warehouse: NewAccountObjectIdentifier("wh"),
+			schedule:  "1 minute",
+			Comment:   String("some comment"),
+			condition: []AlertCondition{{Condition: []string{"SELECT 1"}}},
+			action:    "SELECT 2",
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE ALERT "db"."schema"."myalert" WAREHOUSE = "wh" SCHEDULE = '1 minute' COMMENT = 'some comment' IF (EXISTS (SELECT 1)) THEN SELECT 2`, actual)
+	})
+
+	t.Run("serverless", func(t *testing.T) {
+		opts := &CreateAlertOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			schedule:    "USING CRON 0 * * * * UTC",
+			condition:   []AlertCondition{{Condition: []string{"SELECT 1"}}},
+			action:      "SELECT 2",
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE ALERT IF NOT EXISTS "db"."schema"."myalert" SCHEDULE = 'USING CRON 0 * * * * UTC' IF (EXISTS (SELECT 1)) THEN SELECT 2`, actual)
+	})
+
+	t.Run("validation: missing condition", func(t *testing.T) {
+		opts := &CreateAlertOptions{
+			name:      id,
+			schedule:  "1 minute",
+			condition: []AlertCondition{{Condition: []string{""}}},
+			action:    "SELECT 2",
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: missing schedule", func(t *testing.T) {
+		opts := &CreateAlertOptions{
+			name:      id,
+			condition: []AlertCondition{{Condition: []string{"SELECT 1"}}},
+			action:    "SELECT 2",
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestAlertAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "myalert")
+
+	t.Run("resume", func(t *testing.T) {
+		action := AlertActionResume
+		opts := &AlterAlertOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Action:   &action,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ALERT IF EXISTS "db"."schema"."myalert" RESUME`, actual)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name: id,
+			Set: &AlertSet{
+				Warehouse: NewAccountObjectIdentifier("wh"),
+				Schedule:  String("5 minutes"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ALERT "db"."schema"."myalert" SET WAREHOUSE = "wh" SCHEDULE = '5 minutes'`, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name: id,
+			Unset: &AlertUnset{
+				Warehouse: Bool(true),
+				Comment:   Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ALERT "db"."schema"."myalert" UNSET WAREHOUSE, COMMENT`, actual)
+	})
+
+	t.Run("modify condition", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name:            id,
+			ModifyCondition: []string{"SELECT 1"},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ALERT "db"."schema"."myalert" MODIFY CONDITION EXISTS (SELECT 1)`, actual)
+	})
+
+	t.Run("modify action", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name:         id,
+			ModifyAction: String("SELECT 2"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ALERT "db"."schema"."myalert" MODIFY ACTION SELECT 2`, actual)
+	})
+
+	t.Run("validation: more than one alteration", func(t *testing.T) {
+		action := AlertActionSuspend
+		opts := &AlterAlertOptions{
+			name:         id,
+			Action:       &action,
+			ModifyAction: String("SELECT 2"),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name: id,
+			Set:  &AlertSet{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestAlertDrop(t *testing.T) {
+	opts := &DropAlertOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "myalert"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP ALERT IF EXISTS "db"."schema"."myalert"`, actual)
+}
+
+func TestAlertShow(t *testing.T) {
+	t.Run("with like and in schema", func(t *testing.T) {
+		opts := &ShowAlertOptions{
+			Terse: Bool(true),
+			Like:  &Like{Pattern: String("myalert")},
+			In:    &In{Schema: NewSchemaIdentifier("db", "schema")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW TERSE ALERTS LIKE 'myalert' IN SCHEMA "db"."schema"`, actual)
+	})
+
+	t.Run("with starts with and limit", func(t *testing.T) {
+		opts := &ShowAlertOptions{
+			ShowOptions: ShowOptions{
+				StartsWith: String("my"),
+				Limit:      &LimitFrom{Rows: Int(10)},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW ALERTS STARTS WITH 'my' LIMIT 10`, actual)
+	})
+
+	t.Run("validation: more than one scope", func(t *testing.T) {
+		opts := &ShowAlertOptions{
+			In: &In{Account: Bool(true), Database: NewAccountObjectIdentifier("db")},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestAlertDescribe(t *testing.T) {
+	opts := &describeAlertOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "myalert"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE ALERT "db"."schema"."myalert"`, actual)
+}
+
+func TestAlertDBRowToAlert(t *testing.T) {
+	t.Run("known state", func(t *testing.T) {
+		alert, err := alertDBRow{Name: "myalert", State: sql.NullString{String: "suspended", Valid: true}}.toAlert(true)
+		require.NoError(t, err)
+		assert.Equal(t, AlertStateSuspended, alert.State)
+		assert.True(t, alert.Serverless())
+	})
+
+	t.Run("unknown state", func(t *testing.T) {
+		_, err := alertDBRow{Name: "myalert", State: sql.NullString{String: "paused", Valid: true}}.toAlert(true)
+		assert.ErrorIs(t, err, ErrUnknownEnumValue)
+
+		alert, err := alertDBRow{Name: "myalert", State: sql.NullString{String: "paused", Valid: true}}.toAlert(false)
+		require.NoError(t, err)
+		assert.Equal(t, AlertState("paused"), alert.State)
+	})
+}
+
+func TestAlertHistorySQL(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "myalert")
+
+	t.Run("without options", func(t *testing.T) {
+		actual := alertHistorySQL(id, &AlertHistoryOptions{})
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.ALERT_HISTORY(ALERT_NAME => 'myalert')) WHERE SCHEMA_NAME = 'schema' ORDER BY SCHEDULED_TIME DESC`, actual)
+	})
+
+	t.Run("with time range and limit", func(t *testing.T) {
+		start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+		actual := alertHistorySQL(id, &AlertHistoryOptions{
+			ScheduledTimeRangeStart: &start,
+			ScheduledTimeRangeEnd:   &end,
+			ResultLimit:             Int(50),
+		})
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.ALERT_HISTORY(ALERT_NAME => 'myalert', SCHEDULED_TIME_RANGE_START => TO_TIMESTAMP_LTZ('2023-01-01T00:00:00Z'), SCHEDULED_TIME_RANGE_END => TO_TIMESTAMP_LTZ('2023-01-02T00:00:00Z'), RESULT_LIMIT => 50)) WHERE SCHEMA_NAME = 'schema' ORDER BY SCHEDULED_TIME DESC`, actual)
+	})
+
+	t.Run("escapes quotes in names", func(t *testing.T) {
+		actual := alertHistorySQL(NewSchemaObjectIdentifier("db", "it's", "my'alert"), &AlertHistoryOptions{})
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.ALERT_HISTORY(ALERT_NAME => 'my\'alert')) WHERE SCHEMA_NAME = 'it\'s' ORDER BY SCHEDULED_TIME DESC`, actual)
+	})
+
+	t.Run("validation: result limit out of range", func(t *testing.T) {
+		opts := &AlertHistoryOptions{ResultLimit: Int(20000)}
+		assert.Error(t, opts.validate())
+	})
+}
@@ -0,0 +1,401 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ApplicationPackages = (*applicationPackages)(nil)
+
+// ApplicationPackages hold the versions of a Native App a provider distributes to consumers.
+type ApplicationPackages interface {
+	// Create creates a new application package.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateApplicationPackageOptions) error
+	// Alter modifies an existing application package, including its versions and patches.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterApplicationPackageOptions) error
+	// Drop removes an application package.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropApplicationPackageOptions) error
+	// Show returns a list of application packages.
+	Show(ctx context.Context, opts *ShowApplicationPackageOptions) ([]*ApplicationPackage, error)
+	// ShowByID returns an application package by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error)
+	// ShowVersions returns the versions and patches of an application package.
+	ShowVersions(ctx context.Context, id AccountObjectIdentifier) ([]*ApplicationPackageVersion, error)
+}
+
+// applicationPackages implements ApplicationPackages.
+type applicationPackages struct {
+	client *Client
+}
+
+type Distribution string
+
+const (
+	DistributionInternal Distribution = "INTERNAL"
+	DistributionExternal Distribution = "EXTERNAL"
+)
+
+var allDistributions = []Distribution{
+	DistributionInternal,
+	DistributionExternal,
+}
+
+type CreateApplicationPackageOptions struct {
+	create             bool                    `ddl:"static" sql:"CREATE"`              //lint:ignore U1000 This is used in the ddl tag
+	applicationPackage bool                    `ddl:"static" sql:"APPLICATION PACKAGE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists        *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+
+	// optional
+	DataRetentionTimeInDays    *int             `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int             `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *string          `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	Comment                    *string          `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag                        []TagAssociation `ddl:"keyword,parentheses" sql:"TAG"`
+	// Distribution must be EXTERNAL for the package to be shared with consumers outside the organization.
+	Distribution *Distribution `ddl:"parameter" sql:"DISTRIBUTION"`
+}
+
+func (opts *CreateApplicationPackageOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *applicationPackages) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateApplicationPackageOptions) error {
+	if opts == nil {
+		opts = &CreateApplicationPackageOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterApplicationPackageOptions struct {
+	alter              bool                    `ddl:"static" sql:"ALTER"`               //lint:ignore U1000 This is used in the ddl tag
+	applicationPackage bool                    `ddl:"static" sql:"APPLICATION PACKAGE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists           *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	Set                        *ApplicationPackageSet                        `ddl:"keyword" sql:"SET"`
+	Unset                      *ApplicationPackageUnset                      `ddl:"list,no_parentheses" sql:"UNSET"`
+	AddVersion                 *ApplicationPackageAddVersion                 `ddl:"keyword" sql:"ADD VERSION"`
+	DropVersion                *string                                       `ddl:"parameter,no_equals" sql:"DROP VERSION"`
+	AddPatch                   *ApplicationPackageAddPatch                   `ddl:"keyword" sql:"ADD PATCH"`
+	SetDefaultReleaseDirective *ApplicationPackageSetDefaultReleaseDirective `ddl:"keyword" sql:"SET DEFAULT RELEASE DIRECTIVE"`
+	SetTag                     []TagAssociation                              `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag                   []ObjectIdentifier                            `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterApplicationPackageOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.AddVersion, opts.DropVersion, opts.AddPatch, opts.SetDefaultReleaseDirective, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, AddVersion, DropVersion, AddPatch, SetDefaultReleaseDirective, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.DataRetentionTimeInDays, opts.Set.MaxDataExtensionTimeInDays, opts.Set.DefaultDDLCollation, opts.Set.Comment, opts.Set.Distribution) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.DataRetentionTimeInDays, opts.Unset.MaxDataExtensionTimeInDays, opts.Unset.DefaultDDLCollation, opts.Unset.Comment, opts.Unset.Distribution) {
+		return errors.New("at least one property must be unset")
+	}
+	if valueSet(opts.AddVersion) && opts.AddVersion.Using == "" {
+		return errors.New("Using is required when adding a version")
+	}
+	if valueSet(opts.AddPatch) && (opts.AddPatch.ForVersion == "" || opts.AddPatch.Using == "") {
+		return errors.New("ForVersion and Using are required when adding a patch")
+	}
+	if valueSet(opts.SetDefaultReleaseDirective) && opts.SetDefaultReleaseDirective.Version == "" {
+		return errors.New("Version is required for the default release directive")
+	}
+	return nil
+}
+
+type ApplicationPackageSet struct {
+	DataRetentionTimeInDays    *int          `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int          `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *string       `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	Comment                    *string       `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Distribution               *Distribution `ddl:"parameter" sql:"DISTRIBUTION"`
+}
+
+type ApplicationPackageUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *bool `ddl:"keyword" sql:"DEFAULT_DDL_COLLATION"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+	Distribution               *bool `ddl:"keyword" sql:"DISTRIBUTION"`
+}
+
+type ApplicationPackageAddVersion struct {
+	// VersionIdentifier is optional, Snowflake generates one when it is not set.
+	VersionIdentifier *string `ddl:"keyword"`
+	// Using is the stage location of the application files, e.g. @db.schema.stage/v1.
+	Using string  `ddl:"parameter,single_quotes,no_equals" sql:"USING"`
+	Label *string `ddl:"parameter,single_quotes" sql:"LABEL"`
+}
+
+type ApplicationPackageAddPatch struct {
+	// PatchNumber is optional, Snowflake uses the next patch number when it is not set.
+	PatchNumber *int    `ddl:"keyword"`
+	ForVersion  string  `ddl:"parameter,no_equals" sql:"FOR VERSION"`
+	Using       string  `ddl:"parameter,single_quotes,no_equals" sql:"USING"`
+	Label       *string `ddl:"parameter,single_quotes" sql:"LABEL"`
+}
+
+type ApplicationPackageSetDefaultReleaseDirective struct {
+	Version string `ddl:"parameter" sql:"VERSION"`
+	Patch   int    `ddl:"parameter" sql:"PATCH"`
+}
+
+func (v *applicationPackages) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterApplicationPackageOptions) error {
+	if opts == nil {
+		opts = &AlterApplicationPackageOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropApplicationPackageOptions struct {
+	drop               bool                    `ddl:"static" sql:"DROP"`                //lint:ignore U1000 This is used in the ddl tag
+	applicationPackage bool                    `ddl:"static" sql:"APPLICATION PACKAGE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists           *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropApplicationPackageOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *applicationPackages) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropApplicationPackageOptions) error {
+	if opts == nil {
+		opts = &DropApplicationPackageOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowApplicationPackageOptions struct {
+	show                bool `ddl:"static" sql:"SHOW"`                 //lint:ignore U1000 This is used in the ddl tag
+	applicationPackages bool `ddl:"static" sql:"APPLICATION PACKAGES"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	ShowOptions
+}
+
+func (opts *ShowApplicationPackageOptions) validate() error {
+	return nil
+}
+
+type ApplicationPackage struct {
+	CreatedOn     time.Time
+	Name          string
+	IsDefault     bool
+	IsCurrent     bool
+	Distribution  Distribution
+	Owner         string
+	Comment       string
+	RetentionTime int
+	Options       string
+	DroppedOn     time.Time
+	// ApplicationClass is set for packages created from an application class.
+	ApplicationClass string
+}
+
+func (v *ApplicationPackage) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ApplicationPackage) ObjectType() ObjectType {
+	return ObjectTypeApplicationPackage
+}
+
+type applicationPackageRow struct {
+	CreatedOn        time.Time      `db:"created_on"`
+	Name             string         `db:"name"`
+	IsDefault        string         `db:"is_default"`
+	IsCurrent        string         `db:"is_current"`
+	Distribution     string         `db:"distribution"`
+	Owner            sql.NullString `db:"owner"`
+	Comment          sql.NullString `db:"comment"`
+	RetentionTime    sql.NullInt64  `db:"retention_time"`
+	Options          sql.NullString `db:"options"`
+	DroppedOn        sql.NullTime   `db:"dropped_on"`
+	ApplicationClass sql.NullString `db:"application_class"`
+}
+
+func (row applicationPackageRow) toApplicationPackage(strict bool) (*ApplicationPackage, error) {
+	pkg := &ApplicationPackage{
+		CreatedOn:        row.CreatedOn,
+		Name:             row.Name,
+		IsDefault:        row.IsDefault == "Y",
+		IsCurrent:        row.IsCurrent == "Y",
+		Owner:            row.Owner.String,
+		Comment:          row.Comment.String,
+		RetentionTime:    int(row.RetentionTime.Int64),
+		Options:          row.Options.String,
+		ApplicationClass: row.ApplicationClass.String,
+	}
+	if row.DroppedOn.Valid {
+		pkg.DroppedOn = row.DroppedOn.Time
+	}
+	distribution, err := toEnum(strict, "distribution", row.Distribution, allDistributions)
+	if err != nil {
+		return nil, err
+	}
+	pkg.Distribution = distribution
+	return pkg, nil
+}
+
+func (v *applicationPackages) Show(ctx context.Context, opts *ShowApplicationPackageOptions) ([]*ApplicationPackage, error) {
+	if opts == nil {
+		opts = &ShowApplicationPackageOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []applicationPackageRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ApplicationPackage, len(dest))
+	for i, row := range dest {
+		resultList[i], err = row.toApplicationPackage(v.client.strictEnumParsing)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resultList, nil
+}
+
+func (v *applicationPackages) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error) {
+	packages, err := v.Show(ctx, &ShowApplicationPackageOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range packages {
+		if pkg.Name == id.Name() {
+			return pkg, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type showVersionsInApplicationPackageOptions struct {
+	show                 bool                    `ddl:"static" sql:"SHOW VERSIONS"` //lint:ignore U1000 This is used in the ddl tag
+	inApplicationPackage AccountObjectIdentifier `ddl:"identifier" sql:"IN APPLICATION PACKAGE"`
+}
+
+func (opts *showVersionsInApplicationPackageOptions) validate() error {
+	if !validObjectidentifier(opts.inApplicationPackage) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type ApplicationPackageVersion struct {
+	Version      string
+	Patch        int
+	Label        string
+	Comment      string
+	CreatedOn    time.Time
+	DroppedOn    time.Time
+	LogLevel     string
+	TraceLevel   string
+	State        string
+	ReviewStatus string
+}
+
+type applicationPackageVersionRow struct {
+	Version      string         `db:"version"`
+	Patch        int            `db:"patch"`
+	Label        sql.NullString `db:"label"`
+	Comment      sql.NullString `db:"comment"`
+	CreatedOn    time.Time      `db:"created_on"`
+	DroppedOn    sql.NullTime   `db:"dropped_on"`
+	LogLevel     sql.NullString `db:"log_level"`
+	TraceLevel   sql.NullString `db:"trace_level"`
+	State        sql.NullString `db:"state"`
+	ReviewStatus sql.NullString `db:"review_status"`
+}
+
+func (row applicationPackageVersionRow) toApplicationPackageVersion() *ApplicationPackageVersion {
+	version := &ApplicationPackageVersion{
+		Version:      row.Version,
+		Patch:        row.Patch,
+		Label:        row.Label.String,
+		Comment:      row.Comment.String,
+		CreatedOn:    row.CreatedOn,
+		LogLevel:     row.LogLevel.String,
+		TraceLevel:   row.TraceLevel.String,
+		State:        row.State.String,
+		ReviewStatus: row.ReviewStatus.String,
+	}
+	if row.DroppedOn.Valid {
+		version.DroppedOn = row.DroppedOn.Time
+	}
+	return version
+}
+
+func (v *applicationPackages) ShowVersions(ctx context.Context, id AccountObjectIdentifier) ([]*ApplicationPackageVersion, error) {
+	opts := &showVersionsInApplicationPackageOptions{
+		inApplicationPackage: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []applicationPackageVersionRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ApplicationPackageVersion, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toApplicationPackageVersion()
+	}
+	return resultList, nil
+}
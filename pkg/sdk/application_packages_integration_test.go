@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ApplicationPackages(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.ApplicationPackages.Create(ctx, id, &CreateApplicationPackageOptions{Comment: String("some comment")})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ApplicationPackages.Drop(ctx, id, &DropApplicationPackageOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		pkg, err := client.ApplicationPackages.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, pkg.ID())
+		assert.Equal(t, "some comment", pkg.Comment)
+		assert.Equal(t, DistributionInternal, pkg.Distribution)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		distribution := DistributionExternal
+		err := client.ApplicationPackages.Alter(ctx, id, &AlterApplicationPackageOptions{
+			Set: &ApplicationPackageSet{
+				Comment:      String("new comment"),
+				Distribution: &distribution,
+			},
+		})
+		require.NoError(t, err)
+		pkg, err := client.ApplicationPackages.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", pkg.Comment)
+		assert.Equal(t, DistributionExternal, pkg.Distribution)
+
+		err = client.ApplicationPackages.Alter(ctx, id, &AlterApplicationPackageOptions{
+			Unset: &ApplicationPackageUnset{Comment: Bool(true), Distribution: Bool(true)},
+		})
+		require.NoError(t, err)
+		pkg, err = client.ApplicationPackages.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, pkg.Comment)
+		assert.Equal(t, DistributionInternal, pkg.Distribution)
+	})
+
+	t.Run("show versions", func(t *testing.T) {
+		versions, err := client.ApplicationPackages.ShowVersions(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, versions)
+	})
+}
@@ -0,0 +1,174 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationPackageCreate(t *testing.T) {
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreateApplicationPackageOptions{
+			name: NewAccountObjectIdentifier("mypackage"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE APPLICATION PACKAGE "mypackage"`, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		distribution := DistributionExternal
+		opts := &CreateApplicationPackageOptions{
+			IfNotExists:             Bool(true),
+			name:                    NewAccountObjectIdentifier("mypackage"),
+			DataRetentionTimeInDays: Int(1),
+			DefaultDDLCollation:     String("en_US"),
+			Comment:                 String("some comment"),
+			Tag: []TagAssociation{
+				{
+					Name:  NewSchemaObjectIdentifier("db", "schema", "tag"),
+					Value: "v1",
+				},
+			},
+			Distribution: &distribution,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE APPLICATION PACKAGE IF NOT EXISTS "mypackage" DATA_RETENTION_TIME_IN_DAYS = 1 DEFAULT_DDL_COLLATION = 'en_US' COMMENT = 'some comment' TAG ("db"."schema"."tag" = 'v1') DISTRIBUTION = EXTERNAL`, actual)
+	})
+}
+
+func TestApplicationPackageAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("mypackage")
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: add version without using", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name:       id,
+			AddVersion: &ApplicationPackageAddVersion{VersionIdentifier: String("v1")},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("with set", func(t *testing.T) {
+		distribution := DistributionInternal
+		opts := &AlterApplicationPackageOptions{
+			name: id,
+			Set: &ApplicationPackageSet{
+				Comment:      String("some comment"),
+				Distribution: &distribution,
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION PACKAGE "mypackage" SET COMMENT = 'some comment' DISTRIBUTION = INTERNAL`, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name: id,
+			Unset: &ApplicationPackageUnset{
+				Comment:      Bool(true),
+				Distribution: Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION PACKAGE "mypackage" UNSET COMMENT, DISTRIBUTION`, actual)
+	})
+
+	t.Run("with add version", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name: id,
+			AddVersion: &ApplicationPackageAddVersion{
+				VersionIdentifier: String("v1_0"),
+				Using:             "@db.schema.stage/v1",
+				Label:             String("first version"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION PACKAGE "mypackage" ADD VERSION v1_0 USING '@db.schema.stage/v1' LABEL = 'first version'`, actual)
+	})
+
+	t.Run("with drop version", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name:        id,
+			DropVersion: String("v1_0"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION PACKAGE "mypackage" DROP VERSION v1_0`, actual)
+	})
+
+	t.Run("with add patch", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name: id,
+			AddPatch: &ApplicationPackageAddPatch{
+				PatchNumber: Int(2),
+				ForVersion:  "v1_0",
+				Using:       "@db.schema.stage/v1_2",
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION PACKAGE "mypackage" ADD PATCH 2 FOR VERSION v1_0 USING '@db.schema.stage/v1_2'`, actual)
+	})
+
+	t.Run("with set default release directive", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name: id,
+			SetDefaultReleaseDirective: &ApplicationPackageSetDefaultReleaseDirective{
+				Version: "v1_0",
+				Patch:   2,
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION PACKAGE "mypackage" SET DEFAULT RELEASE DIRECTIVE VERSION = v1_0 PATCH = 2`, actual)
+	})
+}
+
+func TestApplicationPackageDrop(t *testing.T) {
+	opts := &DropApplicationPackageOptions{
+		IfExists: Bool(true),
+		name:     NewAccountObjectIdentifier("mypackage"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP APPLICATION PACKAGE IF EXISTS "mypackage"`, actual)
+}
+
+func TestApplicationPackageShow(t *testing.T) {
+	opts := &ShowApplicationPackageOptions{
+		Like: &Like{Pattern: String("my%")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW APPLICATION PACKAGES LIKE 'my%'`, actual)
+}
+
+func TestApplicationPackageShowVersions(t *testing.T) {
+	opts := &showVersionsInApplicationPackageOptions{
+		inApplicationPackage: NewAccountObjectIdentifier("mypackage"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW VERSIONS IN APPLICATION PACKAGE "mypackage"`, actual)
+}
+
+func TestApplicationPackageRowToApplicationPackage(t *testing.T) {
+	row := applicationPackageRow{Name: "mypackage", IsDefault: "N", IsCurrent: "N", Distribution: "UNKNOWN"}
+
+	pkg, err := row.toApplicationPackage(false)
+	require.NoError(t, err)
+	assert.Equal(t, Distribution("UNKNOWN"), pkg.Distribution)
+
+	_, err = row.toApplicationPackage(true)
+	assert.ErrorIs(t, err, ErrUnknownEnumValue)
+}
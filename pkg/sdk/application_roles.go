@@ -0,0 +1,190 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// ApplicationRoles are created by the setup script of a Native App and can only be granted by consumers.
+type ApplicationRoles interface {
+	// Show returns a list of application roles in an application.
+	Show(ctx context.Context, application AccountObjectIdentifier, opts *ShowApplicationRoleOptions) ([]*ApplicationRole, error)
+	// ShowByID returns an application role by ID.
+	ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*ApplicationRole, error)
+	// Grant grants an application role to an account role or to another application.
+	Grant(ctx context.Context, id DatabaseObjectIdentifier, opts *GrantApplicationRoleOptions) error
+	// Revoke revokes an application role from an account role or from another application.
+	Revoke(ctx context.Context, id DatabaseObjectIdentifier, opts *RevokeApplicationRoleOptions) error
+}
+
+// Compile-time proof of interface implementation.
+var _ ApplicationRoles = (*applicationRoles)(nil)
+
+type applicationRoles struct {
+	client *Client
+}
+
+type ApplicationRole struct {
+	CreatedOn       time.Time
+	ApplicationName string
+	Name            string
+	Owner           string
+	Comment         string
+	OwnerRoleType   string
+}
+
+func (v *ApplicationRole) ID() DatabaseObjectIdentifier {
+	return NewDatabaseObjectIdentifier(v.ApplicationName, v.Name)
+}
+
+func (v *ApplicationRole) ObjectType() ObjectType {
+	return ObjectTypeApplicationRole
+}
+
+type applicationRoleDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	Owner         sql.NullString `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+func (row applicationRoleDBRow) toApplicationRole(application AccountObjectIdentifier) *ApplicationRole {
+	return &ApplicationRole{
+		CreatedOn:       row.CreatedOn,
+		ApplicationName: application.Name(),
+		Name:            row.Name,
+		Owner:           row.Owner.String,
+		Comment:         row.Comment.String,
+		OwnerRoleType:   row.OwnerRoleType.String,
+	}
+}
+
+// ShowApplicationRoleOptions contains options for listing application roles.
+type ShowApplicationRoleOptions struct {
+	show        bool                    `ddl:"static" sql:"SHOW APPLICATION ROLES IN APPLICATION"` //lint:ignore U1000 This is used in the ddl tag
+	application AccountObjectIdentifier `ddl:"identifier"`
+	Limit       *LimitFrom              `ddl:"keyword" sql:"LIMIT"`
+}
+
+func (opts *ShowApplicationRoleOptions) validate() error {
+	if !validObjectidentifier(opts.application) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *applicationRoles) Show(ctx context.Context, application AccountObjectIdentifier, opts *ShowApplicationRoleOptions) ([]*ApplicationRole, error) {
+	if opts == nil {
+		opts = &ShowApplicationRoleOptions{}
+	}
+	opts.application = application
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []applicationRoleDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ApplicationRole, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toApplicationRole(application)
+	}
+	return resultList, nil
+}
+
+// ShowByID lists all roles of the application, SHOW APPLICATION ROLES does not support LIKE.
+func (v *applicationRoles) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*ApplicationRole, error) {
+	applicationRoles, err := v.Show(ctx, NewAccountObjectIdentifier(id.DatabaseName()), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, applicationRole := range applicationRoles {
+		if applicationRole.Name == id.Name() {
+			return applicationRole, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// ApplicationRoleGrantee is the account role or the application an application role is granted to or revoked from.
+type ApplicationRoleGrantee struct {
+	AccountRole AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
+	Application AccountObjectIdentifier `ddl:"identifier" sql:"APPLICATION"`
+}
+
+func (v *ApplicationRoleGrantee) validate() error {
+	if !exactlyOneValueSet(v.AccountRole, v.Application) {
+		return errors.New("exactly one of AccountRole, Application must be set")
+	}
+	return nil
+}
+
+// GrantApplicationRoleOptions contains options for granting an application role.
+type GrantApplicationRoleOptions struct {
+	grant           bool                     `ddl:"static" sql:"GRANT"`            //lint:ignore U1000 This is used in the ddl tag
+	applicationRole bool                     `ddl:"static" sql:"APPLICATION ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name            DatabaseObjectIdentifier `ddl:"identifier"`
+	To              ApplicationRoleGrantee   `ddl:"keyword" sql:"TO"`
+}
+
+func (opts *GrantApplicationRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) || opts.name.DatabaseName() == "" {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.To.validate()
+}
+
+func (v *applicationRoles) Grant(ctx context.Context, id DatabaseObjectIdentifier, opts *GrantApplicationRoleOptions) error {
+	if opts == nil {
+		opts = &GrantApplicationRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// RevokeApplicationRoleOptions contains options for revoking an application role.
+type RevokeApplicationRoleOptions struct {
+	revoke          bool                     `ddl:"static" sql:"REVOKE"`           //lint:ignore U1000 This is used in the ddl tag
+	applicationRole bool                     `ddl:"static" sql:"APPLICATION ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name            DatabaseObjectIdentifier `ddl:"identifier"`
+	From            ApplicationRoleGrantee   `ddl:"keyword" sql:"FROM"`
+}
+
+func (opts *RevokeApplicationRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) || opts.name.DatabaseName() == "" {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.From.validate()
+}
+
+func (v *applicationRoles) Revoke(ctx context.Context, id DatabaseObjectIdentifier, opts *RevokeApplicationRoleOptions) error {
+	if opts == nil {
+		opts = &RevokeApplicationRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
@@ -0,0 +1,52 @@
+package sdk
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInt_ApplicationRoles needs an installed application whose setup script creates at least one application role.
+func TestInt_ApplicationRoles(t *testing.T) {
+	applicationName := os.Getenv("SNOWFLAKE_TEST_APPLICATION")
+	if applicationName == "" {
+		t.Skip("SNOWFLAKE_TEST_APPLICATION is not set")
+	}
+	client := testClient(t)
+	ctx := context.Background()
+	application := NewAccountObjectIdentifier(applicationName)
+
+	applicationRoles, err := client.ApplicationRoles.Show(ctx, application, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, applicationRoles)
+	id := applicationRoles[0].ID()
+
+	t.Run("show by id", func(t *testing.T) {
+		applicationRole, err := client.ApplicationRoles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, applicationRole.ID())
+	})
+
+	t.Run("grant and revoke to account role", func(t *testing.T) {
+		roleID := randomAccountObjectIdentifier(t)
+		err := client.Roles.Create(ctx, roleID, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Roles.Drop(ctx, roleID, nil)
+			require.NoError(t, err)
+		})
+
+		err = client.ApplicationRoles.Grant(ctx, id, &GrantApplicationRoleOptions{To: ApplicationRoleGrantee{AccountRole: roleID}})
+		require.NoError(t, err)
+		err = client.ApplicationRoles.Revoke(ctx, id, &RevokeApplicationRoleOptions{From: ApplicationRoleGrantee{AccountRole: roleID}})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id: not existing", func(t *testing.T) {
+		_, err := client.ApplicationRoles.ShowByID(ctx, NewDatabaseObjectIdentifier(applicationName, randomStringN(t, 12)))
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
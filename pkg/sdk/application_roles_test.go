@@ -0,0 +1,87 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationRoleShow(t *testing.T) {
+	t.Run("in application", func(t *testing.T) {
+		opts := &ShowApplicationRoleOptions{
+			application: NewAccountObjectIdentifier("myapp"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW APPLICATION ROLES IN APPLICATION "myapp"`, actual)
+	})
+
+	t.Run("with limit", func(t *testing.T) {
+		opts := &ShowApplicationRoleOptions{
+			application: NewAccountObjectIdentifier("myapp"),
+			Limit:       &LimitFrom{Rows: Int(3), From: String("app_")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW APPLICATION ROLES IN APPLICATION "myapp" LIMIT 3 FROM 'app_'`, actual)
+	})
+
+	t.Run("validation: missing application", func(t *testing.T) {
+		opts := &ShowApplicationRoleOptions{}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestApplicationRoleGrant(t *testing.T) {
+	id := NewDatabaseObjectIdentifier("myapp", "app_viewer")
+
+	t.Run("to account role", func(t *testing.T) {
+		opts := &GrantApplicationRoleOptions{
+			name: id,
+			To:   ApplicationRoleGrantee{AccountRole: NewAccountObjectIdentifier("analyst")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `GRANT APPLICATION ROLE "myapp"."app_viewer" TO ROLE "analyst"`, actual)
+	})
+
+	t.Run("to application", func(t *testing.T) {
+		opts := &GrantApplicationRoleOptions{
+			name: id,
+			To:   ApplicationRoleGrantee{Application: NewAccountObjectIdentifier("otherapp")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `GRANT APPLICATION ROLE "myapp"."app_viewer" TO APPLICATION "otherapp"`, actual)
+	})
+
+	t.Run("validation: account role and application", func(t *testing.T) {
+		opts := &GrantApplicationRoleOptions{
+			name: id,
+			To: ApplicationRoleGrantee{
+				AccountRole: NewAccountObjectIdentifier("analyst"),
+				Application: NewAccountObjectIdentifier("otherapp"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: missing application name", func(t *testing.T) {
+		opts := &GrantApplicationRoleOptions{
+			name: NewDatabaseObjectIdentifier("", "app_viewer"),
+			To:   ApplicationRoleGrantee{AccountRole: NewAccountObjectIdentifier("analyst")},
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestApplicationRoleRevoke(t *testing.T) {
+	opts := &RevokeApplicationRoleOptions{
+		name: NewDatabaseObjectIdentifier("myapp", "app_viewer"),
+		From: ApplicationRoleGrantee{AccountRole: NewAccountObjectIdentifier("analyst")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `REVOKE APPLICATION ROLE "myapp"."app_viewer" FROM ROLE "analyst"`, actual)
+}
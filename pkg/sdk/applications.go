@@ -0,0 +1,493 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Applications = (*applications)(nil)
+
+// Applications are Native Apps installed in the consumer account from an application package or a listing.
+type Applications interface {
+	// Create installs a new application.
+	Create(ctx context.Context, id AccountObjectIdentifier, source *ApplicationSource, opts *CreateApplicationOptions) error
+	// Alter modifies an existing application, including upgrading it to another version.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterApplicationOptions) error
+	// Drop removes an application.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropApplicationOptions) error
+	// Show returns a list of applications.
+	Show(ctx context.Context, opts *ShowApplicationOptions) ([]*Application, error)
+	// ShowByID returns an application by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Application, error)
+	// Describe returns the details of an application.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*ApplicationDetails, error)
+	// ShowReferences returns the references the application requests, and the objects bound to them.
+	ShowReferences(ctx context.Context, id AccountObjectIdentifier) ([]*ApplicationReference, error)
+}
+
+// applications implements Applications.
+type applications struct {
+	client *Client
+}
+
+// ApplicationSource is where an application is installed from. Exactly one of ApplicationPackage or Listing must be set.
+type ApplicationSource struct {
+	ApplicationPackage AccountObjectIdentifier `ddl:"identifier" sql:"FROM APPLICATION PACKAGE"`
+	Listing            AccountObjectIdentifier `ddl:"identifier" sql:"FROM LISTING"`
+	// Using is only valid for application packages. When it is not set the default release directive is used.
+	Using *ApplicationVersion `ddl:"keyword" sql:"USING"`
+}
+
+func (s *ApplicationSource) validate() error {
+	if validObjectidentifier(s.ApplicationPackage) == validObjectidentifier(s.Listing) {
+		return errors.New("exactly one of ApplicationPackage or Listing must be set")
+	}
+	if valueSet(s.Using) {
+		if validObjectidentifier(s.Listing) {
+			return errors.New("Using can only be set for applications created from an application package")
+		}
+		if err := s.Using.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplicationVersion selects either a version, optionally with a patch, or a release channel.
+type ApplicationVersion struct {
+	Version        *string `ddl:"parameter,no_equals" sql:"VERSION"`
+	Patch          *int    `ddl:"parameter,no_equals" sql:"PATCH"`
+	ReleaseChannel *string `ddl:"parameter,no_equals" sql:"RELEASE CHANNEL"`
+}
+
+func (v *ApplicationVersion) validate() error {
+	if !exactlyOneValueSet(v.Version, v.ReleaseChannel) {
+		return errors.New("exactly one of Version or ReleaseChannel must be set")
+	}
+	if valueSet(v.Patch) && !valueSet(v.Version) {
+		return errors.New("Patch can only be set together with Version")
+	}
+	return nil
+}
+
+type CreateApplicationOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"`      //lint:ignore U1000 This is used in the ddl tag
+	application bool                    `ddl:"static" sql:"APPLICATION"` //lint:ignore U1000 This is used in the ddl tag
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	source      *ApplicationSource      `ddl:"-"`
+
+	// optional
+	// DebugMode is only valid for applications created from an application package the current role owns.
+	DebugMode *bool            `ddl:"parameter" sql:"DEBUG_MODE"`
+	Comment   *string          `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag       []TagAssociation `ddl:"keyword,parentheses" sql:"WITH TAG"`
+}
+
+func (opts *CreateApplicationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.source) {
+		return errors.New("source is required")
+	}
+	if err := opts.source.validate(); err != nil {
+		return err
+	}
+	if valueSet(opts.DebugMode) && validObjectidentifier(opts.source.Listing) {
+		return errors.New("DebugMode cannot be set for applications created from a listing")
+	}
+	return nil
+}
+
+func (v *applications) Create(ctx context.Context, id AccountObjectIdentifier, source *ApplicationSource, opts *CreateApplicationOptions) error {
+	if opts == nil {
+		opts = &CreateApplicationOptions{}
+	}
+	opts.name = id
+	opts.source = source
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterApplicationOptions struct {
+	alter       bool                    `ddl:"static" sql:"ALTER"`       //lint:ignore U1000 This is used in the ddl tag
+	application bool                    `ddl:"static" sql:"APPLICATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	NewName  AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set      *ApplicationSet         `ddl:"keyword" sql:"SET"`
+	Unset    *ApplicationUnset       `ddl:"list,no_parentheses" sql:"UNSET"`
+	Upgrade  *ApplicationUpgrade     `ddl:"keyword" sql:"UPGRADE"`
+	SetTag   []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterApplicationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	var newName *AccountObjectIdentifier
+	if validObjectidentifier(opts.NewName) {
+		newName = &opts.NewName
+	}
+	if !exactlyOneValueSet(newName, opts.Set, opts.Unset, opts.Upgrade, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of NewName, Set, Unset, Upgrade, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.Comment, opts.Set.ShareEventsWithProvider, opts.Set.DebugMode) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.Comment, opts.Unset.ShareEventsWithProvider, opts.Unset.DebugMode) {
+		return errors.New("at least one property must be unset")
+	}
+	if valueSet(opts.Upgrade) && valueSet(opts.Upgrade.Using) {
+		if err := opts.Upgrade.Using.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ApplicationSet struct {
+	Comment                 *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	ShareEventsWithProvider *bool   `ddl:"parameter" sql:"SHARE_EVENTS_WITH_PROVIDER"`
+	DebugMode               *bool   `ddl:"parameter" sql:"DEBUG_MODE"`
+}
+
+type ApplicationUnset struct {
+	Comment                 *bool `ddl:"keyword" sql:"COMMENT"`
+	ShareEventsWithProvider *bool `ddl:"keyword" sql:"SHARE_EVENTS_WITH_PROVIDER"`
+	DebugMode               *bool `ddl:"keyword" sql:"DEBUG_MODE"`
+}
+
+// ApplicationUpgrade upgrades the application to the version in Using, or to the
+// default release directive of the application package when Using is not set.
+type ApplicationUpgrade struct {
+	Using *ApplicationVersion `ddl:"keyword" sql:"USING"`
+}
+
+func (v *applications) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterApplicationOptions) error {
+	if opts == nil {
+		opts = &AlterApplicationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropApplicationOptions struct {
+	drop        bool                    `ddl:"static" sql:"DROP"`        //lint:ignore U1000 This is used in the ddl tag
+	application bool                    `ddl:"static" sql:"APPLICATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	// Cascade also drops the objects the application owns outside of itself, e.g. compute pools.
+	Cascade *bool `ddl:"keyword" sql:"CASCADE"`
+}
+
+func (opts *DropApplicationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *applications) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropApplicationOptions) error {
+	if opts == nil {
+		opts = &DropApplicationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowApplicationOptions struct {
+	show         bool `ddl:"static" sql:"SHOW"`         //lint:ignore U1000 This is used in the ddl tag
+	applications bool `ddl:"static" sql:"APPLICATIONS"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	ShowOptions
+}
+
+func (opts *ShowApplicationOptions) validate() error {
+	return nil
+}
+
+type Application struct {
+	CreatedOn     time.Time
+	Name          string
+	IsDefault     bool
+	IsCurrent     bool
+	SourceType    string
+	Source        string
+	Owner         string
+	Comment       string
+	Version       string
+	Label         string
+	Patch         int
+	Options       string
+	RetentionTime int
+}
+
+func (v *Application) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *Application) ObjectType() ObjectType {
+	return ObjectTypeApplication
+}
+
+type applicationRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	IsDefault     string         `db:"is_default"`
+	IsCurrent     string         `db:"is_current"`
+	SourceType    sql.NullString `db:"source_type"`
+	Source        sql.NullString `db:"source"`
+	Owner         sql.NullString `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	Version       sql.NullString `db:"version"`
+	Label         sql.NullString `db:"label"`
+	Patch         sql.NullInt64  `db:"patch"`
+	Options       sql.NullString `db:"options"`
+	RetentionTime sql.NullInt64  `db:"retention_time"`
+}
+
+func (row applicationRow) toApplication() *Application {
+	return &Application{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		IsDefault:     row.IsDefault == "Y",
+		IsCurrent:     row.IsCurrent == "Y",
+		SourceType:    row.SourceType.String,
+		Source:        row.Source.String,
+		Owner:         row.Owner.String,
+		Comment:       row.Comment.String,
+		Version:       row.Version.String,
+		Label:         row.Label.String,
+		Patch:         int(row.Patch.Int64),
+		Options:       row.Options.String,
+		RetentionTime: int(row.RetentionTime.Int64),
+	}
+}
+
+func (v *applications) Show(ctx context.Context, opts *ShowApplicationOptions) ([]*Application, error) {
+	if opts == nil {
+		opts = &ShowApplicationOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []applicationRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Application, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toApplication()
+	}
+	return resultList, nil
+}
+
+func (v *applications) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Application, error) {
+	applications, err := v.Show(ctx, &ShowApplicationOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, application := range applications {
+		if application.Name == id.Name() {
+			return application, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeApplicationOptions struct {
+	describe bool                    `ddl:"static" sql:"DESCRIBE APPLICATION"` //lint:ignore U1000 This is used in the ddl tag
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeApplicationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type ApplicationDetails struct {
+	Name                    string
+	Source                  string
+	Version                 string
+	Patch                   int
+	Label                   string
+	Comment                 string
+	DebugMode               bool
+	LogLevel                string
+	TraceLevel              string
+	ShareEventsWithProvider bool
+	UpgradeState            string
+}
+
+// applicationPropertyRow is a single row of DESCRIBE APPLICATION output.
+type applicationPropertyRow struct {
+	Property string         `db:"property"`
+	Value    sql.NullString `db:"value"`
+}
+
+func applicationDetailsFromRows(rows []applicationPropertyRow) *ApplicationDetails {
+	details := &ApplicationDetails{}
+	for _, row := range rows {
+		value := row.Value.String
+		switch row.Property {
+		case "name":
+			details.Name = value
+		case "source":
+			details.Source = value
+		case "version":
+			details.Version = value
+		case "patch":
+			if value != "" {
+				details.Patch = toInt(value)
+			}
+		case "label", "version_label":
+			details.Label = value
+		case "comment":
+			details.Comment = value
+		case "debug_mode":
+			details.DebugMode = strings.EqualFold(value, "true")
+		case "log_level":
+			details.LogLevel = value
+		case "trace_level":
+			details.TraceLevel = value
+		case "share_events_with_provider":
+			details.ShareEventsWithProvider = strings.EqualFold(value, "true")
+		case "upgrade_state":
+			details.UpgradeState = value
+		}
+	}
+	return details
+}
+
+func (v *applications) Describe(ctx context.Context, id AccountObjectIdentifier) (*ApplicationDetails, error) {
+	opts := &describeApplicationOptions{
+		name: id,
+	}
+	dest, err := describeRows[applicationPropertyRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return applicationDetailsFromRows(dest), nil
+}
+
+type showReferencesInApplicationOptions struct {
+	show          bool                    `ddl:"static" sql:"SHOW REFERENCES"` //lint:ignore U1000 This is used in the ddl tag
+	inApplication AccountObjectIdentifier `ddl:"identifier" sql:"IN APPLICATION"`
+}
+
+func (opts *showReferencesInApplicationOptions) validate() error {
+	if !validObjectidentifier(opts.inApplication) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ApplicationReference is a reference defined in the manifest of an application. The consumer
+// binds an object to it and grants the listed privileges before the application can use the object.
+type ApplicationReference struct {
+	Name         string
+	Label        string
+	Description  string
+	Privileges   []string
+	ObjectType   string
+	MultiValued  bool
+	ObjectName   string
+	SchemaName   string
+	DatabaseName string
+}
+
+// Bound reports whether an object has been bound to the reference.
+func (v *ApplicationReference) Bound() bool {
+	return v.ObjectName != ""
+}
+
+type applicationReferenceRow struct {
+	Name         string         `db:"name"`
+	Label        sql.NullString `db:"label"`
+	Description  sql.NullString `db:"description"`
+	Privileges   sql.NullString `db:"privileges"`
+	ObjectType   sql.NullString `db:"object_type"`
+	MultiValued  sql.NullString `db:"multi_valued"`
+	ObjectName   sql.NullString `db:"object_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	DatabaseName sql.NullString `db:"database_name"`
+}
+
+func (row applicationReferenceRow) toApplicationReference() *ApplicationReference {
+	return &ApplicationReference{
+		Name:         row.Name,
+		Label:        row.Label.String,
+		Description:  row.Description.String,
+		Privileges:   splitList(row.Privileges.String),
+		ObjectType:   row.ObjectType.String,
+		MultiValued:  strings.EqualFold(row.MultiValued.String, "true"),
+		ObjectName:   row.ObjectName.String,
+		SchemaName:   row.SchemaName.String,
+		DatabaseName: row.DatabaseName.String,
+	}
+}
+
+func (v *applications) ShowReferences(ctx context.Context, id AccountObjectIdentifier) ([]*ApplicationReference, error) {
+	opts := &showReferencesInApplicationOptions{
+		inApplication: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []applicationReferenceRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ApplicationReference, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toApplicationReference()
+	}
+	return resultList, nil
+}
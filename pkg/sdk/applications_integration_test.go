@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInt_Applications needs an installed application, e.g. the one used by TestInt_ApplicationRoles.
+func TestInt_Applications(t *testing.T) {
+	applicationName := os.Getenv("SNOWFLAKE_TEST_APPLICATION")
+	if applicationName == "" {
+		t.Skip("SNOWFLAKE_TEST_APPLICATION is not set")
+	}
+	client := testClient(t)
+	ctx := context.Background()
+	id := NewAccountObjectIdentifier(applicationName)
+
+	t.Run("show by id", func(t *testing.T) {
+		application, err := client.Applications.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, application.ID())
+		assert.NotEmpty(t, application.Source)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		details, err := client.Applications.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, applicationName, details.Name)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		err := client.Applications.Alter(ctx, id, &AlterApplicationOptions{
+			Set: &ApplicationSet{Comment: String("some comment")},
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Applications.Alter(ctx, id, &AlterApplicationOptions{
+				Unset: &ApplicationUnset{Comment: Bool(true)},
+			})
+			require.NoError(t, err)
+		})
+		application, err := client.Applications.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "some comment", application.Comment)
+	})
+
+	t.Run("show references", func(t *testing.T) {
+		_, err := client.Applications.ShowReferences(ctx, id)
+		require.NoError(t, err)
+	})
+
+	t.Run("show grants to application", func(t *testing.T) {
+		_, err := client.Grants.Show(ctx, &ShowGrantOptions{To: &ShowGrantsTo{Application: id}})
+		require.NoError(t, err)
+	})
+}
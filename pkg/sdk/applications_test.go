@@ -0,0 +1,217 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("myapp")
+	packageID := NewAccountObjectIdentifier("mypackage")
+
+	t.Run("from application package", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name:   id,
+			source: &ApplicationSource{ApplicationPackage: packageID},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE APPLICATION "myapp" FROM APPLICATION PACKAGE "mypackage"`, actual)
+	})
+
+	t.Run("from application package using version and patch", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name: id,
+			source: &ApplicationSource{
+				ApplicationPackage: packageID,
+				Using:              &ApplicationVersion{Version: String("v1_0"), Patch: Int(2)},
+			},
+			DebugMode: Bool(true),
+			Comment:   String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE APPLICATION "myapp" FROM APPLICATION PACKAGE "mypackage" USING VERSION v1_0 PATCH 2 DEBUG_MODE = true COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("from application package using release channel", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name: id,
+			source: &ApplicationSource{
+				ApplicationPackage: packageID,
+				Using:              &ApplicationVersion{ReleaseChannel: String("QA")},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE APPLICATION "myapp" FROM APPLICATION PACKAGE "mypackage" USING RELEASE CHANNEL QA`, actual)
+	})
+
+	t.Run("from listing", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name:   id,
+			source: &ApplicationSource{Listing: NewAccountObjectIdentifier("GZ1M00000001")},
+			Tag: []TagAssociation{
+				{
+					Name:  NewSchemaObjectIdentifier("db", "schema", "tag"),
+					Value: "v1",
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE APPLICATION "myapp" FROM LISTING "GZ1M00000001" WITH TAG ("db"."schema"."tag" = 'v1')`, actual)
+	})
+
+	t.Run("validation: no source", func(t *testing.T) {
+		opts := &CreateApplicationOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: both package and listing", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name:   id,
+			source: &ApplicationSource{ApplicationPackage: packageID, Listing: NewAccountObjectIdentifier("listing")},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: version and release channel", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name: id,
+			source: &ApplicationSource{
+				ApplicationPackage: packageID,
+				Using:              &ApplicationVersion{Version: String("v1_0"), ReleaseChannel: String("QA")},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: debug mode from listing", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name:      id,
+			source:    &ApplicationSource{Listing: NewAccountObjectIdentifier("listing")},
+			DebugMode: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestApplicationAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("myapp")
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterApplicationOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("upgrade", func(t *testing.T) {
+		opts := &AlterApplicationOptions{
+			name:    id,
+			Upgrade: &ApplicationUpgrade{},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION "myapp" UPGRADE`, actual)
+	})
+
+	t.Run("upgrade using version", func(t *testing.T) {
+		opts := &AlterApplicationOptions{
+			name:    id,
+			Upgrade: &ApplicationUpgrade{Using: &ApplicationVersion{Version: String("v2_0")}},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION "myapp" UPGRADE USING VERSION v2_0`, actual)
+	})
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &AlterApplicationOptions{
+			name: id,
+			Set: &ApplicationSet{
+				Comment:                 String("some comment"),
+				ShareEventsWithProvider: Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION "myapp" SET COMMENT = 'some comment' SHARE_EVENTS_WITH_PROVIDER = true`, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &AlterApplicationOptions{
+			name:  id,
+			Unset: &ApplicationUnset{Comment: Bool(true), DebugMode: Bool(true)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION "myapp" UNSET COMMENT, DEBUG_MODE`, actual)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterApplicationOptions{
+			name:    id,
+			NewName: NewAccountObjectIdentifier("newapp"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER APPLICATION "myapp" RENAME TO "newapp"`, actual)
+	})
+}
+
+func TestApplicationDrop(t *testing.T) {
+	opts := &DropApplicationOptions{
+		IfExists: Bool(true),
+		name:     NewAccountObjectIdentifier("myapp"),
+		Cascade:  Bool(true),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP APPLICATION IF EXISTS "myapp" CASCADE`, actual)
+}
+
+func TestApplicationShow(t *testing.T) {
+	opts := &ShowApplicationOptions{
+		Like: &Like{Pattern: String("my%")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW APPLICATIONS LIKE 'my%'`, actual)
+}
+
+func TestApplicationDescribe(t *testing.T) {
+	opts := &describeApplicationOptions{
+		name: NewAccountObjectIdentifier("myapp"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE APPLICATION "myapp"`, actual)
+}
+
+func TestApplicationDetailsFromRows(t *testing.T) {
+	rows := []applicationPropertyRow{
+		{Property: "name", Value: sql.NullString{String: "MYAPP", Valid: true}},
+		{Property: "version", Value: sql.NullString{String: "V1_0", Valid: true}},
+		{Property: "patch", Value: sql.NullString{String: "2", Valid: true}},
+		{Property: "debug_mode", Value: sql.NullString{String: "true", Valid: true}},
+		{Property: "comment"},
+	}
+	details := applicationDetailsFromRows(rows)
+	assert.Equal(t, "MYAPP", details.Name)
+	assert.Equal(t, "V1_0", details.Version)
+	assert.Equal(t, 2, details.Patch)
+	assert.True(t, details.DebugMode)
+	assert.Empty(t, details.Comment)
+}
+
+func TestApplicationShowReferences(t *testing.T) {
+	opts := &showReferencesInApplicationOptions{
+		inApplication: NewAccountObjectIdentifier("myapp"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW REFERENCES IN APPLICATION "myapp"`, actual)
+}
@@ -0,0 +1,155 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// defaultAwaitQueryInterval is used by AwaitQuery when no interval is given.
+const defaultAwaitQueryInterval = 2 * time.Second
+
+// awaitQueryNotFoundPolls is the number of polls for which AwaitQuery waits for a query to show up in the query
+// history, which lags behind for queries that were just submitted.
+const awaitQueryNotFoundPolls = 5
+
+var queryIDPattern = regexp.MustCompile(`^[0-9a-fA-F-]+$`)
+
+// QueryExecutionStatus is the EXECUTION_STATUS of a query in the query history.
+type QueryExecutionStatus string
+
+const (
+	QueryExecutionStatusResumingWarehouse  QueryExecutionStatus = "RESUMING_WAREHOUSE"
+	QueryExecutionStatusRunning            QueryExecutionStatus = "RUNNING"
+	QueryExecutionStatusQueued             QueryExecutionStatus = "QUEUED"
+	QueryExecutionStatusBlocked            QueryExecutionStatus = "BLOCKED"
+	QueryExecutionStatusSuccess            QueryExecutionStatus = "SUCCESS"
+	QueryExecutionStatusFailedWithError    QueryExecutionStatus = "FAILED_WITH_ERROR"
+	QueryExecutionStatusFailedWithIncident QueryExecutionStatus = "FAILED_WITH_INCIDENT"
+)
+
+// Done reports whether the query finished, successfully or not.
+func (s QueryExecutionStatus) Done() bool {
+	return s == QueryExecutionStatusSuccess || s.Failed()
+}
+
+// Failed reports whether the query finished with an error.
+func (s QueryExecutionStatus) Failed() bool {
+	return s == QueryExecutionStatusFailedWithError || s == QueryExecutionStatusFailedWithIncident
+}
+
+// QueryStatus is the status of a query started with ExecAsync.
+type QueryStatus struct {
+	QueryID         string
+	ExecutionStatus QueryExecutionStatus
+	ErrorCode       string
+	ErrorMessage    string
+}
+
+type queryStatusRow struct {
+	QueryID         string         `db:"QUERY_ID"`
+	ExecutionStatus sql.NullString `db:"EXECUTION_STATUS"`
+	ErrorCode       sql.NullString `db:"ERROR_CODE"`
+	ErrorMessage    sql.NullString `db:"ERROR_MESSAGE"`
+}
+
+func (row queryStatusRow) toQueryStatus() *QueryStatus {
+	return &QueryStatus{
+		QueryID:         row.QueryID,
+		ExecutionStatus: QueryExecutionStatus(row.ExecutionStatus.String),
+		ErrorCode:       row.ErrorCode.String,
+		ErrorMessage:    row.ErrorMessage.String,
+	}
+}
+
+func validateQueryID(queryID string) error {
+	if !queryIDPattern.MatchString(queryID) {
+		return fmt.Errorf("invalid query ID %q", queryID)
+	}
+	return nil
+}
+
+// queryStatusSQL reads the status of a query from the query history of the current user, which includes the
+// queries of all their sessions.
+func queryStatusSQL(queryID string) string {
+	return fmt.Sprintf(`SELECT QUERY_ID, EXECUTION_STATUS, ERROR_CODE, ERROR_MESSAGE FROM TABLE(INFORMATION_SCHEMA.QUERY_HISTORY_BY_USER(RESULT_LIMIT => 10000)) WHERE QUERY_ID = '%s'`, queryID)
+}
+
+// ExecAsync submits a statement without waiting for it to finish and returns its query ID, e.g. for long-running
+// CREATE TABLE AS SELECT statements. The statement keeps running when ctx is canceled or the client times out,
+// see PollQuery, AwaitQuery and CancelQuery.
+func (c *Client) ExecAsync(ctx context.Context, sql string) (string, error) {
+	queryIDs := make(chan string, 1)
+	ctx = gosnowflake.WithQueryIDChan(gosnowflake.WithAsyncMode(ctx), queryIDs)
+	if _, err := c.exec(ctx, sql); err != nil {
+		return "", err
+	}
+	select {
+	case queryID := <-queryIDs:
+		return queryID, nil
+	default:
+		return "", errors.New("the driver did not return a query ID")
+	}
+}
+
+// PollQuery returns the current status of a query. Queries that are not in the query history, e.g. because they
+// were just submitted, are older than its last 10000 entries or the ID is wrong, are reported with an error wrapping
+// ErrObjectNotExistOrAuthorized.
+func (c *Client) PollQuery(ctx context.Context, queryID string) (*QueryStatus, error) {
+	if err := validateQueryID(queryID); err != nil {
+		return nil, err
+	}
+	dest := []queryStatusRow{}
+	if err := c.query(ctx, &dest, queryStatusSQL(queryID)); err != nil {
+		return nil, err
+	}
+	if len(dest) == 0 {
+		return nil, fmt.Errorf("query %s is not in the query history: %w", queryID, ErrObjectNotExistOrAuthorized)
+	}
+	return dest[0].toQueryStatus(), nil
+}
+
+// AwaitQuery polls a query every interval (2 seconds by default) until it finished or ctx is done. Failed queries
+// are returned together with an error wrapping ErrQueryFailed. A query that is still not in the query history after
+// a few polls is reported with an error wrapping ErrObjectNotExistOrAuthorized.
+func (c *Client) AwaitQuery(ctx context.Context, queryID string, interval time.Duration) (*QueryStatus, error) {
+	if interval <= 0 {
+		interval = defaultAwaitQueryInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for poll := 1; ; poll++ {
+		status, err := c.PollQuery(ctx, queryID)
+		switch {
+		case errors.Is(err, ErrObjectNotExistOrAuthorized) && poll < awaitQueryNotFoundPolls:
+			status = &QueryStatus{QueryID: queryID, ExecutionStatus: QueryExecutionStatusRunning}
+		case err != nil:
+			return nil, err
+		}
+		if status.ExecutionStatus.Failed() {
+			return status, fmt.Errorf("%w: query %s: %s %s", ErrQueryFailed, queryID, status.ErrorCode, status.ErrorMessage)
+		}
+		if status.ExecutionStatus.Done() {
+			return status, nil
+		}
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CancelQuery cancels a running query with SYSTEM$CANCEL_QUERY.
+func (c *Client) CancelQuery(ctx context.Context, queryID string) error {
+	if err := validateQueryID(queryID); err != nil {
+		return err
+	}
+	_, err := c.exec(ctx, fmt.Sprintf(`SELECT SYSTEM$CANCEL_QUERY('%s')`, queryID))
+	return err
+}
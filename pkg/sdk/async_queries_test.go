@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncQueries(t *testing.T) {
+	ctx := context.Background()
+	queryID := "01b2c3d4-0000-1234-0000-00000000abcd"
+	statusSQL := regexp.QuoteMeta(queryStatusSQL(queryID))
+	statusColumns := []string{"QUERY_ID", "EXECUTION_STATUS", "ERROR_CODE", "ERROR_MESSAGE"}
+
+	t.Run("poll a query not in the history", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(statusSQL).WillReturnRows(sqlmock.NewRows(statusColumns))
+
+		_, err := client.PollQuery(ctx, queryID)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("await a query that shows up in the history late", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(statusSQL).WillReturnRows(sqlmock.NewRows(statusColumns))
+		mock.ExpectQuery(statusSQL).WillReturnRows(sqlmock.NewRows(statusColumns).AddRow(queryID, "SUCCESS", nil, nil))
+
+		status, err := client.AwaitQuery(ctx, queryID, time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, QueryExecutionStatusSuccess, status.ExecutionStatus)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("await a query that is not in the history", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		for i := 0; i < awaitQueryNotFoundPolls; i++ {
+			mock.ExpectQuery(statusSQL).WillReturnRows(sqlmock.NewRows(statusColumns))
+		}
+
+		_, err := client.AwaitQuery(ctx, queryID, time.Millisecond)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("await success", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(statusSQL).WillReturnRows(sqlmock.NewRows(statusColumns).AddRow(queryID, "RUNNING", nil, nil))
+		mock.ExpectQuery(statusSQL).WillReturnRows(sqlmock.NewRows(statusColumns).AddRow(queryID, "SUCCESS", nil, nil))
+
+		status, err := client.AwaitQuery(ctx, queryID, time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, QueryExecutionStatusSuccess, status.ExecutionStatus)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("await failure", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(statusSQL).WillReturnRows(sqlmock.NewRows(statusColumns).AddRow(queryID, "FAILED_WITH_ERROR", "002003", "Object does not exist"))
+
+		status, err := client.AwaitQuery(ctx, queryID, time.Millisecond)
+		require.ErrorIs(t, err, ErrQueryFailed)
+		assert.Equal(t, "Object does not exist", status.ErrorMessage)
+	})
+
+	t.Run("await canceled", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(statusSQL).WillReturnRows(sqlmock.NewRows(statusColumns).AddRow(queryID, "QUEUED", nil, nil))
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := client.AwaitQuery(ctx, queryID, time.Hour)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(regexp.QuoteMeta(`SELECT SYSTEM$CANCEL_QUERY('` + queryID + `')`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		require.NoError(t, client.CancelQuery(ctx, queryID))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid query ID", func(t *testing.T) {
+		client, _ := newMockClient(t)
+		_, err := client.PollQuery(ctx, "x'); DROP TABLE t; --")
+		require.Error(t, err)
+		require.Error(t, client.CancelQuery(ctx, ""))
+	})
+}
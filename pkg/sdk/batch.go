@@ -0,0 +1,107 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// ExecBatchOptions controls how ExecBatch and ExecBatchSQL execute the statements.
+type ExecBatchOptions struct {
+	// ContinueOnError executes every statement and returns a *BatchError with the failed ones instead of stopping
+	// at the first error. The statements are then executed concurrently, up to the fan-out limit of the client
+	// (see WithFanOutLimit), so they must not depend on each other, e.g. grants on different objects.
+	ContinueOnError bool
+}
+
+// BatchError is returned by ExecBatch and ExecBatchSQL with ContinueOnError when some statements failed.
+type BatchError struct {
+	// Statements is the number of statements in the batch.
+	Statements int
+	// Errors are the errors of the failed statements by their index in the batch.
+	Errors map[int]error
+}
+
+func (e *BatchError) Error() string {
+	indexes := make([]int, 0, len(e.Errors))
+	for i := range e.Errors {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	messages := make([]string, len(indexes))
+	for j, i := range indexes {
+		messages[j] = fmt.Sprintf("statement %d: %v", i, e.Errors[i])
+	}
+	return fmt.Sprintf("%d of %d statements failed: %s", len(e.Errors), e.Statements, strings.Join(messages, "; "))
+}
+
+// ExecBatch validates and renders the statements, see ExecBatchSQL.
+func (c *Client) ExecBatch(ctx context.Context, statements []Statement, opts *ExecBatchOptions) error {
+	sqls, err := statementsToSQL(sqlBuilder{quoting: c.IdentifierQuoting()}, statements)
+	if err != nil {
+		return err
+	}
+	return c.ExecBatchSQL(ctx, sqls, opts)
+}
+
+// ExecBatchSQL executes the statements in order. By default they are sent as a single multi-statement request,
+// which saves a round trip per statement, and Snowflake stops at the first failing statement. See
+// ExecBatchOptions.ContinueOnError for executing all statements and collecting their errors.
+func (c *Client) ExecBatchSQL(ctx context.Context, sqls []string, opts *ExecBatchOptions) error {
+	if len(sqls) == 0 {
+		return nil
+	}
+	if opts == nil {
+		opts = &ExecBatchOptions{}
+	}
+	if opts.ContinueOnError {
+		return c.execConcurrently(ctx, sqls)
+	}
+	if len(sqls) == 1 {
+		_, err := c.exec(ctx, sqls[0])
+		return err
+	}
+	ctx, err := gosnowflake.WithMultiStatement(ctx, len(sqls))
+	if err != nil {
+		return err
+	}
+	_, err = c.exec(ctx, multiStatementSQL(sqls))
+	return err
+}
+
+// multiStatementSQL joins statements into the text of a multi-statement request.
+func multiStatementSQL(sqls []string) string {
+	trimmed := make([]string, len(sqls))
+	for i, sql := range sqls {
+		trimmed[i] = strings.TrimRight(strings.TrimSpace(sql), ";")
+	}
+	return strings.Join(trimmed, ";\n")
+}
+
+func (c *Client) execConcurrently(ctx context.Context, sqls []string) error {
+	indexes := make([]int, len(sqls))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	// the errors are collected instead of returned so that FanOut does not cancel the remaining statements
+	errs := make([]error, len(sqls))
+	if _, err := FanOut(ctx, c, indexes, func(ctx context.Context, i int) (struct{}, error) {
+		_, errs[i] = c.exec(ctx, sqls[i])
+		return struct{}{}, nil
+	}); err != nil {
+		return err
+	}
+	batchErr := &BatchError{Statements: len(sqls), Errors: map[int]error{}}
+	for i, err := range errs {
+		if err != nil {
+			batchErr.Errors[i] = err
+		}
+	}
+	if len(batchErr.Errors) > 0 {
+		return batchErr
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecBatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("single round trip", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(regexp.QuoteMeta("DROP WAREHOUSE \"WH1\";\nDROP ROLE \"ROLE1\"")).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := client.ExecBatch(ctx, []Statement{
+			&DropWarehouseOptions{name: NewAccountObjectIdentifier("WH1")},
+			&DropRoleOptions{name: NewAccountObjectIdentifier("ROLE1")},
+		}, nil)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid statement", func(t *testing.T) {
+		client, _ := newMockClient(t)
+		err := client.ExecBatch(ctx, []Statement{&DropWarehouseOptions{}}, nil)
+		assert.ErrorIs(t, err, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("continue on error", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.MatchExpectationsInOrder(false)
+		mock.ExpectExec("GRANT 1").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("GRANT 2").WillReturnError(errors.New("insufficient privileges"))
+		mock.ExpectExec("GRANT 3").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := client.ExecBatchSQL(ctx, []string{"GRANT 1", "GRANT 2", "GRANT 3"}, &ExecBatchOptions{ContinueOnError: true})
+		var batchErr *BatchError
+		require.ErrorAs(t, err, &batchErr)
+		assert.Len(t, batchErr.Errors, 1)
+		assert.ErrorContains(t, batchErr.Errors[1], "insufficient privileges")
+		assert.Equal(t, "1 of 3 statements failed: statement 1: insufficient privileges", err.Error())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no statements", func(t *testing.T) {
+		client, _ := newMockClient(t)
+		require.NoError(t, client.ExecBatchSQL(ctx, nil, nil))
+	})
+}
+
+func TestMultiStatementSQL(t *testing.T) {
+	assert.Equal(t, "SELECT 1;\nSELECT 2", multiStatementSQL([]string{" SELECT 1; ", "SELECT 2"}))
+}
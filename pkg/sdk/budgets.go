@@ -0,0 +1,332 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Budgets = (*budgets)(nil)
+
+// Budgets are instances of the SNOWFLAKE.CORE.BUDGET class. Apart from creating, dropping and listing them,
+// budgets are managed by calling their instance methods, which this interface wraps.
+type Budgets interface {
+	// Create creates a new budget.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateBudgetOptions) error
+	// Drop removes a budget.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropBudgetOptions) error
+	// Show returns a list of budgets.
+	Show(ctx context.Context, opts *ShowBudgetOptions) ([]*Budget, error)
+	// ShowByID returns a budget by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error)
+	// SetSpendingLimit sets the monthly spending limit of the budget in credits.
+	SetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier, credits int) error
+	// AddResource links an object to the budget, so that its credit usage counts towards the budget.
+	AddResource(ctx context.Context, id SchemaObjectIdentifier, resource BudgetResource) error
+	// RemoveResource unlinks an object from the budget.
+	RemoveResource(ctx context.Context, id SchemaObjectIdentifier, resource BudgetResource) error
+	// SetEmailNotifications sets the notification integration and the addresses notified when
+	// the budget is projected to exceed its spending limit.
+	SetEmailNotifications(ctx context.Context, id SchemaObjectIdentifier, integration AccountObjectIdentifier, emails []string) error
+	// State returns the spending limit, the notification integration and the linked resources of the budget.
+	State(ctx context.Context, id SchemaObjectIdentifier) (*BudgetState, error)
+}
+
+// budgets implements Budgets.
+type budgets struct {
+	client *Client
+}
+
+type CreateBudgetOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	budget      bool                   `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	arguments   bool                   `ddl:"static" sql:"()"` //lint:ignore U1000 This is used in the ddl tag
+}
+
+func (opts *CreateBudgetOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	return nil
+}
+
+func (v *budgets) Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateBudgetOptions) error {
+	if opts == nil {
+		opts = &CreateBudgetOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropBudgetOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`                  //lint:ignore U1000 This is used in the ddl tag
+	budget   bool                   `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropBudgetOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *budgets) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropBudgetOptions) error {
+	if opts == nil {
+		opts = &DropBudgetOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowBudgetOptions struct {
+	show      bool  `ddl:"static" sql:"SHOW"`                  //lint:ignore U1000 This is used in the ddl tag
+	budget    bool  `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET"` //lint:ignore U1000 This is used in the ddl tag
+	instances bool  `ddl:"static" sql:"INSTANCES"`             //lint:ignore U1000 This is used in the ddl tag
+	Like      *Like `ddl:"keyword" sql:"LIKE"`
+	In        *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowBudgetOptions) validate() error {
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type Budget struct {
+	CreatedOn      time.Time
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	CurrentVersion string
+	Comment        string
+	Owner          string
+	OwnerRoleType  string
+}
+
+func (v *Budget) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+type budgetRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	CurrentVersion sql.NullString `db:"current_version"`
+	Comment        sql.NullString `db:"comment"`
+	Owner          sql.NullString `db:"owner"`
+	OwnerRoleType  sql.NullString `db:"owner_role_type"`
+}
+
+func (row budgetRow) toBudget() *Budget {
+	return &Budget{
+		CreatedOn:      row.CreatedOn,
+		Name:           row.Name,
+		DatabaseName:   row.DatabaseName,
+		SchemaName:     row.SchemaName,
+		CurrentVersion: row.CurrentVersion.String,
+		Comment:        row.Comment.String,
+		Owner:          row.Owner.String,
+		OwnerRoleType:  row.OwnerRoleType.String,
+	}
+}
+
+func (v *budgets) Show(ctx context.Context, opts *ShowBudgetOptions) ([]*Budget, error) {
+	if opts == nil {
+		opts = &ShowBudgetOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []budgetRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Budget, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toBudget()
+	}
+	return resultList, nil
+}
+
+func (v *budgets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error) {
+	budgets, err := v.Show(ctx, &ShowBudgetOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, budget := range budgets {
+		if budget.Name == id.Name() {
+			return budget, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// BudgetResource is an object whose credit usage is tracked by a budget, e.g. a warehouse or a database.
+type BudgetResource struct {
+	ObjectType ObjectType
+	ID         ObjectIdentifier
+}
+
+// reference returns the SYSTEM$REFERENCE call granting the budget the APPLYBUDGET privilege on the resource.
+func (r BudgetResource) reference() string {
+	return fmt.Sprintf(`SYSTEM$REFERENCE('%s', '%s', 'SESSION', 'APPLYBUDGET')`, r.ObjectType, escapeStringLiteral(r.ID.FullyQualifiedName()))
+}
+
+func (r BudgetResource) validate() error {
+	if r.ObjectType == "" {
+		return errors.New("ObjectType must be set")
+	}
+	if r.ID == nil || !validObjectidentifier(r.ID) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// budgetMethodSQL builds the CALL of an instance method of the budget.
+func budgetMethodSQL(id SchemaObjectIdentifier, method string, args ...string) string {
+	return fmt.Sprintf("CALL %s!%s(%s)", id.FullyQualifiedName(), method, strings.Join(args, ", "))
+}
+
+func (v *budgets) call(ctx context.Context, id SchemaObjectIdentifier, method string, args ...string) error {
+	if !validObjectidentifier(id) {
+		return ErrInvalidObjectIdentifier
+	}
+	_, err := v.client.exec(ctx, budgetMethodSQL(id, method, args...))
+	return err
+}
+
+func (v *budgets) SetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier, credits int) error {
+	if !validateIntGreaterThanOrEqual(credits, 0) {
+		return errors.New("credits must be greater than or equal to 0")
+	}
+	return v.call(ctx, id, "SET_SPENDING_LIMIT", fmt.Sprintf("%d", credits))
+}
+
+func (v *budgets) AddResource(ctx context.Context, id SchemaObjectIdentifier, resource BudgetResource) error {
+	if err := resource.validate(); err != nil {
+		return err
+	}
+	return v.call(ctx, id, "ADD_RESOURCE", resource.reference())
+}
+
+func (v *budgets) RemoveResource(ctx context.Context, id SchemaObjectIdentifier, resource BudgetResource) error {
+	if err := resource.validate(); err != nil {
+		return err
+	}
+	return v.call(ctx, id, "REMOVE_RESOURCE", resource.reference())
+}
+
+func (v *budgets) SetEmailNotifications(ctx context.Context, id SchemaObjectIdentifier, integration AccountObjectIdentifier, emails []string) error {
+	if !validObjectidentifier(integration) {
+		return ErrInvalidObjectIdentifier
+	}
+	if len(emails) == 0 {
+		return errors.New("at least one email must be set")
+	}
+	return v.call(ctx, id, "SET_EMAIL_NOTIFICATIONS", fmt.Sprintf("'%s'", escapeStringLiteral(integration.Name())), fmt.Sprintf("'%s'", escapeStringLiteral(strings.Join(emails, ", "))))
+}
+
+type BudgetState struct {
+	SpendingLimit           int
+	NotificationIntegration string
+	LinkedResources         []BudgetLinkedResource
+}
+
+type BudgetLinkedResource struct {
+	ResourceID   int
+	Name         string
+	Domain       string
+	SchemaName   string
+	DatabaseName string
+}
+
+type budgetLinkedResourceRow struct {
+	ResourceID   sql.NullInt64  `db:"RESOURCE_ID"`
+	Name         string         `db:"NAME"`
+	Domain       sql.NullString `db:"DOMAIN"`
+	SchemaName   sql.NullString `db:"SCHEMA_NAME"`
+	DatabaseName sql.NullString `db:"DATABASE_NAME"`
+}
+
+func (row budgetLinkedResourceRow) toBudgetLinkedResource() BudgetLinkedResource {
+	return BudgetLinkedResource{
+		ResourceID:   int(row.ResourceID.Int64),
+		Name:         row.Name,
+		Domain:       row.Domain.String,
+		SchemaName:   row.SchemaName.String,
+		DatabaseName: row.DatabaseName.String,
+	}
+}
+
+func (v *budgets) State(ctx context.Context, id SchemaObjectIdentifier) (*BudgetState, error) {
+	if !validObjectidentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	limit := struct {
+		SpendingLimit sql.NullInt64 `db:"GET_SPENDING_LIMIT"`
+	}{}
+	if err := v.client.queryOne(ctx, &limit, budgetMethodSQL(id, "GET_SPENDING_LIMIT")); err != nil {
+		return nil, err
+	}
+	integration := struct {
+		Name sql.NullString `db:"GET_NOTIFICATION_INTEGRATION_NAME"`
+	}{}
+	if err := v.client.queryOne(ctx, &integration, budgetMethodSQL(id, "GET_NOTIFICATION_INTEGRATION_NAME")); err != nil {
+		return nil, err
+	}
+	var rows []budgetLinkedResourceRow
+	if err := v.client.query(ctx, &rows, budgetMethodSQL(id, "GET_LINKED_RESOURCES")); err != nil {
+		return nil, err
+	}
+	state := &BudgetState{
+		SpendingLimit:           int(limit.SpendingLimit.Int64),
+		NotificationIntegration: integration.Name.String,
+		LinkedResources:         make([]BudgetLinkedResource, len(rows)),
+	}
+	for i, row := range rows {
+		state.LinkedResources[i] = row.toBudgetLinkedResource()
+	}
+	return state, nil
+}
@@ -0,0 +1,59 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Budgets(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	warehouse, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err := client.Budgets.Create(ctx, id, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Budgets.Drop(ctx, id, &DropBudgetOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		budget, err := client.Budgets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, budget.ID())
+	})
+
+	t.Run("set spending limit", func(t *testing.T) {
+		err := client.Budgets.SetSpendingLimit(ctx, id, 100)
+		require.NoError(t, err)
+		state, err := client.Budgets.State(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 100, state.SpendingLimit)
+	})
+
+	t.Run("add and remove resource", func(t *testing.T) {
+		resource := BudgetResource{ObjectType: ObjectTypeWarehouse, ID: warehouse.ID()}
+		err := client.Budgets.AddResource(ctx, id, resource)
+		require.NoError(t, err)
+		state, err := client.Budgets.State(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, state.LinkedResources, 1)
+		assert.Equal(t, warehouse.Name, state.LinkedResources[0].Name)
+
+		err = client.Budgets.RemoveResource(ctx, id, resource)
+		require.NoError(t, err)
+		state, err = client.Budgets.State(ctx, id)
+		require.NoError(t, err)
+		assert.Len(t, state.LinkedResources, 0)
+	})
+}
@@ -0,0 +1,68 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetCreate(t *testing.T) {
+	t.Run("with or replace", func(t *testing.T) {
+		opts := &CreateBudgetOptions{
+			OrReplace: Bool(true),
+			name:      NewSchemaObjectIdentifier("db", "schema", "mybudget"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE SNOWFLAKE.CORE.BUDGET "db"."schema"."mybudget" ()`, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateBudgetOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        NewSchemaObjectIdentifier("db", "schema", "mybudget"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestBudgetDrop(t *testing.T) {
+	opts := &DropBudgetOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "mybudget"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP SNOWFLAKE.CORE.BUDGET IF EXISTS "db"."schema"."mybudget"`, actual)
+}
+
+func TestBudgetShow(t *testing.T) {
+	opts := &ShowBudgetOptions{
+		Like: &Like{Pattern: String("mybudget")},
+		In:   &In{Schema: NewSchemaIdentifier("db", "schema")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW SNOWFLAKE.CORE.BUDGET INSTANCES LIKE 'mybudget' IN SCHEMA "db"."schema"`, actual)
+}
+
+func TestBudgetMethodSQL(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "mybudget")
+
+	t.Run("without arguments", func(t *testing.T) {
+		assert.Equal(t, `CALL "db"."schema"."mybudget"!GET_SPENDING_LIMIT()`, budgetMethodSQL(id, "GET_SPENDING_LIMIT"))
+	})
+
+	t.Run("with resource reference", func(t *testing.T) {
+		resource := BudgetResource{ObjectType: ObjectTypeWarehouse, ID: NewAccountObjectIdentifier("wh")}
+		require.NoError(t, resource.validate())
+		assert.Equal(t, `CALL "db"."schema"."mybudget"!ADD_RESOURCE(SYSTEM$REFERENCE('WAREHOUSE', '"wh"', 'SESSION', 'APPLYBUDGET'))`, budgetMethodSQL(id, "ADD_RESOURCE", resource.reference()))
+	})
+
+	t.Run("validation: resource without object type", func(t *testing.T) {
+		resource := BudgetResource{ID: NewAccountObjectIdentifier("wh")}
+		assert.Error(t, resource.validate())
+	})
+}
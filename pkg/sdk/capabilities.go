@@ -0,0 +1,199 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// errorCodeInsufficientPrivileges is returned for statements the current role is not allowed to run.
+const errorCodeInsufficientPrivileges = 3001
+
+// Capabilities detects what the account the client is connected to supports, so that
+// options unavailable in the target account can be rejected before anything is executed.
+type Capabilities interface {
+	// Probe reads the edition, cloud and region of the current account. The result is cached per client,
+	// as the account a client is connected to does not change. Errors are not cached, so a failed probe is
+	// repeated by the next call.
+	Probe(ctx context.Context) (*AccountCapabilities, error)
+}
+
+var _ Capabilities = (*capabilities)(nil)
+
+type capabilities struct {
+	client *Client
+
+	mu     sync.Mutex
+	probed *AccountCapabilities
+}
+
+var allCloudTypes = []CloudType{
+	CloudTypeAWS,
+	CloudTypeAzure,
+	CloudTypeGCP,
+}
+
+// AccountFeature is an account-level feature that is only available starting with a given edition.
+type AccountFeature string
+
+const (
+	AccountFeatureMultiClusterWarehouses  AccountFeature = "MULTI_CLUSTER_WAREHOUSES"
+	AccountFeatureExtendedTimeTravel      AccountFeature = "EXTENDED_TIME_TRAVEL"
+	AccountFeatureColumnLevelSecurity     AccountFeature = "COLUMN_LEVEL_SECURITY"
+	AccountFeatureRowAccessPolicies       AccountFeature = "ROW_ACCESS_POLICIES"
+	AccountFeatureSearchOptimization      AccountFeature = "SEARCH_OPTIMIZATION"
+	AccountFeatureMaterializedViews       AccountFeature = "MATERIALIZED_VIEWS"
+	AccountFeatureObjectTagging           AccountFeature = "OBJECT_TAGGING"
+	AccountFeatureFailover                AccountFeature = "FAILOVER"
+	AccountFeatureShareRestrictions       AccountFeature = "SHARE_RESTRICTIONS"
+	AccountFeaturePrivateConnectivity     AccountFeature = "PRIVATE_CONNECTIVITY"
+	AccountFeatureTriSecretSecure         AccountFeature = "TRI_SECRET_SECURE"
+	AccountFeatureCustomerManagedKeyRekey AccountFeature = "PERIODIC_REKEYING"
+)
+
+// accountFeatureMinimumEditions lists the lowest edition in which a feature is available.
+// Features that are not listed are available in every edition.
+var accountFeatureMinimumEditions = map[AccountFeature]AccountEdition{
+	AccountFeatureMultiClusterWarehouses:  EditionEnterprise,
+	AccountFeatureExtendedTimeTravel:      EditionEnterprise,
+	AccountFeatureColumnLevelSecurity:     EditionEnterprise,
+	AccountFeatureRowAccessPolicies:       EditionEnterprise,
+	AccountFeatureSearchOptimization:      EditionEnterprise,
+	AccountFeatureMaterializedViews:       EditionEnterprise,
+	AccountFeatureObjectTagging:           EditionEnterprise,
+	AccountFeatureCustomerManagedKeyRekey: EditionEnterprise,
+	AccountFeatureFailover:                EditionBusinessCritical,
+	AccountFeatureShareRestrictions:       EditionBusinessCritical,
+	AccountFeaturePrivateConnectivity:     EditionBusinessCritical,
+	AccountFeatureTriSecretSecure:         EditionBusinessCritical,
+}
+
+// editionRank orders the editions so that a higher edition includes the features of the lower ones.
+func editionRank(edition AccountEdition) int {
+	switch edition {
+	case EditionStandard:
+		return 1
+	case EditionEnterprise:
+		return 2
+	case EditionBusinessCritical:
+		return 3
+	default:
+		return 0
+	}
+}
+
+type AccountCapabilities struct {
+	AccountName string
+	// Edition is empty when it could not be determined, e.g. because the current role
+	// is not allowed to run SHOW ORGANIZATION ACCOUNTS.
+	Edition     AccountEdition
+	Cloud       CloudType
+	Region      string
+	RegionGroup string
+}
+
+// EditionKnown reports whether the edition of the account could be determined.
+func (v *AccountCapabilities) EditionKnown() bool {
+	return editionRank(v.Edition) > 0
+}
+
+// SupportsEdition reports whether the account is at least of the given edition.
+// An account with an unknown edition is assumed to support every edition.
+func (v *AccountCapabilities) SupportsEdition(edition AccountEdition) bool {
+	if !v.EditionKnown() {
+		return true
+	}
+	return editionRank(v.Edition) >= editionRank(edition)
+}
+
+// SupportsFeature reports whether the feature is available in the account.
+func (v *AccountCapabilities) SupportsFeature(feature AccountFeature) bool {
+	minimum, ok := accountFeatureMinimumEditions[feature]
+	if !ok {
+		return true
+	}
+	return v.SupportsEdition(minimum)
+}
+
+// CheckFeature returns ErrFeatureNotSupported when the feature is not available in the account.
+func (v *AccountCapabilities) CheckFeature(feature AccountFeature) error {
+	if v.SupportsFeature(feature) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s requires %s edition, account %s is %s", ErrFeatureNotSupported, feature, accountFeatureMinimumEditions[feature], v.AccountName, v.Edition)
+}
+
+// parseRegion splits the output of CURRENT_REGION(), e.g. AWS_US_WEST_2 or PUBLIC.AWS_US_WEST_2,
+// into the region group, the cloud and the region.
+func parseRegion(raw string) (regionGroup string, cloud CloudType, region string) {
+	region = raw
+	if i := strings.LastIndex(region, "."); i >= 0 {
+		regionGroup, region = region[:i], region[i+1:]
+	}
+	for _, c := range allCloudTypes {
+		if strings.HasPrefix(strings.ToLower(region), string(c)+"_") {
+			cloud = c
+			break
+		}
+	}
+	return regionGroup, cloud, region
+}
+
+func (c *capabilities) Probe(ctx context.Context) (*AccountCapabilities, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.probed != nil {
+		return c.probed, nil
+	}
+	probed, err := c.probe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.probed = probed
+	return probed, nil
+}
+
+func (c *capabilities) probe(ctx context.Context) (*AccountCapabilities, error) {
+	accountName, err := c.client.ContextFunctions.CurrentAccountName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentRegion, err := c.client.ContextFunctions.CurrentRegion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	regionGroup, cloud, region := parseRegion(currentRegion)
+	result := &AccountCapabilities{
+		AccountName: accountName,
+		Cloud:       cloud,
+		Region:      region,
+		RegionGroup: regionGroup,
+	}
+
+	// The edition is only exposed through SHOW ORGANIZATION ACCOUNTS, which requires ORGADMIN.
+	// Not being allowed to read it is not an error, the edition is left unknown instead.
+	account, err := c.client.Accounts.ShowByID(ctx, NewAccountObjectIdentifier(accountName))
+	if err != nil {
+		if !isAuthorizationError(err) {
+			return nil, err
+		}
+		log.Printf("[DEBUG] could not determine edition of account %s: %v\n", accountName, err)
+		return result, nil
+	}
+	result.Edition = account.Edition
+	return result, nil
+}
+
+// isAuthorizationError reports whether err means that the current role may not see an object or run a statement.
+func isAuthorizationError(err error) bool {
+	if errors.Is(err, ErrObjectNotExistOrAuthorized) {
+		return true
+	}
+	var snowflakeErr *gosnowflake.SnowflakeError
+	return errors.As(err, &snowflakeErr) && snowflakeErr.Number == errorCodeInsufficientPrivileges
+}
@@ -0,0 +1,20 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_CapabilitiesProbe(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	capabilities, err := client.Capabilities.Probe(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, capabilities.AccountName)
+	assert.NotEmpty(t, capabilities.Cloud)
+	assert.NotEmpty(t, capabilities.Region)
+}
@@ -0,0 +1,107 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegion(t *testing.T) {
+	t.Run("region only", func(t *testing.T) {
+		regionGroup, cloud, region := parseRegion("AWS_US_WEST_2")
+		assert.Equal(t, "", regionGroup)
+		assert.Equal(t, CloudTypeAWS, cloud)
+		assert.Equal(t, "AWS_US_WEST_2", region)
+	})
+
+	t.Run("with region group", func(t *testing.T) {
+		regionGroup, cloud, region := parseRegion("PUBLIC.AZURE_WESTEUROPE")
+		assert.Equal(t, "PUBLIC", regionGroup)
+		assert.Equal(t, CloudTypeAzure, cloud)
+		assert.Equal(t, "AZURE_WESTEUROPE", region)
+	})
+
+	t.Run("unknown cloud", func(t *testing.T) {
+		_, cloud, region := parseRegion("SOMEWHERE")
+		assert.Equal(t, CloudType(""), cloud)
+		assert.Equal(t, "SOMEWHERE", region)
+	})
+}
+
+func TestAccountCapabilities(t *testing.T) {
+	t.Run("standard edition", func(t *testing.T) {
+		c := &AccountCapabilities{AccountName: "acc", Edition: EditionStandard}
+		assert.True(t, c.SupportsEdition(EditionStandard))
+		assert.False(t, c.SupportsEdition(EditionEnterprise))
+		assert.False(t, c.SupportsFeature(AccountFeatureMultiClusterWarehouses))
+		err := c.CheckFeature(AccountFeatureShareRestrictions)
+		assert.True(t, errors.Is(err, ErrFeatureNotSupported))
+		assert.Equal(t, "feature not supported by account edition: SHARE_RESTRICTIONS requires BUSINESS_CRITICAL edition, account acc is STANDARD", err.Error())
+	})
+
+	t.Run("enterprise edition", func(t *testing.T) {
+		c := &AccountCapabilities{Edition: EditionEnterprise}
+		assert.True(t, c.SupportsFeature(AccountFeatureMultiClusterWarehouses))
+		assert.False(t, c.SupportsFeature(AccountFeatureShareRestrictions))
+	})
+
+	t.Run("business critical edition", func(t *testing.T) {
+		c := &AccountCapabilities{Edition: EditionBusinessCritical}
+		assert.True(t, c.SupportsFeature(AccountFeatureShareRestrictions))
+		assert.NoError(t, c.CheckFeature(AccountFeatureTriSecretSecure))
+	})
+
+	t.Run("unknown edition", func(t *testing.T) {
+		c := &AccountCapabilities{}
+		assert.False(t, c.EditionKnown())
+		assert.True(t, c.SupportsFeature(AccountFeatureShareRestrictions))
+		assert.NoError(t, c.CheckFeature(AccountFeatureShareRestrictions))
+	})
+
+	t.Run("feature without minimum edition", func(t *testing.T) {
+		c := &AccountCapabilities{Edition: EditionStandard}
+		assert.True(t, c.SupportsFeature(AccountFeature("SOMETHING_ELSE")))
+	})
+}
+
+func TestProbeCache(t *testing.T) {
+	client, mock := newMockClient(t)
+
+	mock.ExpectQuery(`SELECT CURRENT_ACCOUNT_NAME\(\)`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ACCOUNT_NAME"}).AddRow("ACC"))
+	mock.ExpectQuery(`SELECT CURRENT_REGION\(\)`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_REGION"}).AddRow("AWS_US_WEST_2"))
+	mock.ExpectQuery(`SHOW ORGANIZATION ACCOUNTS`).WillReturnError(&gosnowflake.SnowflakeError{Number: 3001, Message: "Insufficient privileges to operate on account 'ACC'"})
+
+	first, err := client.Capabilities.Probe(context.Background())
+	require.NoError(t, err)
+	assert.False(t, first.EditionKnown())
+
+	second, err := client.Capabilities.Probe(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProbeErrorNotCached(t *testing.T) {
+	client, mock := newMockClient(t)
+	expectProbe := func() {
+		mock.ExpectQuery(`SELECT CURRENT_ACCOUNT_NAME\(\)`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ACCOUNT_NAME"}).AddRow("ACC"))
+		mock.ExpectQuery(`SELECT CURRENT_REGION\(\)`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_REGION"}).AddRow("AWS_US_WEST_2"))
+	}
+
+	expectProbe()
+	mock.ExpectQuery(`SHOW ORGANIZATION ACCOUNTS`).WillReturnError(errors.New("connection reset by peer"))
+	_, err := client.Capabilities.Probe(context.Background())
+	require.ErrorContains(t, err, "connection reset by peer")
+
+	expectProbe()
+	mock.ExpectQuery(`SHOW ORGANIZATION ACCOUNTS`).WillReturnRows(sqlmock.NewRows([]string{"account_name", "edition"}).AddRow("ACC", "ENTERPRISE"))
+	probed, err := client.Capabilities.Probe(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, EditionEnterprise, probed.Edition)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
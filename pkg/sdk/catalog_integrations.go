@@ -0,0 +1,533 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ CatalogIntegrations = (*catalogIntegrations)(nil)
+
+// CatalogIntegrations describes all the catalog integration related methods that the
+// Snowflake API supports.
+type CatalogIntegrations interface {
+	// Create creates a new catalog integration.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateCatalogIntegrationOptions) error
+	// Alter modifies an existing catalog integration.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterCatalogIntegrationOptions) error
+	// Drop removes a catalog integration.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropCatalogIntegrationOptions) error
+	// Show returns a list of catalog integrations.
+	Show(ctx context.Context, opts *ShowCatalogIntegrationOptions) ([]*CatalogIntegration, error)
+	// ShowByID returns a catalog integration by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegration, error)
+	// Describe returns the details of a catalog integration.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegrationDetails, error)
+}
+
+// catalogIntegrations implements CatalogIntegrations.
+type catalogIntegrations struct {
+	client *Client
+}
+
+type CatalogIntegrationTableFormat string
+
+const (
+	CatalogIntegrationTableFormatIceberg CatalogIntegrationTableFormat = "ICEBERG"
+	CatalogIntegrationTableFormatDelta   CatalogIntegrationTableFormat = "DELTA"
+)
+
+type CatalogIntegrationCatalogAPIType string
+
+const (
+	CatalogIntegrationCatalogAPITypePublic               CatalogIntegrationCatalogAPIType = "PUBLIC"
+	CatalogIntegrationCatalogAPITypeAWSAPIGateway        CatalogIntegrationCatalogAPIType = "AWS_API_GATEWAY"
+	CatalogIntegrationCatalogAPITypeAWSPrivateAPIGateway CatalogIntegrationCatalogAPIType = "AWS_PRIVATE_API_GATEWAY"
+	CatalogIntegrationCatalogAPITypeAWSGlue              CatalogIntegrationCatalogAPIType = "AWS_GLUE"
+)
+
+type CatalogIntegrationAccessDelegationMode string
+
+const (
+	CatalogIntegrationAccessDelegationModeVendedCredentials         CatalogIntegrationAccessDelegationMode = "VENDED_CREDENTIALS"
+	CatalogIntegrationAccessDelegationModeExternalVolumeCredentials CatalogIntegrationAccessDelegationMode = "EXTERNAL_VOLUME_CREDENTIALS"
+)
+
+type CreateCatalogIntegrationOptions struct {
+	create             bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace          *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	catalogIntegration bool                    `ddl:"static" sql:"CATALOG INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists        *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+
+	GlueParams        *GlueCatalogParams        `ddl:"keyword"`
+	ObjectStoreParams *ObjectStoreCatalogParams `ddl:"keyword"`
+	IcebergRestParams *IcebergRestCatalogParams `ddl:"keyword"`
+	PolarisParams     *PolarisCatalogParams     `ddl:"keyword"`
+
+	Enabled                bool    `ddl:"parameter" sql:"ENABLED"`
+	RefreshIntervalSeconds *int    `ddl:"parameter" sql:"REFRESH_INTERVAL_SECONDS"`
+	Comment                *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// GlueCatalogParams configures an integration for Iceberg tables managed by AWS Glue.
+type GlueCatalogParams struct {
+	catalogSource    bool    `ddl:"static" sql:"CATALOG_SOURCE = GLUE"`  //lint:ignore U1000 This is used in the ddl tag
+	tableFormat      bool    `ddl:"static" sql:"TABLE_FORMAT = ICEBERG"` //lint:ignore U1000 This is used in the ddl tag
+	GlueAWSRoleARN   string  `ddl:"parameter,single_quotes" sql:"GLUE_AWS_ROLE_ARN"`
+	GlueCatalogID    string  `ddl:"parameter,single_quotes" sql:"GLUE_CATALOG_ID"`
+	GlueRegion       *string `ddl:"parameter,single_quotes" sql:"GLUE_REGION"`
+	CatalogNamespace *string `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+}
+
+// ObjectStoreCatalogParams configures an integration for Iceberg or Delta tables whose metadata
+// lives in object storage.
+type ObjectStoreCatalogParams struct {
+	catalogSource bool                          `ddl:"static" sql:"CATALOG_SOURCE = OBJECT_STORE"` //lint:ignore U1000 This is used in the ddl tag
+	TableFormat   CatalogIntegrationTableFormat `ddl:"parameter" sql:"TABLE_FORMAT"`
+}
+
+// IcebergRestCatalogParams configures an integration for a remote catalog that implements the
+// Apache Iceberg REST OpenAPI specification.
+type IcebergRestCatalogParams struct {
+	catalogSource      bool                      `ddl:"static" sql:"CATALOG_SOURCE = ICEBERG_REST"` //lint:ignore U1000 This is used in the ddl tag
+	tableFormat        bool                      `ddl:"static" sql:"TABLE_FORMAT = ICEBERG"`        //lint:ignore U1000 This is used in the ddl tag
+	CatalogNamespace   *string                   `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+	RestConfig         IcebergRestConfig         `ddl:"list,parentheses,no_comma" sql:"REST_CONFIG ="`
+	RestAuthentication IcebergRestAuthentication `ddl:"list,parentheses,no_comma" sql:"REST_AUTHENTICATION ="`
+}
+
+type IcebergRestConfig struct {
+	CatalogURI           string                                  `ddl:"parameter,single_quotes" sql:"CATALOG_URI"`
+	Prefix               *string                                 `ddl:"parameter,single_quotes" sql:"PREFIX"`
+	CatalogName          *string                                 `ddl:"parameter,single_quotes" sql:"CATALOG_NAME"`
+	CatalogAPIType       *CatalogIntegrationCatalogAPIType       `ddl:"parameter" sql:"CATALOG_API_TYPE"`
+	AccessDelegationMode *CatalogIntegrationAccessDelegationMode `ddl:"parameter" sql:"ACCESS_DELEGATION_MODE"`
+}
+
+// IcebergRestAuthentication holds exactly one of the supported authentication methods.
+type IcebergRestAuthentication struct {
+	OAuth  *OAuthRestAuthentication  `ddl:"keyword"`
+	Bearer *BearerRestAuthentication `ddl:"keyword"`
+	SigV4  *SigV4RestAuthentication  `ddl:"keyword"`
+}
+
+type OAuthRestAuthentication struct {
+	authenticationType bool                      `ddl:"static" sql:"TYPE = OAUTH"` //lint:ignore U1000 This is used in the ddl tag
+	OAuthTokenURI      *string                   `ddl:"parameter,single_quotes" sql:"OAUTH_TOKEN_URI"`
+	OAuthClientID      string                    `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_ID"`
+	OAuthClientSecret  string                    `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_SECRET"`
+	OAuthAllowedScopes []CatalogIntegrationScope `ddl:"parameter,parentheses" sql:"OAUTH_ALLOWED_SCOPES"`
+}
+
+type CatalogIntegrationScope struct {
+	Scope string `ddl:"keyword,single_quotes"`
+}
+
+type BearerRestAuthentication struct {
+	authenticationType bool   `ddl:"static" sql:"TYPE = BEARER"` //lint:ignore U1000 This is used in the ddl tag
+	BearerToken        string `ddl:"parameter,single_quotes" sql:"BEARER_TOKEN"`
+}
+
+type SigV4RestAuthentication struct {
+	authenticationType bool    `ddl:"static" sql:"TYPE = SIGV4"` //lint:ignore U1000 This is used in the ddl tag
+	SigV4IAMRole       string  `ddl:"parameter,single_quotes" sql:"SIGV4_IAM_ROLE"`
+	SigV4SigningRegion *string `ddl:"parameter,single_quotes" sql:"SIGV4_SIGNING_REGION"`
+	SigV4ExternalID    *string `ddl:"parameter,single_quotes" sql:"SIGV4_EXTERNAL_ID"`
+}
+
+// PolarisCatalogParams configures an integration for Snowflake Open Catalog (Polaris).
+type PolarisCatalogParams struct {
+	catalogSource      bool                    `ddl:"static" sql:"CATALOG_SOURCE = POLARIS"` //lint:ignore U1000 This is used in the ddl tag
+	tableFormat        bool                    `ddl:"static" sql:"TABLE_FORMAT = ICEBERG"`   //lint:ignore U1000 This is used in the ddl tag
+	CatalogNamespace   *string                 `ddl:"parameter,single_quotes" sql:"CATALOG_NAMESPACE"`
+	RestConfig         PolarisRestConfig       `ddl:"list,parentheses,no_comma" sql:"REST_CONFIG ="`
+	RestAuthentication OAuthRestAuthentication `ddl:"list,parentheses,no_comma" sql:"REST_AUTHENTICATION ="`
+}
+
+type PolarisRestConfig struct {
+	CatalogURI           string                                  `ddl:"parameter,single_quotes" sql:"CATALOG_URI"`
+	Prefix               *string                                 `ddl:"parameter,single_quotes" sql:"PREFIX"`
+	CatalogName          string                                  `ddl:"parameter,single_quotes" sql:"CATALOG_NAME"`
+	AccessDelegationMode *CatalogIntegrationAccessDelegationMode `ddl:"parameter" sql:"ACCESS_DELEGATION_MODE"`
+}
+
+func (opts *CreateCatalogIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if !exactlyOneValueSet(opts.GlueParams, opts.ObjectStoreParams, opts.IcebergRestParams, opts.PolarisParams) {
+		return errors.New("exactly one of GlueParams, ObjectStoreParams, IcebergRestParams, PolarisParams must be set")
+	}
+	if valueSet(opts.GlueParams) && (opts.GlueParams.GlueAWSRoleARN == "" || opts.GlueParams.GlueCatalogID == "") {
+		return errors.New("GlueAWSRoleARN and GlueCatalogID are required for Glue catalog integrations")
+	}
+	if valueSet(opts.ObjectStoreParams) && opts.ObjectStoreParams.TableFormat == "" {
+		return errors.New("TableFormat is required for object store catalog integrations")
+	}
+	if valueSet(opts.IcebergRestParams) {
+		if err := opts.IcebergRestParams.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.PolarisParams) {
+		if err := opts.PolarisParams.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.RefreshIntervalSeconds) && *opts.RefreshIntervalSeconds < 30 {
+		return errors.New("RefreshIntervalSeconds must be at least 30")
+	}
+	return nil
+}
+
+func (v *IcebergRestCatalogParams) validate() error {
+	if v.RestConfig.CatalogURI == "" {
+		return errors.New("CatalogURI is required for Iceberg REST catalog integrations")
+	}
+	auth := v.RestAuthentication
+	if !exactlyOneValueSet(auth.OAuth, auth.Bearer, auth.SigV4) {
+		return errors.New("exactly one of OAuth, Bearer, SigV4 must be set")
+	}
+	if valueSet(auth.OAuth) {
+		return auth.OAuth.validate()
+	}
+	if valueSet(auth.Bearer) && auth.Bearer.BearerToken == "" {
+		return errors.New("BearerToken is required for bearer authentication")
+	}
+	if valueSet(auth.SigV4) && auth.SigV4.SigV4IAMRole == "" {
+		return errors.New("SigV4IAMRole is required for SigV4 authentication")
+	}
+	return nil
+}
+
+func (v *PolarisCatalogParams) validate() error {
+	if v.RestConfig.CatalogURI == "" || v.RestConfig.CatalogName == "" {
+		return errors.New("CatalogURI and CatalogName are required for Polaris catalog integrations")
+	}
+	return v.RestAuthentication.validate()
+}
+
+func (v *OAuthRestAuthentication) validate() error {
+	if v.OAuthClientID == "" || v.OAuthClientSecret == "" {
+		return errors.New("OAuthClientID and OAuthClientSecret are required for OAuth authentication")
+	}
+	if len(v.OAuthAllowedScopes) == 0 {
+		return errors.New("at least one OAuthAllowedScopes entry must be set")
+	}
+	return nil
+}
+
+func (v *catalogIntegrations) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateCatalogIntegrationOptions) error {
+	if opts == nil {
+		opts = &CreateCatalogIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterCatalogIntegrationOptions struct {
+	alter              bool                     `ddl:"static" sql:"ALTER"`               //lint:ignore U1000 This is used in the ddl tag
+	catalogIntegration bool                     `ddl:"static" sql:"CATALOG INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists           *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier  `ddl:"identifier"`
+	Set                *CatalogIntegrationSet   `ddl:"keyword" sql:"SET"`
+	Unset              *CatalogIntegrationUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTag             []TagAssociation         `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag           []ObjectIdentifier       `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterCatalogIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+type CatalogIntegrationSet struct {
+	RestAuthentication     *CatalogIntegrationRestAuthenticationSet `ddl:"list,parentheses,no_comma" sql:"REST_AUTHENTICATION ="`
+	RefreshIntervalSeconds *int                                     `ddl:"parameter" sql:"REFRESH_INTERVAL_SECONDS"`
+	Comment                *string                                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// CatalogIntegrationRestAuthenticationSet rotates the secret of an Iceberg REST or Polaris integration.
+type CatalogIntegrationRestAuthenticationSet struct {
+	OAuthClientSecret *string `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_SECRET"`
+	BearerToken       *string `ddl:"parameter,single_quotes" sql:"BEARER_TOKEN"`
+}
+
+func (v *CatalogIntegrationSet) validate() error {
+	if !anyValueSet(v.RestAuthentication, v.RefreshIntervalSeconds, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(v.RestAuthentication) && !exactlyOneValueSet(v.RestAuthentication.OAuthClientSecret, v.RestAuthentication.BearerToken) {
+		return errors.New("exactly one of OAuthClientSecret, BearerToken must be set")
+	}
+	if valueSet(v.RefreshIntervalSeconds) && *v.RefreshIntervalSeconds < 30 {
+		return errors.New("RefreshIntervalSeconds must be at least 30")
+	}
+	return nil
+}
+
+type CatalogIntegrationUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *catalogIntegrations) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterCatalogIntegrationOptions) error {
+	if opts == nil {
+		opts = &AlterCatalogIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropCatalogIntegrationOptions struct {
+	drop               bool                    `ddl:"static" sql:"DROP"`                //lint:ignore U1000 This is used in the ddl tag
+	catalogIntegration bool                    `ddl:"static" sql:"CATALOG INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists           *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropCatalogIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *catalogIntegrations) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropCatalogIntegrationOptions) error {
+	if opts == nil {
+		opts = &DropCatalogIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowCatalogIntegrationOptions represents the options for listing catalog integrations.
+type ShowCatalogIntegrationOptions struct {
+	show                bool  `ddl:"static" sql:"SHOW"`                 //lint:ignore U1000 This is used in the ddl tag
+	catalogIntegrations bool  `ddl:"static" sql:"CATALOG INTEGRATIONS"` //lint:ignore U1000 This is used in the ddl tag
+	Like                *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowCatalogIntegrationOptions) validate() error {
+	return nil
+}
+
+type CatalogIntegration struct {
+	Name      string
+	Type      string
+	Category  string
+	Enabled   bool
+	Comment   string
+	CreatedOn time.Time
+}
+
+func (v *CatalogIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *CatalogIntegration) ObjectType() ObjectType {
+	return ObjectTypeIntegration
+}
+
+type catalogIntegrationRow struct {
+	Name      string         `db:"name"`
+	Type      string         `db:"type"`
+	Category  string         `db:"category"`
+	Enabled   bool           `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
+	CreatedOn time.Time      `db:"created_on"`
+}
+
+func (row *catalogIntegrationRow) toCatalogIntegration() *CatalogIntegration {
+	v := &CatalogIntegration{
+		Name:      row.Name,
+		Type:      row.Type,
+		Category:  row.Category,
+		Enabled:   row.Enabled,
+		CreatedOn: row.CreatedOn,
+	}
+	if row.Comment.Valid {
+		v.Comment = row.Comment.String
+	}
+	return v
+}
+
+func (v *catalogIntegrations) Show(ctx context.Context, opts *ShowCatalogIntegrationOptions) ([]*CatalogIntegration, error) {
+	if opts == nil {
+		opts = &ShowCatalogIntegrationOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []catalogIntegrationRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*CatalogIntegration, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toCatalogIntegration()
+	}
+	return resultList, nil
+}
+
+func (v *catalogIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegration, error) {
+	catalogIntegrations, err := v.Show(ctx, &ShowCatalogIntegrationOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, catalogIntegration := range catalogIntegrations {
+		if catalogIntegration.Name == id.Name() {
+			return catalogIntegration, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeCatalogIntegrationOptions struct {
+	describe           bool                    `ddl:"static" sql:"DESCRIBE"`            //lint:ignore U1000 This is used in the ddl tag
+	catalogIntegration bool                    `ddl:"static" sql:"CATALOG INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeCatalogIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// CatalogIntegrationDetails contains the typed output of DESCRIBE CATALOG INTEGRATION. Fields that
+// are not relevant to the integration's catalog source are left empty.
+type CatalogIntegrationDetails struct {
+	Enabled                bool
+	CatalogSource          string
+	TableFormat            string
+	CatalogNamespace       string
+	RefreshIntervalSeconds int
+	Comment                string
+
+	// Glue
+	GlueAWSRoleARN    string
+	GlueCatalogID     string
+	GlueRegion        string
+	GlueAWSIAMUserARN string
+	GlueAWSExternalID string
+
+	// Iceberg REST and Polaris
+	CatalogURI           string
+	CatalogName          string
+	Prefix               string
+	CatalogAPIType       string
+	AccessDelegationMode string
+	OAuthTokenURI        string
+	OAuthClientID        string
+	OAuthAllowedScopes   []string
+}
+
+func catalogIntegrationDetailsFromRows(rows []integrationPropertyRow) *CatalogIntegrationDetails {
+	v := &CatalogIntegrationDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "ENABLED":
+			v.Enabled = row.toBool()
+		case "CATALOG_SOURCE":
+			v.CatalogSource = row.Value
+		case "TABLE_FORMAT":
+			v.TableFormat = row.Value
+		case "CATALOG_NAMESPACE":
+			v.CatalogNamespace = row.Value
+		case "REFRESH_INTERVAL_SECONDS":
+			v.RefreshIntervalSeconds, _ = strconv.Atoi(row.Value)
+		case "COMMENT":
+			v.Comment = row.Value
+		case "GLUE_AWS_ROLE_ARN":
+			v.GlueAWSRoleARN = row.Value
+		case "GLUE_CATALOG_ID":
+			v.GlueCatalogID = row.Value
+		case "GLUE_REGION":
+			v.GlueRegion = row.Value
+		case "GLUE_AWS_IAM_USER_ARN":
+			v.GlueAWSIAMUserARN = row.Value
+		case "GLUE_AWS_EXTERNAL_ID":
+			v.GlueAWSExternalID = row.Value
+		case "CATALOG_URI":
+			v.CatalogURI = row.Value
+		case "CATALOG_NAME":
+			v.CatalogName = row.Value
+		case "PREFIX":
+			v.Prefix = row.Value
+		case "CATALOG_API_TYPE":
+			v.CatalogAPIType = row.Value
+		case "ACCESS_DELEGATION_MODE":
+			v.AccessDelegationMode = row.Value
+		case "OAUTH_TOKEN_URI":
+			v.OAuthTokenURI = row.Value
+		case "OAUTH_CLIENT_ID":
+			v.OAuthClientID = row.Value
+		case "OAUTH_ALLOWED_SCOPES":
+			v.OAuthAllowedScopes = unquoteList(row.Value)
+		}
+	}
+	return v
+}
+
+func (v *catalogIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) (*CatalogIntegrationDetails, error) {
+	opts := &describeCatalogIntegrationOptions{
+		name: id,
+	}
+	rows, err := describeRows[integrationPropertyRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return catalogIntegrationDetailsFromRows(rows), nil
+}
@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_CatalogIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	createCatalogIntegration := func(t *testing.T, opts *CreateCatalogIntegrationOptions) AccountObjectIdentifier {
+		t.Helper()
+		id := randomAccountObjectIdentifier(t)
+		err := client.CatalogIntegrations.Create(ctx, id, opts)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.CatalogIntegrations.Drop(ctx, id, &DropCatalogIntegrationOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("create object store, show and describe", func(t *testing.T) {
+		id := createCatalogIntegration(t, &CreateCatalogIntegrationOptions{
+			ObjectStoreParams: &ObjectStoreCatalogParams{TableFormat: CatalogIntegrationTableFormatIceberg},
+			Enabled:           true,
+			Comment:           String("some comment"),
+		})
+
+		catalogIntegration, err := client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), catalogIntegration.Name)
+		assert.Equal(t, "CATALOG", catalogIntegration.Category)
+		assert.True(t, catalogIntegration.Enabled)
+		assert.Equal(t, "some comment", catalogIntegration.Comment)
+
+		details, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "OBJECT_STORE", details.CatalogSource)
+		assert.Equal(t, "ICEBERG", details.TableFormat)
+	})
+
+	t.Run("create glue and describe", func(t *testing.T) {
+		id := createCatalogIntegration(t, &CreateCatalogIntegrationOptions{
+			GlueParams: &GlueCatalogParams{
+				GlueAWSRoleARN:   "arn:aws:iam::000000000001:role/test",
+				GlueCatalogID:    "000000000001",
+				GlueRegion:       String("us-west-2"),
+				CatalogNamespace: String("namespace"),
+			},
+			Enabled: true,
+		})
+
+		details, err := client.CatalogIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "GLUE", details.CatalogSource)
+		assert.Equal(t, "arn:aws:iam::000000000001:role/test", details.GlueAWSRoleARN)
+		assert.Equal(t, "000000000001", details.GlueCatalogID)
+		assert.Equal(t, "namespace", details.CatalogNamespace)
+		assert.NotEmpty(t, details.GlueAWSIAMUserARN)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		id := createCatalogIntegration(t, &CreateCatalogIntegrationOptions{
+			ObjectStoreParams: &ObjectStoreCatalogParams{TableFormat: CatalogIntegrationTableFormatIceberg},
+			Enabled:           true,
+		})
+
+		err := client.CatalogIntegrations.Alter(ctx, id, &AlterCatalogIntegrationOptions{
+			Set: &CatalogIntegrationSet{Comment: String("new comment")},
+		})
+		require.NoError(t, err)
+		catalogIntegration, err := client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", catalogIntegration.Comment)
+
+		err = client.CatalogIntegrations.Alter(ctx, id, &AlterCatalogIntegrationOptions{
+			Unset: &CatalogIntegrationUnset{Comment: Bool(true)},
+		})
+		require.NoError(t, err)
+		catalogIntegration, err = client.CatalogIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, catalogIntegration.Comment)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		id := createCatalogIntegration(t, &CreateCatalogIntegrationOptions{
+			ObjectStoreParams: &ObjectStoreCatalogParams{TableFormat: CatalogIntegrationTableFormatDelta},
+			Enabled:           true,
+		})
+
+		err := client.CatalogIntegrations.Drop(ctx, id, nil)
+		require.NoError(t, err)
+		_, err = client.CatalogIntegrations.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,246 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogIntegrationsCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("glue", func(t *testing.T) {
+		opts := &CreateCatalogIntegrationOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			GlueParams: &GlueCatalogParams{
+				GlueAWSRoleARN:   "arn:aws:iam::123456789123:role/glue",
+				GlueCatalogID:    "123456789123",
+				GlueRegion:       String("us-east-2"),
+				CatalogNamespace: String("my_namespace"),
+			},
+			Enabled:                true,
+			RefreshIntervalSeconds: Int(60),
+			Comment:                String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE CATALOG INTEGRATION ` + id.FullyQualifiedName() + ` CATALOG_SOURCE = GLUE TABLE_FORMAT = ICEBERG GLUE_AWS_ROLE_ARN = 'arn:aws:iam::123456789123:role/glue' GLUE_CATALOG_ID = '123456789123' GLUE_REGION = 'us-east-2' CATALOG_NAMESPACE = 'my_namespace' ENABLED = true REFRESH_INTERVAL_SECONDS = 60 COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("object store", func(t *testing.T) {
+		opts := &CreateCatalogIntegrationOptions{
+			IfNotExists:       Bool(true),
+			name:              id,
+			ObjectStoreParams: &ObjectStoreCatalogParams{TableFormat: CatalogIntegrationTableFormatDelta},
+			Enabled:           true,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE CATALOG INTEGRATION IF NOT EXISTS ` + id.FullyQualifiedName() + ` CATALOG_SOURCE = OBJECT_STORE TABLE_FORMAT = DELTA ENABLED = true`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("iceberg rest", func(t *testing.T) {
+		opts := &CreateCatalogIntegrationOptions{
+			name: id,
+			IcebergRestParams: &IcebergRestCatalogParams{
+				CatalogNamespace: String("default"),
+				RestConfig: IcebergRestConfig{
+					CatalogURI:           "https://api.tabular.io/ws",
+					CatalogName:          String("catalog"),
+					CatalogAPIType:       Pointer(CatalogIntegrationCatalogAPITypePublic),
+					AccessDelegationMode: Pointer(CatalogIntegrationAccessDelegationModeVendedCredentials),
+				},
+				RestAuthentication: IcebergRestAuthentication{
+					SigV4: &SigV4RestAuthentication{
+						SigV4IAMRole:       "arn:aws:iam::123456789123:role/rest",
+						SigV4SigningRegion: String("us-west-2"),
+					},
+				},
+			},
+			Enabled: true,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE CATALOG INTEGRATION ` + id.FullyQualifiedName() + ` CATALOG_SOURCE = ICEBERG_REST TABLE_FORMAT = ICEBERG CATALOG_NAMESPACE = 'default' REST_CONFIG = (CATALOG_URI = 'https://api.tabular.io/ws' CATALOG_NAME = 'catalog' CATALOG_API_TYPE = PUBLIC ACCESS_DELEGATION_MODE = VENDED_CREDENTIALS) REST_AUTHENTICATION = (TYPE = SIGV4 SIGV4_IAM_ROLE = 'arn:aws:iam::123456789123:role/rest' SIGV4_SIGNING_REGION = 'us-west-2') ENABLED = true`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("polaris", func(t *testing.T) {
+		opts := &CreateCatalogIntegrationOptions{
+			name: id,
+			PolarisParams: &PolarisCatalogParams{
+				CatalogNamespace: String("my_namespace"),
+				RestConfig: PolarisRestConfig{
+					CatalogURI:  "https://myorg-myaccount.snowflakecomputing.com/polaris/api/catalog",
+					CatalogName: "my_catalog",
+				},
+				RestAuthentication: OAuthRestAuthentication{
+					OAuthClientID:      "client_id",
+					OAuthClientSecret:  "secret",
+					OAuthAllowedScopes: []CatalogIntegrationScope{{Scope: "PRINCIPAL_ROLE:ALL"}},
+				},
+			},
+			Enabled: true,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE CATALOG INTEGRATION ` + id.FullyQualifiedName() + ` CATALOG_SOURCE = POLARIS TABLE_FORMAT = ICEBERG CATALOG_NAMESPACE = 'my_namespace' REST_CONFIG = (CATALOG_URI = 'https://myorg-myaccount.snowflakecomputing.com/polaris/api/catalog' CATALOG_NAME = 'my_catalog') REST_AUTHENTICATION = (TYPE = OAUTH OAUTH_CLIENT_ID = 'client_id' OAUTH_CLIENT_SECRET = 'secret' OAUTH_ALLOWED_SCOPES = ('PRINCIPAL_ROLE:ALL')) ENABLED = true`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: exactly one catalog source", func(t *testing.T) {
+		opts := &CreateCatalogIntegrationOptions{
+			name:              id,
+			GlueParams:        &GlueCatalogParams{GlueAWSRoleARN: "arn", GlueCatalogID: "id"},
+			ObjectStoreParams: &ObjectStoreCatalogParams{TableFormat: CatalogIntegrationTableFormatIceberg},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: iceberg rest without authentication", func(t *testing.T) {
+		opts := &CreateCatalogIntegrationOptions{
+			name: id,
+			IcebergRestParams: &IcebergRestCatalogParams{
+				RestConfig: IcebergRestConfig{CatalogURI: "https://example.com"},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: polaris without scopes", func(t *testing.T) {
+		opts := &CreateCatalogIntegrationOptions{
+			name: id,
+			PolarisParams: &PolarisCatalogParams{
+				RestConfig:         PolarisRestConfig{CatalogURI: "https://example.com", CatalogName: "catalog"},
+				RestAuthentication: OAuthRestAuthentication{OAuthClientID: "client_id", OAuthClientSecret: "secret"},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: refresh interval too short", func(t *testing.T) {
+		opts := &CreateCatalogIntegrationOptions{
+			name:                   id,
+			ObjectStoreParams:      &ObjectStoreCatalogParams{TableFormat: CatalogIntegrationTableFormatIceberg},
+			RefreshIntervalSeconds: Int(10),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestCatalogIntegrationsAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterCatalogIntegrationOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &CatalogIntegrationSet{
+				RestAuthentication:     &CatalogIntegrationRestAuthenticationSet{OAuthClientSecret: String("new_secret")},
+				RefreshIntervalSeconds: Int(120),
+				Comment:                String("some comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER CATALOG INTEGRATION IF EXISTS ` + id.FullyQualifiedName() + ` SET REST_AUTHENTICATION = (OAUTH_CLIENT_SECRET = 'new_secret') REFRESH_INTERVAL_SECONDS = 120 COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterCatalogIntegrationOptions{
+			name:  id,
+			Unset: &CatalogIntegrationUnset{Comment: Bool(true)},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER CATALOG INTEGRATION ` + id.FullyQualifiedName() + ` UNSET COMMENT`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: exactly one action", func(t *testing.T) {
+		opts := &AlterCatalogIntegrationOptions{
+			name:  id,
+			Set:   &CatalogIntegrationSet{Comment: String("some comment")},
+			Unset: &CatalogIntegrationUnset{Comment: Bool(true)},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: both secrets", func(t *testing.T) {
+		opts := &AlterCatalogIntegrationOptions{
+			name: id,
+			Set: &CatalogIntegrationSet{
+				RestAuthentication: &CatalogIntegrationRestAuthenticationSet{OAuthClientSecret: String("secret"), BearerToken: String("token")},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestCatalogIntegrationsDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &DropCatalogIntegrationOptions{
+		IfExists: Bool(true),
+		name:     id,
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := `DROP CATALOG INTEGRATION IF EXISTS ` + id.FullyQualifiedName()
+	assert.Equal(t, expected, actual)
+}
+
+func TestCatalogIntegrationsShow(t *testing.T) {
+	opts := &ShowCatalogIntegrationOptions{
+		Like: &Like{Pattern: String("catalog%")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := `SHOW CATALOG INTEGRATIONS LIKE 'catalog%'`
+	assert.Equal(t, expected, actual)
+}
+
+func TestCatalogIntegrationsDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("sql", func(t *testing.T) {
+		opts := &describeCatalogIntegrationOptions{name: id}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `DESCRIBE CATALOG INTEGRATION ` + id.FullyQualifiedName()
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("details from rows", func(t *testing.T) {
+		rows := []integrationPropertyRow{
+			{Property: "ENABLED", Value: "true"},
+			{Property: "CATALOG_SOURCE", Value: "POLARIS"},
+			{Property: "TABLE_FORMAT", Value: "ICEBERG"},
+			{Property: "REFRESH_INTERVAL_SECONDS", Value: "30"},
+			{Property: "CATALOG_URI", Value: "https://example.com/api/catalog"},
+			{Property: "CATALOG_NAME", Value: "my_catalog"},
+			{Property: "OAUTH_CLIENT_ID", Value: "client_id"},
+			{Property: "OAUTH_ALLOWED_SCOPES", Value: `["PRINCIPAL_ROLE:ALL"]`},
+		}
+		details := catalogIntegrationDetailsFromRows(rows)
+		assert.True(t, details.Enabled)
+		assert.Equal(t, "POLARIS", details.CatalogSource)
+		assert.Equal(t, "ICEBERG", details.TableFormat)
+		assert.Equal(t, 30, details.RefreshIntervalSeconds)
+		assert.Equal(t, "https://example.com/api/catalog", details.CatalogURI)
+		assert.Equal(t, "my_catalog", details.CatalogName)
+		assert.Equal(t, "client_id", details.OAuthClientID)
+		assert.Equal(t, []string{"PRINCIPAL_ROLE:ALL"}, details.OAuthAllowedScopes)
+	})
+}
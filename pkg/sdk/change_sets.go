@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"context"
+)
+
+// Statement is a single statement of a change-set: one of the options structs of the SDK,
+// e.g. *AlterWarehouseOptions, with the identifier of the object already filled in.
+// Change-sets are computed by the Compute<Object>Alterations functions from the current and the
+// desired state of an object, so that the resulting statements can be tested without a connection.
+type Statement interface {
+	validate() error
+}
+
+// StatementsToSQL renders the statements of a change-set in order, double-quoting every identifier.
+func StatementsToSQL(statements []Statement) ([]string, error) {
+	return statementsToSQL(builder, statements)
+}
+
+func statementsToSQL(b sqlBuilder, statements []Statement) ([]string, error) {
+	result := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		if err := statement.validate(); err != nil {
+			return nil, err
+		}
+		sql, err := b.structToSQL(statement)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, sql)
+	}
+	return result, nil
+}
+
+// ExecStatements executes the statements of a change-set in order. All statements are validated
+// and rendered before the first one is executed.
+func (c *Client) ExecStatements(ctx context.Context, statements []Statement) error {
+	sqls, err := statementsToSQL(sqlBuilder{quoting: c.IdentifierQuoting()}, statements)
+	if err != nil {
+		return err
+	}
+	for _, sql := range sqls {
+		if _, err := c.exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// propertyChanged reports whether a property differs between the current and the desired state.
+// A nil pointer stands for a property that is not set.
+func propertyChanged[T comparable](current *T, desired *T) bool {
+	if current == nil || desired == nil {
+		return current != desired
+	}
+	return *current != *desired
+}
+
+// propertyAlterations tracks whether the properties that changed between the current and the desired
+// state need a SET statement, an UNSET statement or both.
+type propertyAlterations struct {
+	runSet   bool
+	runUnset bool
+}
+
+// alter applies a changed property to the SET options when it is set in the desired state and to the UNSET
+// options otherwise. Unchanged properties are skipped.
+func (a *propertyAlterations) alter(changed bool, isSet bool, applySet func(), unsetField **bool) {
+	if !changed {
+		return
+	}
+	if isSet {
+		a.runSet = true
+		applySet()
+		return
+	}
+	a.runUnset = true
+	*unsetField = Bool(true)
+}
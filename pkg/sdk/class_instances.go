@@ -0,0 +1,280 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ClassInstances = (*classInstances)(nil)
+
+// ClassInstances manages instances of Snowflake classes, e.g. SNOWFLAKE.ML.FORECAST. Several features are only
+// exposed as classes, whose instances are created, listed and dropped with the class name in place of an object type
+// and are managed by calling their instance methods.
+type ClassInstances interface {
+	// Create creates a new instance of a class, passing the arguments to the constructor of the class.
+	Create(ctx context.Context, class Class, id SchemaObjectIdentifier, args []ClassInstanceArgument, opts *CreateClassInstanceOptions) error
+	// Drop removes an instance of a class.
+	Drop(ctx context.Context, class Class, id SchemaObjectIdentifier, opts *DropClassInstanceOptions) error
+	// Show returns a list of instances of a class.
+	Show(ctx context.Context, class Class, opts *ShowClassInstanceOptions) ([]*ClassInstance, error)
+	// ShowByID returns an instance of a class by ID.
+	ShowByID(ctx context.Context, class Class, id SchemaObjectIdentifier) (*ClassInstance, error)
+	// Call calls an instance method, discarding its result.
+	Call(ctx context.Context, id SchemaObjectIdentifier, method string, args ...string) error
+	// Query calls an instance method and scans the rows it returns into dest, which must be a pointer to a slice.
+	Query(ctx context.Context, dest interface{}, id SchemaObjectIdentifier, method string, args ...string) error
+}
+
+// classInstances implements ClassInstances.
+type classInstances struct {
+	client *Client
+}
+
+// Class is the fully qualified name of a Snowflake class.
+type Class string
+
+const (
+	ClassBudget           Class = "SNOWFLAKE.CORE.BUDGET"
+	ClassForecast         Class = "SNOWFLAKE.ML.FORECAST"
+	ClassAnomalyDetection Class = "SNOWFLAKE.ML.ANOMALY_DETECTION"
+	ClassClassification   Class = "SNOWFLAKE.ML.CLASSIFICATION"
+)
+
+// ClassInstanceArgument is a named argument of the constructor of a class. Value is rendered as is,
+// so string values must be quoted, e.g. 'TS', or be an expression, e.g. SYSTEM$REFERENCE(...).
+type ClassInstanceArgument struct {
+	Name  string
+	Value string
+}
+
+func (a ClassInstanceArgument) validate() error {
+	if a.Name == "" || a.Value == "" {
+		return errors.New("arguments must have a name and a value")
+	}
+	return nil
+}
+
+// String renders the argument as a named argument, e.g. TIMESTAMP_COLNAME => 'TS'.
+func (a ClassInstanceArgument) String() string {
+	return fmt.Sprintf("%s => %s", a.Name, a.Value)
+}
+
+func classInstanceArguments(args []ClassInstanceArgument) string {
+	rendered := make([]string, len(args))
+	for i, arg := range args {
+		rendered[i] = arg.String()
+	}
+	return fmt.Sprintf("(%s)", strings.Join(rendered, ", "))
+}
+
+type CreateClassInstanceOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	class       Class                  `ddl:"keyword"`
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	arguments   string                 `ddl:"keyword"`
+	Comment     *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateClassInstanceOptions) validate() error {
+	if opts.class == "" {
+		return errors.New("class must be set")
+	}
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	return nil
+}
+
+func (v *classInstances) Create(ctx context.Context, class Class, id SchemaObjectIdentifier, args []ClassInstanceArgument, opts *CreateClassInstanceOptions) error {
+	if opts == nil {
+		opts = &CreateClassInstanceOptions{}
+	}
+	for _, arg := range args {
+		if err := arg.validate(); err != nil {
+			return err
+		}
+	}
+	opts.class = class
+	opts.name = id
+	opts.arguments = classInstanceArguments(args)
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropClassInstanceOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"` //lint:ignore U1000 This is used in the ddl tag
+	class    Class                  `ddl:"keyword"`
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropClassInstanceOptions) validate() error {
+	if opts.class == "" {
+		return errors.New("class must be set")
+	}
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *classInstances) Drop(ctx context.Context, class Class, id SchemaObjectIdentifier, opts *DropClassInstanceOptions) error {
+	if opts == nil {
+		opts = &DropClassInstanceOptions{}
+	}
+	opts.class = class
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowClassInstanceOptions struct {
+	show      bool  `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	class     Class `ddl:"keyword"`
+	instances bool  `ddl:"static" sql:"INSTANCES"` //lint:ignore U1000 This is used in the ddl tag
+	Like      *Like `ddl:"keyword" sql:"LIKE"`
+	In        *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowClassInstanceOptions) validate() error {
+	if opts.class == "" {
+		return errors.New("class must be set")
+	}
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ClassInstance struct {
+	CreatedOn      time.Time
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	CurrentVersion string
+	Comment        string
+	Owner          string
+	OwnerRoleType  string
+}
+
+func (v *ClassInstance) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+type classInstanceRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	CurrentVersion sql.NullString `db:"current_version"`
+	Comment        sql.NullString `db:"comment"`
+	Owner          sql.NullString `db:"owner"`
+	OwnerRoleType  sql.NullString `db:"owner_role_type"`
+}
+
+func (row classInstanceRow) toClassInstance() *ClassInstance {
+	return &ClassInstance{
+		CreatedOn:      row.CreatedOn,
+		Name:           row.Name,
+		DatabaseName:   row.DatabaseName,
+		SchemaName:     row.SchemaName,
+		CurrentVersion: row.CurrentVersion.String,
+		Comment:        row.Comment.String,
+		Owner:          row.Owner.String,
+		OwnerRoleType:  row.OwnerRoleType.String,
+	}
+}
+
+func (v *classInstances) Show(ctx context.Context, class Class, opts *ShowClassInstanceOptions) ([]*ClassInstance, error) {
+	if opts == nil {
+		opts = &ShowClassInstanceOptions{}
+	}
+	opts.class = class
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []classInstanceRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ClassInstance, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toClassInstance()
+	}
+	return resultList, nil
+}
+
+func (v *classInstances) ShowByID(ctx context.Context, class Class, id SchemaObjectIdentifier) (*ClassInstance, error) {
+	instances, err := v.Show(ctx, class, &ShowClassInstanceOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if instance.Name == id.Name() {
+			return instance, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// classInstanceMethodSQL builds the CALL of an instance method.
+func classInstanceMethodSQL(id SchemaObjectIdentifier, method string, args ...string) string {
+	return fmt.Sprintf("CALL %s!%s(%s)", id.FullyQualifiedName(), method, strings.Join(args, ", "))
+}
+
+func (v *classInstances) Call(ctx context.Context, id SchemaObjectIdentifier, method string, args ...string) error {
+	if !validObjectidentifier(id) {
+		return ErrInvalidObjectIdentifier
+	}
+	if method == "" {
+		return errors.New("method must be set")
+	}
+	_, err := v.client.exec(ctx, classInstanceMethodSQL(id, method, args...))
+	return err
+}
+
+func (v *classInstances) Query(ctx context.Context, dest interface{}, id SchemaObjectIdentifier, method string, args ...string) error {
+	if !validObjectidentifier(id) {
+		return ErrInvalidObjectIdentifier
+	}
+	if method == "" {
+		return errors.New("method must be set")
+	}
+	return v.client.query(ctx, dest, classInstanceMethodSQL(id, method, args...))
+}
@@ -0,0 +1,53 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ClassInstances(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err := client.ClassInstances.Create(ctx, ClassBudget, id, nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ClassInstances.Drop(ctx, ClassBudget, id, &DropClassInstanceOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		instance, err := client.ClassInstances.ShowByID(ctx, ClassBudget, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, instance.ID())
+	})
+
+	t.Run("call", func(t *testing.T) {
+		err := client.ClassInstances.Call(ctx, id, "SET_SPENDING_LIMIT", "100")
+		require.NoError(t, err)
+
+		var dest []struct {
+			SpendingLimit int `db:"GET_SPENDING_LIMIT"`
+		}
+		err = client.ClassInstances.Query(ctx, &dest, id, "GET_SPENDING_LIMIT")
+		require.NoError(t, err)
+		require.Len(t, dest, 1)
+		assert.Equal(t, 100, dest[0].SpendingLimit)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		err := client.ClassInstances.Drop(ctx, ClassBudget, id, nil)
+		require.NoError(t, err)
+		_, err = client.ClassInstances.ShowByID(ctx, ClassBudget, id)
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassInstanceCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "mymodel")
+
+	t.Run("without arguments", func(t *testing.T) {
+		opts := &CreateClassInstanceOptions{
+			class:     ClassBudget,
+			name:      id,
+			arguments: classInstanceArguments(nil),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE SNOWFLAKE.CORE.BUDGET "db"."schema"."mymodel" ()`, actual)
+	})
+
+	t.Run("with arguments", func(t *testing.T) {
+		args := []ClassInstanceArgument{
+			{Name: "INPUT_DATA", Value: `SYSTEM$REFERENCE('VIEW', 'db.schema.sales')`},
+			{Name: "TIMESTAMP_COLNAME", Value: `'TS'`},
+		}
+		opts := &CreateClassInstanceOptions{
+			OrReplace: Bool(true),
+			class:     ClassForecast,
+			name:      id,
+			arguments: classInstanceArguments(args),
+			Comment:   String("forecast"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE SNOWFLAKE.ML.FORECAST "db"."schema"."mymodel" (INPUT_DATA => SYSTEM$REFERENCE('VIEW', 'db.schema.sales'), TIMESTAMP_COLNAME => 'TS') COMMENT = 'forecast'`, actual)
+	})
+
+	t.Run("validation: no class", func(t *testing.T) {
+		opts := &CreateClassInstanceOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: argument without value", func(t *testing.T) {
+		arg := ClassInstanceArgument{Name: "INPUT_DATA"}
+		assert.Error(t, arg.validate())
+	})
+}
+
+func TestClassInstanceDrop(t *testing.T) {
+	opts := &DropClassInstanceOptions{
+		class:    ClassAnomalyDetection,
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "mymodel"),
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP SNOWFLAKE.ML.ANOMALY_DETECTION IF EXISTS "db"."schema"."mymodel"`, actual)
+}
+
+func TestClassInstanceShow(t *testing.T) {
+	opts := &ShowClassInstanceOptions{
+		class: ClassForecast,
+		Like:  &Like{Pattern: String("my%")},
+		In:    &In{Database: NewAccountObjectIdentifier("db")},
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW SNOWFLAKE.ML.FORECAST INSTANCES LIKE 'my%' IN DATABASE "db"`, actual)
+}
+
+func TestClassInstanceMethodSQL(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "mymodel")
+	assert.Equal(t, `CALL "db"."schema"."mymodel"!FORECAST(FORECASTING_PERIODS => 3)`, classInstanceMethodSQL(id, "FORECAST", "FORECASTING_PERIODS => 3"))
+}
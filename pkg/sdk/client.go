@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"reflect"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/luna-duclos/instrumentedsql"
@@ -19,6 +20,33 @@ type Client struct {
 	sessionID      string
 	accountLocator string
 
+	// tx is the transaction the statements of the client run in, see Begin.
+	tx *sqlx.Tx
+	// conn is the connection the statements of the client run in, see WithSessionContext.
+	conn *sqlx.Conn
+
+	// strictEnumParsing makes SHOW and DESCRIBE fail on values the SDK does not know about.
+	strictEnumParsing bool
+	// fanOutLimit is the maximum number of statements FanOut runs concurrently.
+	fanOutLimit int
+	// identifierQuoting controls how identifiers are rendered in the statements the client builds.
+	identifierQuoting IdentifierQuoting
+	// retryPolicy configures the retries of transient errors, statements are not retried without it.
+	retryPolicy *RetryPolicy
+	// queryTag is the QUERY_TAG of the sessions of the client, see WithQueryTag.
+	queryTag string
+	// secondaryRoles are activated in every session of the client, see WithSecondaryRoles.
+	secondaryRoles *SecondaryRoles
+	// sessionInitStatements run on every new connection of the client, see WithSessionInitStatements.
+	sessionInitStatements []string
+	// poolConfig configures the connection pool of the client, see WithPoolConfig.
+	poolConfig *PoolConfig
+	// statementSlots limits the number of statements running at the same time, see WithMaxConcurrentStatements.
+	statementSlots chan struct{}
+
+	// Account Capabilities
+	Capabilities Capabilities
+
 	// System-Defined Functions
 	ContextFunctions     ContextFunctions
 	ConversionFunctions  ConversionFunctions
@@ -26,26 +54,101 @@ type Client struct {
 	ReplicationFunctions ReplicationFunctions
 
 	// DDL Commands
-	Accounts         Accounts
-	Comments         Comments
-	Databases        Databases
-	FailoverGroups   FailoverGroups
-	Grants           Grants
-	MaskingPolicies  MaskingPolicies
-	PasswordPolicies PasswordPolicies
-	ResourceMonitors ResourceMonitors
-	Roles            Roles
-	SessionPolicies  SessionPolicies
-	Sessions         Sessions
-	Shares           Shares
-	Warehouses       Warehouses
-}
-
-func NewDefaultClient() (*Client, error) {
-	return NewClient(nil)
-}
-
-func NewClient(cfg *gosnowflake.Config) (*Client, error) {
+	Accounts                   Accounts
+	AggregationPolicies        AggregationPolicies
+	Alerts                     Alerts
+	ApplicationPackages        ApplicationPackages
+	ApplicationRoles           ApplicationRoles
+	Applications               Applications
+	Budgets                    Budgets
+	CatalogIntegrations        CatalogIntegrations
+	ClassInstances             ClassInstances
+	Comments                   Comments
+	ComputePools               ComputePools
+	Connections                Connections
+	CortexSearchServices       CortexSearchServices
+	DataMetricFunctions        DataMetricFunctions
+	Databases                  Databases
+	DatabaseRoles              DatabaseRoles
+	DataExchanges              DataExchanges
+	ExternalAccessIntegrations ExternalAccessIntegrations
+	ExternalVolumes            ExternalVolumes
+	FailoverGroups             FailoverGroups
+	GitRepositories            GitRepositories
+	Grants                     Grants
+	ImageRepositories          ImageRepositories
+	Listings                   Listings
+	MLAnomalyDetections        MLAnomalyDetections
+	MLForecasts                MLForecasts
+	ManagedAccounts            ManagedAccounts
+	MaskingPolicies            MaskingPolicies
+	Notebooks                  Notebooks
+	NotificationIntegrations   NotificationIntegrations
+	ObjectDependencies         ObjectDependencies
+	PackagesPolicies           PackagesPolicies
+	Parameters                 Parameters
+	PasswordPolicies           PasswordPolicies
+	Pipes                      Pipes
+	PolicyReferences           PolicyReferences
+	ProjectionPolicies         ProjectionPolicies
+	QueryHistory               QueryHistory
+	ReplicationGroups          ReplicationGroups
+	ResourceMonitors           ResourceMonitors
+	Roles                      Roles
+	Schemas                    Schemas
+	SecurityIntegrations       SecurityIntegrations
+	SemanticViews              SemanticViews
+	Services                   Services
+	SessionPolicies            SessionPolicies
+	Sessions                   Sessions
+	Shares                     Shares
+	Stages                     Stages
+	StorageIntegrations        StorageIntegrations
+	Streamlits                 Streamlits
+	Streams                    Streams
+	TableConstraints           TableConstraints
+	Tables                     Tables
+	TagReferences              TagReferences
+	Tags                       Tags
+	Tasks                      Tasks
+	Users                      Users
+	Views                      Views
+	Warehouses                 Warehouses
+}
+
+// ClientOption configures optional behavior of a Client.
+type ClientOption func(*Client)
+
+// WithStrictEnumParsing makes the client return ErrUnknownEnumValue when SHOW or DESCRIBE output
+// contains an enum value (e.g. a warehouse size or state) the SDK does not know about.
+// By default such values are preserved as raw strings so that new Snowflake releases do not break reads.
+func WithStrictEnumParsing() ClientOption {
+	return func(c *Client) {
+		c.strictEnumParsing = true
+	}
+}
+
+// WithFanOutLimit sets the maximum number of statements FanOut runs concurrently.
+func WithFanOutLimit(limit int) ClientOption {
+	return func(c *Client) {
+		c.fanOutLimit = limit
+	}
+}
+
+// WithIdentifierQuoting sets how the client renders identifiers, see IdentifierQuoting. By default every
+// identifier is double-quoted, which keeps lower-case names case-sensitive unless the account sets
+// QUOTED_IDENTIFIERS_IGNORE_CASE.
+func WithIdentifierQuoting(quoting IdentifierQuoting) ClientOption {
+	return func(c *Client) {
+		c.identifierQuoting = quoting
+	}
+}
+
+func NewDefaultClient(opts ...ClientOption) (*Client, error) {
+	return NewClient(nil, opts...)
+}
+
+func NewClient(cfg *gosnowflake.Config, opts ...ClientOption) (*Client, error) {
 	var err error
 	if cfg == nil {
 		log.Printf("[DEBUG] Searching for default config in credentials chain...\n")
@@ -66,21 +169,50 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 		sql.Register("snowflake-instrumented", instrumentedsql.WrapDriver(gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger)))
 	}
 
+	client = &Client{
+		config: cfg,
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	if client.queryTag != "" {
+		// the config of the caller is left untouched
+		tagged := *cfg
+		cfg, client.config = &tagged, &tagged
+		params := make(map[string]*string, len(cfg.Params)+1)
+		for k, v := range cfg.Params {
+			params[k] = v
+		}
+		params["query_tag"] = &client.queryTag
+		cfg.Params = params
+	}
+
 	dsn, err := gosnowflake.DSN(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	db, err := sqlx.Connect("snowflake-instrumented", dsn)
+	var db *sqlx.DB
+	if statements := client.sessionSetupStatements(); len(statements) > 0 {
+		var sqlDB *sql.DB
+		sqlDB, err = openWithSessionSetup("snowflake-instrumented", dsn, statements)
+		if err == nil {
+			db = sqlx.NewDb(sqlDB, "snowflake-instrumented")
+			if err = db.Ping(); err != nil {
+				db.Close()
+			}
+		}
+	} else {
+		db, err = sqlx.Connect("snowflake-instrumented", dsn)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("open snowflake connection: %w", err)
 	}
-
-	client = &Client{
-		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
-		db:     db.Unsafe(),
-		config: cfg,
+	if client.poolConfig != nil {
+		client.poolConfig.apply(db.DB)
 	}
+	// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
+	client.db = db.Unsafe()
 	client.initialize()
 
 	err = client.Ping()
@@ -104,35 +236,102 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 	return client, nil
 }
 
-func NewClientFromDB(db *sql.DB) *Client {
+func NewClientFromDB(db *sql.DB, opts ...ClientOption) *Client {
 	dbx := sqlx.NewDb(db, "snowflake")
 	client := &Client{
 		db: dbx.Unsafe(),
 	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	if client.poolConfig != nil && db != nil {
+		client.poolConfig.apply(db)
+	}
 	client.initialize()
 	return client
 }
 
 func (c *Client) initialize() {
 	c.Accounts = &accounts{client: c}
+	c.AggregationPolicies = &aggregationPolicies{client: c}
+	c.Alerts = &alerts{client: c}
+	c.ApplicationPackages = &applicationPackages{client: c}
+	c.ApplicationRoles = &applicationRoles{client: c}
+	c.Applications = &applications{client: c}
+	c.Budgets = &budgets{client: c}
+	c.Capabilities = &capabilities{client: c}
+	c.CatalogIntegrations = &catalogIntegrations{client: c}
+	c.ClassInstances = &classInstances{client: c}
 	c.Comments = &comments{client: c}
+	c.ComputePools = &computePools{client: c}
+	c.Connections = &connections{client: c}
 	c.ContextFunctions = &contextFunctions{client: c}
 	c.ConversionFunctions = &conversionFunctions{client: c}
+	c.CortexSearchServices = &cortexSearchServices{client: c}
+	c.DataMetricFunctions = &dataMetricFunctions{client: c}
 	c.Databases = &databases{client: c}
+	c.DatabaseRoles = &databaseRoles{client: c}
+	c.DataExchanges = &dataExchanges{client: c}
+	c.ExternalAccessIntegrations = &externalAccessIntegrations{client: c}
+	c.ExternalVolumes = &externalVolumes{client: c}
 	c.FailoverGroups = &failoverGroups{client: c}
+	c.GitRepositories = &gitRepositories{client: c}
 	c.Grants = &grants{client: c}
+	c.ImageRepositories = &imageRepositories{client: c}
+	c.Listings = &listings{client: c}
+	c.MLAnomalyDetections = &mlAnomalyDetections{client: c}
+	c.MLForecasts = &mlForecasts{client: c}
+	c.ManagedAccounts = &managedAccounts{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
+	c.Notebooks = &notebooks{client: c}
+	c.NotificationIntegrations = &notificationIntegrations{client: c}
+	c.ObjectDependencies = &objectDependencies{client: c}
+	c.PackagesPolicies = &packagesPolicies{client: c}
+	c.Parameters = &parameters{client: c}
 	c.PasswordPolicies = &passwordPolicies{client: c}
+	c.Pipes = &pipes{client: c}
+	c.PolicyReferences = &policyReferences{client: c}
+	c.ProjectionPolicies = &projectionPolicies{client: c}
+	c.QueryHistory = &queryHistory{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
+	c.ReplicationGroups = &replicationGroups{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
 	c.Roles = &roles{client: c}
+	c.Schemas = &schemas{client: c}
+	c.SecurityIntegrations = &securityIntegrations{client: c}
+	c.SemanticViews = &semanticViews{client: c}
+	c.Services = &services{client: c}
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
+	c.Stages = &stages{client: c}
+	c.StorageIntegrations = &storageIntegrations{client: c}
+	c.Streamlits = &streamlits{client: c}
+	c.Streams = &streams{client: c}
 	c.SystemFunctions = &systemFunctions{client: c}
+	c.TableConstraints = &tableConstraints{client: c}
+	c.Tables = &tables{client: c}
+	c.TagReferences = &tagReferences{client: c}
+	c.Tags = &tags{client: c}
+	c.Tasks = &tasks{client: c}
+	c.Users = &users{client: c}
+	c.Views = &views{client: c}
 	c.Warehouses = &warehouses{client: c}
 }
 
+// IdentifierQuoting returns how the client renders identifiers.
+func (c *Client) IdentifierQuoting() IdentifierQuoting {
+	if c.identifierQuoting == "" {
+		return IdentifierQuotingAlways
+	}
+	return c.identifierQuoting
+}
+
+// structToSQL renders v with the identifier quoting of the client.
+func (c *Client) structToSQL(v interface{}) (string, error) {
+	return sqlBuilder{quoting: c.IdentifierQuoting()}.structToSQL(v)
+}
+
 func (c *Client) Ping() error {
 	return c.db.Ping()
 }
@@ -150,21 +349,55 @@ const (
 	snowflakeAccountLocatorContextKey snowflakeAccountLocatorContext = "snowflake_account_locator"
 )
 
+// executor is implemented by *sqlx.DB, *sqlx.Tx and *sqlx.Conn.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+// executor returns the transaction or the connection of the client if it is bound to one, and the connection
+// pool otherwise.
+func (c *Client) executor() executor {
+	if c.tx != nil {
+		return c.tx
+	}
+	if c.conn != nil {
+		return c.conn
+	}
+	return c.db
+}
+
 // Exec executes a query that does not return rows.
-func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
+func (c *Client) exec(ctx context.Context, sql string) (result sql.Result, err error) {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	result, err := c.db.ExecContext(ctx, sql)
+	err = c.run(ctx, func(e executor) (execErr error) {
+		result, execErr = e.ExecContext(ctx, sql)
+		return execErr
+	})
 	return result, decodeDriverError(err)
 }
 
 // query runs a query and returns the rows. dest is expected to be a slice of structs.
 func (c *Client) query(ctx context.Context, dest interface{}, sql string) error {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+	return decodeDriverError(c.run(ctx, func(e executor) error {
+		resetRows(dest)
+		return e.SelectContext(ctx, dest, sql)
+	}))
+}
+
+// resetRows drops the rows scanned into dest by a failed attempt, as SelectContext appends to it.
+func resetRows(dest interface{}) {
+	if v := reflect.ValueOf(dest); v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Slice {
+		v.Elem().SetLen(0)
+	}
 }
 
 // queryOne runs a query and returns one row. dest is expected to be a pointer to a struct.
 func (c *Client) queryOne(ctx context.Context, dest interface{}, sql string) error {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	return decodeDriverError(c.run(ctx, func(e executor) error {
+		return e.GetContext(ctx, dest, sql)
+	}))
 }
@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -64,3 +65,15 @@ func TestClient_queryOne(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, row.One)
 }
+
+func TestClient_execAsync(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	queryID, err := client.ExecAsync(ctx, "SELECT SYSTEM$WAIT(2)")
+	require.NoError(t, err)
+	require.NotEmpty(t, queryID)
+
+	status, err := client.AwaitQuery(ctx, queryID, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, QueryExecutionStatusSuccess, status.ExecutionStatus)
+}
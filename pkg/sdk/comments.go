@@ -2,10 +2,15 @@ package sdk
 
 import (
 	"context"
+	"errors"
 )
 
+// Comments sets comments with COMMENT ON, which works for every object type, so that comments can be
+// changed without building object specific ALTER statements.
 type Comments interface {
+	// Set sets the comment of an object. An empty comment removes it.
 	Set(ctx context.Context, opts *SetCommentOptions) error
+	// SetColumn sets the comment of a table or view column. An empty comment removes it.
 	SetColumn(ctx context.Context, opts *SetColumnCommentOptions) error
 }
 
@@ -16,15 +21,24 @@ type comments struct {
 var _ Comments = (*comments)(nil)
 
 type SetCommentOptions struct {
-	comment    bool             `ddl:"static" sql:"COMMENT"`
+	comment    bool             `ddl:"static" sql:"COMMENT"` //lint:ignore U1000 This is used in the ddl tag
 	IfExists   *bool            `ddl:"keyword" sql:"IF EXISTS"`
-	on         bool             `ddl:"static" sql:"ON"`
+	on         bool             `ddl:"static" sql:"ON"` //lint:ignore U1000 This is used in the ddl tag
 	ObjectType ObjectType       `ddl:"keyword"`
 	ObjectName ObjectIdentifier `ddl:"identifier"`
 	Value      *string          `ddl:"parameter,single_quotes,no_equals" sql:"IS"`
 }
 
 func (opts *SetCommentOptions) validate() error {
+	if opts.ObjectType == "" {
+		return errors.New("ObjectType must be set")
+	}
+	if opts.ObjectName == nil || !validObjectidentifier(opts.ObjectName) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.Value) {
+		return errors.New("Value must be set")
+	}
 	return nil
 }
 
@@ -32,11 +46,10 @@ func (c *comments) Set(ctx context.Context, opts *SetCommentOptions) error {
 	if opts == nil {
 		opts = &SetCommentOptions{}
 	}
-	// opts.name = name
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	stmt, err := structToSQL(opts)
+	stmt, err := c.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -45,14 +58,20 @@ func (c *comments) Set(ctx context.Context, opts *SetCommentOptions) error {
 }
 
 type SetColumnCommentOptions struct {
-	comment  bool             `ddl:"static" sql:"COMMENT"`
+	comment  bool             `ddl:"static" sql:"COMMENT"` //lint:ignore U1000 This is used in the ddl tag
 	IfExists *bool            `ddl:"keyword" sql:"IF EXISTS"`
-	on       bool             `ddl:"static" sql:"ON"`
+	on       bool             `ddl:"static" sql:"ON"` //lint:ignore U1000 This is used in the ddl tag
 	Column   ObjectIdentifier `ddl:"identifier" sql:"COLUMN"`
 	Value    *string          `ddl:"parameter,single_quotes,no_equals" sql:"IS"`
 }
 
 func (opts *SetColumnCommentOptions) validate() error {
+	if opts.Column == nil || !validObjectidentifier(opts.Column) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.Value) {
+		return errors.New("Value must be set")
+	}
 	return nil
 }
 
@@ -60,14 +79,14 @@ func (c *comments) SetColumn(ctx context.Context, opts *SetColumnCommentOptions)
 	if opts == nil {
 		opts = &SetColumnCommentOptions{}
 	}
-	// We only want to render table.column, not the fully qualified name with database and schema.
-	if v, ok := opts.Column.(TableColumnIdentifier); ok {
+	// Columns without a database and schema are rendered as table.column and resolved in the current schema.
+	if v, ok := opts.Column.(TableColumnIdentifier); ok && v.databaseName == "" && v.schemaName == "" {
 		opts.Column = NewSchemaIdentifier(v.tableName, v.columnName)
 	}
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	stmt, err := structToSQL(opts)
+	stmt, err := c.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,16 +29,34 @@ func TestInt_Comment(t *testing.T) {
 		assert.Equal(t, comment, wh.Comment)
 	})
 
-	// TODO: uncomment once we can create tables/columns
-	// t.Run("set column", func(t *testing.T) {
-	// 	comment := randomComment(t)
-	// 	err := client.Comments.SetColumn(ctx, &SetColumnCommentOpts{
-	// 		Column: testWarehouse.ID(),
-	// 		Value:  String(comment),
-	// 	})
-	// 	require.NoError(t, err)
-	// 	wh, err := client.Warehouses.ShowByID(ctx, testWarehouse.ID())
-	// 	require.NoError(t, err)
-	// 	assert.Equal(t, comment, wh.Comment)
-	// })
+	t.Run("set column", func(t *testing.T) {
+		database, databaseCleanup := createDatabase(t, client)
+		t.Cleanup(databaseCleanup)
+		schema, schemaCleanup := createSchema(t, client, database)
+		t.Cleanup(schemaCleanup)
+		tableID, tableCleanup := createTable(t, client, database, schema)
+		t.Cleanup(tableCleanup)
+
+		comment := randomComment(t)
+		err := client.Comments.SetColumn(ctx, &SetColumnCommentOptions{
+			Column: NewTableColumnIdentifier(tableID.DatabaseName(), tableID.SchemaName(), tableID.Name(), "EMAIL"),
+			Value:  String(comment),
+		})
+		require.NoError(t, err)
+
+		var actual string
+		err = client.queryOne(ctx, &actual, fmt.Sprintf(`SELECT COMMENT FROM %s.INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = '%s' AND TABLE_NAME = '%s' AND COLUMN_NAME = 'EMAIL'`, database.ID().FullyQualifiedName(), schema.Name, tableID.Name()))
+		require.NoError(t, err)
+		assert.Equal(t, comment, actual)
+	})
+
+	t.Run("set if exists on missing object", func(t *testing.T) {
+		err := client.Comments.Set(ctx, &SetCommentOptions{
+			IfExists:   Bool(true),
+			ObjectType: ObjectTypeWarehouse,
+			ObjectName: randomAccountObjectIdentifier(t),
+			Value:      String(randomComment(t)),
+		})
+		require.NoError(t, err)
+	})
 }
@@ -47,3 +47,57 @@ func TestComments(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 }
+
+func TestCommentsValidation(t *testing.T) {
+	t.Run("set without object type", func(t *testing.T) {
+		opts := &SetCommentOptions{
+			ObjectName: NewAccountObjectIdentifier("wh"),
+			Value:      String("mycomment"),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("set without object name", func(t *testing.T) {
+		opts := &SetCommentOptions{
+			ObjectType: ObjectTypeWarehouse,
+			Value:      String("mycomment"),
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("set without value", func(t *testing.T) {
+		opts := &SetCommentOptions{
+			ObjectType: ObjectTypeWarehouse,
+			ObjectName: NewAccountObjectIdentifier("wh"),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("set empty comment", func(t *testing.T) {
+		opts := &SetCommentOptions{
+			ObjectType: ObjectTypeWarehouse,
+			ObjectName: NewAccountObjectIdentifier("wh"),
+			Value:      String(""),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `COMMENT ON WAREHOUSE "wh" IS ''`, actual)
+	})
+
+	t.Run("set fully qualified column comment", func(t *testing.T) {
+		opts := &SetColumnCommentOptions{
+			Column: NewTableColumnIdentifier("db", "schema", "table", "column"),
+			Value:  String("mycomment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `COMMENT ON COLUMN "db"."schema"."table"."column" IS 'mycomment'`, actual)
+	})
+
+	t.Run("set column without column", func(t *testing.T) {
+		opts := &SetColumnCommentOptions{Value: String("mycomment")}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
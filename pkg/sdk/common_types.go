@@ -1,8 +1,12 @@
 package sdk
 
 import (
+	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 type TimeTravel struct {
@@ -25,6 +29,9 @@ type Clone struct {
 }
 
 func (v *Clone) validate() error {
+	if v.SourceObject == nil || !validObjectidentifier(v.SourceObject) {
+		return ErrInvalidObjectIdentifier
+	}
 	if everyValueSet(v.At, v.Before) {
 		return errors.New("only one of AT or BEFORE can be set")
 	}
@@ -37,21 +44,113 @@ func (v *Clone) validate() error {
 	return nil
 }
 
+// validateWithoutTimeTravel validates the clone of an object type that can only be cloned in its current state,
+// e.g. stages and streams.
+func (v *Clone) validateWithoutTimeTravel(objectType ObjectType) error {
+	if anyValueSet(v.At, v.Before) {
+		return fmt.Errorf("AT and BEFORE are not supported when cloning a %s", strings.ToLower(objectType.String()))
+	}
+	return v.validate()
+}
+
+// ShowOptions are the STARTS WITH and LIMIT options shared by SHOW commands. They are embedded in the options
+// of the commands supporting both, after LIKE and IN.
+type ShowOptions struct {
+	StartsWith *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit      *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+// LimitFrom renders LIMIT <rows> [ FROM '<name>' ] of SHOW commands. FROM starts the listing at the
+// given name, which together with STARTS WITH allows paging through large result sets.
 type LimitFrom struct {
 	Rows *int    `ddl:"keyword"`
 	From *string `ddl:"parameter,no_equals,single_quotes" sql:"FROM"`
 }
 
+// In scopes SHOW commands to the account, a database, a schema, an application or an application package.
 type In struct {
-	Account  *bool                   `ddl:"keyword" sql:"ACCOUNT"`
-	Database AccountObjectIdentifier `ddl:"identifier" sql:"DATABASE"`
-	Schema   SchemaIdentifier        `ddl:"identifier" sql:"SCHEMA"`
+	Account            *bool                   `ddl:"keyword" sql:"ACCOUNT"`
+	Database           AccountObjectIdentifier `ddl:"identifier" sql:"DATABASE"`
+	Schema             SchemaIdentifier        `ddl:"identifier" sql:"SCHEMA"`
+	Application        AccountObjectIdentifier `ddl:"identifier" sql:"APPLICATION"`
+	ApplicationPackage AccountObjectIdentifier `ddl:"identifier" sql:"APPLICATION PACKAGE"`
+}
+
+func (v *In) validate() error {
+	if !exactlyOneValueSet(v.Account, v.Database, v.Schema, v.Application, v.ApplicationPackage) {
+		return errors.New("exactly one of Account, Database, Schema, Application, ApplicationPackage must be set in In")
+	}
+	return nil
+}
+
+// terseRow is a row of SHOW TERSE <objects>, which only returns the columns identifying an object and is
+// considerably cheaper than the full output for object types with many objects.
+type terseRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	Kind         sql.NullString `db:"kind"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
 }
 
 type Like struct {
 	Pattern *string `ddl:"keyword,single_quotes"`
 }
 
+// likePatternReplacer escapes the _ and % wildcards of LIKE patterns with a backslash. Backslashes are doubled once
+// more because the pattern is rendered as a string constant.
+var likePatternReplacer = strings.NewReplacer(`\`, `\\\\`, `_`, `\\_`, `%`, `\\%`)
+
+// likeExactly returns a LIKE pattern that only matches the given name.
+func likeExactly(name string) *Like {
+	return &Like{Pattern: String(likePatternReplacer.Replace(name))}
+}
+
+// likeIDs returns a LIKE pattern matching all ids, so that they can be shown with a single SHOW: the exact name
+// for one id, or the common prefix of the names otherwise. It returns nil when the names have no common prefix.
+func likeIDs(ids []AccountObjectIdentifier) *Like {
+	if len(ids) == 1 {
+		return likeExactly(ids[0].Name())
+	}
+	var prefix string
+	for i, id := range ids {
+		if i == 0 {
+			prefix = id.Name()
+			continue
+		}
+		for !strings.HasPrefix(id.Name(), prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	for !utf8.ValidString(prefix) {
+		prefix = prefix[:len(prefix)-1]
+	}
+	if prefix == "" {
+		return nil
+	}
+	return &Like{Pattern: String(likePatternReplacer.Replace(prefix) + "%")}
+}
+
+// accountObject is an object identified by an AccountObjectIdentifier.
+type accountObject interface {
+	ID() AccountObjectIdentifier
+}
+
+// filterByIDs returns the objects with the given ids in the order of the ids. Ids without an object are skipped.
+func filterByIDs[T accountObject](objects []T, ids []AccountObjectIdentifier) []T {
+	byID := make(map[AccountObjectIdentifier]T, len(objects))
+	for _, object := range objects {
+		byID[object.ID()] = object
+	}
+	resultList := make([]T, 0, len(ids))
+	for _, id := range ids {
+		if object, ok := byID[id]; ok {
+			resultList = append(resultList, object)
+		}
+	}
+	return resultList
+}
+
 type TagAssociation struct {
 	Name  ObjectIdentifier `ddl:"identifier"`
 	Value string           `ddl:"parameter,single_quotes"`
@@ -74,6 +173,12 @@ type IntProperty struct {
 	Description  string
 }
 
+type BoolProperty struct {
+	Value        bool
+	DefaultValue bool
+	Description  string
+}
+
 type propertyRow struct {
 	Property     string `db:"property"`
 	Value        string `db:"value"`
@@ -95,10 +200,68 @@ func (row *propertyRow) toStringProperty() *StringProperty {
 	}
 }
 
+// toIntProperty treats null values as 0, e.g. DAYS_TO_EXPIRY of a user that never expires.
 func (row *propertyRow) toIntProperty() *IntProperty {
+	intOrZero := func(s string) int {
+		if s == "" || s == "null" {
+			return 0
+		}
+		return toInt(s)
+	}
 	return &IntProperty{
-		Value:        toInt(row.Value),
-		DefaultValue: toInt(row.DefaultValue),
+		Value:        intOrZero(row.Value),
+		DefaultValue: intOrZero(row.DefaultValue),
 		Description:  row.Description,
 	}
 }
+
+func (row *propertyRow) toBoolProperty() *BoolProperty {
+	return &BoolProperty{
+		Value:        strings.EqualFold(row.Value, "true"),
+		DefaultValue: strings.EqualFold(row.DefaultValue, "true"),
+		Description:  row.Description,
+	}
+}
+
+// integrationPropertyRow is a single row of DESCRIBE ... INTEGRATION output.
+type integrationPropertyRow struct {
+	Property     string `db:"property"`
+	PropertyType string `db:"property_type"`
+	Value        string `db:"property_value"`
+	Default      string `db:"property_default"`
+}
+
+func (row *integrationPropertyRow) toBool() bool {
+	return strings.EqualFold(row.Value, "true")
+}
+
+// toList splits comma separated list values, e.g. STORAGE_ALLOWED_LOCATIONS. Some integrations
+// wrap the list in brackets, e.g. [example.com, example.org], which is stripped as well.
+func (row *integrationPropertyRow) toList() []string {
+	return splitList(row.Value)
+}
+
+// splitList splits a comma separated list returned by SHOW or DESCRIBE, optionally wrapped in brackets.
+func splitList(raw string) []string {
+	value := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(raw), "["), "]")
+	if value == "" {
+		return nil
+	}
+	parts := strings.Split(value, ",")
+	list := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if s := strings.TrimSpace(part); s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// unquoteList splits a list such as ["A","B"] and removes the quotes around its elements.
+func unquoteList(raw string) []string {
+	list := splitList(raw)
+	for i, s := range list {
+		list[i] = strings.Trim(s, `"'`)
+	}
+	return list
+}
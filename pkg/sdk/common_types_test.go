@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLikeExactly(t *testing.T) {
+	t.Run("without wildcards", func(t *testing.T) {
+		assert.Equal(t, "monitor", *likeExactly("monitor").Pattern)
+	})
+
+	t.Run("with wildcards", func(t *testing.T) {
+		assert.Equal(t, `my\\_monitor\\%`, *likeExactly("my_monitor%").Pattern)
+	})
+
+	t.Run("with backslash", func(t *testing.T) {
+		assert.Equal(t, `my\\\\monitor`, *likeExactly(`my\monitor`).Pattern)
+	})
+}
+
+func TestLikeIDs(t *testing.T) {
+	t.Run("single id", func(t *testing.T) {
+		like := likeIDs([]AccountObjectIdentifier{NewAccountObjectIdentifier("my_monitor")})
+		assert.Equal(t, `my\\_monitor`, *like.Pattern)
+	})
+
+	t.Run("common prefix", func(t *testing.T) {
+		like := likeIDs([]AccountObjectIdentifier{NewAccountObjectIdentifier("TF_MONITOR_1"), NewAccountObjectIdentifier("TF_MONITOR_2"), NewAccountObjectIdentifier("TF_MON")})
+		assert.Equal(t, `TF\\_MON%`, *like.Pattern)
+	})
+
+	t.Run("common prefix ending within a character", func(t *testing.T) {
+		like := likeIDs([]AccountObjectIdentifier{NewAccountObjectIdentifier("wäre"), NewAccountObjectIdentifier("wöre")})
+		assert.Equal(t, `w%`, *like.Pattern)
+	})
+
+	t.Run("no common prefix", func(t *testing.T) {
+		assert.Nil(t, likeIDs([]AccountObjectIdentifier{NewAccountObjectIdentifier("a"), NewAccountObjectIdentifier("b")}))
+	})
+}
+
+func TestFilterByIDs(t *testing.T) {
+	roles := []*Role{{Name: "A"}, {Name: "B"}, {Name: "C"}}
+
+	filtered := filterByIDs(roles, []AccountObjectIdentifier{NewAccountObjectIdentifier("C"), NewAccountObjectIdentifier("missing"), NewAccountObjectIdentifier("A")})
+	assert.Equal(t, []*Role{roles[2], roles[0]}, filtered)
+}
@@ -0,0 +1,418 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ComputePools = (*computePools)(nil)
+
+// ComputePools are the virtual machine nodes Snowpark Container Services run on.
+type ComputePools interface {
+	// Create creates a new compute pool.
+	Create(ctx context.Context, id AccountObjectIdentifier, minNodes int, maxNodes int, instanceFamily ComputePoolInstanceFamily, opts *CreateComputePoolOptions) error
+	// Alter modifies an existing compute pool.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterComputePoolOptions) error
+	// Drop removes a compute pool.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropComputePoolOptions) error
+	// Show returns a list of compute pools.
+	Show(ctx context.Context, opts *ShowComputePoolOptions) ([]*ComputePool, error)
+	// ShowByID returns a compute pool by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ComputePool, error)
+	// Describe returns the details of a compute pool, including the reason it is in its state.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*ComputePool, error)
+}
+
+// computePools implements ComputePools.
+type computePools struct {
+	client *Client
+}
+
+type ComputePoolInstanceFamily string
+
+const (
+	ComputePoolInstanceFamilyCPUX64XS    ComputePoolInstanceFamily = "CPU_X64_XS"
+	ComputePoolInstanceFamilyCPUX64S     ComputePoolInstanceFamily = "CPU_X64_S"
+	ComputePoolInstanceFamilyCPUX64M     ComputePoolInstanceFamily = "CPU_X64_M"
+	ComputePoolInstanceFamilyCPUX64L     ComputePoolInstanceFamily = "CPU_X64_L"
+	ComputePoolInstanceFamilyHighMemX64S ComputePoolInstanceFamily = "HIGHMEM_X64_S"
+	ComputePoolInstanceFamilyHighMemX64M ComputePoolInstanceFamily = "HIGHMEM_X64_M"
+	ComputePoolInstanceFamilyHighMemX64L ComputePoolInstanceFamily = "HIGHMEM_X64_L"
+	ComputePoolInstanceFamilyGPUNVS      ComputePoolInstanceFamily = "GPU_NV_S"
+	ComputePoolInstanceFamilyGPUNVM      ComputePoolInstanceFamily = "GPU_NV_M"
+	ComputePoolInstanceFamilyGPUNVL      ComputePoolInstanceFamily = "GPU_NV_L"
+)
+
+var allComputePoolInstanceFamilies = []ComputePoolInstanceFamily{
+	ComputePoolInstanceFamilyCPUX64XS,
+	ComputePoolInstanceFamilyCPUX64S,
+	ComputePoolInstanceFamilyCPUX64M,
+	ComputePoolInstanceFamilyCPUX64L,
+	ComputePoolInstanceFamilyHighMemX64S,
+	ComputePoolInstanceFamilyHighMemX64M,
+	ComputePoolInstanceFamilyHighMemX64L,
+	ComputePoolInstanceFamilyGPUNVS,
+	ComputePoolInstanceFamilyGPUNVM,
+	ComputePoolInstanceFamilyGPUNVL,
+}
+
+type ComputePoolState string
+
+const (
+	ComputePoolStateIdle      ComputePoolState = "IDLE"
+	ComputePoolStateActive    ComputePoolState = "ACTIVE"
+	ComputePoolStateSuspended ComputePoolState = "SUSPENDED"
+	ComputePoolStateStarting  ComputePoolState = "STARTING"
+	ComputePoolStateStopping  ComputePoolState = "STOPPING"
+	ComputePoolStateResizing  ComputePoolState = "RESIZING"
+)
+
+var allComputePoolStates = []ComputePoolState{
+	ComputePoolStateIdle,
+	ComputePoolStateActive,
+	ComputePoolStateSuspended,
+	ComputePoolStateStarting,
+	ComputePoolStateStopping,
+	ComputePoolStateResizing,
+}
+
+type CreateComputePoolOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"`       //lint:ignore U1000 This is used in the ddl tag
+	computePool bool                    `ddl:"static" sql:"COMPUTE POOL"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+
+	// optional, creates the pool for the exclusive use of an application
+	ForApplication AccountObjectIdentifier `ddl:"identifier" sql:"FOR APPLICATION"`
+
+	// required
+	minNodes       int                       `ddl:"parameter" sql:"MIN_NODES"`
+	maxNodes       int                       `ddl:"parameter" sql:"MAX_NODES"`
+	instanceFamily ComputePoolInstanceFamily `ddl:"parameter" sql:"INSTANCE_FAMILY"`
+
+	// optional
+	AutoResume         *bool            `ddl:"parameter" sql:"AUTO_RESUME"`
+	InitiallySuspended *bool            `ddl:"parameter" sql:"INITIALLY_SUSPENDED"`
+	AutoSuspendSecs    *int             `ddl:"parameter" sql:"AUTO_SUSPEND_SECS"`
+	Tag                []TagAssociation `ddl:"keyword,parentheses" sql:"TAG"`
+	Comment            *string          `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateComputePoolOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !validateIntGreaterThanOrEqual(opts.minNodes, 1) {
+		return errors.New("MIN_NODES must be greater than or equal to 1")
+	}
+	if opts.maxNodes < opts.minNodes {
+		return errors.New("MAX_NODES must be greater than or equal to MIN_NODES")
+	}
+	if opts.instanceFamily == "" {
+		return errors.New("INSTANCE_FAMILY is required")
+	}
+	if valueSet(opts.AutoSuspendSecs) && !validateIntGreaterThanOrEqual(*opts.AutoSuspendSecs, 0) {
+		return errors.New("AUTO_SUSPEND_SECS must be greater than or equal to 0")
+	}
+	return nil
+}
+
+func (v *computePools) Create(ctx context.Context, id AccountObjectIdentifier, minNodes int, maxNodes int, instanceFamily ComputePoolInstanceFamily, opts *CreateComputePoolOptions) error {
+	if opts == nil {
+		opts = &CreateComputePoolOptions{}
+	}
+	opts.name = id
+	opts.minNodes = minNodes
+	opts.maxNodes = maxNodes
+	opts.instanceFamily = instanceFamily
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterComputePoolOptions struct {
+	alter       bool                    `ddl:"static" sql:"ALTER"`        //lint:ignore U1000 This is used in the ddl tag
+	computePool bool                    `ddl:"static" sql:"COMPUTE POOL"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	Suspend *bool `ddl:"keyword" sql:"SUSPEND"`
+	Resume  *bool `ddl:"keyword" sql:"RESUME"`
+	// StopAll stops all services and jobs running in the pool.
+	StopAll *bool             `ddl:"keyword" sql:"STOP ALL"`
+	Set     *ComputePoolSet   `ddl:"keyword" sql:"SET"`
+	Unset   *ComputePoolUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *AlterComputePoolOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Suspend, opts.Resume, opts.StopAll, opts.Set, opts.Unset) {
+		return errors.New("exactly one of Suspend, Resume, StopAll, Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.AutoResume, opts.Unset.AutoSuspendSecs, opts.Unset.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+type ComputePoolSet struct {
+	MinNodes        *int    `ddl:"parameter" sql:"MIN_NODES"`
+	MaxNodes        *int    `ddl:"parameter" sql:"MAX_NODES"`
+	AutoResume      *bool   `ddl:"parameter" sql:"AUTO_RESUME"`
+	AutoSuspendSecs *int    `ddl:"parameter" sql:"AUTO_SUSPEND_SECS"`
+	Comment         *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *ComputePoolSet) validate() error {
+	if !anyValueSet(v.MinNodes, v.MaxNodes, v.AutoResume, v.AutoSuspendSecs, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(v.MinNodes) && !validateIntGreaterThanOrEqual(*v.MinNodes, 1) {
+		return errors.New("MIN_NODES must be greater than or equal to 1")
+	}
+	if everyValueSet(v.MinNodes, v.MaxNodes) && *v.MaxNodes < *v.MinNodes {
+		return errors.New("MAX_NODES must be greater than or equal to MIN_NODES")
+	}
+	return nil
+}
+
+type ComputePoolUnset struct {
+	AutoResume      *bool `ddl:"keyword" sql:"AUTO_RESUME"`
+	AutoSuspendSecs *bool `ddl:"keyword" sql:"AUTO_SUSPEND_SECS"`
+	Comment         *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *computePools) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterComputePoolOptions) error {
+	if opts == nil {
+		opts = &AlterComputePoolOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropComputePoolOptions struct {
+	drop        bool                    `ddl:"static" sql:"DROP"`         //lint:ignore U1000 This is used in the ddl tag
+	computePool bool                    `ddl:"static" sql:"COMPUTE POOL"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropComputePoolOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *computePools) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropComputePoolOptions) error {
+	if opts == nil {
+		opts = &DropComputePoolOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowComputePoolOptions struct {
+	show         bool `ddl:"static" sql:"SHOW"`          //lint:ignore U1000 This is used in the ddl tag
+	computePools bool `ddl:"static" sql:"COMPUTE POOLS"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	ShowOptions
+}
+
+func (opts *ShowComputePoolOptions) validate() error {
+	return nil
+}
+
+type ComputePool struct {
+	Name            string
+	State           ComputePoolState
+	MinNodes        int
+	MaxNodes        int
+	InstanceFamily  ComputePoolInstanceFamily
+	NumServices     int
+	NumJobs         int
+	AutoSuspendSecs int
+	AutoResume      bool
+	ActiveNodes     int
+	IdleNodes       int
+	TargetNodes     int
+	CreatedOn       time.Time
+	ResumedOn       time.Time
+	UpdatedOn       time.Time
+	Owner           string
+	Comment         string
+	IsExclusive     bool
+	// Application is the application the pool was created for, if any.
+	Application string
+	// ErrorCode and StatusMessage are only returned by Describe.
+	ErrorCode     string
+	StatusMessage string
+}
+
+func (v *ComputePool) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ComputePool) ObjectType() ObjectType {
+	return ObjectTypeComputePool
+}
+
+type computePoolRow struct {
+	Name            string         `db:"name"`
+	State           string         `db:"state"`
+	MinNodes        int            `db:"min_nodes"`
+	MaxNodes        int            `db:"max_nodes"`
+	InstanceFamily  string         `db:"instance_family"`
+	NumServices     sql.NullInt64  `db:"num_services"`
+	NumJobs         sql.NullInt64  `db:"num_jobs"`
+	AutoSuspendSecs sql.NullInt64  `db:"auto_suspend_secs"`
+	AutoResume      bool           `db:"auto_resume"`
+	ActiveNodes     sql.NullInt64  `db:"active_nodes"`
+	IdleNodes       sql.NullInt64  `db:"idle_nodes"`
+	TargetNodes     sql.NullInt64  `db:"target_nodes"`
+	CreatedOn       time.Time      `db:"created_on"`
+	ResumedOn       sql.NullTime   `db:"resumed_on"`
+	UpdatedOn       sql.NullTime   `db:"updated_on"`
+	Owner           sql.NullString `db:"owner"`
+	Comment         sql.NullString `db:"comment"`
+	IsExclusive     bool           `db:"is_exclusive"`
+	Application     sql.NullString `db:"application"`
+	ErrorCode       sql.NullString `db:"error_code"`
+	StatusMessage   sql.NullString `db:"status_message"`
+}
+
+func (row computePoolRow) toComputePool(strict bool) (*ComputePool, error) {
+	pool := &ComputePool{
+		Name:            row.Name,
+		MinNodes:        row.MinNodes,
+		MaxNodes:        row.MaxNodes,
+		NumServices:     int(row.NumServices.Int64),
+		NumJobs:         int(row.NumJobs.Int64),
+		AutoSuspendSecs: int(row.AutoSuspendSecs.Int64),
+		AutoResume:      row.AutoResume,
+		ActiveNodes:     int(row.ActiveNodes.Int64),
+		IdleNodes:       int(row.IdleNodes.Int64),
+		TargetNodes:     int(row.TargetNodes.Int64),
+		CreatedOn:       row.CreatedOn,
+		Owner:           row.Owner.String,
+		Comment:         row.Comment.String,
+		IsExclusive:     row.IsExclusive,
+		Application:     row.Application.String,
+		ErrorCode:       row.ErrorCode.String,
+		StatusMessage:   row.StatusMessage.String,
+	}
+	if row.ResumedOn.Valid {
+		pool.ResumedOn = row.ResumedOn.Time
+	}
+	if row.UpdatedOn.Valid {
+		pool.UpdatedOn = row.UpdatedOn.Time
+	}
+	var err error
+	if pool.State, err = toEnum(strict, "compute pool state", row.State, allComputePoolStates); err != nil {
+		return nil, err
+	}
+	if pool.InstanceFamily, err = toEnum(strict, "compute pool instance family", row.InstanceFamily, allComputePoolInstanceFamilies); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func (v *computePools) Show(ctx context.Context, opts *ShowComputePoolOptions) ([]*ComputePool, error) {
+	if opts == nil {
+		opts = &ShowComputePoolOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []computePoolRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ComputePool, len(dest))
+	for i, row := range dest {
+		resultList[i], err = row.toComputePool(v.client.strictEnumParsing)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resultList, nil
+}
+
+func (v *computePools) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ComputePool, error) {
+	pools, err := v.Show(ctx, &ShowComputePoolOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range pools {
+		if pool.Name == id.Name() {
+			return pool, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeComputePoolOptions struct {
+	describe    bool                    `ddl:"static" sql:"DESCRIBE"`     //lint:ignore U1000 This is used in the ddl tag
+	computePool bool                    `ddl:"static" sql:"COMPUTE POOL"` //lint:ignore U1000 This is used in the ddl tag
+	name        AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeComputePoolOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *computePools) Describe(ctx context.Context, id AccountObjectIdentifier) (*ComputePool, error) {
+	opts := &describeComputePoolOptions{
+		name: id,
+	}
+	dest, err := describeRow[computePoolRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toComputePool(v.client.strictEnumParsing)
+}
@@ -0,0 +1,62 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ComputePools(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.ComputePools.Create(ctx, id, 1, 1, ComputePoolInstanceFamilyCPUX64XS, &CreateComputePoolOptions{
+		InitiallySuspended: Bool(true),
+		AutoResume:         Bool(false),
+		Comment:            String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ComputePools.Drop(ctx, id, &DropComputePoolOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		pool, err := client.ComputePools.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, pool.ID())
+		assert.Equal(t, ComputePoolInstanceFamilyCPUX64XS, pool.InstanceFamily)
+		assert.Equal(t, "some comment", pool.Comment)
+		assert.False(t, pool.AutoResume)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		pool, err := client.ComputePools.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, pool.MinNodes)
+		assert.Equal(t, 1, pool.MaxNodes)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		err := client.ComputePools.Alter(ctx, id, &AlterComputePoolOptions{Set: &ComputePoolSet{MaxNodes: Int(2), AutoSuspendSecs: Int(300)}})
+		require.NoError(t, err)
+		pool, err := client.ComputePools.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 2, pool.MaxNodes)
+		assert.Equal(t, 300, pool.AutoSuspendSecs)
+
+		err = client.ComputePools.Alter(ctx, id, &AlterComputePoolOptions{Unset: &ComputePoolUnset{Comment: Bool(true)}})
+		require.NoError(t, err)
+		pool, err = client.ComputePools.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", pool.Comment)
+	})
+
+	t.Run("alter: stop all", func(t *testing.T) {
+		err := client.ComputePools.Alter(ctx, id, &AlterComputePoolOptions{StopAll: Bool(true)})
+		require.NoError(t, err)
+	})
+}
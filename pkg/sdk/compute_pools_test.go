@@ -0,0 +1,142 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputePoolCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("mypool")
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateComputePoolOptions{
+			IfNotExists:        Bool(true),
+			name:               id,
+			ForApplication:     NewAccountObjectIdentifier("myapp"),
+			minNodes:           1,
+			maxNodes:           2,
+			instanceFamily:     ComputePoolInstanceFamilyCPUX64XS,
+			AutoResume:         Bool(true),
+			InitiallySuspended: Bool(true),
+			AutoSuspendSecs:    Int(600),
+			Comment:            String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE COMPUTE POOL IF NOT EXISTS "mypool" FOR APPLICATION "myapp" MIN_NODES = 1 MAX_NODES = 2 INSTANCE_FAMILY = CPU_X64_XS AUTO_RESUME = true INITIALLY_SUSPENDED = true AUTO_SUSPEND_SECS = 600 COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: max nodes lower than min nodes", func(t *testing.T) {
+		opts := &CreateComputePoolOptions{
+			name:           id,
+			minNodes:       3,
+			maxNodes:       2,
+			instanceFamily: ComputePoolInstanceFamilyCPUX64XS,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: missing instance family", func(t *testing.T) {
+		opts := &CreateComputePoolOptions{
+			name:     id,
+			minNodes: 1,
+			maxNodes: 1,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestComputePoolAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("mypool")
+
+	t.Run("stop all", func(t *testing.T) {
+		opts := &AlterComputePoolOptions{
+			IfExists: Bool(true),
+			name:     id,
+			StopAll:  Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER COMPUTE POOL IF EXISTS "mypool" STOP ALL`, actual)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterComputePoolOptions{
+			name: id,
+			Set:  &ComputePoolSet{MinNodes: Int(2), MaxNodes: Int(4), AutoSuspendSecs: Int(300)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER COMPUTE POOL "mypool" SET MIN_NODES = 2 MAX_NODES = 4 AUTO_SUSPEND_SECS = 300`, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterComputePoolOptions{
+			name:  id,
+			Unset: &ComputePoolUnset{AutoResume: Bool(true), Comment: Bool(true)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER COMPUTE POOL "mypool" UNSET AUTO_RESUME, COMMENT`, actual)
+	})
+
+	t.Run("validation: suspend and resume", func(t *testing.T) {
+		opts := &AlterComputePoolOptions{
+			name:    id,
+			Suspend: Bool(true),
+			Resume:  Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: max nodes lower than min nodes", func(t *testing.T) {
+		opts := &AlterComputePoolOptions{
+			name: id,
+			Set:  &ComputePoolSet{MinNodes: Int(4), MaxNodes: Int(2)},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestComputePoolDrop(t *testing.T) {
+	opts := &DropComputePoolOptions{
+		IfExists: Bool(true),
+		name:     NewAccountObjectIdentifier("mypool"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP COMPUTE POOL IF EXISTS "mypool"`, actual)
+}
+
+func TestComputePoolShow(t *testing.T) {
+	opts := &ShowComputePoolOptions{
+		Like: &Like{Pattern: String("mypool")},
+		ShowOptions: ShowOptions{
+			Limit: &LimitFrom{Rows: Int(1)},
+		},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW COMPUTE POOLS LIKE 'mypool' LIMIT 1`, actual)
+}
+
+func TestComputePoolDescribe(t *testing.T) {
+	opts := &describeComputePoolOptions{
+		name: NewAccountObjectIdentifier("mypool"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE COMPUTE POOL "mypool"`, actual)
+}
+
+func TestComputePoolRowToComputePool(t *testing.T) {
+	pool, err := computePoolRow{Name: "mypool", State: "IDLE", InstanceFamily: "CPU_X64_XS"}.toComputePool(true)
+	require.NoError(t, err)
+	assert.Equal(t, ComputePoolStateIdle, pool.State)
+	assert.Equal(t, ComputePoolInstanceFamilyCPUX64XS, pool.InstanceFamily)
+
+	_, err = computePoolRow{Name: "mypool", State: "IDLE", InstanceFamily: "CPU_X64_XL"}.toComputePool(true)
+	assert.ErrorIs(t, err, ErrUnknownEnumValue)
+}
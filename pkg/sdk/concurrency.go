@@ -0,0 +1,28 @@
+package sdk
+
+import "context"
+
+// WithMaxConcurrentStatements limits the number of statements the client runs at the same time, e.g. to stay below
+// the connection and statement limits of the account during large applies. Statements over the limit wait for
+// a running one to finish or for their context to be done. By default the number is not limited.
+func WithMaxConcurrentStatements(limit int) ClientOption {
+	return func(c *Client) {
+		if limit > 0 {
+			c.statementSlots = make(chan struct{}, limit)
+		}
+	}
+}
+
+// withStatementSlot calls fn once a statement slot is free, see WithMaxConcurrentStatements.
+func (c *Client) withStatementSlot(ctx context.Context, fn func() error) error {
+	if c.statementSlots == nil {
+		return fn()
+	}
+	select {
+	case c.statementSlots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-c.statementSlots }()
+	return fn()
+}
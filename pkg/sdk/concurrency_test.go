@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMaxConcurrentStatements(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("limits statements in flight", func(t *testing.T) {
+		client := &Client{}
+		WithMaxConcurrentStatements(2)(client)
+		var inFlight, maxInFlight int32
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := client.withStatementSlot(ctx, func() error {
+					n := atomic.AddInt32(&inFlight, 1)
+					for {
+						m := atomic.LoadInt32(&maxInFlight)
+						if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+							break
+						}
+					}
+					time.Sleep(time.Millisecond)
+					atomic.AddInt32(&inFlight, -1)
+					return nil
+				})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		assert.LessOrEqual(t, maxInFlight, int32(2))
+	})
+
+	t.Run("waiting respects the context", func(t *testing.T) {
+		client := &Client{}
+		WithMaxConcurrentStatements(1)(client)
+		client.statementSlots <- struct{}{}
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		err := client.withStatementSlot(ctx, func() error {
+			t.Fatal("statement ran without a free slot")
+			return nil
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("unlimited by default", func(t *testing.T) {
+		client := &Client{}
+		require.NoError(t, client.withStatementSlot(ctx, func() error { return nil }))
+		assert.Nil(t, client.statementSlots)
+	})
+}
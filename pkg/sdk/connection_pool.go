@@ -0,0 +1,43 @@
+package sdk
+
+import (
+	"database/sql"
+	"time"
+)
+
+// PoolConfig configures the connection pool of a client, see WithPoolConfig. Every connection is a Snowflake
+// session, so a pool that is too small for the parallelism of the caller keeps logging in and out. Zero values
+// keep the defaults of database/sql.
+type PoolConfig struct {
+	// MaxOpenConnections limits the number of open connections, unlimited by default.
+	MaxOpenConnections int
+	// MaxIdleConnections limits the number of idle connections kept open, 2 by default.
+	MaxIdleConnections int
+	// ConnectionMaxLifetime closes connections that were opened longer ago, never by default.
+	ConnectionMaxLifetime time.Duration
+	// ConnectionMaxIdleTime closes connections that were idle for longer, never by default.
+	ConnectionMaxIdleTime time.Duration
+}
+
+// WithPoolConfig configures the connection pool of the client. It also applies to the pool passed to
+// NewClientFromDB.
+func WithPoolConfig(config PoolConfig) ClientOption {
+	return func(c *Client) {
+		c.poolConfig = &config
+	}
+}
+
+func (p PoolConfig) apply(db *sql.DB) {
+	if p.MaxOpenConnections != 0 {
+		db.SetMaxOpenConns(p.MaxOpenConnections)
+	}
+	if p.MaxIdleConnections != 0 {
+		db.SetMaxIdleConns(p.MaxIdleConnections)
+	}
+	if p.ConnectionMaxLifetime != 0 {
+		db.SetConnMaxLifetime(p.ConnectionMaxLifetime)
+	}
+	if p.ConnectionMaxIdleTime != 0 {
+		db.SetConnMaxIdleTime(p.ConnectionMaxIdleTime)
+	}
+}
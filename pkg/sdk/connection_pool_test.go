@@ -0,0 +1,31 @@
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolConfig(t *testing.T) {
+	t.Run("applied to the pool", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		NewClientFromDB(db, WithPoolConfig(PoolConfig{MaxOpenConnections: 8, MaxIdleConnections: 4, ConnectionMaxLifetime: time.Hour}))
+		assert.Equal(t, 8, db.Stats().MaxOpenConnections)
+	})
+
+	t.Run("zero values keep the defaults", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		db.SetMaxOpenConns(3)
+
+		NewClientFromDB(db, WithPoolConfig(PoolConfig{MaxIdleConnections: 1}))
+		assert.Equal(t, 3, db.Stats().MaxOpenConnections)
+	})
+}
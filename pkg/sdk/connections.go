@@ -0,0 +1,326 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Connections = (*connections)(nil)
+
+// Connections describes all the connection related methods that the Snowflake API supports.
+// Connections are used for Client Redirect: clients connect through the connection URL and
+// are redirected to whichever account holds the primary connection.
+type Connections interface {
+	// Create creates a new primary connection.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateConnectionOptions) error
+	// CreateReplica creates a secondary connection as a replica of a primary connection in another account.
+	CreateReplica(ctx context.Context, id AccountObjectIdentifier, primaryConnectionID ExternalObjectIdentifier, opts *CreateReplicaConnectionOptions) error
+	// Alter modifies an existing connection.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterConnectionOptions) error
+	// Drop removes a connection.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropConnectionOptions) error
+	// Show returns a list of connections.
+	Show(ctx context.Context, opts *ShowConnectionOptions) ([]*Connection, error)
+	// ShowByID returns a connection by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Connection, error)
+}
+
+// connections implements Connections.
+type connections struct {
+	client *Client
+}
+
+type CreateConnectionOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"`     //lint:ignore U1000 This is used in the ddl tag
+	connection  bool                    `ddl:"static" sql:"CONNECTION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	Comment     *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateConnectionOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *connections) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateConnectionOptions) error {
+	if opts == nil {
+		opts = &CreateConnectionOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type CreateReplicaConnectionOptions struct {
+	create            bool                     `ddl:"static" sql:"CREATE"`     //lint:ignore U1000 This is used in the ddl tag
+	connection        bool                     `ddl:"static" sql:"CONNECTION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists       *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name              AccountObjectIdentifier  `ddl:"identifier"`
+	primaryConnection ExternalObjectIdentifier `ddl:"identifier" sql:"AS REPLICA OF"`
+	Comment           *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateReplicaConnectionOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !validObjectidentifier(opts.primaryConnection) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *connections) CreateReplica(ctx context.Context, id AccountObjectIdentifier, primaryConnectionID ExternalObjectIdentifier, opts *CreateReplicaConnectionOptions) error {
+	if opts == nil {
+		opts = &CreateReplicaConnectionOptions{}
+	}
+	opts.name = id
+	opts.primaryConnection = primaryConnectionID
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterConnectionOptions struct {
+	alter      bool                    `ddl:"static" sql:"ALTER"`      //lint:ignore U1000 This is used in the ddl tag
+	connection bool                    `ddl:"static" sql:"CONNECTION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists   *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name       AccountObjectIdentifier `ddl:"identifier"`
+
+	EnableFailover  *ConnectionEnableFailover  `ddl:"keyword" sql:"ENABLE FAILOVER"`
+	DisableFailover *ConnectionDisableFailover `ddl:"keyword" sql:"DISABLE FAILOVER"`
+	// Primary promotes a secondary connection to primary, redirecting clients to the current account.
+	Primary *bool            `ddl:"keyword" sql:"PRIMARY"`
+	Set     *ConnectionSet   `ddl:"keyword" sql:"SET"`
+	Unset   *ConnectionUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *AlterConnectionOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.EnableFailover, opts.DisableFailover, opts.Primary, opts.Set, opts.Unset) {
+		return errors.New("exactly one of EnableFailover, DisableFailover, Primary, Set, Unset must be set")
+	}
+	if valueSet(opts.EnableFailover) && len(opts.EnableFailover.ToAccounts) == 0 {
+		return errors.New("EnableFailover.ToAccounts must contain at least one account")
+	}
+	if valueSet(opts.Set) && !valueSet(opts.Set.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(opts.Unset) && !valueSet(opts.Unset.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+type ConnectionEnableFailover struct {
+	ToAccounts         []AccountIdentifier `ddl:"parameter,no_equals" sql:"TO ACCOUNTS"`
+	IgnoreEditionCheck *bool               `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+}
+
+// ConnectionDisableFailover disables failover to the given accounts, or to all accounts when ToAccounts is empty.
+type ConnectionDisableFailover struct {
+	ToAccounts []AccountIdentifier `ddl:"parameter,no_equals" sql:"TO ACCOUNTS"`
+}
+
+type ConnectionSet struct {
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ConnectionUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *connections) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterConnectionOptions) error {
+	if opts == nil {
+		opts = &AlterConnectionOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropConnectionOptions struct {
+	drop       bool                    `ddl:"static" sql:"DROP"`       //lint:ignore U1000 This is used in the ddl tag
+	connection bool                    `ddl:"static" sql:"CONNECTION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists   *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name       AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropConnectionOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *connections) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropConnectionOptions) error {
+	if opts == nil {
+		opts = &DropConnectionOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowConnectionOptions represents the options for listing connections.
+type ShowConnectionOptions struct {
+	show        bool  `ddl:"static" sql:"SHOW"`        //lint:ignore U1000 This is used in the ddl tag
+	connections bool  `ddl:"static" sql:"CONNECTIONS"` //lint:ignore U1000 This is used in the ddl tag
+	Like        *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowConnectionOptions) validate() error {
+	return nil
+}
+
+type Connection struct {
+	RegionGroup               string
+	SnowflakeRegion           string
+	CreatedOn                 time.Time
+	AccountName               string
+	Name                      string
+	Comment                   string
+	IsPrimary                 bool
+	Primary                   ExternalObjectIdentifier
+	FailoverAllowedToAccounts []AccountIdentifier
+	// ConnectionURL is the URL clients use to connect through the connection.
+	ConnectionURL    string
+	OrganizationName string
+	AccountLocator   string
+}
+
+func (v *Connection) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *Connection) ExternalID() ExternalObjectIdentifier {
+	return NewExternalObjectIdentifier(AccountIdentifier{
+		organizationName: v.OrganizationName,
+		accountName:      v.AccountName,
+		accountLocator:   v.AccountLocator,
+	}, v.ID())
+}
+
+func (v *Connection) ObjectType() ObjectType {
+	return ObjectTypeConnection
+}
+
+type connectionRow struct {
+	RegionGroup               sql.NullString `db:"region_group"`
+	SnowflakeRegion           string         `db:"snowflake_region"`
+	CreatedOn                 time.Time      `db:"created_on"`
+	AccountName               string         `db:"account_name"`
+	Name                      string         `db:"name"`
+	Comment                   sql.NullString `db:"comment"`
+	IsPrimary                 bool           `db:"is_primary"`
+	Primary                   string         `db:"primary"`
+	FailoverAllowedToAccounts sql.NullString `db:"failover_allowed_to_accounts"`
+	ConnectionURL             string         `db:"connection_url"`
+	OrganizationName          string         `db:"organization_name"`
+	AccountLocator            string         `db:"account_locator"`
+}
+
+func (row connectionRow) toConnection() *Connection {
+	var failoverAllowedToAccounts []AccountIdentifier
+	for _, account := range strings.Split(row.FailoverAllowedToAccounts.String, ",") {
+		p := strings.Split(strings.TrimSpace(account), ".")
+		if len(p) != 2 {
+			continue
+		}
+		failoverAllowedToAccounts = append(failoverAllowedToAccounts, NewAccountIdentifier(p[0], p[1]))
+	}
+	return &Connection{
+		RegionGroup:               row.RegionGroup.String,
+		SnowflakeRegion:           row.SnowflakeRegion,
+		CreatedOn:                 row.CreatedOn,
+		AccountName:               row.AccountName,
+		Name:                      row.Name,
+		Comment:                   row.Comment.String,
+		IsPrimary:                 row.IsPrimary,
+		Primary:                   NewExternalObjectIdentifierFromFullyQualifiedName(row.Primary),
+		FailoverAllowedToAccounts: failoverAllowedToAccounts,
+		ConnectionURL:             row.ConnectionURL,
+		OrganizationName:          row.OrganizationName,
+		AccountLocator:            row.AccountLocator,
+	}
+}
+
+func (v *connections) Show(ctx context.Context, opts *ShowConnectionOptions) ([]*Connection, error) {
+	if opts == nil {
+		opts = &ShowConnectionOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []connectionRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Connection, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toConnection()
+	}
+	return resultList, nil
+}
+
+// ShowByID returns the connection in the current account, SHOW CONNECTIONS also lists the
+// primary and secondary connections in the other accounts of the organization.
+func (v *connections) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Connection, error) {
+	currentAccount, err := v.client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	connections, err := v.Show(ctx, &ShowConnectionOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, connection := range connections {
+		if connection.ID() == id && connection.AccountLocator == currentAccount {
+			return connection, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Connections(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.Connections.Create(ctx, id, &CreateConnectionOptions{Comment: String("some comment")})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Connections.Drop(ctx, id, &DropConnectionOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		connection, err := client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), connection.Name)
+		assert.True(t, connection.IsPrimary)
+		assert.Equal(t, "some comment", connection.Comment)
+		assert.NotEmpty(t, connection.ConnectionURL)
+	})
+
+	t.Run("enable and disable failover", func(t *testing.T) {
+		secondaryAccount := getSecondaryAccountIdentifier(t)
+		err := client.Connections.Alter(ctx, id, &AlterConnectionOptions{
+			EnableFailover: &ConnectionEnableFailover{ToAccounts: []AccountIdentifier{secondaryAccount}},
+		})
+		require.NoError(t, err)
+		connection, err := client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, connection.FailoverAllowedToAccounts, secondaryAccount)
+
+		err = client.Connections.Alter(ctx, id, &AlterConnectionOptions{DisableFailover: &ConnectionDisableFailover{}})
+		require.NoError(t, err)
+		connection, err = client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.NotContains(t, connection.FailoverAllowedToAccounts, secondaryAccount)
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		err := client.Connections.Alter(ctx, id, &AlterConnectionOptions{Unset: &ConnectionUnset{Comment: Bool(true)}})
+		require.NoError(t, err)
+		connection, err := client.Connections.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", connection.Comment)
+	})
+
+	t.Run("show by id: not existing", func(t *testing.T) {
+		_, err := client.Connections.ShowByID(ctx, randomAccountObjectIdentifier(t))
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
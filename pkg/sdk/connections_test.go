@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionsCreate(t *testing.T) {
+	t.Run("primary", func(t *testing.T) {
+		opts := &CreateConnectionOptions{
+			IfNotExists: Bool(true),
+			name:        NewAccountObjectIdentifier("conn1"),
+			Comment:     String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE CONNECTION IF NOT EXISTS "conn1" COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("replica", func(t *testing.T) {
+		opts := &CreateReplicaConnectionOptions{
+			name:              NewAccountObjectIdentifier("conn1"),
+			primaryConnection: NewExternalObjectIdentifierFromFullyQualifiedName("myorg.myaccount.conn1"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE CONNECTION "conn1" AS REPLICA OF myorg.myaccount."conn1"`, actual)
+	})
+}
+
+func TestConnectionsAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("conn1")
+	account := NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")
+
+	t.Run("enable failover", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name: id,
+			EnableFailover: &ConnectionEnableFailover{
+				ToAccounts:         []AccountIdentifier{account, NewAccountIdentifier("MY_ORG", "OTHER_ACCOUNT")},
+				IgnoreEditionCheck: Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER CONNECTION "conn1" ENABLE FAILOVER TO ACCOUNTS "MY_ORG.MY_ACCOUNT", "MY_ORG.OTHER_ACCOUNT" IGNORE EDITION CHECK`, actual)
+	})
+
+	t.Run("disable failover", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name:            id,
+			DisableFailover: &ConnectionDisableFailover{ToAccounts: []AccountIdentifier{account}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER CONNECTION "conn1" DISABLE FAILOVER TO ACCOUNTS "MY_ORG.MY_ACCOUNT"`, actual)
+	})
+
+	t.Run("disable failover to all accounts", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name:            id,
+			DisableFailover: &ConnectionDisableFailover{},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER CONNECTION "conn1" DISABLE FAILOVER`, actual)
+	})
+
+	t.Run("primary", func(t *testing.T) {
+		opts := &AlterConnectionOptions{name: id, Primary: Bool(true)}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER CONNECTION "conn1" PRIMARY`, actual)
+	})
+
+	t.Run("set and unset comment", func(t *testing.T) {
+		opts := &AlterConnectionOptions{IfExists: Bool(true), name: id, Set: &ConnectionSet{Comment: String("some comment")}}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER CONNECTION IF EXISTS "conn1" SET COMMENT = 'some comment'`, actual)
+
+		opts = &AlterConnectionOptions{name: id, Unset: &ConnectionUnset{Comment: Bool(true)}}
+		require.NoError(t, opts.validate())
+		actual, err = structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER CONNECTION "conn1" UNSET COMMENT`, actual)
+	})
+
+	t.Run("validation: enable failover without accounts", func(t *testing.T) {
+		opts := &AlterConnectionOptions{name: id, EnableFailover: &ConnectionEnableFailover{}}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: more than one action", func(t *testing.T) {
+		opts := &AlterConnectionOptions{name: id, Primary: Bool(true), DisableFailover: &ConnectionDisableFailover{}}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestConnectionsDropAndShow(t *testing.T) {
+	actual, err := structToSQL(&DropConnectionOptions{IfExists: Bool(true), name: NewAccountObjectIdentifier("conn1")})
+	require.NoError(t, err)
+	assert.Equal(t, `DROP CONNECTION IF EXISTS "conn1"`, actual)
+
+	actual, err = structToSQL(&ShowConnectionOptions{Like: &Like{Pattern: String("conn%")}})
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW CONNECTIONS LIKE 'conn%'`, actual)
+}
+
+func TestConnectionRow(t *testing.T) {
+	row := connectionRow{
+		Name:                      "CONN1",
+		Primary:                   "MY_ORG.MY_ACCOUNT.CONN1",
+		FailoverAllowedToAccounts: sql.NullString{String: "MY_ORG.MY_ACCOUNT, MY_ORG.OTHER_ACCOUNT", Valid: true},
+		ConnectionURL:             "my_org-conn1.snowflakecomputing.com",
+	}
+	connection := row.toConnection()
+	assert.Equal(t, []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"), NewAccountIdentifier("MY_ORG", "OTHER_ACCOUNT")}, connection.FailoverAllowedToAccounts)
+	assert.Equal(t, "my_org-conn1.snowflakecomputing.com", connection.ConnectionURL)
+	assert.Equal(t, "CONN1", connection.Primary.Name())
+}
@@ -9,7 +9,9 @@ import (
 type ContextFunctions interface {
 	// Session functions.
 	CurrentAccount(ctx context.Context) (string, error)
+	CurrentAccountName(ctx context.Context) (string, error)
 	CurrentRole(ctx context.Context) (string, error)
+	CurrentSecondaryRoles(ctx context.Context) (SecondaryRoles, error)
 	CurrentRegion(ctx context.Context) (string, error)
 	CurrentSession(ctx context.Context) (string, error)
 	CurrentUser(ctx context.Context) (string, error)
@@ -38,6 +40,17 @@ func (c *contextFunctions) CurrentAccount(ctx context.Context) (string, error) {
 	return s.CurrentAccount, nil
 }
 
+func (c *contextFunctions) CurrentAccountName(ctx context.Context) (string, error) {
+	s := &struct {
+		CurrentAccountName string `db:"CURRENT_ACCOUNT_NAME"`
+	}{}
+	err := c.client.queryOne(ctx, s, "SELECT CURRENT_ACCOUNT_NAME() as CURRENT_ACCOUNT_NAME")
+	if err != nil {
+		return "", err
+	}
+	return s.CurrentAccountName, nil
+}
+
 func (c *contextFunctions) CurrentRole(ctx context.Context) (string, error) {
 	s := &struct {
 		CurrentRole string `db:"CURRENT_ROLE"`
@@ -49,6 +62,17 @@ func (c *contextFunctions) CurrentRole(ctx context.Context) (string, error) {
 	return s.CurrentRole, nil
 }
 
+func (c *contextFunctions) CurrentSecondaryRoles(ctx context.Context) (SecondaryRoles, error) {
+	s := &struct {
+		CurrentSecondaryRoles string `db:"CURRENT_SECONDARY_ROLES"`
+	}{}
+	err := c.client.queryOne(ctx, s, "SELECT CURRENT_SECONDARY_ROLES() as CURRENT_SECONDARY_ROLES")
+	if err != nil {
+		return SecondaryRoles{}, err
+	}
+	return parseSecondaryRoles(s.CurrentSecondaryRoles)
+}
+
 func (c *contextFunctions) CurrentRegion(ctx context.Context) (string, error) {
 	s := &struct {
 		CurrentRegion string `db:"CURRENT_REGION"`
@@ -128,7 +152,7 @@ func (c *contextFunctions) IsRoleInSession(ctx context.Context, role AccountObje
 	s := &struct {
 		IsRoleInSession bool `db:"IS_ROLE_IN_SESSION"`
 	}{}
-	sql := fmt.Sprintf("SELECT IS_ROLE_IN_SESSION('%s') AS IS_ROLE_IN_SESSION", role.FullyQualifiedName())
+	sql := fmt.Sprintf("SELECT IS_ROLE_IN_SESSION('%s') AS IS_ROLE_IN_SESSION", escapeStringLiteral(role.FullyQualifiedName()))
 	err := c.client.queryOne(ctx, s, sql)
 	if err != nil {
 		return false, err
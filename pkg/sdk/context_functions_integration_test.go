@@ -17,6 +17,15 @@ func TestInt_CurrentAccount(t *testing.T) {
 	assert.NotEmpty(t, account)
 }
 
+func TestInt_CurrentAccountName(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	accountName, err := client.ContextFunctions.CurrentAccountName(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, accountName)
+}
+
 func TestInt_CurrentRole(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -25,6 +34,20 @@ func TestInt_CurrentRole(t *testing.T) {
 	assert.NotEmpty(t, role)
 }
 
+func TestInt_CurrentSecondaryRoles(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	err := client.Sessions.UseSecondaryRoles(ctx, SecondaryRoles{All: true})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Sessions.UseSecondaryRoles(ctx, SecondaryRoles{})
+		require.NoError(t, err)
+	})
+	secondaryRoles, err := client.ContextFunctions.CurrentSecondaryRoles(ctx)
+	require.NoError(t, err)
+	assert.True(t, secondaryRoles.All)
+}
+
 func TestInt_CurrentRegion(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
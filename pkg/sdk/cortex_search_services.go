@@ -0,0 +1,370 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ CortexSearchServices = (*cortexSearchServices)(nil)
+
+// CortexSearchServices are search indexes over the result of a query, kept up to date within a target lag.
+type CortexSearchServices interface {
+	// Create creates a new Cortex Search service.
+	Create(ctx context.Context, id SchemaObjectIdentifier, on string, warehouse AccountObjectIdentifier, targetLag string, query string, opts *CreateCortexSearchServiceOptions) error
+	// Alter modifies an existing Cortex Search service.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterCortexSearchServiceOptions) error
+	// Drop removes a Cortex Search service.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropCortexSearchServiceOptions) error
+	// Show returns a list of Cortex Search services.
+	Show(ctx context.Context, opts *ShowCortexSearchServiceOptions) ([]*CortexSearchService, error)
+	// ShowByID returns a Cortex Search service by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*CortexSearchService, error)
+	// Describe returns the details of a Cortex Search service.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*CortexSearchServiceDetails, error)
+}
+
+// cortexSearchServices implements CortexSearchServices.
+type cortexSearchServices struct {
+	client *Client
+}
+
+type CreateCortexSearchServiceOptions struct {
+	create              bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace           *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	cortexSearchService bool                   `ddl:"static" sql:"CORTEX SEARCH SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists         *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                SchemaObjectIdentifier `ddl:"identifier"`
+
+	// required
+	on string `ddl:"parameter,no_equals" sql:"ON"`
+
+	// optional, the columns the search results can be filtered on
+	Attributes []string `ddl:"keyword" sql:"ATTRIBUTES"`
+
+	// required
+	warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	targetLag string                  `ddl:"parameter,single_quotes" sql:"TARGET_LAG"`
+
+	// optional
+	EmbeddingModel *string `ddl:"parameter,single_quotes" sql:"EMBEDDING_MODEL"`
+	Comment        *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+
+	// required
+	query string `ddl:"parameter,no_equals" sql:"AS"`
+}
+
+func (opts *CreateCortexSearchServiceOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.on == "" {
+		return errors.New("search column is required")
+	}
+	if !validObjectidentifier(opts.warehouse) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.targetLag == "" {
+		return errors.New("target lag is required")
+	}
+	if opts.query == "" {
+		return errors.New("query is required")
+	}
+	return nil
+}
+
+func (v *cortexSearchServices) Create(ctx context.Context, id SchemaObjectIdentifier, on string, warehouse AccountObjectIdentifier, targetLag string, query string, opts *CreateCortexSearchServiceOptions) error {
+	if opts == nil {
+		opts = &CreateCortexSearchServiceOptions{}
+	}
+	opts.name = id
+	opts.on = on
+	opts.warehouse = warehouse
+	opts.targetLag = targetLag
+	opts.query = query
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterCortexSearchServiceOptions struct {
+	alter               bool                    `ddl:"static" sql:"ALTER"`                 //lint:ignore U1000 This is used in the ddl tag
+	cortexSearchService bool                    `ddl:"static" sql:"CORTEX SEARCH SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists            *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name                SchemaObjectIdentifier  `ddl:"identifier"`
+	Set                 *CortexSearchServiceSet `ddl:"keyword" sql:"SET"`
+}
+
+func (opts *AlterCortexSearchServiceOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.Set) {
+		return errors.New("Set must be set")
+	}
+	if !anyValueSet(opts.Set.Warehouse, opts.Set.TargetLag, opts.Set.Comment) {
+		return errors.New("at least one of Warehouse, TargetLag, Comment must be set")
+	}
+	return nil
+}
+
+type CortexSearchServiceSet struct {
+	Warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	TargetLag *string                 `ddl:"parameter,single_quotes" sql:"TARGET_LAG"`
+	Comment   *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *cortexSearchServices) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterCortexSearchServiceOptions) error {
+	if opts == nil {
+		opts = &AlterCortexSearchServiceOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropCortexSearchServiceOptions struct {
+	drop                bool                   `ddl:"static" sql:"DROP"`                  //lint:ignore U1000 This is used in the ddl tag
+	cortexSearchService bool                   `ddl:"static" sql:"CORTEX SEARCH SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists            *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name                SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropCortexSearchServiceOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *cortexSearchServices) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropCortexSearchServiceOptions) error {
+	if opts == nil {
+		opts = &DropCortexSearchServiceOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowCortexSearchServiceOptions struct {
+	show                 bool `ddl:"static" sql:"SHOW"`                   //lint:ignore U1000 This is used in the ddl tag
+	cortexSearchServices bool `ddl:"static" sql:"CORTEX SEARCH SERVICES"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	In   *In   `ddl:"keyword" sql:"IN"`
+	ShowOptions
+}
+
+func (opts *ShowCortexSearchServiceOptions) validate() error {
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type CortexSearchService struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Comment      string
+}
+
+func (v *CortexSearchService) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *CortexSearchService) ObjectType() ObjectType {
+	return ObjectTypeCortexSearchService
+}
+
+type cortexSearchServiceRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+func (row cortexSearchServiceRow) toCortexSearchService() *CortexSearchService {
+	return &CortexSearchService{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Comment:      row.Comment.String,
+	}
+}
+
+func (v *cortexSearchServices) Show(ctx context.Context, opts *ShowCortexSearchServiceOptions) ([]*CortexSearchService, error) {
+	if opts == nil {
+		opts = &ShowCortexSearchServiceOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []cortexSearchServiceRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*CortexSearchService, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toCortexSearchService()
+	}
+	return resultList, nil
+}
+
+func (v *cortexSearchServices) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*CortexSearchService, error) {
+	services, err := v.Show(ctx, &ShowCortexSearchServiceOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services {
+		if service.Name == id.Name() {
+			return service, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeCortexSearchServiceOptions struct {
+	describe            bool                   `ddl:"static" sql:"DESCRIBE"`              //lint:ignore U1000 This is used in the ddl tag
+	cortexSearchService bool                   `ddl:"static" sql:"CORTEX SEARCH SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	name                SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeCortexSearchServiceOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type CortexSearchServiceState string
+
+const (
+	CortexSearchServiceStateActive    CortexSearchServiceState = "ACTIVE"
+	CortexSearchServiceStateSuspended CortexSearchServiceState = "SUSPENDED"
+)
+
+var allCortexSearchServiceStates = []CortexSearchServiceState{
+	CortexSearchServiceStateActive,
+	CortexSearchServiceStateSuspended,
+}
+
+type CortexSearchServiceDetails struct {
+	CreatedOn         time.Time
+	Name              string
+	DatabaseName      string
+	SchemaName        string
+	TargetLag         string
+	Warehouse         string
+	SearchColumn      string
+	AttributeColumns  []string
+	Columns           []string
+	Definition        string
+	Comment           string
+	ServiceQueryURL   string
+	DataTimestamp     string
+	SourceDataNumRows int
+	IndexingState     CortexSearchServiceState
+	IndexingError     string
+	ServingState      CortexSearchServiceState
+}
+
+type cortexSearchServiceDetailsRow struct {
+	CreatedOn         time.Time      `db:"created_on"`
+	Name              string         `db:"name"`
+	DatabaseName      string         `db:"database_name"`
+	SchemaName        string         `db:"schema_name"`
+	TargetLag         sql.NullString `db:"target_lag"`
+	Warehouse         sql.NullString `db:"warehouse"`
+	SearchColumn      sql.NullString `db:"search_column"`
+	AttributeColumns  sql.NullString `db:"attribute_columns"`
+	Columns           sql.NullString `db:"columns"`
+	Definition        sql.NullString `db:"definition"`
+	Comment           sql.NullString `db:"comment"`
+	ServiceQueryURL   sql.NullString `db:"service_query_url"`
+	DataTimestamp     sql.NullString `db:"data_timestamp"`
+	SourceDataNumRows sql.NullInt64  `db:"source_data_num_rows"`
+	IndexingState     sql.NullString `db:"indexing_state"`
+	IndexingError     sql.NullString `db:"indexing_error"`
+	ServingState      sql.NullString `db:"serving_state"`
+}
+
+func (row cortexSearchServiceDetailsRow) toCortexSearchServiceDetails(strict bool) (*CortexSearchServiceDetails, error) {
+	details := &CortexSearchServiceDetails{
+		CreatedOn:         row.CreatedOn,
+		Name:              row.Name,
+		DatabaseName:      row.DatabaseName,
+		SchemaName:        row.SchemaName,
+		TargetLag:         row.TargetLag.String,
+		Warehouse:         row.Warehouse.String,
+		SearchColumn:      row.SearchColumn.String,
+		AttributeColumns:  splitList(row.AttributeColumns.String),
+		Columns:           splitList(row.Columns.String),
+		Definition:        row.Definition.String,
+		Comment:           row.Comment.String,
+		ServiceQueryURL:   row.ServiceQueryURL.String,
+		DataTimestamp:     row.DataTimestamp.String,
+		SourceDataNumRows: int(row.SourceDataNumRows.Int64),
+		IndexingError:     row.IndexingError.String,
+	}
+	var err error
+	if details.IndexingState, err = toEnum(strict, "indexing state", row.IndexingState.String, allCortexSearchServiceStates); err != nil {
+		return nil, err
+	}
+	if details.ServingState, err = toEnum(strict, "serving state", row.ServingState.String, allCortexSearchServiceStates); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+func (v *cortexSearchServices) Describe(ctx context.Context, id SchemaObjectIdentifier) (*CortexSearchServiceDetails, error) {
+	opts := &describeCortexSearchServiceOptions{
+		name: id,
+	}
+	dest, err := describeRow[cortexSearchServiceDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toCortexSearchServiceDetails(v.client.strictEnumParsing)
+}
@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_CortexSearchServices(t *testing.T) {
+	if os.Getenv("SNOWFLAKE_TEST_CORTEX") == "" {
+		t.Skip("SNOWFLAKE_TEST_CORTEX is not set, Cortex Search is not available in every region")
+	}
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	warehouse, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+
+	table := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE TABLE %s (id INT, description STRING) CHANGE_TRACKING = TRUE", table.FullyQualifiedName()))
+	require.NoError(t, err)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	query := fmt.Sprintf("SELECT id, description FROM %s", table.FullyQualifiedName())
+	err = client.CortexSearchServices.Create(ctx, id, "description", warehouse.ID(), "1 hour", query, &CreateCortexSearchServiceOptions{
+		Attributes: []string{"id"},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.CortexSearchServices.Drop(ctx, id, &DropCortexSearchServiceOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		service, err := client.CortexSearchServices.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, service.ID())
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		details, err := client.CortexSearchServices.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "1 hour", details.TargetLag)
+		assert.Equal(t, "DESCRIPTION", details.SearchColumn)
+		assert.NotEmpty(t, details.ServingState)
+	})
+
+	t.Run("alter: set", func(t *testing.T) {
+		err := client.CortexSearchServices.Alter(ctx, id, &AlterCortexSearchServiceOptions{Set: &CortexSearchServiceSet{TargetLag: String("2 hours"), Comment: String("new comment")}})
+		require.NoError(t, err)
+		details, err := client.CortexSearchServices.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "2 hours", details.TargetLag)
+		assert.Equal(t, "new comment", details.Comment)
+	})
+}
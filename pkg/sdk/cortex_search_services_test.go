@@ -0,0 +1,113 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCortexSearchServiceCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "mysearch")
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateCortexSearchServiceOptions{
+			OrReplace:  Bool(true),
+			name:       id,
+			on:         "transcript",
+			Attributes: []string{"region", "agent_id"},
+			warehouse:  NewAccountObjectIdentifier("wh"),
+			targetLag:  "1 hour",
+			Comment:    String("some comment"),
+			query:      "SELECT transcript, region, agent_id FROM support_transcripts",
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE CORTEX SEARCH SERVICE "db"."schema"."mysearch" ON transcript ATTRIBUTES region, agent_id WAREHOUSE = "wh" TARGET_LAG = '1 hour' COMMENT = 'some comment' AS SELECT transcript, region, agent_id FROM support_transcripts`, actual)
+	})
+
+	t.Run("validation: missing warehouse", func(t *testing.T) {
+		opts := &CreateCortexSearchServiceOptions{
+			name:      id,
+			on:        "transcript",
+			targetLag: "1 hour",
+			query:     "SELECT transcript FROM support_transcripts",
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestCortexSearchServiceAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "mysearch")
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterCortexSearchServiceOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &CortexSearchServiceSet{
+				Warehouse: NewAccountObjectIdentifier("wh"),
+				TargetLag: String("2 hours"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER CORTEX SEARCH SERVICE IF EXISTS "db"."schema"."mysearch" SET WAREHOUSE = "wh" TARGET_LAG = '2 hours'`, actual)
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterCortexSearchServiceOptions{
+			name: id,
+			Set:  &CortexSearchServiceSet{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestCortexSearchServiceDrop(t *testing.T) {
+	opts := &DropCortexSearchServiceOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "mysearch"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP CORTEX SEARCH SERVICE IF EXISTS "db"."schema"."mysearch"`, actual)
+}
+
+func TestCortexSearchServiceShow(t *testing.T) {
+	opts := &ShowCortexSearchServiceOptions{
+		Like: &Like{Pattern: String("mysearch")},
+		In:   &In{Schema: NewSchemaIdentifier("db", "schema")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW CORTEX SEARCH SERVICES LIKE 'mysearch' IN SCHEMA "db"."schema"`, actual)
+}
+
+func TestCortexSearchServiceDescribe(t *testing.T) {
+	opts := &describeCortexSearchServiceOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "mysearch"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE CORTEX SEARCH SERVICE "db"."schema"."mysearch"`, actual)
+
+	t.Run("serving state", func(t *testing.T) {
+		row := cortexSearchServiceDetailsRow{
+			AttributeColumns: sql.NullString{String: "REGION,AGENT_ID", Valid: true},
+			IndexingState:    sql.NullString{String: "ACTIVE", Valid: true},
+			ServingState:     sql.NullString{String: "SUSPENDED", Valid: true},
+		}
+		details, err := row.toCortexSearchServiceDetails(true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"REGION", "AGENT_ID"}, details.AttributeColumns)
+		assert.Equal(t, CortexSearchServiceStateActive, details.IndexingState)
+		assert.Equal(t, CortexSearchServiceStateSuspended, details.ServingState)
+	})
+
+	t.Run("unknown serving state", func(t *testing.T) {
+		row := cortexSearchServiceDetailsRow{ServingState: sql.NullString{String: "PAUSED", Valid: true}}
+		_, err := row.toCortexSearchServiceDetails(true)
+		assert.ErrorIs(t, err, ErrUnknownEnumValue)
+	})
+}
@@ -0,0 +1,176 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ DataExchanges = (*dataExchanges)(nil)
+
+// DataExchanges describes the data exchange related methods that the Snowflake API supports.
+// Data exchanges are set up by Snowflake, so the SDK only reads them and the provider profiles published in them.
+type DataExchanges interface {
+	// Show returns a list of data exchanges.
+	Show(ctx context.Context, opts *ShowDataExchangeOptions) ([]*DataExchange, error)
+	// ShowByID returns a data exchange by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*DataExchange, error)
+	// ShowProfiles returns the provider profiles in a data exchange.
+	ShowProfiles(ctx context.Context, id AccountObjectIdentifier, opts *ShowDataExchangeProfileOptions) ([]*DataExchangeProfile, error)
+}
+
+// dataExchanges implements DataExchanges.
+type dataExchanges struct {
+	client *Client
+}
+
+// ShowDataExchangeOptions represents the options for listing data exchanges.
+type ShowDataExchangeOptions struct {
+	show          bool  `ddl:"static" sql:"SHOW"`           //lint:ignore U1000 This is used in the ddl tag
+	dataExchanges bool  `ddl:"static" sql:"DATA EXCHANGES"` //lint:ignore U1000 This is used in the ddl tag
+	Like          *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowDataExchangeOptions) validate() error {
+	return nil
+}
+
+type DataExchange struct {
+	CreatedOn   time.Time
+	Name        string
+	Description string
+	Owner       string
+	Comment     string
+}
+
+func (v *DataExchange) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+type dataExchangeRow struct {
+	CreatedOn   time.Time      `db:"created_on"`
+	Name        string         `db:"name"`
+	Description sql.NullString `db:"description"`
+	Owner       sql.NullString `db:"owner"`
+	Comment     sql.NullString `db:"comment"`
+}
+
+func (row *dataExchangeRow) toDataExchange() *DataExchange {
+	return &DataExchange{
+		CreatedOn:   row.CreatedOn,
+		Name:        row.Name,
+		Description: row.Description.String,
+		Owner:       row.Owner.String,
+		Comment:     row.Comment.String,
+	}
+}
+
+func (v *dataExchanges) Show(ctx context.Context, opts *ShowDataExchangeOptions) ([]*DataExchange, error) {
+	if opts == nil {
+		opts = &ShowDataExchangeOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []dataExchangeRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*DataExchange, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toDataExchange()
+	}
+	return resultList, nil
+}
+
+func (v *dataExchanges) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*DataExchange, error) {
+	exchanges, err := v.Show(ctx, &ShowDataExchangeOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, exchange := range exchanges {
+		if exchange.Name == id.Name() {
+			return exchange, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// ShowDataExchangeProfileOptions represents the options for listing the profiles in a data exchange.
+type ShowDataExchangeProfileOptions struct {
+	show     bool                    `ddl:"static" sql:"SHOW"`                      //lint:ignore U1000 This is used in the ddl tag
+	profiles bool                    `ddl:"static" sql:"PROFILES IN DATA EXCHANGE"` //lint:ignore U1000 This is used in the ddl tag
+	name     AccountObjectIdentifier `ddl:"identifier"`
+	Like     *Like                   `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowDataExchangeProfileOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type DataExchangeProfile struct {
+	CreatedOn   time.Time
+	Name        string
+	Title       string
+	Description string
+	Contact     string
+	Owner       string
+	Comment     string
+}
+
+type dataExchangeProfileRow struct {
+	CreatedOn   time.Time      `db:"created_on"`
+	Name        string         `db:"name"`
+	Title       sql.NullString `db:"title"`
+	Description sql.NullString `db:"description"`
+	Contact     sql.NullString `db:"contact"`
+	Owner       sql.NullString `db:"owner"`
+	Comment     sql.NullString `db:"comment"`
+}
+
+func (row *dataExchangeProfileRow) toDataExchangeProfile() *DataExchangeProfile {
+	return &DataExchangeProfile{
+		CreatedOn:   row.CreatedOn,
+		Name:        row.Name,
+		Title:       row.Title.String,
+		Description: row.Description.String,
+		Contact:     row.Contact.String,
+		Owner:       row.Owner.String,
+		Comment:     row.Comment.String,
+	}
+}
+
+func (v *dataExchanges) ShowProfiles(ctx context.Context, id AccountObjectIdentifier, opts *ShowDataExchangeProfileOptions) ([]*DataExchangeProfile, error) {
+	if opts == nil {
+		opts = &ShowDataExchangeProfileOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []dataExchangeProfileRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*DataExchangeProfile, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toDataExchangeProfile()
+	}
+	return resultList, nil
+}
@@ -0,0 +1,35 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_DataExchanges(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	exchanges, err := client.DataExchanges.Show(ctx, nil)
+	require.NoError(t, err)
+	if len(exchanges) == 0 {
+		t.Skip("no data exchange is available in the test account")
+	}
+
+	t.Run("show by id", func(t *testing.T) {
+		exchange, err := client.DataExchanges.ShowByID(ctx, exchanges[0].ID())
+		require.NoError(t, err)
+		require.Equal(t, exchanges[0].Name, exchange.Name)
+	})
+
+	t.Run("show profiles", func(t *testing.T) {
+		_, err := client.DataExchanges.ShowProfiles(ctx, exchanges[0].ID(), nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id: not existing", func(t *testing.T) {
+		_, err := client.DataExchanges.ShowByID(ctx, randomAccountObjectIdentifier(t))
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
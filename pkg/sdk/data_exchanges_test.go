@@ -0,0 +1,42 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataExchangesShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&ShowDataExchangeOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW DATA EXCHANGES`, actual)
+	})
+
+	t.Run("like", func(t *testing.T) {
+		actual, err := structToSQL(&ShowDataExchangeOptions{Like: &Like{Pattern: String("exchange%")}})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW DATA EXCHANGES LIKE 'exchange%'`, actual)
+	})
+}
+
+func TestDataExchangesShowProfiles(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("with like", func(t *testing.T) {
+		opts := &ShowDataExchangeProfileOptions{
+			name: id,
+			Like: &Like{Pattern: String("profile%")},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW PROFILES IN DATA EXCHANGE `+id.FullyQualifiedName()+` LIKE 'profile%'`, actual)
+	})
+
+	t.Run("validation: no data exchange", func(t *testing.T) {
+		opts := &ShowDataExchangeProfileOptions{}
+		require.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
@@ -0,0 +1,302 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Compile-time proof of interface implementation.
+var _ DataMetricFunctions = (*dataMetricFunctions)(nil)
+
+// DataMetricFunctions measure the quality of the data in a table, e.g. the number of duplicate or null values,
+// on a schedule set on the table.
+type DataMetricFunctions interface {
+	// Create creates a new data metric function. The expression must return a single number.
+	Create(ctx context.Context, id SchemaObjectIdentifier, argument DataMetricFunctionArgument, expression string, opts *CreateDataMetricFunctionOptions) error
+	// Drop removes a data metric function. The column types identify the function, as function names can be overloaded.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, columnTypes []DataType, opts *DropDataMetricFunctionOptions) error
+	// AddToTable associates data metric functions with columns of a table.
+	AddToTable(ctx context.Context, table SchemaObjectIdentifier, associations []DataMetricFunctionAssociation) error
+	// DropFromTable removes the association of data metric functions with columns of a table.
+	DropFromTable(ctx context.Context, table SchemaObjectIdentifier, associations []DataMetricFunctionAssociation) error
+	// SetSchedule sets how often the data metric functions associated with a table run,
+	// e.g. '5 MINUTE', 'USING CRON 0 8 * * * UTC' or 'TRIGGER_ON_CHANGES'.
+	SetSchedule(ctx context.Context, table SchemaObjectIdentifier, schedule string) error
+	// UnsetSchedule stops the data metric functions associated with a table from running.
+	UnsetSchedule(ctx context.Context, table SchemaObjectIdentifier) error
+	// References returns the data metric functions associated with a table.
+	References(ctx context.Context, table SchemaObjectIdentifier) ([]*DataMetricFunctionReference, error)
+}
+
+// dataMetricFunctions implements DataMetricFunctions.
+type dataMetricFunctions struct {
+	client *Client
+}
+
+// DataMetricFunctionArgument is the table argument of a data metric function, e.g. ARG_T TABLE(ARG_C NUMBER).
+type DataMetricFunctionArgument struct {
+	Name    string
+	Columns []TableColumnSignature
+}
+
+func (a DataMetricFunctionArgument) validate() error {
+	if a.Name == "" {
+		return errors.New("argument name is required")
+	}
+	if len(a.Columns) == 0 {
+		return errors.New("at least one argument column is required")
+	}
+	return nil
+}
+
+// String renders the argument, e.g. (ARG_T TABLE(ARG_C NUMBER)). The names are not quoted,
+// so that the expression can refer to them without quotes.
+func (a DataMetricFunctionArgument) String() string {
+	columns := make([]string, len(a.Columns))
+	for i, c := range a.Columns {
+		columns[i] = fmt.Sprintf("%s %s", c.Name, c.Type)
+	}
+	return fmt.Sprintf("(%s TABLE(%s))", a.Name, strings.Join(columns, ", "))
+}
+
+type CreateDataMetricFunctionOptions struct {
+	create             bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace          *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	Secure             *bool                  `ddl:"keyword" sql:"SECURE"`
+	dataMetricFunction bool                   `ddl:"static" sql:"DATA METRIC FUNCTION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists        *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name               SchemaObjectIdentifier `ddl:"identifier"`
+	argument           string                 `ddl:"keyword"`
+	returns            bool                   `ddl:"static" sql:"RETURNS NUMBER"` //lint:ignore U1000 This is used in the ddl tag
+	NotNull            *bool                  `ddl:"keyword" sql:"NOT NULL"`
+	Comment            *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	expression         string                 `ddl:"parameter,single_quotes,no_equals" sql:"AS"`
+}
+
+func (opts *CreateDataMetricFunctionOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.expression == "" {
+		return errors.New("expression is required")
+	}
+	return nil
+}
+
+func (v *dataMetricFunctions) Create(ctx context.Context, id SchemaObjectIdentifier, argument DataMetricFunctionArgument, expression string, opts *CreateDataMetricFunctionOptions) error {
+	if opts == nil {
+		opts = &CreateDataMetricFunctionOptions{}
+	}
+	if err := argument.validate(); err != nil {
+		return err
+	}
+	opts.name = id
+	opts.argument = argument.String()
+	opts.expression = expression
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropDataMetricFunctionOptions struct {
+	drop          bool                   `ddl:"static" sql:"DROP FUNCTION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists      *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name          SchemaObjectIdentifier `ddl:"identifier"`
+	argumentTypes string                 `ddl:"keyword"`
+}
+
+func (opts *DropDataMetricFunctionOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *dataMetricFunctions) Drop(ctx context.Context, id SchemaObjectIdentifier, columnTypes []DataType, opts *DropDataMetricFunctionOptions) error {
+	if opts == nil {
+		opts = &DropDataMetricFunctionOptions{}
+	}
+	if len(columnTypes) == 0 {
+		return errors.New("at least one column type is required")
+	}
+	types := make([]string, len(columnTypes))
+	for i, t := range columnTypes {
+		types[i] = string(t)
+	}
+	opts.name = id
+	opts.argumentTypes = fmt.Sprintf("(TABLE(%s))", strings.Join(types, ", "))
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DataMetricFunctionAssociation is a data metric function with the table columns it is evaluated on.
+// System data metric functions live in the SNOWFLAKE.CORE schema, e.g. SNOWFLAKE.CORE.NULL_COUNT.
+type DataMetricFunctionAssociation struct {
+	DataMetricFunction SchemaObjectIdentifier `ddl:"identifier"`
+	On                 []string               `ddl:"keyword,parentheses" sql:"ON"`
+}
+
+type alterTableDataMetricFunctionsOptions struct {
+	alterTable bool                   `ddl:"static" sql:"ALTER TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	table      SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	add           []DataMetricFunctionAssociation `ddl:"keyword" sql:"ADD DATA METRIC FUNCTION"`
+	drop          []DataMetricFunctionAssociation `ddl:"keyword" sql:"DROP DATA METRIC FUNCTION"`
+	setSchedule   *string                         `ddl:"parameter,single_quotes" sql:"SET DATA_METRIC_SCHEDULE"`
+	unsetSchedule *bool                           `ddl:"keyword" sql:"UNSET DATA_METRIC_SCHEDULE"`
+}
+
+func (opts *alterTableDataMetricFunctionsOptions) validate() error {
+	if !validObjectidentifier(opts.table) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.add, opts.drop, opts.setSchedule, opts.unsetSchedule) {
+		return errors.New("exactly one of add, drop, setSchedule, unsetSchedule must be set")
+	}
+	for _, association := range append(opts.add, opts.drop...) {
+		if !validObjectidentifier(association.DataMetricFunction) {
+			return ErrInvalidObjectIdentifier
+		}
+		if len(association.On) == 0 {
+			return errors.New("at least one column is required for each data metric function")
+		}
+	}
+	return nil
+}
+
+func (v *dataMetricFunctions) alterTable(ctx context.Context, opts *alterTableDataMetricFunctionsOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *dataMetricFunctions) AddToTable(ctx context.Context, table SchemaObjectIdentifier, associations []DataMetricFunctionAssociation) error {
+	return v.alterTable(ctx, &alterTableDataMetricFunctionsOptions{table: table, add: associations})
+}
+
+func (v *dataMetricFunctions) DropFromTable(ctx context.Context, table SchemaObjectIdentifier, associations []DataMetricFunctionAssociation) error {
+	return v.alterTable(ctx, &alterTableDataMetricFunctionsOptions{table: table, drop: associations})
+}
+
+func (v *dataMetricFunctions) SetSchedule(ctx context.Context, table SchemaObjectIdentifier, schedule string) error {
+	if schedule == "" {
+		return errors.New("schedule is required")
+	}
+	return v.alterTable(ctx, &alterTableDataMetricFunctionsOptions{table: table, setSchedule: String(schedule)})
+}
+
+func (v *dataMetricFunctions) UnsetSchedule(ctx context.Context, table SchemaObjectIdentifier) error {
+	return v.alterTable(ctx, &alterTableDataMetricFunctionsOptions{table: table, unsetSchedule: Bool(true)})
+}
+
+type DataMetricFunctionReference struct {
+	DataMetricFunction SchemaObjectIdentifier
+	ArgumentSignature  string
+	DataType           string
+	RefEntity          SchemaObjectIdentifier
+	RefEntityDomain    string
+	// Columns are the names of the columns the data metric function is evaluated on.
+	Columns        []string
+	RefID          string
+	Schedule       string
+	ScheduleStatus string
+}
+
+type dataMetricFunctionReferenceRow struct {
+	MetricDatabaseName    string         `db:"METRIC_DATABASE_NAME"`
+	MetricSchemaName      string         `db:"METRIC_SCHEMA_NAME"`
+	MetricName            string         `db:"METRIC_NAME"`
+	ArgumentSignature     sql.NullString `db:"ARGUMENT_SIGNATURE"`
+	DataType              sql.NullString `db:"DATA_TYPE"`
+	RefEntityDatabaseName string         `db:"REF_ENTITY_DATABASE_NAME"`
+	RefEntitySchemaName   string         `db:"REF_ENTITY_SCHEMA_NAME"`
+	RefEntityName         string         `db:"REF_ENTITY_NAME"`
+	RefEntityDomain       sql.NullString `db:"REF_ENTITY_DOMAIN"`
+	RefArguments          sql.NullString `db:"REF_ARGUMENTS"`
+	RefID                 sql.NullString `db:"REF_ID"`
+	Schedule              sql.NullString `db:"SCHEDULE"`
+	ScheduleStatus        sql.NullString `db:"SCHEDULE_STATUS"`
+}
+
+// parseRefArguments reads the column names from REF_ARGUMENTS, e.g. [{"domain":"COLUMN","id":2,"name":"EMAIL"}].
+func parseRefArguments(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	var arguments []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(raw), &arguments); err != nil {
+		return nil
+	}
+	columns := make([]string, len(arguments))
+	for i, argument := range arguments {
+		columns[i] = argument.Name
+	}
+	return columns
+}
+
+func (row dataMetricFunctionReferenceRow) toDataMetricFunctionReference() *DataMetricFunctionReference {
+	return &DataMetricFunctionReference{
+		DataMetricFunction: NewSchemaObjectIdentifier(row.MetricDatabaseName, row.MetricSchemaName, row.MetricName),
+		ArgumentSignature:  row.ArgumentSignature.String,
+		DataType:           row.DataType.String,
+		RefEntity:          NewSchemaObjectIdentifier(row.RefEntityDatabaseName, row.RefEntitySchemaName, row.RefEntityName),
+		RefEntityDomain:    row.RefEntityDomain.String,
+		Columns:            parseRefArguments(row.RefArguments.String),
+		RefID:              row.RefID.String,
+		Schedule:           row.Schedule.String,
+		ScheduleStatus:     row.ScheduleStatus.String,
+	}
+}
+
+// dataMetricFunctionReferencesSQL builds the query for the data metric functions associated with a table.
+// The table function lives in the information schema of the database of the table.
+func dataMetricFunctionReferencesSQL(table SchemaObjectIdentifier) string {
+	database := NewAccountObjectIdentifier(table.DatabaseName())
+	refEntityName := escapeStringLiteral(table.FullyQualifiedName())
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s.INFORMATION_SCHEMA.DATA_METRIC_FUNCTION_REFERENCES(REF_ENTITY_NAME => '%s', REF_ENTITY_DOMAIN => 'TABLE'))`, database.FullyQualifiedName(), refEntityName)
+}
+
+func (v *dataMetricFunctions) References(ctx context.Context, table SchemaObjectIdentifier) ([]*DataMetricFunctionReference, error) {
+	if !validObjectidentifier(table) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	dest := []dataMetricFunctionReferenceRow{}
+	err := v.client.query(ctx, &dest, dataMetricFunctionReferencesSQL(table))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*DataMetricFunctionReference, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toDataMetricFunctionReference()
+	}
+	return resultList, nil
+}
@@ -0,0 +1,59 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_DataMetricFunctions(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	table, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	argument := DataMetricFunctionArgument{
+		Name:    "ARG_T",
+		Columns: []TableColumnSignature{{Name: "ARG_C", Type: DataTypeNumber}},
+	}
+	err := client.DataMetricFunctions.Create(ctx, id, argument, "SELECT COUNT(*) FROM ARG_T WHERE ARG_C < 0", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.DataMetricFunctions.Drop(ctx, id, []DataType{DataTypeNumber}, &DropDataMetricFunctionOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	// The schedule must be set before data metric functions can be associated with the table.
+	err = client.DataMetricFunctions.SetSchedule(ctx, table, "TRIGGER_ON_CHANGES")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.DataMetricFunctions.UnsetSchedule(ctx, table)
+		require.NoError(t, err)
+	})
+
+	associations := []DataMetricFunctionAssociation{{DataMetricFunction: id, On: []string{"ID"}}}
+	err = client.DataMetricFunctions.AddToTable(ctx, table, associations)
+	require.NoError(t, err)
+
+	references, err := client.DataMetricFunctions.References(ctx, table)
+	require.NoError(t, err)
+	require.Len(t, references, 1)
+	assert.Equal(t, id, references[0].DataMetricFunction)
+	assert.Equal(t, table, references[0].RefEntity)
+	assert.Equal(t, []string{"ID"}, references[0].Columns)
+	assert.Equal(t, "TRIGGER_ON_CHANGES", references[0].Schedule)
+
+	err = client.DataMetricFunctions.DropFromTable(ctx, table, associations)
+	require.NoError(t, err)
+	references, err = client.DataMetricFunctions.References(ctx, table)
+	require.NoError(t, err)
+	assert.Empty(t, references)
+}
@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataMetricFunctionCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "dmf")
+	argument := DataMetricFunctionArgument{
+		Name:    "ARG_T",
+		Columns: []TableColumnSignature{{Name: "ARG_C1", Type: DataTypeNumber}, {Name: "ARG_C2", Type: DataTypeVARCHAR}},
+	}
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateDataMetricFunctionOptions{
+			OrReplace:  Bool(true),
+			Secure:     Bool(true),
+			name:       id,
+			argument:   argument.String(),
+			NotNull:    Bool(true),
+			Comment:    String("some comment"),
+			expression: "SELECT COUNT(*) FROM ARG_T WHERE ARG_C1 < 0 AND ARG_C2 = 'x'",
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE SECURE DATA METRIC FUNCTION "db"."schema"."dmf" (ARG_T TABLE(ARG_C1 NUMBER, ARG_C2 VARCHAR)) RETURNS NUMBER NOT NULL COMMENT = 'some comment' AS 'SELECT COUNT(*) FROM ARG_T WHERE ARG_C1 < 0 AND ARG_C2 = \'x\''`, actual)
+	})
+
+	t.Run("validation: no argument columns", func(t *testing.T) {
+		assert.Error(t, DataMetricFunctionArgument{Name: "ARG_T"}.validate())
+	})
+
+	t.Run("validation: no expression", func(t *testing.T) {
+		opts := &CreateDataMetricFunctionOptions{name: id, argument: argument.String()}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestDataMetricFunctionDrop(t *testing.T) {
+	opts := &DropDataMetricFunctionOptions{
+		IfExists:      Bool(true),
+		name:          NewSchemaObjectIdentifier("db", "schema", "dmf"),
+		argumentTypes: "(TABLE(NUMBER))",
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP FUNCTION IF EXISTS "db"."schema"."dmf" (TABLE(NUMBER))`, actual)
+}
+
+func TestDataMetricFunctionAlterTable(t *testing.T) {
+	table := NewSchemaObjectIdentifier("db", "schema", "table")
+	associations := []DataMetricFunctionAssociation{
+		{DataMetricFunction: NewSchemaObjectIdentifier("db", "schema", "dmf"), On: []string{"A", "B"}},
+		{DataMetricFunction: NewSchemaObjectIdentifier("SNOWFLAKE", "CORE", "NULL_COUNT"), On: []string{"C"}},
+	}
+
+	t.Run("add", func(t *testing.T) {
+		opts := &alterTableDataMetricFunctionsOptions{table: table, add: associations}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" ADD DATA METRIC FUNCTION "db"."schema"."dmf" ON (A, B), "SNOWFLAKE"."CORE"."NULL_COUNT" ON (C)`, actual)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		opts := &alterTableDataMetricFunctionsOptions{table: table, drop: associations[1:]}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" DROP DATA METRIC FUNCTION "SNOWFLAKE"."CORE"."NULL_COUNT" ON (C)`, actual)
+	})
+
+	t.Run("set schedule", func(t *testing.T) {
+		opts := &alterTableDataMetricFunctionsOptions{table: table, setSchedule: String("USING CRON 0 8 * * * UTC")}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" SET DATA_METRIC_SCHEDULE = 'USING CRON 0 8 * * * UTC'`, actual)
+	})
+
+	t.Run("unset schedule", func(t *testing.T) {
+		opts := &alterTableDataMetricFunctionsOptions{table: table, unsetSchedule: Bool(true)}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" UNSET DATA_METRIC_SCHEDULE`, actual)
+	})
+
+	t.Run("validation: association without columns", func(t *testing.T) {
+		opts := &alterTableDataMetricFunctionsOptions{
+			table: table,
+			add:   []DataMetricFunctionAssociation{{DataMetricFunction: NewSchemaObjectIdentifier("db", "schema", "dmf")}},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestDataMetricFunctionReferences(t *testing.T) {
+	t.Run("sql", func(t *testing.T) {
+		actual := dataMetricFunctionReferencesSQL(NewSchemaObjectIdentifier("db", "schema", "table"))
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.DATA_METRIC_FUNCTION_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."table"', REF_ENTITY_DOMAIN => 'TABLE'))`, actual)
+	})
+
+	t.Run("ref arguments", func(t *testing.T) {
+		assert.Equal(t, []string{"A", "B"}, parseRefArguments(`[{"domain":"COLUMN","id":1,"name":"A"},{"domain":"COLUMN","id":2,"name":"B"}]`))
+		assert.Empty(t, parseRefArguments(""))
+		assert.Empty(t, parseRefArguments("not json"))
+	})
+}
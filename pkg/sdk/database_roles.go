@@ -0,0 +1,404 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type DatabaseRoles interface {
+	// Create creates a database role.
+	Create(ctx context.Context, id DatabaseObjectIdentifier, opts *CreateDatabaseRoleOptions) error
+	// Alter modifies an existing database role.
+	Alter(ctx context.Context, id DatabaseObjectIdentifier, opts *AlterDatabaseRoleOptions) error
+	// Drop removes a database role.
+	Drop(ctx context.Context, id DatabaseObjectIdentifier, opts *DropDatabaseRoleOptions) error
+	// Show returns a list of database roles in a database.
+	Show(ctx context.Context, database AccountObjectIdentifier, opts *ShowDatabaseRoleOptions) ([]*DatabaseRole, error)
+	// ShowByID returns a database role by ID.
+	ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*DatabaseRole, error)
+	// Grant grants a database role to an account role or to another database role.
+	Grant(ctx context.Context, id DatabaseObjectIdentifier, opts *GrantDatabaseRoleOptions) error
+	// Revoke revokes a database role from an account role or from another database role.
+	Revoke(ctx context.Context, id DatabaseObjectIdentifier, opts *RevokeDatabaseRoleOptions) error
+	// GrantToShare grants a database role to a share.
+	GrantToShare(ctx context.Context, id DatabaseObjectIdentifier, share AccountObjectIdentifier) error
+	// RevokeFromShare revokes a database role from a share.
+	RevokeFromShare(ctx context.Context, id DatabaseObjectIdentifier, share AccountObjectIdentifier) error
+}
+
+// Compile-time proof of interface implementation.
+var _ DatabaseRoles = (*databaseRoles)(nil)
+
+type databaseRoles struct {
+	client *Client
+}
+
+type DatabaseRole struct {
+	CreatedOn              time.Time
+	DatabaseName           string
+	Name                   string
+	IsDefault              bool
+	IsCurrent              bool
+	IsInherited            bool
+	GrantedToRoles         int
+	GrantedToDatabaseRoles int
+	GrantedDatabaseRoles   int
+	Owner                  string
+	Comment                string
+	OwnerRoleType          string
+}
+
+func (v *DatabaseRole) ID() DatabaseObjectIdentifier {
+	return NewDatabaseObjectIdentifier(v.DatabaseName, v.Name)
+}
+
+func (v *DatabaseRole) ObjectType() ObjectType {
+	return ObjectTypeDatabaseRole
+}
+
+type databaseRoleDBRow struct {
+	CreatedOn              time.Time      `db:"created_on"`
+	Name                   string         `db:"name"`
+	IsDefault              sql.NullString `db:"is_default"`
+	IsCurrent              sql.NullString `db:"is_current"`
+	IsInherited            sql.NullString `db:"is_inherited"`
+	GrantedToRoles         sql.NullInt64  `db:"granted_to_roles"`
+	GrantedToDatabaseRoles sql.NullInt64  `db:"granted_to_database_roles"`
+	GrantedDatabaseRoles   sql.NullInt64  `db:"granted_database_roles"`
+	Owner                  sql.NullString `db:"owner"`
+	Comment                sql.NullString `db:"comment"`
+	OwnerRoleType          sql.NullString `db:"owner_role_type"`
+}
+
+// toDatabaseRole converts a row of SHOW DATABASE ROLES, which does not contain the database name.
+func (row databaseRoleDBRow) toDatabaseRole(database AccountObjectIdentifier) *DatabaseRole {
+	return &DatabaseRole{
+		CreatedOn:              row.CreatedOn,
+		DatabaseName:           database.Name(),
+		Name:                   row.Name,
+		IsDefault:              row.IsDefault.String == "Y",
+		IsCurrent:              row.IsCurrent.String == "Y",
+		IsInherited:            row.IsInherited.String == "Y",
+		GrantedToRoles:         int(row.GrantedToRoles.Int64),
+		GrantedToDatabaseRoles: int(row.GrantedToDatabaseRoles.Int64),
+		GrantedDatabaseRoles:   int(row.GrantedDatabaseRoles.Int64),
+		Owner:                  row.Owner.String,
+		Comment:                row.Comment.String,
+		OwnerRoleType:          row.OwnerRoleType.String,
+	}
+}
+
+// CreateDatabaseRoleOptions contains options for creating a database role.
+type CreateDatabaseRoleOptions struct {
+	create       bool                     `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace    *bool                    `ddl:"keyword" sql:"OR REPLACE"`
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists  *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) || opts.name.DatabaseName() == "" {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	return nil
+}
+
+func (v *databaseRoles) Create(ctx context.Context, id DatabaseObjectIdentifier, opts *CreateDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &CreateDatabaseRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterDatabaseRoleOptions contains options for altering a database role.
+type AlterDatabaseRoleOptions struct {
+	alter        bool                     `ddl:"static" sql:"ALTER"`         //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists     *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	// NewName must be in the same database as the renamed role.
+	NewName DatabaseObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set     *DatabaseRoleSet         `ddl:"keyword" sql:"SET"`
+	Unset   *DatabaseRoleUnset       `ddl:"keyword" sql:"UNSET"`
+}
+
+func (opts *AlterDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) || opts.name.DatabaseName() == "" {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Unset) {
+		return errors.New("exactly one of NewName, Set, Unset must be set")
+	}
+	if valueSet(opts.NewName) && opts.NewName.DatabaseName() != opts.name.DatabaseName() {
+		return errors.New("database role can only be renamed within the same database")
+	}
+	if valueSet(opts.Set) && !valueSet(opts.Set.Comment) {
+		return errors.New("Comment must be set")
+	}
+	if valueSet(opts.Unset) && !valueSet(opts.Unset.Comment) {
+		return errors.New("Comment must be set")
+	}
+	return nil
+}
+
+type DatabaseRoleSet struct {
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type DatabaseRoleUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *databaseRoles) Alter(ctx context.Context, id DatabaseObjectIdentifier, opts *AlterDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &AlterDatabaseRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropDatabaseRoleOptions contains options for dropping a database role.
+type DropDatabaseRoleOptions struct {
+	drop         bool                     `ddl:"static" sql:"DROP"`          //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists     *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) || opts.name.DatabaseName() == "" {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *databaseRoles) Drop(ctx context.Context, id DatabaseObjectIdentifier, opts *DropDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &DropDatabaseRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowDatabaseRoleOptions contains options for listing database roles.
+type ShowDatabaseRoleOptions struct {
+	show     bool                    `ddl:"static" sql:"SHOW DATABASE ROLES"` //lint:ignore U1000 This is used in the ddl tag
+	Like     *Like                   `ddl:"keyword" sql:"LIKE"`
+	in       bool                    `ddl:"static" sql:"IN DATABASE"` //lint:ignore U1000 This is used in the ddl tag
+	database AccountObjectIdentifier `ddl:"identifier"`
+	Limit    *LimitFrom              `ddl:"keyword" sql:"LIMIT"`
+}
+
+func (opts *ShowDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.database) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *databaseRoles) Show(ctx context.Context, database AccountObjectIdentifier, opts *ShowDatabaseRoleOptions) ([]*DatabaseRole, error) {
+	if opts == nil {
+		opts = &ShowDatabaseRoleOptions{}
+	}
+	opts.database = database
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []databaseRoleDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*DatabaseRole, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toDatabaseRole(database)
+	}
+	return resultList, nil
+}
+
+func (v *databaseRoles) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*DatabaseRole, error) {
+	databaseRoles, err := v.Show(ctx, NewAccountObjectIdentifier(id.DatabaseName()), &ShowDatabaseRoleOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, databaseRole := range databaseRoles {
+		if databaseRole.Name == id.Name() {
+			return databaseRole, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// DatabaseRoleGrantee is the account role or the database role a database role is granted to or revoked from.
+type DatabaseRoleGrantee struct {
+	AccountRole AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
+	// DatabaseRole must be in the same database as the granted role.
+	DatabaseRole DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+}
+
+func (v *DatabaseRoleGrantee) validate() error {
+	if !exactlyOneValueSet(v.AccountRole, v.DatabaseRole) {
+		return errors.New("exactly one of AccountRole, DatabaseRole must be set")
+	}
+	return nil
+}
+
+// GrantDatabaseRoleOptions contains options for granting a database role.
+type GrantDatabaseRoleOptions struct {
+	grant        bool                     `ddl:"static" sql:"GRANT"`         //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	To           DatabaseRoleGrantee      `ddl:"keyword" sql:"TO"`
+}
+
+func (opts *GrantDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) || opts.name.DatabaseName() == "" {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.To.validate()
+}
+
+func (v *databaseRoles) Grant(ctx context.Context, id DatabaseObjectIdentifier, opts *GrantDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &GrantDatabaseRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// RevokeDatabaseRoleOptions contains options for revoking a database role.
+type RevokeDatabaseRoleOptions struct {
+	revoke       bool                     `ddl:"static" sql:"REVOKE"`        //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	From         DatabaseRoleGrantee      `ddl:"keyword" sql:"FROM"`
+}
+
+func (opts *RevokeDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) || opts.name.DatabaseName() == "" {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.From.validate()
+}
+
+func (v *databaseRoles) Revoke(ctx context.Context, id DatabaseObjectIdentifier, opts *RevokeDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &RevokeDatabaseRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type grantDatabaseRoleToShareOptions struct {
+	grant        bool                     `ddl:"static" sql:"GRANT"`         //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	share        AccountObjectIdentifier  `ddl:"identifier" sql:"TO SHARE"`
+}
+
+func (opts *grantDatabaseRoleToShareOptions) validate() error {
+	if !validObjectidentifier(opts.name) || opts.name.DatabaseName() == "" || !validObjectidentifier(opts.share) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *databaseRoles) GrantToShare(ctx context.Context, id DatabaseObjectIdentifier, share AccountObjectIdentifier) error {
+	opts := &grantDatabaseRoleToShareOptions{
+		name:  id,
+		share: share,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type revokeDatabaseRoleFromShareOptions struct {
+	revoke       bool                     `ddl:"static" sql:"REVOKE"`        //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	share        AccountObjectIdentifier  `ddl:"identifier" sql:"FROM SHARE"`
+}
+
+func (opts *revokeDatabaseRoleFromShareOptions) validate() error {
+	if !validObjectidentifier(opts.name) || opts.name.DatabaseName() == "" || !validObjectidentifier(opts.share) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *databaseRoles) RevokeFromShare(ctx context.Context, id DatabaseObjectIdentifier, share AccountObjectIdentifier) error {
+	opts := &revokeDatabaseRoleFromShareOptions{
+		name:  id,
+		share: share,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
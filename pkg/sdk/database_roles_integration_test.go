@@ -0,0 +1,90 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_DatabaseRoles(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+
+	id := NewDatabaseObjectIdentifier(database.Name, randomStringN(t, 12))
+	err := client.DatabaseRoles.Create(ctx, id, &CreateDatabaseRoleOptions{Comment: String("some comment")})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.DatabaseRoles.Drop(ctx, id, &DropDatabaseRoleOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		databaseRole, err := client.DatabaseRoles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, databaseRole.ID())
+		assert.Equal(t, "some comment", databaseRole.Comment)
+	})
+
+	t.Run("show in database", func(t *testing.T) {
+		databaseRoles, err := client.DatabaseRoles.Show(ctx, database.ID(), nil)
+		require.NoError(t, err)
+		assert.Len(t, databaseRoles, 1)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		err := client.DatabaseRoles.Alter(ctx, id, &AlterDatabaseRoleOptions{Set: &DatabaseRoleSet{Comment: String("new comment")}})
+		require.NoError(t, err)
+		databaseRole, err := client.DatabaseRoles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", databaseRole.Comment)
+
+		err = client.DatabaseRoles.Alter(ctx, id, &AlterDatabaseRoleOptions{Unset: &DatabaseRoleUnset{Comment: Bool(true)}})
+		require.NoError(t, err)
+		databaseRole, err = client.DatabaseRoles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", databaseRole.Comment)
+	})
+
+	t.Run("grant and revoke to account role", func(t *testing.T) {
+		roleID := randomAccountObjectIdentifier(t)
+		err := client.Roles.Create(ctx, roleID, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Roles.Drop(ctx, roleID, nil)
+			require.NoError(t, err)
+		})
+
+		err = client.DatabaseRoles.Grant(ctx, id, &GrantDatabaseRoleOptions{To: DatabaseRoleGrantee{AccountRole: roleID}})
+		require.NoError(t, err)
+		databaseRole, err := client.DatabaseRoles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, databaseRole.GrantedToRoles)
+
+		err = client.DatabaseRoles.Revoke(ctx, id, &RevokeDatabaseRoleOptions{From: DatabaseRoleGrantee{AccountRole: roleID}})
+		require.NoError(t, err)
+	})
+
+	t.Run("grant and revoke to database role", func(t *testing.T) {
+		parentID := NewDatabaseObjectIdentifier(database.Name, randomStringN(t, 12))
+		err := client.DatabaseRoles.Create(ctx, parentID, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.DatabaseRoles.Drop(ctx, parentID, nil)
+			require.NoError(t, err)
+		})
+
+		err = client.DatabaseRoles.Grant(ctx, id, &GrantDatabaseRoleOptions{To: DatabaseRoleGrantee{DatabaseRole: parentID}})
+		require.NoError(t, err)
+		databaseRole, err := client.DatabaseRoles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, databaseRole.GrantedToDatabaseRoles)
+
+		err = client.DatabaseRoles.Revoke(ctx, id, &RevokeDatabaseRoleOptions{From: DatabaseRoleGrantee{DatabaseRole: parentID}})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,187 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseRoleCreate(t *testing.T) {
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateDatabaseRoleOptions{
+			OrReplace: Bool(true),
+			name:      NewDatabaseObjectIdentifier("db", "myrole"),
+			Comment:   String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE DATABASE ROLE "db"."myrole" COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: missing database", func(t *testing.T) {
+		opts := &CreateDatabaseRoleOptions{
+			name: NewDatabaseObjectIdentifier("", "myrole"),
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateDatabaseRoleOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        NewDatabaseObjectIdentifier("db", "myrole"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestDatabaseRoleAlter(t *testing.T) {
+	id := NewDatabaseObjectIdentifier("db", "myrole")
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterDatabaseRoleOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  NewDatabaseObjectIdentifier("db", "newrole"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER DATABASE ROLE IF EXISTS "db"."myrole" RENAME TO "db"."newrole"`, actual)
+	})
+
+	t.Run("set and unset comment", func(t *testing.T) {
+		opts := &AlterDatabaseRoleOptions{
+			name: id,
+			Set:  &DatabaseRoleSet{Comment: String("some comment")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER DATABASE ROLE "db"."myrole" SET COMMENT = 'some comment'`, actual)
+
+		opts = &AlterDatabaseRoleOptions{
+			name:  id,
+			Unset: &DatabaseRoleUnset{Comment: Bool(true)},
+		}
+		actual, err = structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER DATABASE ROLE "db"."myrole" UNSET COMMENT`, actual)
+	})
+
+	t.Run("validation: rename to another database", func(t *testing.T) {
+		opts := &AlterDatabaseRoleOptions{
+			name:    id,
+			NewName: NewDatabaseObjectIdentifier("otherdb", "newrole"),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterDatabaseRoleOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestDatabaseRoleDrop(t *testing.T) {
+	opts := &DropDatabaseRoleOptions{
+		IfExists: Bool(true),
+		name:     NewDatabaseObjectIdentifier("db", "myrole"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP DATABASE ROLE IF EXISTS "db"."myrole"`, actual)
+}
+
+func TestDatabaseRoleShow(t *testing.T) {
+	t.Run("in database", func(t *testing.T) {
+		opts := &ShowDatabaseRoleOptions{
+			database: NewAccountObjectIdentifier("db"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW DATABASE ROLES IN DATABASE "db"`, actual)
+	})
+
+	t.Run("with like and limit", func(t *testing.T) {
+		opts := &ShowDatabaseRoleOptions{
+			Like:     &Like{Pattern: String("my%")},
+			database: NewAccountObjectIdentifier("db"),
+			Limit:    &LimitFrom{Rows: Int(5)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW DATABASE ROLES LIKE 'my%' IN DATABASE "db" LIMIT 5`, actual)
+	})
+
+	t.Run("validation: missing database", func(t *testing.T) {
+		opts := &ShowDatabaseRoleOptions{}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestDatabaseRoleGrant(t *testing.T) {
+	id := NewDatabaseObjectIdentifier("db", "myrole")
+
+	t.Run("to account role", func(t *testing.T) {
+		opts := &GrantDatabaseRoleOptions{
+			name: id,
+			To:   DatabaseRoleGrantee{AccountRole: NewAccountObjectIdentifier("parent")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `GRANT DATABASE ROLE "db"."myrole" TO ROLE "parent"`, actual)
+	})
+
+	t.Run("to database role", func(t *testing.T) {
+		opts := &GrantDatabaseRoleOptions{
+			name: id,
+			To:   DatabaseRoleGrantee{DatabaseRole: NewDatabaseObjectIdentifier("db", "parent")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `GRANT DATABASE ROLE "db"."myrole" TO DATABASE ROLE "db"."parent"`, actual)
+	})
+
+	t.Run("to share", func(t *testing.T) {
+		opts := &grantDatabaseRoleToShareOptions{
+			name:  id,
+			share: NewAccountObjectIdentifier("myshare"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `GRANT DATABASE ROLE "db"."myrole" TO SHARE "myshare"`, actual)
+	})
+
+	t.Run("validation: no grantee", func(t *testing.T) {
+		opts := &GrantDatabaseRoleOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestDatabaseRoleRevoke(t *testing.T) {
+	id := NewDatabaseObjectIdentifier("db", "myrole")
+
+	t.Run("from account role", func(t *testing.T) {
+		opts := &RevokeDatabaseRoleOptions{
+			name: id,
+			From: DatabaseRoleGrantee{AccountRole: NewAccountObjectIdentifier("parent")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `REVOKE DATABASE ROLE "db"."myrole" FROM ROLE "parent"`, actual)
+	})
+
+	t.Run("from share", func(t *testing.T) {
+		opts := &revokeDatabaseRoleFromShareOptions{
+			name:  id,
+			share: NewAccountObjectIdentifier("myshare"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `REVOKE DATABASE ROLE "db"."myrole" FROM SHARE "myshare"`, actual)
+	})
+}
@@ -30,6 +30,10 @@ type Databases interface {
 	Show(ctx context.Context, opts *ShowDatabasesOptions) ([]*Database, error)
 	// ShowByID returns a database by ID
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Database, error)
+	// ShowByIDs returns the databases with the given IDs using a single SHOW, IDs that do not exist are skipped.
+	ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*Database, error)
+	// ShowTerse returns a list of databases with only the columns of SHOW TERSE DATABASES.
+	ShowTerse(ctx context.Context, opts *ShowDatabasesOptions) ([]*TerseDatabase, error)
 	// Describe returns the details of a database.
 	Describe(ctx context.Context, id AccountObjectIdentifier) (*DatabaseDetails, error)
 	// Use sets the active database for the current session.
@@ -165,7 +169,7 @@ func (v *databases) Create(ctx context.Context, id AccountObjectIdentifier, opts
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -202,7 +206,7 @@ func (v *databases) CreateShared(ctx context.Context, id AccountObjectIdentifier
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -237,7 +241,7 @@ func (v *databases) CreateSecondary(ctx context.Context, id AccountObjectIdentif
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -320,7 +324,7 @@ func (v *databases) Alter(ctx context.Context, id AccountObjectIdentifier, opts
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -385,7 +389,7 @@ func (v *databases) AlterReplication(ctx context.Context, id AccountObjectIdenti
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -449,7 +453,7 @@ func (v *databases) AlterFailover(ctx context.Context, id AccountObjectIdentifie
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -479,7 +483,7 @@ func (v *databases) Drop(ctx context.Context, id AccountObjectIdentifier, opts *
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -507,7 +511,7 @@ func (v *databases) Undrop(ctx context.Context, id AccountObjectIdentifier) erro
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -516,13 +520,12 @@ func (v *databases) Undrop(ctx context.Context, id AccountObjectIdentifier) erro
 }
 
 type ShowDatabasesOptions struct {
-	show       bool       `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
-	Terse      *bool      `ddl:"keyword" sql:"TERSE"`
-	databases  bool       `ddl:"static" sql:"DATABASES"` //lint:ignore U1000 This is used in the ddl tag
-	History    *bool      `ddl:"keyword" sql:"HISTORY"`
-	Like       *Like      `ddl:"keyword" sql:"LIKE"`
-	StartsWith *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
-	LimitFrom  *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+	show      bool  `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Terse     *bool `ddl:"keyword" sql:"TERSE"`
+	databases bool  `ddl:"static" sql:"DATABASES"` //lint:ignore U1000 This is used in the ddl tag
+	History   *bool `ddl:"keyword" sql:"HISTORY"`
+	Like      *Like `ddl:"keyword" sql:"LIKE"`
+	ShowOptions
 }
 
 func (opts *ShowDatabasesOptions) validate() error {
@@ -536,7 +539,7 @@ func (v *databases) Show(ctx context.Context, opts *ShowDatabasesOptions) ([]*Da
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -549,11 +552,51 @@ func (v *databases) Show(ctx context.Context, opts *ShowDatabasesOptions) ([]*Da
 	return databases, err
 }
 
+type TerseDatabase struct {
+	CreatedOn time.Time
+	Name      string
+	Kind      string
+}
+
+func (v *TerseDatabase) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (row terseRow) toTerseDatabase() *TerseDatabase {
+	return &TerseDatabase{
+		CreatedOn: row.CreatedOn,
+		Name:      row.Name,
+		Kind:      row.Kind.String,
+	}
+}
+
+func (v *databases) ShowTerse(ctx context.Context, opts *ShowDatabasesOptions) ([]*TerseDatabase, error) {
+	if opts == nil {
+		opts = &ShowDatabasesOptions{}
+	}
+	opts.Terse = Bool(true)
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []terseRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*TerseDatabase, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTerseDatabase()
+	}
+	return resultList, nil
+}
+
 func (v *databases) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Database, error) {
 	databases, err := v.client.Databases.Show(ctx, &ShowDatabasesOptions{
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+		Like: likeExactly(id.Name()),
 	})
 	if err != nil {
 		return nil, err
@@ -566,6 +609,19 @@ func (v *databases) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*
 	return nil, ErrObjectNotExistOrAuthorized
 }
 
+func (v *databases) ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*Database, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	databases, err := v.Show(ctx, &ShowDatabasesOptions{
+		Like: likeIDs(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filterByIDs(databases, ids), nil
+}
+
 type DatabaseDetails struct {
 	Rows []DatabaseDetailsRow
 }
@@ -593,15 +649,7 @@ func (v *databases) Describe(ctx context.Context, id AccountObjectIdentifier) (*
 	opts := &describeDatabaseOptions{
 		name: id,
 	}
-	if err := opts.validate(); err != nil {
-		return nil, err
-	}
-	sql, err := structToSQL(opts)
-	if err != nil {
-		return nil, err
-	}
-	var rows []DatabaseDetailsRow
-	err = v.client.query(ctx, &rows, sql)
+	rows, err := describeRows[DatabaseDetailsRow](ctx, v.client, opts)
 	if err != nil {
 		return nil, err
 	}
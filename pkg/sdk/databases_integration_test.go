@@ -332,6 +332,18 @@ func TestInt_DatabasesShow(t *testing.T) {
 		assert.Empty(t, database.Owner)
 	})
 
+	t.Run("show terse", func(t *testing.T) {
+		databases, err := client.Databases.ShowTerse(ctx, &ShowDatabasesOptions{
+			Like: &Like{
+				Pattern: String(databaseTest.Name),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(databases))
+		assert.Equal(t, databaseTest.ID(), databases[0].ID())
+		assert.NotEmpty(t, databases[0].CreatedOn)
+	})
+
 	t.Run("with history", func(t *testing.T) {
 		// need to drop a database to test if the "dropped_on" column is populated
 		databaseCleanup2()
@@ -351,9 +363,11 @@ func TestInt_DatabasesShow(t *testing.T) {
 
 	t.Run("with like starts with", func(t *testing.T) {
 		showOptions := &ShowDatabasesOptions{
-			StartsWith: String(databaseTest.Name),
-			LimitFrom: &LimitFrom{
-				Rows: Int(1),
+			ShowOptions: ShowOptions{
+				StartsWith: String(databaseTest.Name),
+				Limit: &LimitFrom{
+					Rows: Int(1),
+				},
 			},
 		}
 		databases, err := client.Databases.Show(ctx, showOptions)
@@ -319,9 +319,11 @@ func TestDatabasesShow(t *testing.T) {
 			Like: &Like{
 				Pattern: String("db2"),
 			},
-			LimitFrom: &LimitFrom{
-				Rows: Int(1),
-				From: String("db1"),
+			ShowOptions: ShowOptions{
+				Limit: &LimitFrom{
+					Rows: Int(1),
+					From: String("db1"),
+				},
 			},
 		}
 		actual, err := structToSQL(showOptions)
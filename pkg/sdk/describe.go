@@ -0,0 +1,89 @@
+package sdk
+
+import "context"
+
+// describeOptions is implemented by the options of DESCRIBE statements.
+type describeOptions interface {
+	validate() error
+}
+
+// describeRows validates opts, runs the DESCRIBE statement rendered from them and scans every row of the output into T,
+// e.g. propertyRow or integrationPropertyRow.
+func describeRows[T any](ctx context.Context, client *Client, opts describeOptions) ([]T, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []T{}
+	if err := client.query(ctx, &dest, sql); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// describeRow validates opts, runs the DESCRIBE statement rendered from them and scans its single row into T, for objects
+// whose DESCRIBE output has the same shape as SHOW, e.g. warehouses or services.
+func describeRow[T any](ctx context.Context, client *Client, opts describeOptions) (*T, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := new(T)
+	if err := client.queryOne(ctx, dest, sql); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// Properties is the output of a DESCRIBE statement with property, value, default and description columns, by property name.
+type Properties map[string]propertyRow
+
+func newProperties(rows []propertyRow) Properties {
+	properties := make(Properties, len(rows))
+	for _, row := range rows {
+		properties[row.Property] = row
+	}
+	return properties
+}
+
+// describeProperties runs the DESCRIBE statement rendered from opts and returns its properties.
+func describeProperties(ctx context.Context, client *Client, opts describeOptions) (Properties, error) {
+	rows, err := describeRows[propertyRow](ctx, client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newProperties(rows), nil
+}
+
+// String returns the property as a string, or nil if the output does not contain it.
+func (p Properties) String(name string) *StringProperty {
+	row, ok := p[name]
+	if !ok {
+		return nil
+	}
+	return row.toStringProperty()
+}
+
+// Int returns the property as an int, or nil if the output does not contain it.
+func (p Properties) Int(name string) *IntProperty {
+	row, ok := p[name]
+	if !ok {
+		return nil
+	}
+	return row.toIntProperty()
+}
+
+// Bool returns the property as a bool, or nil if the output does not contain it.
+func (p Properties) Bool(name string) *BoolProperty {
+	row, ok := p[name]
+	if !ok {
+		return nil
+	}
+	return row.toBoolProperty()
+}
@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProperties(t *testing.T) {
+	properties := newProperties([]propertyRow{
+		{Property: "COMMENT", Value: "null", DefaultValue: "null", Description: "comment"},
+		{Property: "PASSWORD_MIN_LENGTH", Value: "12", DefaultValue: "8", Description: "minimum length"},
+		{Property: "DISABLED", Value: "TRUE", DefaultValue: "false"},
+	})
+
+	t.Run("string", func(t *testing.T) {
+		property := properties.String("COMMENT")
+		require.NotNil(t, property)
+		assert.Equal(t, "", property.Value)
+		assert.Equal(t, "comment", property.Description)
+	})
+
+	t.Run("int", func(t *testing.T) {
+		property := properties.Int("PASSWORD_MIN_LENGTH")
+		require.NotNil(t, property)
+		assert.Equal(t, 12, property.Value)
+		assert.Equal(t, 8, property.DefaultValue)
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		property := properties.Bool("DISABLED")
+		require.NotNil(t, property)
+		assert.True(t, property.Value)
+		assert.False(t, property.DefaultValue)
+	})
+
+	t.Run("missing property", func(t *testing.T) {
+		assert.Nil(t, properties.String("OWNER"))
+		assert.Nil(t, properties.Int("OWNER"))
+		assert.Nil(t, properties.Bool("OWNER"))
+	})
+}
+
+func TestPasswordPolicyDetailsFromProperties(t *testing.T) {
+	details := passwordPolicyDetailsFromProperties(newProperties([]propertyRow{
+		{Property: "NAME", Value: "POLICY"},
+		{Property: "PASSWORD_MAX_RETRIES", Value: "5", DefaultValue: "5"},
+	}))
+	assert.Equal(t, "POLICY", details.Name.Value)
+	assert.Equal(t, 5, details.PasswordMaxRetries.Value)
+	assert.Nil(t, details.PasswordMinLength)
+}
+
+func TestDescribeRow(t *testing.T) {
+	ctx := context.Background()
+	client, mock := newMockClient(t)
+	id := NewSchemaObjectIdentifier("db", "schema", "policy")
+
+	mock.ExpectQuery(regexp.QuoteMeta(`DESCRIBE SESSION POLICY "db"."schema"."policy"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"created_on", "name", "session_idle_timeout_mins", "session_ui_idle_timeout_mins", "comment"}).
+			AddRow("2024-01-01", "policy", 60, 30, nil))
+	details, err := client.SessionPolicies.Describe(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, &SessionPolicyDetails{Name: "policy", SessionIdleTimeoutMins: 60, SessionUIIdleTimeoutMins: 30}, details)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = client.SessionPolicies.Describe(ctx, NewSchemaObjectIdentifier("", "", ""))
+	assert.ErrorIs(t, err, ErrInvalidObjectIdentifier)
+}
@@ -0,0 +1,20 @@
+package sdk
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// toEnum converts a raw value returned by SHOW or DESCRIBE into an enum type.
+// Snowflake regularly introduces new values (sizes, states, editions, ...), so by default
+// unknown values are preserved as-is instead of failing the whole read. When strict is set
+// (see WithStrictEnumParsing) unknown values are reported as ErrUnknownEnumValue instead,
+// which is what the integration tests use to detect values the SDK is not aware of yet.
+func toEnum[T ~string](strict bool, field string, raw string, known []T) (T, error) {
+	v := T(raw)
+	if raw == "" || !strict || slices.Contains(known, v) {
+		return v, nil
+	}
+	return v, fmt.Errorf("%w: %s %q", ErrUnknownEnumValue, field, raw)
+}
@@ -0,0 +1,36 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToEnum(t *testing.T) {
+	known := []WarehouseState{WarehouseStateStarted, WarehouseStateSuspended}
+
+	t.Run("known value", func(t *testing.T) {
+		v, err := toEnum(true, "warehouse state", "STARTED", known)
+		require.NoError(t, err)
+		assert.Equal(t, WarehouseStateStarted, v)
+	})
+
+	t.Run("unknown value in lenient mode is preserved", func(t *testing.T) {
+		v, err := toEnum(false, "warehouse state", "HIBERNATING", known)
+		require.NoError(t, err)
+		assert.Equal(t, WarehouseState("HIBERNATING"), v)
+	})
+
+	t.Run("unknown value in strict mode", func(t *testing.T) {
+		_, err := toEnum(true, "warehouse state", "HIBERNATING", known)
+		require.ErrorIs(t, err, ErrUnknownEnumValue)
+		assert.Contains(t, err.Error(), `warehouse state "HIBERNATING"`)
+	})
+
+	t.Run("empty value in strict mode", func(t *testing.T) {
+		v, err := toEnum(true, "warehouse state", "", known)
+		require.NoError(t, err)
+		assert.Equal(t, WarehouseState(""), v)
+	})
+}
@@ -13,6 +13,12 @@ var (
 
 	// snowflake-sdk errors.
 	ErrInvalidObjectIdentifier = errors.New("invalid object identifier")
+	ErrUnknownEnumValue        = errors.New("unknown enum value")
+	ErrFeatureNotSupported     = errors.New("feature not supported by account edition")
+	ErrInvalidTriggers         = errors.New("invalid resource monitor triggers")
+	ErrEmptyStatement          = errors.New("statement must not be empty")
+	ErrQueryFailed             = errors.New("query failed")
+	ErrNotInTransaction        = errors.New("client is not in a transaction")
 )
 
 func decodeDriverError(err error) error {
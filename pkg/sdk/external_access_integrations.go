@@ -0,0 +1,324 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ExternalAccessIntegrations = (*externalAccessIntegrations)(nil)
+
+// ExternalAccessIntegrations describes all the external access integration related methods that the
+// Snowflake API supports. External access integrations allow UDFs and procedures to reach external
+// network locations.
+type ExternalAccessIntegrations interface {
+	// Create creates a new external access integration.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateExternalAccessIntegrationOptions) error
+	// Alter modifies an existing external access integration.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterExternalAccessIntegrationOptions) error
+	// Drop removes an external access integration.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropExternalAccessIntegrationOptions) error
+	// Show returns a list of external access integrations.
+	Show(ctx context.Context, opts *ShowExternalAccessIntegrationOptions) ([]*ExternalAccessIntegration, error)
+	// ShowByID returns an external access integration by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegration, error)
+	// Describe returns the details of an external access integration.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegrationDetails, error)
+}
+
+// externalAccessIntegrations implements ExternalAccessIntegrations.
+type externalAccessIntegrations struct {
+	client *Client
+}
+
+type CreateExternalAccessIntegrationOptions struct {
+	create                    bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace                 *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	externalAccessIntegration bool                    `ddl:"static" sql:"EXTERNAL ACCESS INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists               *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                      AccountObjectIdentifier `ddl:"identifier"`
+
+	AllowedNetworkRules                  []SchemaObjectIdentifier  `ddl:"parameter,parentheses" sql:"ALLOWED_NETWORK_RULES"`
+	AllowedAPIAuthenticationIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"ALLOWED_API_AUTHENTICATION_INTEGRATIONS"`
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier  `ddl:"parameter,parentheses" sql:"ALLOWED_AUTHENTICATION_SECRETS"`
+	Enabled                              bool                      `ddl:"parameter" sql:"ENABLED"`
+	Comment                              *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateExternalAccessIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if len(opts.AllowedNetworkRules) == 0 {
+		return errors.New("AllowedNetworkRules is required")
+	}
+	return nil
+}
+
+func (v *externalAccessIntegrations) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateExternalAccessIntegrationOptions) error {
+	if opts == nil {
+		opts = &CreateExternalAccessIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterExternalAccessIntegrationOptions struct {
+	alter                     bool                            `ddl:"static" sql:"ALTER"`                       //lint:ignore U1000 This is used in the ddl tag
+	externalAccessIntegration bool                            `ddl:"static" sql:"EXTERNAL ACCESS INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists                  *bool                           `ddl:"keyword" sql:"IF EXISTS"`
+	name                      AccountObjectIdentifier         `ddl:"identifier"`
+	Set                       *ExternalAccessIntegrationSet   `ddl:"keyword" sql:"SET"`
+	Unset                     *ExternalAccessIntegrationUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTag                    []TagAssociation                `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag                  []ObjectIdentifier              `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterExternalAccessIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ExternalAccessIntegrationSet struct {
+	AllowedNetworkRules                  []SchemaObjectIdentifier  `ddl:"parameter,parentheses" sql:"ALLOWED_NETWORK_RULES"`
+	AllowedAPIAuthenticationIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"ALLOWED_API_AUTHENTICATION_INTEGRATIONS"`
+	AllowedAuthenticationSecrets         []SchemaObjectIdentifier  `ddl:"parameter,parentheses" sql:"ALLOWED_AUTHENTICATION_SECRETS"`
+	Enabled                              *bool                     `ddl:"parameter" sql:"ENABLED"`
+	Comment                              *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *ExternalAccessIntegrationSet) validate() error {
+	if !anyValueSet(v.AllowedNetworkRules, v.AllowedAPIAuthenticationIntegrations, v.AllowedAuthenticationSecrets, v.Enabled, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	return nil
+}
+
+type ExternalAccessIntegrationUnset struct {
+	AllowedAPIAuthenticationIntegrations *bool `ddl:"keyword" sql:"ALLOWED_API_AUTHENTICATION_INTEGRATIONS"`
+	AllowedAuthenticationSecrets         *bool `ddl:"keyword" sql:"ALLOWED_AUTHENTICATION_SECRETS"`
+	Comment                              *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *ExternalAccessIntegrationUnset) validate() error {
+	if !anyValueSet(v.AllowedAPIAuthenticationIntegrations, v.AllowedAuthenticationSecrets, v.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+func (v *externalAccessIntegrations) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterExternalAccessIntegrationOptions) error {
+	if opts == nil {
+		opts = &AlterExternalAccessIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropExternalAccessIntegrationOptions struct {
+	drop                      bool                    `ddl:"static" sql:"DROP"`                        //lint:ignore U1000 This is used in the ddl tag
+	externalAccessIntegration bool                    `ddl:"static" sql:"EXTERNAL ACCESS INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists                  *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name                      AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropExternalAccessIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *externalAccessIntegrations) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropExternalAccessIntegrationOptions) error {
+	if opts == nil {
+		opts = &DropExternalAccessIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowExternalAccessIntegrationOptions represents the options for listing external access integrations.
+type ShowExternalAccessIntegrationOptions struct {
+	show                       bool  `ddl:"static" sql:"SHOW"`                         //lint:ignore U1000 This is used in the ddl tag
+	externalAccessIntegrations bool  `ddl:"static" sql:"EXTERNAL ACCESS INTEGRATIONS"` //lint:ignore U1000 This is used in the ddl tag
+	Like                       *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowExternalAccessIntegrationOptions) validate() error {
+	return nil
+}
+
+type ExternalAccessIntegration struct {
+	Name      string
+	Type      string
+	Category  string
+	Enabled   bool
+	Comment   string
+	CreatedOn time.Time
+}
+
+func (v *ExternalAccessIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ExternalAccessIntegration) ObjectType() ObjectType {
+	return ObjectTypeIntegration
+}
+
+type externalAccessIntegrationRow struct {
+	Name      string         `db:"name"`
+	Type      string         `db:"type"`
+	Category  string         `db:"category"`
+	Enabled   bool           `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
+	CreatedOn time.Time      `db:"created_on"`
+}
+
+func (row *externalAccessIntegrationRow) toExternalAccessIntegration() *ExternalAccessIntegration {
+	v := &ExternalAccessIntegration{
+		Name:      row.Name,
+		Type:      row.Type,
+		Category:  row.Category,
+		Enabled:   row.Enabled,
+		CreatedOn: row.CreatedOn,
+	}
+	if row.Comment.Valid {
+		v.Comment = row.Comment.String
+	}
+	return v
+}
+
+func (v *externalAccessIntegrations) Show(ctx context.Context, opts *ShowExternalAccessIntegrationOptions) ([]*ExternalAccessIntegration, error) {
+	if opts == nil {
+		opts = &ShowExternalAccessIntegrationOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []externalAccessIntegrationRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ExternalAccessIntegration, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toExternalAccessIntegration()
+	}
+	return resultList, nil
+}
+
+func (v *externalAccessIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegration, error) {
+	externalAccessIntegrations, err := v.Show(ctx, &ShowExternalAccessIntegrationOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, externalAccessIntegration := range externalAccessIntegrations {
+		if externalAccessIntegration.Name == id.Name() {
+			return externalAccessIntegration, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeExternalAccessIntegrationOptions struct {
+	describe                  bool                    `ddl:"static" sql:"DESCRIBE"`                    //lint:ignore U1000 This is used in the ddl tag
+	externalAccessIntegration bool                    `ddl:"static" sql:"EXTERNAL ACCESS INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	name                      AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeExternalAccessIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ExternalAccessIntegrationDetails contains the typed output of DESCRIBE EXTERNAL ACCESS INTEGRATION.
+// The lists hold the fully qualified names as returned by Snowflake.
+type ExternalAccessIntegrationDetails struct {
+	Enabled                              bool
+	AllowedNetworkRules                  []string
+	AllowedAPIAuthenticationIntegrations []string
+	AllowedAuthenticationSecrets         []string
+	Comment                              string
+}
+
+func externalAccessIntegrationDetailsFromRows(rows []integrationPropertyRow) *ExternalAccessIntegrationDetails {
+	v := &ExternalAccessIntegrationDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "ENABLED":
+			v.Enabled = row.toBool()
+		case "ALLOWED_NETWORK_RULES":
+			v.AllowedNetworkRules = row.toList()
+		case "ALLOWED_API_AUTHENTICATION_INTEGRATIONS":
+			v.AllowedAPIAuthenticationIntegrations = row.toList()
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			v.AllowedAuthenticationSecrets = row.toList()
+		case "COMMENT":
+			v.Comment = row.Value
+		}
+	}
+	return v
+}
+
+func (v *externalAccessIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegrationDetails, error) {
+	opts := &describeExternalAccessIntegrationOptions{
+		name: id,
+	}
+	rows, err := describeRows[integrationPropertyRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return externalAccessIntegrationDetailsFromRows(rows), nil
+}
@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalAccessIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	networkRule := NewSchemaObjectIdentifier(database.Name, schema.Name, "EGRESS_RULE")
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE NETWORK RULE %s MODE = EGRESS TYPE = HOST_PORT VALUE_LIST = ('example.com')", networkRule.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP NETWORK RULE IF EXISTS %s", networkRule.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	id := randomAccountObjectIdentifier(t)
+	err = client.ExternalAccessIntegrations.Create(ctx, id, &CreateExternalAccessIntegrationOptions{
+		AllowedNetworkRules: []SchemaObjectIdentifier{networkRule},
+		Enabled:             true,
+		Comment:             String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ExternalAccessIntegrations.Drop(ctx, id, &DropExternalAccessIntegrationOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show and describe", func(t *testing.T) {
+		externalAccessIntegration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), externalAccessIntegration.Name)
+		assert.Equal(t, "EXTERNAL_ACCESS", externalAccessIntegration.Type)
+		assert.True(t, externalAccessIntegration.Enabled)
+		assert.Equal(t, "some comment", externalAccessIntegration.Comment)
+
+		details, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, details.Enabled)
+		assert.Len(t, details.AllowedNetworkRules, 1)
+	})
+
+	t.Run("alter", func(t *testing.T) {
+		err := client.ExternalAccessIntegrations.Alter(ctx, id, &AlterExternalAccessIntegrationOptions{
+			Set: &ExternalAccessIntegrationSet{
+				Enabled: Bool(false),
+			},
+		})
+		require.NoError(t, err)
+		err = client.ExternalAccessIntegrations.Alter(ctx, id, &AlterExternalAccessIntegrationOptions{
+			Unset: &ExternalAccessIntegrationUnset{
+				Comment: Bool(true),
+			},
+		})
+		require.NoError(t, err)
+
+		details, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, details.Enabled)
+		assert.Empty(t, details.Comment)
+	})
+}
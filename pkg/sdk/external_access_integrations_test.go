@@ -0,0 +1,129 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalAccessIntegrationsCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	networkRule := NewSchemaObjectIdentifier("db", "schema", "network_rule")
+
+	t.Run("required options", func(t *testing.T) {
+		opts := &CreateExternalAccessIntegrationOptions{
+			name:                id,
+			AllowedNetworkRules: []SchemaObjectIdentifier{networkRule},
+			Enabled:             true,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE EXTERNAL ACCESS INTEGRATION ` + id.FullyQualifiedName() + ` ALLOWED_NETWORK_RULES = ("db"."schema"."network_rule") ENABLED = true`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := &CreateExternalAccessIntegrationOptions{
+			OrReplace:                            Bool(true),
+			name:                                 id,
+			AllowedNetworkRules:                  []SchemaObjectIdentifier{networkRule, NewSchemaObjectIdentifier("db", "schema", "other_rule")},
+			AllowedAPIAuthenticationIntegrations: []AccountObjectIdentifier{NewAccountObjectIdentifier("oauth_integration")},
+			AllowedAuthenticationSecrets:         []SchemaObjectIdentifier{NewSchemaObjectIdentifier("db", "schema", "secret")},
+			Enabled:                              false,
+			Comment:                              String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE EXTERNAL ACCESS INTEGRATION ` + id.FullyQualifiedName() + ` ALLOWED_NETWORK_RULES = ("db"."schema"."network_rule", "db"."schema"."other_rule") ALLOWED_API_AUTHENTICATION_INTEGRATIONS = ("oauth_integration") ALLOWED_AUTHENTICATION_SECRETS = ("db"."schema"."secret") ENABLED = false COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: no network rules", func(t *testing.T) {
+		opts := &CreateExternalAccessIntegrationOptions{
+			name:    id,
+			Enabled: true,
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestExternalAccessIntegrationsAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterExternalAccessIntegrationOptions{
+			name: id,
+			Set: &ExternalAccessIntegrationSet{
+				AllowedNetworkRules: []SchemaObjectIdentifier{NewSchemaObjectIdentifier("db", "schema", "network_rule")},
+				Enabled:             Bool(false),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER EXTERNAL ACCESS INTEGRATION ` + id.FullyQualifiedName() + ` SET ALLOWED_NETWORK_RULES = ("db"."schema"."network_rule") ENABLED = false`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterExternalAccessIntegrationOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Unset: &ExternalAccessIntegrationUnset{
+				AllowedAuthenticationSecrets: Bool(true),
+				Comment:                      Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER EXTERNAL ACCESS INTEGRATION IF EXISTS ` + id.FullyQualifiedName() + ` UNSET ALLOWED_AUTHENTICATION_SECRETS, COMMENT`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: set and unset", func(t *testing.T) {
+		opts := &AlterExternalAccessIntegrationOptions{
+			name:  id,
+			Set:   &ExternalAccessIntegrationSet{Enabled: Bool(true)},
+			Unset: &ExternalAccessIntegrationUnset{Comment: Bool(true)},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestExternalAccessIntegrationsDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &DropExternalAccessIntegrationOptions{
+		IfExists: Bool(true),
+		name:     id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP EXTERNAL ACCESS INTEGRATION IF EXISTS `+id.FullyQualifiedName(), actual)
+}
+
+func TestExternalAccessIntegrationsShow(t *testing.T) {
+	opts := &ShowExternalAccessIntegrationOptions{
+		Like: &Like{Pattern: String("EAI%")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'EAI%'`, actual)
+}
+
+func TestExternalAccessIntegrationDetailsFromRows(t *testing.T) {
+	rows := []integrationPropertyRow{
+		{Property: "ENABLED", PropertyType: "Boolean", Value: "true"},
+		{Property: "ALLOWED_NETWORK_RULES", PropertyType: "List", Value: "[DB.SCHEMA.RULE1, DB.SCHEMA.RULE2]"},
+		{Property: "ALLOWED_AUTHENTICATION_SECRETS", PropertyType: "List", Value: "[]"},
+		{Property: "COMMENT", PropertyType: "String", Value: "some comment"},
+	}
+	details := externalAccessIntegrationDetailsFromRows(rows)
+	assert.True(t, details.Enabled)
+	assert.Equal(t, []string{"DB.SCHEMA.RULE1", "DB.SCHEMA.RULE2"}, details.AllowedNetworkRules)
+	assert.Empty(t, details.AllowedAuthenticationSecrets)
+	assert.Equal(t, "some comment", details.Comment)
+}
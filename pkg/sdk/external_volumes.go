@@ -0,0 +1,400 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Compile-time proof of interface implementation.
+var _ ExternalVolumes = (*externalVolumes)(nil)
+
+// ExternalVolumes describes all the external volume related methods that the
+// Snowflake API supports.
+type ExternalVolumes interface {
+	// Create creates a new external volume with the given storage locations.
+	Create(ctx context.Context, id AccountObjectIdentifier, storageLocations []ExternalVolumeStorageLocation, opts *CreateExternalVolumeOptions) error
+	// Alter modifies an existing external volume.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterExternalVolumeOptions) error
+	// Drop removes an external volume.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropExternalVolumeOptions) error
+	// Show returns a list of external volumes.
+	Show(ctx context.Context, opts *ShowExternalVolumeOptions) ([]*ExternalVolume, error)
+	// ShowByID returns an external volume by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalVolume, error)
+	// Describe returns the details of an external volume.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*ExternalVolumeDetails, error)
+}
+
+// externalVolumes implements ExternalVolumes.
+type externalVolumes struct {
+	client *Client
+}
+
+type ExternalVolumeEncryptionType string
+
+const (
+	ExternalVolumeEncryptionTypeAWSSSES3  ExternalVolumeEncryptionType = "AWS_SSE_S3"
+	ExternalVolumeEncryptionTypeAWSSSEKMS ExternalVolumeEncryptionType = "AWS_SSE_KMS"
+	ExternalVolumeEncryptionTypeGCSSSEKMS ExternalVolumeEncryptionType = "GCS_SSE_KMS"
+	ExternalVolumeEncryptionTypeNone      ExternalVolumeEncryptionType = "NONE"
+)
+
+// ExternalVolumeStorageLocation is a single entry of STORAGE_LOCATIONS. Exactly one of the
+// provider params must be set.
+type ExternalVolumeStorageLocation struct {
+	Name                       string                              `ddl:"parameter,single_quotes" sql:"NAME"`
+	S3StorageLocationParams    *S3ExternalVolumeStorageLocation    `ddl:"keyword"`
+	GCSStorageLocationParams   *GCSExternalVolumeStorageLocation   `ddl:"keyword"`
+	AzureStorageLocationParams *AzureExternalVolumeStorageLocation `ddl:"keyword"`
+}
+
+type S3ExternalVolumeStorageLocation struct {
+	StorageProvider      S3StorageProvider         `ddl:"parameter,single_quotes" sql:"STORAGE_PROVIDER"`
+	StorageBaseURL       string                    `ddl:"parameter,single_quotes" sql:"STORAGE_BASE_URL"`
+	StorageAWSRoleARN    string                    `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_ROLE_ARN"`
+	StorageAWSExternalID *string                   `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_EXTERNAL_ID"`
+	Encryption           *ExternalVolumeEncryption `ddl:"list,parentheses,no_comma" sql:"ENCRYPTION ="`
+}
+
+type GCSExternalVolumeStorageLocation struct {
+	storageProvider string                    `ddl:"static" sql:"STORAGE_PROVIDER = 'GCS'"` //lint:ignore U1000 This is used in the ddl tag
+	StorageBaseURL  string                    `ddl:"parameter,single_quotes" sql:"STORAGE_BASE_URL"`
+	Encryption      *ExternalVolumeEncryption `ddl:"list,parentheses,no_comma" sql:"ENCRYPTION ="`
+}
+
+type AzureExternalVolumeStorageLocation struct {
+	storageProvider string `ddl:"static" sql:"STORAGE_PROVIDER = 'AZURE'"` //lint:ignore U1000 This is used in the ddl tag
+	AzureTenantID   string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+	StorageBaseURL  string `ddl:"parameter,single_quotes" sql:"STORAGE_BASE_URL"`
+}
+
+type ExternalVolumeEncryption struct {
+	Type     ExternalVolumeEncryptionType `ddl:"parameter,single_quotes" sql:"TYPE"`
+	KMSKeyID *string                      `ddl:"parameter,single_quotes" sql:"KMS_KEY_ID"`
+}
+
+func (v *ExternalVolumeStorageLocation) validate() error {
+	if v.Name == "" {
+		return errors.New("Name is required for every storage location")
+	}
+	if !exactlyOneValueSet(v.S3StorageLocationParams, v.GCSStorageLocationParams, v.AzureStorageLocationParams) {
+		return fmt.Errorf("exactly one of S3StorageLocationParams, GCSStorageLocationParams, AzureStorageLocationParams must be set for storage location %s", v.Name)
+	}
+	switch {
+	case valueSet(v.S3StorageLocationParams):
+		p := v.S3StorageLocationParams
+		if p.StorageProvider == "" || p.StorageBaseURL == "" || p.StorageAWSRoleARN == "" {
+			return fmt.Errorf("StorageProvider, StorageBaseURL and StorageAWSRoleARN are required for S3 storage location %s", v.Name)
+		}
+		if valueSet(p.Encryption) {
+			return p.Encryption.validate(ExternalVolumeEncryptionTypeAWSSSES3, ExternalVolumeEncryptionTypeAWSSSEKMS, ExternalVolumeEncryptionTypeNone)
+		}
+	case valueSet(v.GCSStorageLocationParams):
+		p := v.GCSStorageLocationParams
+		if p.StorageBaseURL == "" {
+			return fmt.Errorf("StorageBaseURL is required for GCS storage location %s", v.Name)
+		}
+		if valueSet(p.Encryption) {
+			return p.Encryption.validate(ExternalVolumeEncryptionTypeGCSSSEKMS, ExternalVolumeEncryptionTypeNone)
+		}
+	case valueSet(v.AzureStorageLocationParams):
+		p := v.AzureStorageLocationParams
+		if p.AzureTenantID == "" || p.StorageBaseURL == "" {
+			return fmt.Errorf("AzureTenantID and StorageBaseURL are required for Azure storage location %s", v.Name)
+		}
+	}
+	return nil
+}
+
+func (v *ExternalVolumeEncryption) validate(allowed ...ExternalVolumeEncryptionType) error {
+	valid := false
+	for _, t := range allowed {
+		if v.Type == t {
+			valid = true
+		}
+	}
+	if !valid {
+		return fmt.Errorf("encryption type %q is not supported for this storage provider", v.Type)
+	}
+	if valueSet(v.KMSKeyID) && v.Type != ExternalVolumeEncryptionTypeAWSSSEKMS && v.Type != ExternalVolumeEncryptionTypeGCSSSEKMS {
+		return errors.New("KMSKeyID can only be set for KMS encryption")
+	}
+	return nil
+}
+
+type CreateExternalVolumeOptions struct {
+	create           bool                            `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace        *bool                           `ddl:"keyword" sql:"OR REPLACE"`
+	externalVolume   bool                            `ddl:"static" sql:"EXTERNAL VOLUME"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists      *bool                           `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name             AccountObjectIdentifier         `ddl:"identifier"`
+	storageLocations []ExternalVolumeStorageLocation `ddl:"parameter,parentheses,element_parentheses" sql:"STORAGE_LOCATIONS"`
+	AllowWrites      *bool                           `ddl:"parameter" sql:"ALLOW_WRITES"`
+	Comment          *string                         `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateExternalVolumeOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if len(opts.storageLocations) == 0 {
+		return errors.New("at least one storage location must be set")
+	}
+	for _, location := range opts.storageLocations {
+		if err := location.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *externalVolumes) Create(ctx context.Context, id AccountObjectIdentifier, storageLocations []ExternalVolumeStorageLocation, opts *CreateExternalVolumeOptions) error {
+	if opts == nil {
+		opts = &CreateExternalVolumeOptions{}
+	}
+	opts.name = id
+	opts.storageLocations = storageLocations
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterExternalVolumeOptions struct {
+	alter                 bool                           `ddl:"static" sql:"ALTER"`           //lint:ignore U1000 This is used in the ddl tag
+	externalVolume        bool                           `ddl:"static" sql:"EXTERNAL VOLUME"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists              *bool                          `ddl:"keyword" sql:"IF EXISTS"`
+	name                  AccountObjectIdentifier        `ddl:"identifier"`
+	AddStorageLocation    *ExternalVolumeStorageLocation `ddl:"list,parentheses,no_comma" sql:"ADD STORAGE_LOCATION ="`
+	RemoveStorageLocation *string                        `ddl:"parameter,single_quotes,no_equals" sql:"REMOVE STORAGE_LOCATION"`
+	Set                   *ExternalVolumeSet             `ddl:"keyword" sql:"SET"`
+}
+
+type ExternalVolumeSet struct {
+	AllowWrites *bool   `ddl:"parameter" sql:"ALLOW_WRITES"`
+	Comment     *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *AlterExternalVolumeOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.AddStorageLocation, opts.RemoveStorageLocation, opts.Set) {
+		return errors.New("exactly one of AddStorageLocation, RemoveStorageLocation, Set must be set")
+	}
+	if valueSet(opts.AddStorageLocation) {
+		if err := opts.AddStorageLocation.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.AllowWrites, opts.Set.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	return nil
+}
+
+func (v *externalVolumes) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterExternalVolumeOptions) error {
+	if opts == nil {
+		opts = &AlterExternalVolumeOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropExternalVolumeOptions struct {
+	drop           bool                    `ddl:"static" sql:"DROP"`            //lint:ignore U1000 This is used in the ddl tag
+	externalVolume bool                    `ddl:"static" sql:"EXTERNAL VOLUME"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists       *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name           AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropExternalVolumeOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *externalVolumes) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropExternalVolumeOptions) error {
+	if opts == nil {
+		opts = &DropExternalVolumeOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowExternalVolumeOptions represents the options for listing external volumes.
+type ShowExternalVolumeOptions struct {
+	show            bool  `ddl:"static" sql:"SHOW"`             //lint:ignore U1000 This is used in the ddl tag
+	externalVolumes bool  `ddl:"static" sql:"EXTERNAL VOLUMES"` //lint:ignore U1000 This is used in the ddl tag
+	Like            *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowExternalVolumeOptions) validate() error {
+	return nil
+}
+
+type ExternalVolume struct {
+	Name        string
+	AllowWrites bool
+	Comment     string
+}
+
+func (v *ExternalVolume) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ExternalVolume) ObjectType() ObjectType {
+	return ObjectTypeExternalVolume
+}
+
+type externalVolumeRow struct {
+	Name        string         `db:"name"`
+	AllowWrites bool           `db:"allow_writes"`
+	Comment     sql.NullString `db:"comment"`
+}
+
+func (row *externalVolumeRow) toExternalVolume() *ExternalVolume {
+	v := &ExternalVolume{
+		Name:        row.Name,
+		AllowWrites: row.AllowWrites,
+	}
+	if row.Comment.Valid {
+		v.Comment = row.Comment.String
+	}
+	return v
+}
+
+func (v *externalVolumes) Show(ctx context.Context, opts *ShowExternalVolumeOptions) ([]*ExternalVolume, error) {
+	if opts == nil {
+		opts = &ShowExternalVolumeOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []externalVolumeRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ExternalVolume, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toExternalVolume()
+	}
+	return resultList, nil
+}
+
+func (v *externalVolumes) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalVolume, error) {
+	externalVolumes, err := v.Show(ctx, &ShowExternalVolumeOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, externalVolume := range externalVolumes {
+		if externalVolume.Name == id.Name() {
+			return externalVolume, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeExternalVolumeOptions struct {
+	describe       bool                    `ddl:"static" sql:"DESCRIBE"`        //lint:ignore U1000 This is used in the ddl tag
+	externalVolume bool                    `ddl:"static" sql:"EXTERNAL VOLUME"` //lint:ignore U1000 This is used in the ddl tag
+	name           AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeExternalVolumeOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ExternalVolumeDetails contains the typed output of DESCRIBE EXTERNAL VOLUME.
+type ExternalVolumeDetails struct {
+	StorageLocations []ExternalVolumeStorageLocationDetails
+	// Active is the name of the storage location currently in use.
+	Active      string
+	AllowWrites bool
+	Comment     string
+}
+
+// ExternalVolumeStorageLocationDetails is a single STORAGE_LOCATION_<n> property of DESCRIBE EXTERNAL VOLUME.
+// Fields that are not relevant to the location's storage provider are left empty.
+type ExternalVolumeStorageLocationDetails struct {
+	Name                 string `json:"NAME"`
+	StorageProvider      string `json:"STORAGE_PROVIDER"`
+	StorageBaseURL       string `json:"STORAGE_BASE_URL"`
+	StorageAWSRoleARN    string `json:"STORAGE_AWS_ROLE_ARN"`
+	StorageAWSIAMUserARN string `json:"STORAGE_AWS_IAM_USER_ARN"`
+	StorageAWSExternalID string `json:"STORAGE_AWS_EXTERNAL_ID"`
+	EncryptionType       string `json:"ENCRYPTION_TYPE"`
+	EncryptionKMSKeyID   string `json:"ENCRYPTION_KMS_KEY_ID"`
+	AzureTenantID        string `json:"AZURE_TENANT_ID"`
+}
+
+func externalVolumeDetailsFromRows(rows []integrationPropertyRow) (*ExternalVolumeDetails, error) {
+	v := &ExternalVolumeDetails{}
+	for _, row := range rows {
+		switch {
+		case strings.HasPrefix(row.Property, "STORAGE_LOCATION_"):
+			var location ExternalVolumeStorageLocationDetails
+			if err := json.Unmarshal([]byte(row.Value), &location); err != nil {
+				return nil, fmt.Errorf("parse %s: %w", row.Property, err)
+			}
+			v.StorageLocations = append(v.StorageLocations, location)
+		case row.Property == "ACTIVE":
+			v.Active = row.Value
+		case row.Property == "ALLOW_WRITES":
+			v.AllowWrites = row.toBool()
+		case row.Property == "COMMENT":
+			v.Comment = row.Value
+		}
+	}
+	return v, nil
+}
+
+func (v *externalVolumes) Describe(ctx context.Context, id AccountObjectIdentifier) (*ExternalVolumeDetails, error) {
+	opts := &describeExternalVolumeOptions{
+		name: id,
+	}
+	rows, err := describeRows[integrationPropertyRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return externalVolumeDetailsFromRows(rows)
+}
@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalVolumes(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	s3Location := func(name string) ExternalVolumeStorageLocation {
+		return ExternalVolumeStorageLocation{
+			Name: name,
+			S3StorageLocationParams: &S3ExternalVolumeStorageLocation{
+				StorageProvider:   S3StorageProviderS3,
+				StorageBaseURL:    "s3://foo/" + name + "/",
+				StorageAWSRoleARN: "arn:aws:iam::000000000001:role/test",
+				Encryption:        &ExternalVolumeEncryption{Type: ExternalVolumeEncryptionTypeAWSSSES3},
+			},
+		}
+	}
+
+	createExternalVolume := func(t *testing.T) AccountObjectIdentifier {
+		t.Helper()
+		id := randomAccountObjectIdentifier(t)
+		err := client.ExternalVolumes.Create(ctx, id, []ExternalVolumeStorageLocation{s3Location("first")}, &CreateExternalVolumeOptions{
+			AllowWrites: Bool(true),
+			Comment:     String("some comment"),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.ExternalVolumes.Drop(ctx, id, &DropExternalVolumeOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("create, show and describe", func(t *testing.T) {
+		id := createExternalVolume(t)
+
+		externalVolume, err := client.ExternalVolumes.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), externalVolume.Name)
+		assert.True(t, externalVolume.AllowWrites)
+		assert.Equal(t, "some comment", externalVolume.Comment)
+
+		details, err := client.ExternalVolumes.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details.StorageLocations, 1)
+		assert.Equal(t, "first", details.StorageLocations[0].Name)
+		assert.Equal(t, "S3", details.StorageLocations[0].StorageProvider)
+		assert.Equal(t, "s3://foo/first/", details.StorageLocations[0].StorageBaseURL)
+		assert.Equal(t, "AWS_SSE_S3", details.StorageLocations[0].EncryptionType)
+		assert.NotEmpty(t, details.StorageLocations[0].StorageAWSIAMUserARN)
+		assert.True(t, details.AllowWrites)
+	})
+
+	t.Run("alter: add and remove storage location", func(t *testing.T) {
+		id := createExternalVolume(t)
+
+		err := client.ExternalVolumes.Alter(ctx, id, &AlterExternalVolumeOptions{
+			AddStorageLocation: Pointer(s3Location("second")),
+		})
+		require.NoError(t, err)
+		details, err := client.ExternalVolumes.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details.StorageLocations, 2)
+		assert.Equal(t, "second", details.StorageLocations[1].Name)
+
+		err = client.ExternalVolumes.Alter(ctx, id, &AlterExternalVolumeOptions{
+			RemoveStorageLocation: String("first"),
+		})
+		require.NoError(t, err)
+		details, err = client.ExternalVolumes.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, details.StorageLocations, 1)
+		assert.Equal(t, "second", details.StorageLocations[0].Name)
+	})
+
+	t.Run("alter: set", func(t *testing.T) {
+		id := createExternalVolume(t)
+
+		err := client.ExternalVolumes.Alter(ctx, id, &AlterExternalVolumeOptions{
+			Set: &ExternalVolumeSet{
+				AllowWrites: Bool(false),
+				Comment:     String("new comment"),
+			},
+		})
+		require.NoError(t, err)
+		externalVolume, err := client.ExternalVolumes.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, externalVolume.AllowWrites)
+		assert.Equal(t, "new comment", externalVolume.Comment)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		id := createExternalVolume(t)
+
+		err := client.ExternalVolumes.Drop(ctx, id, nil)
+		require.NoError(t, err)
+		_, err = client.ExternalVolumes.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,231 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalVolumesCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("multiple locations", func(t *testing.T) {
+		opts := &CreateExternalVolumeOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			storageLocations: []ExternalVolumeStorageLocation{
+				{
+					Name: "s3-location",
+					S3StorageLocationParams: &S3ExternalVolumeStorageLocation{
+						StorageProvider:      S3StorageProviderS3,
+						StorageBaseURL:       "s3://bucket/path/",
+						StorageAWSRoleARN:    "arn:aws:iam::001234567890:role/myrole",
+						StorageAWSExternalID: String("external_id"),
+						Encryption: &ExternalVolumeEncryption{
+							Type:     ExternalVolumeEncryptionTypeAWSSSEKMS,
+							KMSKeyID: String("1234abcd"),
+						},
+					},
+				},
+				{
+					Name: "gcs-location",
+					GCSStorageLocationParams: &GCSExternalVolumeStorageLocation{
+						StorageBaseURL: "gcs://bucket/path/",
+						Encryption:     &ExternalVolumeEncryption{Type: ExternalVolumeEncryptionTypeNone},
+					},
+				},
+				{
+					Name: "azure-location",
+					AzureStorageLocationParams: &AzureExternalVolumeStorageLocation{
+						AzureTenantID:  "a123b4c5-1234-123a-a12b-1a23b45678c9",
+						StorageBaseURL: "azure://account.blob.core.windows.net/container/",
+					},
+				},
+			},
+			AllowWrites: Bool(false),
+			Comment:     String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE EXTERNAL VOLUME ` + id.FullyQualifiedName() + ` STORAGE_LOCATIONS = (` +
+			`(NAME = 's3-location' STORAGE_PROVIDER = 'S3' STORAGE_BASE_URL = 's3://bucket/path/' STORAGE_AWS_ROLE_ARN = 'arn:aws:iam::001234567890:role/myrole' STORAGE_AWS_EXTERNAL_ID = 'external_id' ENCRYPTION = (TYPE = 'AWS_SSE_KMS' KMS_KEY_ID = '1234abcd')), ` +
+			`(NAME = 'gcs-location' STORAGE_PROVIDER = 'GCS' STORAGE_BASE_URL = 'gcs://bucket/path/' ENCRYPTION = (TYPE = 'NONE')), ` +
+			`(NAME = 'azure-location' STORAGE_PROVIDER = 'AZURE' AZURE_TENANT_ID = 'a123b4c5-1234-123a-a12b-1a23b45678c9' STORAGE_BASE_URL = 'azure://account.blob.core.windows.net/container/')` +
+			`) ALLOW_WRITES = false COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: no storage locations", func(t *testing.T) {
+		opts := &CreateExternalVolumeOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: location without provider", func(t *testing.T) {
+		opts := &CreateExternalVolumeOptions{
+			name:             id,
+			storageLocations: []ExternalVolumeStorageLocation{{Name: "location"}},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: unsupported encryption type", func(t *testing.T) {
+		opts := &CreateExternalVolumeOptions{
+			name: id,
+			storageLocations: []ExternalVolumeStorageLocation{{
+				Name: "location",
+				GCSStorageLocationParams: &GCSExternalVolumeStorageLocation{
+					StorageBaseURL: "gcs://bucket/path/",
+					Encryption:     &ExternalVolumeEncryption{Type: ExternalVolumeEncryptionTypeAWSSSES3},
+				},
+			}},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: incompatible or replace and if not exists", func(t *testing.T) {
+		opts := &CreateExternalVolumeOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestExternalVolumesAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("add storage location", func(t *testing.T) {
+		opts := &AlterExternalVolumeOptions{
+			name: id,
+			AddStorageLocation: &ExternalVolumeStorageLocation{
+				Name: "s3-location",
+				S3StorageLocationParams: &S3ExternalVolumeStorageLocation{
+					StorageProvider:   S3StorageProviderS3GOV,
+					StorageBaseURL:    "s3gov://bucket/path/",
+					StorageAWSRoleARN: "arn:aws-us-gov:iam::001234567890:role/myrole",
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER EXTERNAL VOLUME ` + id.FullyQualifiedName() + ` ADD STORAGE_LOCATION = (NAME = 's3-location' STORAGE_PROVIDER = 'S3GOV' STORAGE_BASE_URL = 's3gov://bucket/path/' STORAGE_AWS_ROLE_ARN = 'arn:aws-us-gov:iam::001234567890:role/myrole')`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("remove storage location", func(t *testing.T) {
+		opts := &AlterExternalVolumeOptions{
+			IfExists:              Bool(true),
+			name:                  id,
+			RemoveStorageLocation: String("s3-location"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER EXTERNAL VOLUME IF EXISTS ` + id.FullyQualifiedName() + ` REMOVE STORAGE_LOCATION 's3-location'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterExternalVolumeOptions{
+			name: id,
+			Set: &ExternalVolumeSet{
+				AllowWrites: Bool(true),
+				Comment:     String("some comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER EXTERNAL VOLUME ` + id.FullyQualifiedName() + ` SET ALLOW_WRITES = true COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: exactly one action", func(t *testing.T) {
+		opts := &AlterExternalVolumeOptions{
+			name:                  id,
+			RemoveStorageLocation: String("s3-location"),
+			Set:                   &ExternalVolumeSet{Comment: String("some comment")},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterExternalVolumeOptions{
+			name: id,
+			Set:  &ExternalVolumeSet{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestExternalVolumesDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &DropExternalVolumeOptions{
+		IfExists: Bool(true),
+		name:     id,
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := `DROP EXTERNAL VOLUME IF EXISTS ` + id.FullyQualifiedName()
+	assert.Equal(t, expected, actual)
+}
+
+func TestExternalVolumesShow(t *testing.T) {
+	opts := &ShowExternalVolumeOptions{
+		Like: &Like{Pattern: String("volume%")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := `SHOW EXTERNAL VOLUMES LIKE 'volume%'`
+	assert.Equal(t, expected, actual)
+}
+
+func TestExternalVolumesDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("sql", func(t *testing.T) {
+		opts := &describeExternalVolumeOptions{name: id}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `DESCRIBE EXTERNAL VOLUME ` + id.FullyQualifiedName()
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("details from rows", func(t *testing.T) {
+		rows := []integrationPropertyRow{
+			{Property: "ALLOW_WRITES", Value: "true"},
+			{Property: "STORAGE_LOCATION_1", Value: `{"NAME":"s3-location","STORAGE_PROVIDER":"S3","STORAGE_BASE_URL":"s3://bucket/path/","STORAGE_ALLOWED_LOCATIONS":["s3://bucket/path/*"],"STORAGE_AWS_ROLE_ARN":"arn:aws:iam::001234567890:role/myrole","STORAGE_AWS_IAM_USER_ARN":"arn:aws:iam::123456789012:user/abc","STORAGE_AWS_EXTERNAL_ID":"external_id","ENCRYPTION_TYPE":"AWS_SSE_KMS","ENCRYPTION_KMS_KEY_ID":"1234abcd"}`},
+			{Property: "STORAGE_LOCATION_2", Value: `{"NAME":"azure-location","STORAGE_PROVIDER":"AZURE","STORAGE_BASE_URL":"azure://account.blob.core.windows.net/container/","AZURE_TENANT_ID":"tenant","ENCRYPTION_TYPE":"NONE"}`},
+			{Property: "ACTIVE", Value: "s3-location"},
+			{Property: "COMMENT", Value: "some comment"},
+		}
+		details, err := externalVolumeDetailsFromRows(rows)
+		require.NoError(t, err)
+		assert.True(t, details.AllowWrites)
+		assert.Equal(t, "s3-location", details.Active)
+		assert.Equal(t, "some comment", details.Comment)
+		require.Len(t, details.StorageLocations, 2)
+		assert.Equal(t, ExternalVolumeStorageLocationDetails{
+			Name:                 "s3-location",
+			StorageProvider:      "S3",
+			StorageBaseURL:       "s3://bucket/path/",
+			StorageAWSRoleARN:    "arn:aws:iam::001234567890:role/myrole",
+			StorageAWSIAMUserARN: "arn:aws:iam::123456789012:user/abc",
+			StorageAWSExternalID: "external_id",
+			EncryptionType:       "AWS_SSE_KMS",
+			EncryptionKMSKeyID:   "1234abcd",
+		}, details.StorageLocations[0])
+		assert.Equal(t, "azure-location", details.StorageLocations[1].Name)
+		assert.Equal(t, "tenant", details.StorageLocations[1].AzureTenantID)
+	})
+
+	t.Run("details from rows: invalid location", func(t *testing.T) {
+		_, err := externalVolumeDetailsFromRows([]integrationPropertyRow{{Property: "STORAGE_LOCATION_1", Value: "not json"}})
+		assert.Error(t, err)
+	})
+}
@@ -69,6 +69,21 @@ func (opts *CreateFailoverGroupOptions) validate() error {
 	if !validObjectidentifier(opts.name) {
 		return ErrInvalidObjectIdentifier
 	}
+	if len(opts.objectTypes) == 0 {
+		return errors.New("at least one object type must be set")
+	}
+	if len(opts.allowedAccounts) == 0 {
+		return errors.New("at least one allowed account must be set")
+	}
+	if len(opts.AllowedDatabases) > 0 && !slices.Contains(opts.objectTypes, PluralObjectTypeDatabases) {
+		return errors.New("DATABASES must be set in OBJECT_TYPES when setting allowed databases")
+	}
+	if len(opts.AllowedShares) > 0 && !slices.Contains(opts.objectTypes, PluralObjectTypeShares) {
+		return errors.New("SHARES must be set in OBJECT_TYPES when setting allowed shares")
+	}
+	if len(opts.AllowedIntegrationTypes) > 0 && !slices.Contains(opts.objectTypes, PluralObjectTypeIntegrations) {
+		return errors.New("INTEGRATIONS must be set in OBJECT_TYPES when setting allowed integration types")
+	}
 	return nil
 }
 
@@ -82,7 +97,7 @@ func (v *failoverGroups) Create(ctx context.Context, id AccountObjectIdentifier,
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -117,7 +132,7 @@ func (v *failoverGroups) CreateSecondaryReplicationGroup(ctx context.Context, id
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -187,10 +202,16 @@ type FailoverGroupAdd struct {
 	AllowedDatabases   []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_DATABASES"`
 	AllowedShares      []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_SHARES"`
 	AllowedAccounts    []AccountIdentifier       `ddl:"parameter,reverse" sql:"TO ALLOWED_ACCOUNTS"`
-	IgnoreEditionCheck *bool                     `ddl:"keyword" sql:"IGNORE_EDITION_CHECK"`
+	IgnoreEditionCheck *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
 }
 
 func (v *FailoverGroupAdd) validate() error {
+	if !exactlyOneValueSet(v.AllowedDatabases, v.AllowedShares, v.AllowedAccounts) {
+		return errors.New("exactly one of AllowedDatabases, AllowedShares, AllowedAccounts must be set")
+	}
+	if valueSet(v.IgnoreEditionCheck) && !valueSet(v.AllowedAccounts) {
+		return errors.New("IgnoreEditionCheck can only be set together with AllowedAccounts")
+	}
 	return nil
 }
 
@@ -201,6 +222,12 @@ type FailoverGroupMove struct {
 }
 
 func (v *FailoverGroupMove) validate() error {
+	if !exactlyOneValueSet(v.Databases, v.Shares) {
+		return errors.New("exactly one of Databases, Shares must be set")
+	}
+	if !validObjectidentifier(v.To) {
+		return ErrInvalidObjectIdentifier
+	}
 	return nil
 }
 
@@ -211,6 +238,9 @@ type FailoverGroupRemove struct {
 }
 
 func (v *FailoverGroupRemove) validate() error {
+	if !exactlyOneValueSet(v.AllowedDatabases, v.AllowedShares, v.AllowedAccounts) {
+		return errors.New("exactly one of AllowedDatabases, AllowedShares, AllowedAccounts must be set")
+	}
 	return nil
 }
 
@@ -223,7 +253,7 @@ func (v *failoverGroups) AlterSource(ctx context.Context, id AccountObjectIdenti
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -260,7 +290,7 @@ func (v *failoverGroups) AlterTarget(ctx context.Context, id AccountObjectIdenti
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -290,7 +320,7 @@ func (v *failoverGroups) Drop(ctx context.Context, id AccountObjectIdentifier, o
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -453,7 +483,7 @@ func (v *failoverGroups) Show(ctx context.Context, opts *ShowFailoverGroupOption
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -508,7 +538,7 @@ func (v *failoverGroups) ShowDatabases(ctx context.Context, id AccountObjectIden
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -546,7 +576,7 @@ func (v *failoverGroups) ShowShares(ctx context.Context, id AccountObjectIdentif
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
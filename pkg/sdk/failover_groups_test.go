@@ -163,6 +163,81 @@ func TestFailoverGroupAlterSource(t *testing.T) {
 	})
 }
 
+func TestFailoverGroupAlterSourceAddAccounts(t *testing.T) {
+	opts := &AlterSourceFailoverGroupOptions{
+		name: NewAccountObjectIdentifier("fg1"),
+		Add: &FailoverGroupAdd{
+			AllowedAccounts:    []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+			IgnoreEditionCheck: Bool(true),
+		},
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := `ALTER FAILOVER GROUP "fg1" ADD "MY_ORG.MY_ACCOUNT" TO ALLOWED_ACCOUNTS IGNORE EDITION CHECK`
+	assert.Equal(t, expected, actual)
+}
+
+func TestFailoverGroupsValidation(t *testing.T) {
+	account := NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")
+
+	t.Run("create: no object types", func(t *testing.T) {
+		opts := &CreateFailoverGroupOptions{
+			name:            NewAccountObjectIdentifier("fg1"),
+			allowedAccounts: []AccountIdentifier{account},
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("create: allowed shares without object type", func(t *testing.T) {
+		opts := &CreateFailoverGroupOptions{
+			name:            NewAccountObjectIdentifier("fg1"),
+			objectTypes:     []PluralObjectType{PluralObjectTypeDatabases},
+			AllowedShares:   []AccountObjectIdentifier{NewAccountObjectIdentifier("share1")},
+			allowedAccounts: []AccountIdentifier{account},
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("add: more than one list", func(t *testing.T) {
+		opts := &AlterSourceFailoverGroupOptions{
+			name: NewAccountObjectIdentifier("fg1"),
+			Add: &FailoverGroupAdd{
+				AllowedDatabases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+				AllowedAccounts:  []AccountIdentifier{account},
+			},
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("add: ignore edition check without accounts", func(t *testing.T) {
+		opts := &AlterSourceFailoverGroupOptions{
+			name: NewAccountObjectIdentifier("fg1"),
+			Add: &FailoverGroupAdd{
+				AllowedShares:      []AccountObjectIdentifier{NewAccountObjectIdentifier("share1")},
+				IgnoreEditionCheck: Bool(true),
+			},
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("move: no target group", func(t *testing.T) {
+		opts := &AlterSourceFailoverGroupOptions{
+			name: NewAccountObjectIdentifier("fg1"),
+			Move: &FailoverGroupMove{Databases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")}},
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("remove: nothing to remove", func(t *testing.T) {
+		opts := &AlterSourceFailoverGroupOptions{
+			name:   NewAccountObjectIdentifier("fg1"),
+			Remove: &FailoverGroupRemove{},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
 func TestFailoverGroupsAlterTarget(t *testing.T) {
 	t.Run("resume", func(t *testing.T) {
 		opts := &AlterTargetFailoverGroupOptions{
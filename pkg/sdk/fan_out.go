@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultFanOutLimit is used when the client was not configured with WithFanOutLimit.
+const defaultFanOutLimit = 10
+
+// FanOut calls fn for every item concurrently and returns the results in the order of items.
+// At most the client's fan-out limit (see WithFanOutLimit) calls are in flight at a time, so
+// that data sources describing hundreds of objects do not exhaust the connection pool.
+// The first error cancels the context passed to the remaining calls and is returned.
+//
+// A typical use is describing every object returned by a Show call:
+//
+//	details, err := sdk.FanOut(ctx, client, warehouses, func(ctx context.Context, wh *sdk.Warehouse) (*sdk.WarehouseDetails, error) {
+//		return client.Warehouses.Describe(ctx, wh.ID())
+//	})
+func FanOut[T any, R any](ctx context.Context, client *Client, items []T, fn func(ctx context.Context, item T) (R, error)) ([]R, error) {
+	results := make([]R, len(items))
+	if len(items) == 0 {
+		return results, nil
+	}
+	limit := client.fanOutLimit
+	if limit <= 0 {
+		limit = defaultFanOutLimit
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(limit)
+	for i, item := range items {
+		i, item := i, item
+		g.Go(func() error {
+			result, err := fn(ctx, item)
+			if err != nil {
+				return err
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFanOut(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keeps the order of items", func(t *testing.T) {
+		client := &Client{}
+		items := []int{5, 1, 4, 2, 3}
+		results, err := FanOut(ctx, client, items, func(ctx context.Context, item int) (int, error) {
+			time.Sleep(time.Duration(item) * time.Millisecond)
+			return item * 10, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int{50, 10, 40, 20, 30}, results)
+	})
+
+	t.Run("respects the limit", func(t *testing.T) {
+		client := &Client{fanOutLimit: 2}
+		var inFlight, maxInFlight int32
+		items := make([]int, 20)
+		_, err := FanOut(ctx, client, items, func(ctx context.Context, item int) (int, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			return item, nil
+		})
+		require.NoError(t, err)
+		assert.LessOrEqual(t, maxInFlight, int32(2))
+	})
+
+	t.Run("returns the first error", func(t *testing.T) {
+		client := &Client{}
+		expected := errors.New("describe failed")
+		_, err := FanOut(ctx, client, []int{1, 2, 3}, func(ctx context.Context, item int) (int, error) {
+			if item == 2 {
+				return 0, expected
+			}
+			return item, nil
+		})
+		require.ErrorIs(t, err, expected)
+	})
+
+	t.Run("no items", func(t *testing.T) {
+		results, err := FanOut(ctx, &Client{}, []int{}, func(ctx context.Context, item int) (int, error) {
+			return item, nil
+		})
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
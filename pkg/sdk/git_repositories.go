@@ -0,0 +1,417 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ GitRepositories = (*gitRepositories)(nil)
+
+// GitRepositories are stages mirroring a remote git repository, so that the files of its branches,
+// tags and commits can be used like any other staged file.
+type GitRepositories interface {
+	// Create creates a new git repository clone.
+	Create(ctx context.Context, id SchemaObjectIdentifier, origin string, apiIntegration AccountObjectIdentifier, opts *CreateGitRepositoryOptions) error
+	// Alter modifies an existing git repository clone.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterGitRepositoryOptions) error
+	// Fetch fetches the latest changes from the remote repository.
+	Fetch(ctx context.Context, id SchemaObjectIdentifier) error
+	// Drop removes a git repository clone.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropGitRepositoryOptions) error
+	// Show returns a list of git repositories.
+	Show(ctx context.Context, opts *ShowGitRepositoryOptions) ([]*GitRepository, error)
+	// ShowByID returns a git repository by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*GitRepository, error)
+	// Describe returns the details of a git repository.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*GitRepository, error)
+	// ShowBranches returns the branches of a git repository.
+	ShowBranches(ctx context.Context, id SchemaObjectIdentifier, opts *ShowGitBranchesOptions) ([]*GitRef, error)
+	// ShowTags returns the tags of a git repository.
+	ShowTags(ctx context.Context, id SchemaObjectIdentifier, opts *ShowGitTagsOptions) ([]*GitRef, error)
+}
+
+// gitRepositories implements GitRepositories.
+type gitRepositories struct {
+	client *Client
+}
+
+type CreateGitRepositoryOptions struct {
+	create        bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace     *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	gitRepository bool                   `ddl:"static" sql:"GIT REPOSITORY"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists   *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name          SchemaObjectIdentifier `ddl:"identifier"`
+
+	// required
+	origin         string                  `ddl:"parameter,single_quotes" sql:"ORIGIN"`
+	apiIntegration AccountObjectIdentifier `ddl:"identifier,equals" sql:"API_INTEGRATION"`
+
+	// optional
+	// GitCredentials is a secret holding the credentials, it is only needed for private repositories.
+	GitCredentials SchemaObjectIdentifier `ddl:"identifier,equals" sql:"GIT_CREDENTIALS"`
+	Comment        *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag            []TagAssociation       `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+func (opts *CreateGitRepositoryOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.origin == "" {
+		return errors.New("origin is required")
+	}
+	if !validObjectidentifier(opts.apiIntegration) {
+		return errors.New("API integration is required")
+	}
+	return nil
+}
+
+func (v *gitRepositories) Create(ctx context.Context, id SchemaObjectIdentifier, origin string, apiIntegration AccountObjectIdentifier, opts *CreateGitRepositoryOptions) error {
+	if opts == nil {
+		opts = &CreateGitRepositoryOptions{}
+	}
+	opts.name = id
+	opts.origin = origin
+	opts.apiIntegration = apiIntegration
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterGitRepositoryOptions struct {
+	alter         bool                   `ddl:"static" sql:"ALTER"`          //lint:ignore U1000 This is used in the ddl tag
+	gitRepository bool                   `ddl:"static" sql:"GIT REPOSITORY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists      *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name          SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	Set      *GitRepositorySet   `ddl:"keyword" sql:"SET"`
+	Unset    *GitRepositoryUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	fetch    *bool               `ddl:"keyword" sql:"FETCH"`
+	SetTag   []TagAssociation    `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag []ObjectIdentifier  `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterGitRepositoryOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.fetch, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.APIIntegration, opts.Set.GitCredentials, opts.Set.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.GitCredentials, opts.Unset.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+type GitRepositorySet struct {
+	APIIntegration AccountObjectIdentifier `ddl:"identifier,equals" sql:"API_INTEGRATION"`
+	GitCredentials SchemaObjectIdentifier  `ddl:"identifier,equals" sql:"GIT_CREDENTIALS"`
+	Comment        *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type GitRepositoryUnset struct {
+	GitCredentials *bool `ddl:"keyword" sql:"GIT_CREDENTIALS"`
+	Comment        *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *gitRepositories) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterGitRepositoryOptions) error {
+	if opts == nil {
+		opts = &AlterGitRepositoryOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *gitRepositories) Fetch(ctx context.Context, id SchemaObjectIdentifier) error {
+	return v.Alter(ctx, id, &AlterGitRepositoryOptions{fetch: Bool(true)})
+}
+
+type DropGitRepositoryOptions struct {
+	drop          bool                   `ddl:"static" sql:"DROP"`           //lint:ignore U1000 This is used in the ddl tag
+	gitRepository bool                   `ddl:"static" sql:"GIT REPOSITORY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists      *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name          SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropGitRepositoryOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *gitRepositories) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropGitRepositoryOptions) error {
+	if opts == nil {
+		opts = &DropGitRepositoryOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowGitRepositoryOptions struct {
+	show            bool `ddl:"static" sql:"SHOW"`             //lint:ignore U1000 This is used in the ddl tag
+	gitRepositories bool `ddl:"static" sql:"GIT REPOSITORIES"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	In   *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowGitRepositoryOptions) validate() error {
+	return nil
+}
+
+type GitRepository struct {
+	CreatedOn      time.Time
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	Origin         string
+	APIIntegration string
+	GitCredentials string
+	Owner          string
+	OwnerRoleType  string
+	Comment        string
+	// LastFetchedAt is zero when the repository has not been fetched since it was created.
+	LastFetchedAt time.Time
+}
+
+func (v *GitRepository) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *GitRepository) ObjectType() ObjectType {
+	return ObjectTypeGitRepository
+}
+
+type gitRepositoryRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	Origin         string         `db:"origin"`
+	APIIntegration sql.NullString `db:"api_integration"`
+	GitCredentials sql.NullString `db:"git_credentials"`
+	Owner          sql.NullString `db:"owner"`
+	OwnerRoleType  sql.NullString `db:"owner_role_type"`
+	Comment        sql.NullString `db:"comment"`
+	LastFetchedAt  sql.NullTime   `db:"last_fetched_at"`
+}
+
+func (row gitRepositoryRow) toGitRepository() *GitRepository {
+	repository := &GitRepository{
+		CreatedOn:      row.CreatedOn,
+		Name:           row.Name,
+		DatabaseName:   row.DatabaseName,
+		SchemaName:     row.SchemaName,
+		Origin:         row.Origin,
+		APIIntegration: row.APIIntegration.String,
+		GitCredentials: row.GitCredentials.String,
+		Owner:          row.Owner.String,
+		OwnerRoleType:  row.OwnerRoleType.String,
+		Comment:        row.Comment.String,
+	}
+	if row.LastFetchedAt.Valid {
+		repository.LastFetchedAt = row.LastFetchedAt.Time
+	}
+	return repository
+}
+
+func (v *gitRepositories) Show(ctx context.Context, opts *ShowGitRepositoryOptions) ([]*GitRepository, error) {
+	if opts == nil {
+		opts = &ShowGitRepositoryOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []gitRepositoryRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*GitRepository, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toGitRepository()
+	}
+	return resultList, nil
+}
+
+func (v *gitRepositories) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*GitRepository, error) {
+	repositories, err := v.Show(ctx, &ShowGitRepositoryOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, repository := range repositories {
+		if repository.Name == id.Name() {
+			return repository, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeGitRepositoryOptions struct {
+	describe      bool                   `ddl:"static" sql:"DESCRIBE"`       //lint:ignore U1000 This is used in the ddl tag
+	gitRepository bool                   `ddl:"static" sql:"GIT REPOSITORY"` //lint:ignore U1000 This is used in the ddl tag
+	name          SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeGitRepositoryOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *gitRepositories) Describe(ctx context.Context, id SchemaObjectIdentifier) (*GitRepository, error) {
+	opts := &describeGitRepositoryOptions{
+		name: id,
+	}
+	dest, err := describeRow[gitRepositoryRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toGitRepository(), nil
+}
+
+type ShowGitBranchesOptions struct {
+	show         bool                   `ddl:"static" sql:"SHOW GIT BRANCHES"` //lint:ignore U1000 This is used in the ddl tag
+	Like         *Like                  `ddl:"keyword" sql:"LIKE"`
+	inRepository SchemaObjectIdentifier `ddl:"identifier" sql:"IN GIT REPOSITORY"`
+}
+
+func (opts *ShowGitBranchesOptions) validate() error {
+	if !validObjectidentifier(opts.inRepository) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type ShowGitTagsOptions struct {
+	show         bool                   `ddl:"static" sql:"SHOW GIT TAGS"` //lint:ignore U1000 This is used in the ddl tag
+	Like         *Like                  `ddl:"keyword" sql:"LIKE"`
+	inRepository SchemaObjectIdentifier `ddl:"identifier" sql:"IN GIT REPOSITORY"`
+}
+
+func (opts *ShowGitTagsOptions) validate() error {
+	if !validObjectidentifier(opts.inRepository) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// GitRef is a branch or tag of a git repository. Its files are staged under Path,
+// e.g. @repo/branches/main/.
+type GitRef struct {
+	Name       string
+	Path       string
+	Checkouts  string
+	CommitHash string
+	// Author and Message are only set for tags.
+	Author  string
+	Message string
+}
+
+type gitRefRow struct {
+	Name       string         `db:"name"`
+	Path       sql.NullString `db:"path"`
+	Checkouts  sql.NullString `db:"checkouts"`
+	CommitHash sql.NullString `db:"commit_hash"`
+	Author     sql.NullString `db:"author"`
+	Message    sql.NullString `db:"message"`
+}
+
+func (row gitRefRow) toGitRef() *GitRef {
+	return &GitRef{
+		Name:       row.Name,
+		Path:       row.Path.String,
+		Checkouts:  row.Checkouts.String,
+		CommitHash: row.CommitHash.String,
+		Author:     row.Author.String,
+		Message:    row.Message.String,
+	}
+}
+
+func (v *gitRepositories) queryRefs(ctx context.Context, sql string) ([]*GitRef, error) {
+	dest := []gitRefRow{}
+	err := v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*GitRef, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toGitRef()
+	}
+	return resultList, nil
+}
+
+func (v *gitRepositories) ShowBranches(ctx context.Context, id SchemaObjectIdentifier, opts *ShowGitBranchesOptions) ([]*GitRef, error) {
+	if opts == nil {
+		opts = &ShowGitBranchesOptions{}
+	}
+	opts.inRepository = id
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	return v.queryRefs(ctx, sql)
+}
+
+func (v *gitRepositories) ShowTags(ctx context.Context, id SchemaObjectIdentifier, opts *ShowGitTagsOptions) ([]*GitRef, error) {
+	if opts == nil {
+		opts = &ShowGitTagsOptions{}
+	}
+	opts.inRepository = id
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	return v.queryRefs(ctx, sql)
+}
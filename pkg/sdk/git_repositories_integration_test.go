@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_GitRepositories(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	apiIntegration := randomAccountObjectIdentifier(t)
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE API INTEGRATION %s API_PROVIDER = git_https_api API_ALLOWED_PREFIXES = ('https://github.com/Snowflake-Labs') ENABLED = TRUE", apiIntegration.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP API INTEGRATION %s", apiIntegration.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	origin := "https://github.com/Snowflake-Labs/terraform-provider-snowflake.git"
+	err = client.GitRepositories.Create(ctx, id, origin, apiIntegration, &CreateGitRepositoryOptions{Comment: String("some comment")})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.GitRepositories.Drop(ctx, id, &DropGitRepositoryOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		repository, err := client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, repository.ID())
+		assert.Equal(t, origin, repository.Origin)
+		assert.Equal(t, "some comment", repository.Comment)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		repository, err := client.GitRepositories.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, apiIntegration.Name(), repository.APIIntegration)
+	})
+
+	t.Run("fetch and show branches", func(t *testing.T) {
+		err := client.GitRepositories.Fetch(ctx, id)
+		require.NoError(t, err)
+		branches, err := client.GitRepositories.ShowBranches(ctx, id, &ShowGitBranchesOptions{Like: &Like{Pattern: String("main")}})
+		require.NoError(t, err)
+		require.Len(t, branches, 1)
+		assert.NotEmpty(t, branches[0].CommitHash)
+	})
+
+	t.Run("show tags", func(t *testing.T) {
+		tags, err := client.GitRepositories.ShowTags(ctx, id, nil)
+		require.NoError(t, err)
+		assert.NotEmpty(t, tags)
+	})
+
+	t.Run("alter: unset comment", func(t *testing.T) {
+		err := client.GitRepositories.Alter(ctx, id, &AlterGitRepositoryOptions{Unset: &GitRepositoryUnset{Comment: Bool(true)}})
+		require.NoError(t, err)
+		repository, err := client.GitRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, repository.Comment)
+	})
+}
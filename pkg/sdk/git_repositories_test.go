@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitRepositoryCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "repo")
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateGitRepositoryOptions{
+			OrReplace:      Bool(true),
+			name:           id,
+			origin:         "https://github.com/org/repo.git",
+			apiIntegration: NewAccountObjectIdentifier("git_api"),
+			GitCredentials: NewSchemaObjectIdentifier("db", "schema", "secret"),
+			Comment:        String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE GIT REPOSITORY "db"."schema"."repo" ORIGIN = 'https://github.com/org/repo.git' API_INTEGRATION = "git_api" GIT_CREDENTIALS = "db"."schema"."secret" COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: no api integration", func(t *testing.T) {
+		opts := &CreateGitRepositoryOptions{
+			name:   id,
+			origin: "https://github.com/org/repo.git",
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestGitRepositoryAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "repo")
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterGitRepositoryOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("fetch", func(t *testing.T) {
+		opts := &AlterGitRepositoryOptions{name: id, fetch: Bool(true)}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER GIT REPOSITORY "db"."schema"."repo" FETCH`, actual)
+	})
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &AlterGitRepositoryOptions{
+			name: id,
+			Set: &GitRepositorySet{
+				GitCredentials: NewSchemaObjectIdentifier("db", "schema", "secret"),
+				Comment:        String("some comment"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER GIT REPOSITORY "db"."schema"."repo" SET GIT_CREDENTIALS = "db"."schema"."secret" COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &AlterGitRepositoryOptions{
+			name:  id,
+			Unset: &GitRepositoryUnset{GitCredentials: Bool(true), Comment: Bool(true)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER GIT REPOSITORY "db"."schema"."repo" UNSET GIT_CREDENTIALS, COMMENT`, actual)
+	})
+}
+
+func TestGitRepositoryDrop(t *testing.T) {
+	opts := &DropGitRepositoryOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "repo"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP GIT REPOSITORY IF EXISTS "db"."schema"."repo"`, actual)
+}
+
+func TestGitRepositoryShow(t *testing.T) {
+	opts := &ShowGitRepositoryOptions{
+		Like: &Like{Pattern: String("repo")},
+		In:   &In{Schema: NewSchemaIdentifier("db", "schema")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW GIT REPOSITORIES LIKE 'repo' IN SCHEMA "db"."schema"`, actual)
+}
+
+func TestGitRepositoryDescribe(t *testing.T) {
+	opts := &describeGitRepositoryOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "repo"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE GIT REPOSITORY "db"."schema"."repo"`, actual)
+}
+
+func TestGitRepositoryShowRefs(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "repo")
+
+	t.Run("branches", func(t *testing.T) {
+		opts := &ShowGitBranchesOptions{
+			Like:         &Like{Pattern: String("main")},
+			inRepository: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW GIT BRANCHES LIKE 'main' IN GIT REPOSITORY "db"."schema"."repo"`, actual)
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		opts := &ShowGitTagsOptions{
+			inRepository: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW GIT TAGS IN GIT REPOSITORY "db"."schema"."repo"`, actual)
+	})
+}
@@ -10,6 +10,10 @@ import (
 type Grants interface {
 	GrantPrivilegeToShare(ctx context.Context, objectPrivilege Privilege, on *GrantPrivilegeToShareOn, to AccountObjectIdentifier) error
 	RevokePrivilegeFromShare(ctx context.Context, objectPrivilege Privilege, on *RevokePrivilegeFromShareOn, from AccountObjectIdentifier) error
+	GrantPrivilegesToAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *GrantPrivilegesToAccountRoleOptions) error
+	RevokePrivilegesFromAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *RevokePrivilegesFromAccountRoleOptions) error
+	GrantPrivilegesToDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToDatabaseRoleOptions) error
+	RevokePrivilegesFromDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromDatabaseRoleOptions) error
 	Show(ctx context.Context, opts *ShowGrantOptions) ([]*Grant, error)
 }
 
@@ -43,22 +47,36 @@ type grantRow struct {
 	GranteeName string    `db:"grantee_name"`
 	GrantOption bool      `db:"grant_option"`
 	GrantedBy   string    `db:"granted_by"`
+	// SHOW FUTURE GRANTS returns grant_on and grant_to instead of granted_on and granted_to.
+	GrantOn string `db:"grant_on"`
+	GrantTo string `db:"grant_to"`
 }
 
 func (row *grantRow) toGrant() (*Grant, error) {
-	grantedTo := ObjectType(row.GrantedTo)
+	if row.GrantedOn == "" {
+		row.GrantedOn = row.GrantOn
+	}
+	if row.GrantedTo == "" {
+		row.GrantedTo = row.GrantTo
+	}
+	// Object types with more than one word are returned with underscores, e.g. DATABASE_ROLE.
+	grantedOn := ObjectType(strings.ReplaceAll(row.GrantedOn, "_", " "))
+	grantedTo := ObjectType(strings.ReplaceAll(row.GrantedTo, "_", " "))
 	granteeName := NewAccountObjectIdentifier(row.GranteeName)
 	if grantedTo == ObjectTypeShare {
-		parts := strings.Split(row.GranteeName, ".")
-		name := strings.Join(parts[1:], ".")
+		// Shares are returned with the account they belong to, e.g. ORG.ACCOUNT.SHARE or LOCATOR.SHARE.
+		name := row.GranteeName
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
 		granteeName = NewAccountObjectIdentifier(name)
 	}
 	grant := &Grant{
 		CreatedOn:   row.CreatedOn,
 		Privilege:   Privilege(row.Privilege),
-		GrantedOn:   ObjectType(row.GrantedOn),
+		GrantedOn:   grantedOn,
 		GrantedTo:   grantedTo,
-		Name:        NewAccountObjectIdentifier(strings.Trim(row.Name, "\"")),
+		Name:        grantedObjectIdentifier(grantedOn, row.Name),
 		GranteeName: granteeName,
 		GrantOption: row.GrantOption,
 		GrantedBy:   NewAccountObjectIdentifier(row.GrantedBy),
@@ -66,6 +84,25 @@ func (row *grantRow) toGrant() (*Grant, error) {
 	return grant, nil
 }
 
+// grantedObjectIdentifier parses the name of a granted object, e.g. DB.SCHEMA."my.table", into an identifier
+// that matches the number of parts of the name. Objects without a known structure are kept as a single name.
+func grantedObjectIdentifier(objectType ObjectType, name string) ObjectIdentifier {
+	parts, err := ParseIdentifierParts(name)
+	switch {
+	case err != nil:
+		return NewAccountObjectIdentifier(name)
+	case len(parts) == 2 && objectType == ObjectTypeSchema:
+		return NewSchemaIdentifier(parts[0], parts[1])
+	case len(parts) == 2 && (objectType == ObjectTypeDatabaseRole || objectType == ObjectTypeApplicationRole):
+		return NewDatabaseObjectIdentifier(parts[0], parts[1])
+	case len(parts) == 3:
+		return NewSchemaObjectIdentifier(parts[0], parts[1], parts[2])
+	case len(parts) == 1:
+		return NewAccountObjectIdentifier(parts[0])
+	}
+	return NewAccountObjectIdentifier(name)
+}
+
 type grantPrivilegeToShareOptions struct {
 	grant           bool                     `ddl:"static" sql:"GRANT"` //lint:ignore U1000 This is used in the ddl tag
 	objectPrivilege Privilege                `ddl:"keyword"`
@@ -80,8 +117,23 @@ func (opts *grantPrivilegeToShareOptions) validate() error {
 	if !valueSet(opts.On) || opts.objectPrivilege == "" {
 		return fmt.Errorf("on and objectPrivilege are required")
 	}
-	if !exactlyOneValueSet(opts.On.Database, opts.On.Schema, opts.On.Function, opts.On.Table, opts.On.View) {
-		return fmt.Errorf("only one of database, schema, function, table, or view can be set")
+	if err := opts.On.validate(); err != nil {
+		return err
+	}
+	// Shares accept USAGE on databases, schemas and functions, REFERENCE_USAGE on databases and SELECT on tables and views.
+	switch opts.objectPrivilege {
+	case PrivilegeUsage:
+		if !anyValueSet(opts.On.Database, opts.On.Schema, opts.On.Function) {
+			return fmt.Errorf("%s can only be granted to a share on a database, schema, or function", opts.objectPrivilege)
+		}
+	case PrivilegeReferenceUsage:
+		if !valueSet(opts.On.Database) {
+			return fmt.Errorf("%s can only be granted to a share on a database", opts.objectPrivilege)
+		}
+	case PrivilegeSelect:
+		if !anyValueSet(opts.On.Table, opts.On.View) {
+			return fmt.Errorf("%s can only be granted to a share on a table or view", opts.objectPrivilege)
+		}
 	}
 	return nil
 }
@@ -127,7 +179,7 @@ func (v *grants) GrantPrivilegeToShare(ctx context.Context, objectPrivilege Priv
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -149,27 +201,20 @@ func (opts *revokePrivilegeFromShareOptions) validate() error {
 	if !valueSet(opts.On) || opts.objectPrivilege == "" {
 		return fmt.Errorf("on and objectPrivilege are required")
 	}
-	if !exactlyOneValueSet(opts.On.Database, opts.On.Schema, opts.On.Table, opts.On.View) {
-		return fmt.Errorf("only one of database, schema, function, table, or view can be set")
-	}
-
-	if err := opts.On.validate(); err != nil {
-		return err
-	}
-
-	return nil
+	return opts.On.validate()
 }
 
 type RevokePrivilegeFromShareOn struct {
 	Database AccountObjectIdentifier `ddl:"identifier" sql:"DATABASE"`
 	Schema   SchemaIdentifier        `ddl:"identifier" sql:"SCHEMA"`
+	Function SchemaObjectIdentifier  `ddl:"identifier" sql:"FUNCTION"`
 	Table    *OnTable                `ddl:"-"`
 	View     *OnView                 `ddl:"-"`
 }
 
 func (v *RevokePrivilegeFromShareOn) validate() error {
-	if !exactlyOneValueSet(v.Database, v.Schema, v.Table, v.View) {
-		return fmt.Errorf("only one of database, schema, table, or view can be set")
+	if !exactlyOneValueSet(v.Database, v.Schema, v.Function, v.Table, v.View) {
+		return fmt.Errorf("only one of database, schema, function, table, or view can be set")
 	}
 	if valueSet(v.Table) {
 		return v.Table.validate()
@@ -201,7 +246,290 @@ func (v *grants) RevokePrivilegeFromShare(ctx context.Context, objectPrivilege P
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AccountRoleGrantPrivileges are the privileges granted to or revoked from an account role.
+type AccountRoleGrantPrivileges struct {
+	Privileges    []Privilege `ddl:"list,no_parentheses"`
+	AllPrivileges *bool       `ddl:"keyword" sql:"ALL PRIVILEGES"`
+}
+
+func (v *AccountRoleGrantPrivileges) validate() error {
+	if !exactlyOneValueSet(v.Privileges, v.AllPrivileges) {
+		return fmt.Errorf("only one of privileges or allPrivileges can be set")
+	}
+	return nil
+}
+
+// AccountRoleGrantOn is the securable of a grant to an account role.
+type AccountRoleGrantOn struct {
+	Account       *bool                `ddl:"keyword" sql:"ACCOUNT"`
+	AccountObject *Object              `ddl:"-"`
+	Schema        *GrantOnSchema       `ddl:"-"`
+	SchemaObject  *GrantOnSchemaObject `ddl:"-"`
+}
+
+func (v *AccountRoleGrantOn) validate() error {
+	if !exactlyOneValueSet(v.Account, v.AccountObject, v.Schema, v.SchemaObject) {
+		return fmt.Errorf("only one of account, accountObject, schema, or schemaObject can be set")
+	}
+	if valueSet(v.Schema) {
+		return v.Schema.validate()
+	}
+	if valueSet(v.SchemaObject) {
+		return v.SchemaObject.validate()
+	}
+	return nil
+}
+
+type GrantOnSchema struct {
+	Schema                  SchemaIdentifier        `ddl:"identifier" sql:"SCHEMA"`
+	AllSchemasInDatabase    AccountObjectIdentifier `ddl:"identifier" sql:"ALL SCHEMAS IN DATABASE"`
+	FutureSchemasInDatabase AccountObjectIdentifier `ddl:"identifier" sql:"FUTURE SCHEMAS IN DATABASE"`
+}
+
+func (v *GrantOnSchema) validate() error {
+	if !exactlyOneValueSet(v.Schema, v.AllSchemasInDatabase, v.FutureSchemasInDatabase) {
+		return fmt.Errorf("only one of schema, allSchemasInDatabase, or futureSchemasInDatabase can be set")
+	}
+	return nil
+}
+
+// GrantOnSchemaObject is a single schema object, or all existing or future objects of a type in a database or schema.
+type GrantOnSchemaObject struct {
+	SchemaObject *Object                `ddl:"-"`
+	All          *GrantOnSchemaObjectIn `ddl:"keyword" sql:"ALL"`
+	Future       *GrantOnSchemaObjectIn `ddl:"keyword" sql:"FUTURE"`
+}
+
+func (v *GrantOnSchemaObject) validate() error {
+	if !exactlyOneValueSet(v.SchemaObject, v.All, v.Future) {
+		return fmt.Errorf("only one of schemaObject, all, or future can be set")
+	}
+	if valueSet(v.All) {
+		return v.All.validate()
+	}
+	if valueSet(v.Future) {
+		return v.Future.validate()
+	}
+	return nil
+}
+
+type GrantOnSchemaObjectIn struct {
+	PluralObjectType PluralObjectType        `ddl:"keyword"`
+	InDatabase       AccountObjectIdentifier `ddl:"identifier" sql:"IN DATABASE"`
+	InSchema         SchemaIdentifier        `ddl:"identifier" sql:"IN SCHEMA"`
+}
+
+func (v *GrantOnSchemaObjectIn) validate() error {
+	if v.PluralObjectType == "" {
+		return fmt.Errorf("pluralObjectType is required")
+	}
+	if !exactlyOneValueSet(v.InDatabase, v.InSchema) {
+		return fmt.Errorf("only one of inDatabase or inSchema can be set")
+	}
+	return nil
+}
+
+type GrantPrivilegesToAccountRoleOptions struct {
+	grant           bool                        `ddl:"static" sql:"GRANT"` //lint:ignore U1000 This is used in the ddl tag
+	privileges      *AccountRoleGrantPrivileges `ddl:"-"`
+	on              *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	accountRole     AccountObjectIdentifier     `ddl:"identifier" sql:"TO ROLE"`
+	WithGrantOption *bool                       `ddl:"keyword" sql:"WITH GRANT OPTION"`
+}
+
+func (opts *GrantPrivilegesToAccountRoleOptions) validate() error {
+	if !validObjectidentifier(opts.accountRole) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.privileges) || !valueSet(opts.on) {
+		return fmt.Errorf("privileges and on are required")
+	}
+	if err := opts.privileges.validate(); err != nil {
+		return err
+	}
+	return opts.on.validate()
+}
+
+func (v *grants) GrantPrivilegesToAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *GrantPrivilegesToAccountRoleOptions) error {
+	if opts == nil {
+		opts = &GrantPrivilegesToAccountRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.accountRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type RevokePrivilegesFromAccountRoleOptions struct {
+	revoke         bool                        `ddl:"static" sql:"REVOKE"` //lint:ignore U1000 This is used in the ddl tag
+	GrantOptionFor *bool                       `ddl:"keyword" sql:"GRANT OPTION FOR"`
+	privileges     *AccountRoleGrantPrivileges `ddl:"-"`
+	on             *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	accountRole    AccountObjectIdentifier     `ddl:"identifier" sql:"FROM ROLE"`
+	Restrict       *bool                       `ddl:"keyword" sql:"RESTRICT"`
+	Cascade        *bool                       `ddl:"keyword" sql:"CASCADE"`
+}
+
+func (opts *RevokePrivilegesFromAccountRoleOptions) validate() error {
+	if !validObjectidentifier(opts.accountRole) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.privileges) || !valueSet(opts.on) {
+		return fmt.Errorf("privileges and on are required")
+	}
+	if everyValueSet(opts.Restrict, opts.Cascade) {
+		return fmt.Errorf("only one of restrict or cascade can be set")
+	}
+	if err := opts.privileges.validate(); err != nil {
+		return err
+	}
+	return opts.on.validate()
+}
+
+func (v *grants) RevokePrivilegesFromAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *RevokePrivilegesFromAccountRoleOptions) error {
+	if opts == nil {
+		opts = &RevokePrivilegesFromAccountRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.accountRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DatabaseRoleGrantPrivileges are the privileges granted to or revoked from a database role.
+type DatabaseRoleGrantPrivileges struct {
+	Privileges    []Privilege `ddl:"list,no_parentheses"`
+	AllPrivileges *bool       `ddl:"keyword" sql:"ALL PRIVILEGES"`
+}
+
+func (v *DatabaseRoleGrantPrivileges) validate() error {
+	if !exactlyOneValueSet(v.Privileges, v.AllPrivileges) {
+		return fmt.Errorf("only one of privileges or allPrivileges can be set")
+	}
+	return nil
+}
+
+// DatabaseRoleGrantOn is the securable of a grant to a database role. Database roles can only be granted
+// privileges on the database they belong to and the objects in it.
+type DatabaseRoleGrantOn struct {
+	Database     AccountObjectIdentifier `ddl:"identifier" sql:"DATABASE"`
+	Schema       *GrantOnSchema          `ddl:"-"`
+	SchemaObject *GrantOnSchemaObject    `ddl:"-"`
+}
+
+func (v *DatabaseRoleGrantOn) validate() error {
+	if !exactlyOneValueSet(v.Database, v.Schema, v.SchemaObject) {
+		return fmt.Errorf("only one of database, schema, or schemaObject can be set")
+	}
+	if valueSet(v.Schema) {
+		return v.Schema.validate()
+	}
+	if valueSet(v.SchemaObject) {
+		return v.SchemaObject.validate()
+	}
+	return nil
+}
+
+type GrantPrivilegesToDatabaseRoleOptions struct {
+	grant           bool                         `ddl:"static" sql:"GRANT"` //lint:ignore U1000 This is used in the ddl tag
+	privileges      *DatabaseRoleGrantPrivileges `ddl:"-"`
+	on              *DatabaseRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	databaseRole    DatabaseObjectIdentifier     `ddl:"identifier" sql:"TO DATABASE ROLE"`
+	WithGrantOption *bool                        `ddl:"keyword" sql:"WITH GRANT OPTION"`
+}
+
+func (opts *GrantPrivilegesToDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.databaseRole) || opts.databaseRole.DatabaseName() == "" {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.privileges) || !valueSet(opts.on) {
+		return fmt.Errorf("privileges and on are required")
+	}
+	if err := opts.privileges.validate(); err != nil {
+		return err
+	}
+	return opts.on.validate()
+}
+
+func (v *grants) GrantPrivilegesToDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &GrantPrivilegesToDatabaseRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.databaseRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type RevokePrivilegesFromDatabaseRoleOptions struct {
+	revoke         bool                         `ddl:"static" sql:"REVOKE"` //lint:ignore U1000 This is used in the ddl tag
+	GrantOptionFor *bool                        `ddl:"keyword" sql:"GRANT OPTION FOR"`
+	privileges     *DatabaseRoleGrantPrivileges `ddl:"-"`
+	on             *DatabaseRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	databaseRole   DatabaseObjectIdentifier     `ddl:"identifier" sql:"FROM DATABASE ROLE"`
+	Restrict       *bool                        `ddl:"keyword" sql:"RESTRICT"`
+	Cascade        *bool                        `ddl:"keyword" sql:"CASCADE"`
+}
+
+func (opts *RevokePrivilegesFromDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.databaseRole) || opts.databaseRole.DatabaseName() == "" {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.privileges) || !valueSet(opts.on) {
+		return fmt.Errorf("privileges and on are required")
+	}
+	if everyValueSet(opts.Restrict, opts.Cascade) {
+		return fmt.Errorf("only one of restrict or cascade can be set")
+	}
+	if err := opts.privileges.validate(); err != nil {
+		return err
+	}
+	return opts.on.validate()
+}
+
+func (v *grants) RevokePrivilegesFromDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &RevokePrivilegesFromDatabaseRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.databaseRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -210,19 +538,47 @@ func (v *grants) RevokePrivilegeFromShare(ctx context.Context, objectPrivilege P
 }
 
 type ShowGrantOptions struct {
-	show   bool          `ddl:"static" sql:"SHOW"`   //lint:ignore U1000 This is used in the ddl tag
+	show   bool          `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Future *bool         `ddl:"keyword" sql:"FUTURE"`
 	grants bool          `ddl:"static" sql:"GRANTS"` //lint:ignore U1000 This is used in the ddl tag
 	On     *ShowGrantsOn `ddl:"keyword" sql:"ON"`
 	To     *ShowGrantsTo `ddl:"keyword" sql:"TO"`
 	Of     *ShowGrantsOf `ddl:"keyword" sql:"OF"`
+	// In is only valid for future grants.
+	In *ShowGrantsIn `ddl:"keyword" sql:"IN"`
 }
 
 func (opts *ShowGrantOptions) validate() error {
-	if everyValueNil(opts.On, opts.To, opts.Of) {
-		return fmt.Errorf("at least one of on, to, or of is required")
+	if everyValueNil(opts.On, opts.To, opts.Of, opts.In) {
+		return fmt.Errorf("at least one of on, to, of, or in is required")
+	}
+	if !exactlyOneValueSet(opts.On, opts.To, opts.Of, opts.In) {
+		return fmt.Errorf("only one of on, to, of, or in can be set")
+	}
+	if valueSet(opts.Future) && *opts.Future {
+		if valueSet(opts.On) || valueSet(opts.Of) {
+			return fmt.Errorf("future grants can only be shown in a database or schema, or to a role or database role")
+		}
+		if valueSet(opts.To) && !anyValueSet(opts.To.Role, opts.To.DatabaseRole) {
+			return fmt.Errorf("future grants can only be shown to a role or database role")
+		}
+	} else if valueSet(opts.In) {
+		return fmt.Errorf("in can only be set for future grants")
+	}
+	if valueSet(opts.To) {
+		if err := opts.To.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Of) {
+		if err := opts.Of.validate(); err != nil {
+			return err
+		}
 	}
-	if !exactlyOneValueSet(opts.On, opts.To, opts.Of) {
-		return fmt.Errorf("only one of on, to, or of can be set")
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -233,14 +589,45 @@ type ShowGrantsOn struct {
 }
 
 type ShowGrantsTo struct {
-	Role  AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
-	User  AccountObjectIdentifier `ddl:"identifier" sql:"USER"`
-	Share AccountObjectIdentifier `ddl:"identifier" sql:"SHARE"`
+	Role            AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	DatabaseRole    DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	User            AccountObjectIdentifier  `ddl:"identifier" sql:"USER"`
+	Share           AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
+	Application     AccountObjectIdentifier  `ddl:"identifier" sql:"APPLICATION"`
+	ApplicationRole DatabaseObjectIdentifier `ddl:"identifier" sql:"APPLICATION ROLE"`
+}
+
+func (v *ShowGrantsTo) validate() error {
+	if !exactlyOneValueSet(v.Role, v.DatabaseRole, v.User, v.Share, v.Application, v.ApplicationRole) {
+		return fmt.Errorf("exactly one of role, database role, user, share, application, or application role must be set")
+	}
+	return nil
+}
+
+type ShowGrantsIn struct {
+	Schema   SchemaIdentifier        `ddl:"identifier" sql:"SCHEMA"`
+	Database AccountObjectIdentifier `ddl:"identifier" sql:"DATABASE"`
+}
+
+func (v *ShowGrantsIn) validate() error {
+	if !exactlyOneValueSet(v.Schema, v.Database) {
+		return fmt.Errorf("only one of schema or database can be set")
+	}
+	return nil
 }
 
 type ShowGrantsOf struct {
-	Role  AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
-	Share AccountObjectIdentifier `ddl:"identifier" sql:"SHARE"`
+	Role            AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	DatabaseRole    DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	Share           AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
+	ApplicationRole DatabaseObjectIdentifier `ddl:"identifier" sql:"APPLICATION ROLE"`
+}
+
+func (v *ShowGrantsOf) validate() error {
+	if !exactlyOneValueSet(v.Role, v.DatabaseRole, v.Share, v.ApplicationRole) {
+		return fmt.Errorf("exactly one of role, database role, share, or application role must be set")
+	}
+	return nil
 }
 
 func (v *grants) Show(ctx context.Context, opts *ShowGrantOptions) ([]*Grant, error) {
@@ -250,7 +637,7 @@ func (v *grants) Show(ctx context.Context, opts *ShowGrantOptions) ([]*Grant, er
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
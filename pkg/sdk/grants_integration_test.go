@@ -109,4 +109,149 @@ func TestInt_ShowGrants(t *testing.T) {
 		require.NoError(t, err)
 		assert.LessOrEqual(t, 2, len(grants))
 	})
+	t.Run("to share", func(t *testing.T) {
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{
+			To: &ShowGrantsTo{
+				Share: shareTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, PrivilegeUsage, grants[0].Privilege)
+		assert.Equal(t, ObjectTypeDatabase, grants[0].GrantedOn)
+		assert.Equal(t, databaseTest.ID(), grants[0].Name)
+		assert.Equal(t, shareTest.ID().Name(), grants[0].GranteeName.Name())
+	})
+}
+
+func TestInt_GrantPrivilegesToAccountRole(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	roleID := randomAccountObjectIdentifier(t)
+	err := client.Roles.Create(ctx, roleID, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Roles.Drop(ctx, roleID, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("on future tables in schema", func(t *testing.T) {
+		privileges := &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect}}
+		on := &AccountRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				Future: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InSchema:         schemaTest.ID(),
+				},
+			},
+		}
+		err := client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, roleID, nil)
+		require.NoError(t, err)
+
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{
+			Future: Bool(true),
+			In:     &ShowGrantsIn{Schema: schemaTest.ID()},
+		})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, PrivilegeSelect, grants[0].Privilege)
+		assert.Equal(t, ObjectTypeTable, grants[0].GrantedOn)
+		assert.Equal(t, ObjectTypeRole, grants[0].GrantedTo)
+		assert.Equal(t, roleID.Name(), grants[0].GranteeName.Name())
+
+		err = client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, on, roleID, nil)
+		require.NoError(t, err)
+		grants, err = client.Grants.Show(ctx, &ShowGrantOptions{
+			Future: Bool(true),
+			In:     &ShowGrantsIn{Schema: schemaTest.ID()},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, grants)
+	})
+
+	t.Run("on all tables in database", func(t *testing.T) {
+		privileges := &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect}}
+		on := &AccountRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				All: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InDatabase:       databaseTest.ID(),
+				},
+			},
+		}
+		err := client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, roleID, nil)
+		require.NoError(t, err)
+		err = client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, on, roleID, nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestInt_GrantPrivilegesToDatabaseRole(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	roleID := NewDatabaseObjectIdentifier(databaseTest.Name, randomStringN(t, 12))
+	err := client.DatabaseRoles.Create(ctx, roleID, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.DatabaseRoles.Drop(ctx, roleID, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("on schema", func(t *testing.T) {
+		privileges := &DatabaseRoleGrantPrivileges{Privileges: []Privilege{PrivilegeUsage}}
+		on := &DatabaseRoleGrantOn{Schema: &GrantOnSchema{Schema: schemaTest.ID()}}
+		err := client.Grants.GrantPrivilegesToDatabaseRole(ctx, privileges, on, roleID, nil)
+		require.NoError(t, err)
+
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{
+			To: &ShowGrantsTo{DatabaseRole: roleID},
+		})
+		require.NoError(t, err)
+		var schemaGrant *Grant
+		for _, grant := range grants {
+			if grant.GrantedOn == ObjectTypeSchema {
+				schemaGrant = grant
+			}
+		}
+		require.NotNil(t, schemaGrant)
+		assert.Equal(t, PrivilegeUsage, schemaGrant.Privilege)
+		assert.Equal(t, ObjectTypeDatabaseRole, schemaGrant.GrantedTo)
+		assert.Equal(t, schemaTest.ID(), schemaGrant.Name)
+
+		err = client.Grants.RevokePrivilegesFromDatabaseRole(ctx, privileges, on, roleID, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("on future tables in schema", func(t *testing.T) {
+		privileges := &DatabaseRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect}}
+		on := &DatabaseRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				Future: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InSchema:         schemaTest.ID(),
+				},
+			},
+		}
+		err := client.Grants.GrantPrivilegesToDatabaseRole(ctx, privileges, on, roleID, nil)
+		require.NoError(t, err)
+
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{
+			Future: Bool(true),
+			To:     &ShowGrantsTo{DatabaseRole: roleID},
+		})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, ObjectTypeTable, grants[0].GrantedOn)
+
+		err = client.Grants.RevokePrivilegesFromDatabaseRole(ctx, privileges, on, roleID, nil)
+		require.NoError(t, err)
+	})
 }
@@ -189,6 +189,20 @@ func TestRevokePrivilegeFromShare(t *testing.T) {
 		expected := fmt.Sprintf("REVOKE USAGE ON ALL VIEWS IN SCHEMA %s FROM SHARE %s", otherID.FullyQualifiedName(), id.FullyQualifiedName())
 		assert.Equal(t, expected, actual)
 	})
+	t.Run("on function", func(t *testing.T) {
+		otherID := randomSchemaObjectIdentifier(t)
+		opts := &revokePrivilegeFromShareOptions{
+			objectPrivilege: PrivilegeUsage,
+			On: &RevokePrivilegeFromShareOn{
+				Function: otherID,
+			},
+			from: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("REVOKE USAGE ON FUNCTION %s FROM SHARE %s", otherID.FullyQualifiedName(), id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
 }
 
 func TestGrantShow(t *testing.T) {
@@ -267,6 +281,19 @@ func TestGrantShow(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("to application", func(t *testing.T) {
+		applicationID := randomAccountObjectIdentifier(t)
+		opts := &ShowGrantOptions{
+			To: &ShowGrantsTo{
+				Application: applicationID,
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("SHOW GRANTS TO APPLICATION %s", applicationID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
 	t.Run("of role", func(t *testing.T) {
 		roleID := randomAccountObjectIdentifier(t)
 		opts := &ShowGrantOptions{
@@ -292,4 +319,544 @@ func TestGrantShow(t *testing.T) {
 		expected := fmt.Sprintf("SHOW GRANTS OF SHARE %s", shareID.FullyQualifiedName())
 		assert.Equal(t, expected, actual)
 	})
+
+	t.Run("to application role", func(t *testing.T) {
+		applicationRoleID := NewDatabaseObjectIdentifier("app", "app_role")
+		opts := &ShowGrantOptions{
+			To: &ShowGrantsTo{
+				ApplicationRole: applicationRoleID,
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW GRANTS TO APPLICATION ROLE "app"."app_role"`, actual)
+	})
+
+	t.Run("of application role", func(t *testing.T) {
+		applicationRoleID := NewDatabaseObjectIdentifier("app", "app_role")
+		opts := &ShowGrantOptions{
+			Of: &ShowGrantsOf{
+				ApplicationRole: applicationRoleID,
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW GRANTS OF APPLICATION ROLE "app"."app_role"`, actual)
+	})
+
+	t.Run("validation: to without grantee", func(t *testing.T) {
+		opts := &ShowGrantOptions{
+			To: &ShowGrantsTo{},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: to with more than one grantee", func(t *testing.T) {
+		opts := &ShowGrantOptions{
+			To: &ShowGrantsTo{
+				Role: randomAccountObjectIdentifier(t),
+				User: randomAccountObjectIdentifier(t),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: of with more than one role", func(t *testing.T) {
+		opts := &ShowGrantOptions{
+			Of: &ShowGrantsOf{
+				Role:            randomAccountObjectIdentifier(t),
+				ApplicationRole: NewDatabaseObjectIdentifier("app", "app_role"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestGrantPrivilegesToAccountRole(t *testing.T) {
+	roleID := randomAccountObjectIdentifier(t)
+
+	t.Run("on account", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges:  &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeMonitor}},
+			on:          &AccountRoleGrantOn{Account: Bool(true)},
+			accountRole: roleID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT MONITOR ON ACCOUNT TO ROLE %s", roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on database", func(t *testing.T) {
+		dbID := randomAccountObjectIdentifier(t)
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeUsage, PrivilegeMonitor}},
+			on: &AccountRoleGrantOn{
+				AccountObject: &Object{
+					ObjectType: ObjectTypeDatabase,
+					Name:       dbID,
+				},
+			},
+			accountRole:     roleID,
+			WithGrantOption: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT USAGE, MONITOR ON DATABASE %s TO ROLE %s WITH GRANT OPTION", dbID.FullyQualifiedName(), roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on future schemas in database", func(t *testing.T) {
+		dbID := randomAccountObjectIdentifier(t)
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{AllPrivileges: Bool(true)},
+			on: &AccountRoleGrantOn{
+				Schema: &GrantOnSchema{FutureSchemasInDatabase: dbID},
+			},
+			accountRole: roleID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT ALL PRIVILEGES ON FUTURE SCHEMAS IN DATABASE %s TO ROLE %s", dbID.FullyQualifiedName(), roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on all tables in schema", func(t *testing.T) {
+		schemaID := randomSchemaIdentifier(t)
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect}},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					All: &GrantOnSchemaObjectIn{
+						PluralObjectType: PluralObjectTypeTables,
+						InSchema:         schemaID,
+					},
+				},
+			},
+			accountRole: roleID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT SELECT ON ALL TABLES IN SCHEMA %s TO ROLE %s", schemaID.FullyQualifiedName(), roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on future tables in database", func(t *testing.T) {
+		dbID := randomAccountObjectIdentifier(t)
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect, PrivilegeInsert}},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					Future: &GrantOnSchemaObjectIn{
+						PluralObjectType: PluralObjectTypeTables,
+						InDatabase:       dbID,
+					},
+				},
+			},
+			accountRole: roleID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT SELECT, INSERT ON FUTURE TABLES IN DATABASE %s TO ROLE %s", dbID.FullyQualifiedName(), roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: privileges and all privileges", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges:  &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect}, AllPrivileges: Bool(true)},
+			on:          &AccountRoleGrantOn{Account: Bool(true)},
+			accountRole: roleID,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: future in database and schema", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect}},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					Future: &GrantOnSchemaObjectIn{
+						PluralObjectType: PluralObjectTypeTables,
+						InDatabase:       randomAccountObjectIdentifier(t),
+						InSchema:         randomSchemaIdentifier(t),
+					},
+				},
+			},
+			accountRole: roleID,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: future without object type", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect}},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					Future: &GrantOnSchemaObjectIn{InSchema: randomSchemaIdentifier(t)},
+				},
+			},
+			accountRole: roleID,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestRevokePrivilegesFromAccountRole(t *testing.T) {
+	roleID := randomAccountObjectIdentifier(t)
+
+	t.Run("on future tables in schema", func(t *testing.T) {
+		schemaID := randomSchemaIdentifier(t)
+		opts := &RevokePrivilegesFromAccountRoleOptions{
+			GrantOptionFor: Bool(true),
+			privileges:     &AccountRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect}},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					Future: &GrantOnSchemaObjectIn{
+						PluralObjectType: PluralObjectTypeTables,
+						InSchema:         schemaID,
+					},
+				},
+			},
+			accountRole: roleID,
+			Cascade:     Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("REVOKE GRANT OPTION FOR SELECT ON FUTURE TABLES IN SCHEMA %s FROM ROLE %s CASCADE", schemaID.FullyQualifiedName(), roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: restrict and cascade", func(t *testing.T) {
+		opts := &RevokePrivilegesFromAccountRoleOptions{
+			privileges:  &AccountRoleGrantPrivileges{AllPrivileges: Bool(true)},
+			on:          &AccountRoleGrantOn{Account: Bool(true)},
+			accountRole: roleID,
+			Restrict:    Bool(true),
+			Cascade:     Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestGrantShowFuture(t *testing.T) {
+	t.Run("in schema", func(t *testing.T) {
+		schemaID := randomSchemaIdentifier(t)
+		opts := &ShowGrantOptions{
+			Future: Bool(true),
+			In:     &ShowGrantsIn{Schema: schemaID},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("SHOW FUTURE GRANTS IN SCHEMA %s", schemaID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("in database", func(t *testing.T) {
+		dbID := randomAccountObjectIdentifier(t)
+		opts := &ShowGrantOptions{
+			Future: Bool(true),
+			In:     &ShowGrantsIn{Database: dbID},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("SHOW FUTURE GRANTS IN DATABASE %s", dbID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("to role", func(t *testing.T) {
+		roleID := randomAccountObjectIdentifier(t)
+		opts := &ShowGrantOptions{
+			Future: Bool(true),
+			To:     &ShowGrantsTo{Role: roleID},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("SHOW FUTURE GRANTS TO ROLE %s", roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: in without future", func(t *testing.T) {
+		opts := &ShowGrantOptions{
+			In: &ShowGrantsIn{Database: randomAccountObjectIdentifier(t)},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: future to share", func(t *testing.T) {
+		opts := &ShowGrantOptions{
+			Future: Bool(true),
+			To:     &ShowGrantsTo{Share: randomAccountObjectIdentifier(t)},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestGrantRowFuture(t *testing.T) {
+	row := grantRow{
+		Privilege:   "SELECT",
+		GrantOn:     "TABLE",
+		Name:        "DB.SCHEMA.<TABLE>",
+		GrantTo:     "ROLE",
+		GranteeName: "ANALYST",
+	}
+	grant, err := row.toGrant()
+	require.NoError(t, err)
+	assert.Equal(t, ObjectTypeTable, grant.GrantedOn)
+	assert.Equal(t, ObjectTypeRole, grant.GrantedTo)
+	assert.Equal(t, "ANALYST", grant.GranteeName.Name())
+}
+
+func TestGrantPrivilegeToShareValidation(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("reference usage on database", func(t *testing.T) {
+		opts := &grantPrivilegeToShareOptions{
+			objectPrivilege: PrivilegeReferenceUsage,
+			On:              &GrantPrivilegeToShareOn{Database: randomAccountObjectIdentifier(t)},
+			to:              id,
+		}
+		assert.NoError(t, opts.validate())
+	})
+
+	t.Run("select on all tables in schema", func(t *testing.T) {
+		opts := &grantPrivilegeToShareOptions{
+			objectPrivilege: PrivilegeSelect,
+			On:              &GrantPrivilegeToShareOn{Table: &OnTable{AllInSchema: randomSchemaIdentifier(t)}},
+			to:              id,
+		}
+		assert.NoError(t, opts.validate())
+	})
+
+	t.Run("usage on table", func(t *testing.T) {
+		opts := &grantPrivilegeToShareOptions{
+			objectPrivilege: PrivilegeUsage,
+			On:              &GrantPrivilegeToShareOn{Table: &OnTable{Name: randomSchemaObjectIdentifier(t)}},
+			to:              id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("reference usage on schema", func(t *testing.T) {
+		opts := &grantPrivilegeToShareOptions{
+			objectPrivilege: PrivilegeReferenceUsage,
+			On:              &GrantPrivilegeToShareOn{Schema: randomSchemaIdentifier(t)},
+			to:              id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("select on database", func(t *testing.T) {
+		opts := &grantPrivilegeToShareOptions{
+			objectPrivilege: PrivilegeSelect,
+			On:              &GrantPrivilegeToShareOn{Database: randomAccountObjectIdentifier(t)},
+			to:              id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("table without name", func(t *testing.T) {
+		opts := &grantPrivilegeToShareOptions{
+			objectPrivilege: PrivilegeSelect,
+			On:              &GrantPrivilegeToShareOn{Table: &OnTable{}},
+			to:              id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestGrantRowToShare(t *testing.T) {
+	t.Run("on table", func(t *testing.T) {
+		row := grantRow{
+			Privilege:   "SELECT",
+			GrantedOn:   "TABLE",
+			Name:        `DB.SCHEMA."my.table"`,
+			GrantedTo:   "SHARE",
+			GranteeName: "MYORG.MYACCOUNT.MYSHARE",
+		}
+		grant, err := row.toGrant()
+		require.NoError(t, err)
+		assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "my.table"), grant.Name)
+		assert.Equal(t, "MYSHARE", grant.GranteeName.Name())
+	})
+
+	t.Run("on table with escaped quotes", func(t *testing.T) {
+		row := grantRow{
+			Privilege:   "SELECT",
+			GrantedOn:   "TABLE",
+			Name:        `"my.db".SCHEMA."say ""hi"""`,
+			GrantedTo:   "SHARE",
+			GranteeName: "MYORG.MYACCOUNT.MYSHARE",
+		}
+		grant, err := row.toGrant()
+		require.NoError(t, err)
+		assert.Equal(t, NewSchemaObjectIdentifier("my.db", "SCHEMA", `say "hi"`), grant.Name)
+	})
+
+	t.Run("on schema", func(t *testing.T) {
+		row := grantRow{
+			Privilege:   "USAGE",
+			GrantedOn:   "SCHEMA",
+			Name:        "DB.SCHEMA",
+			GrantedTo:   "SHARE",
+			GranteeName: "AB12345.MYSHARE",
+		}
+		grant, err := row.toGrant()
+		require.NoError(t, err)
+		assert.Equal(t, NewSchemaIdentifier("DB", "SCHEMA"), grant.Name)
+		assert.Equal(t, "MYSHARE", grant.GranteeName.Name())
+	})
+
+	t.Run("on database", func(t *testing.T) {
+		row := grantRow{
+			Privilege:   "REFERENCE_USAGE",
+			GrantedOn:   "DATABASE",
+			Name:        "DB",
+			GrantedTo:   "SHARE",
+			GranteeName: "AB12345.MYSHARE",
+		}
+		grant, err := row.toGrant()
+		require.NoError(t, err)
+		assert.Equal(t, NewAccountObjectIdentifier("DB"), grant.Name)
+		assert.Equal(t, PrivilegeReferenceUsage, grant.Privilege)
+	})
+}
+
+func TestGrantPrivilegesToDatabaseRole(t *testing.T) {
+	dbID := randomAccountObjectIdentifier(t)
+	roleID := NewDatabaseObjectIdentifier(dbID.Name(), "myrole")
+
+	t.Run("on database", func(t *testing.T) {
+		opts := &GrantPrivilegesToDatabaseRoleOptions{
+			privileges:      &DatabaseRoleGrantPrivileges{Privileges: []Privilege{PrivilegeUsage, PrivilegeMonitor}},
+			on:              &DatabaseRoleGrantOn{Database: dbID},
+			databaseRole:    roleID,
+			WithGrantOption: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT USAGE, MONITOR ON DATABASE %s TO DATABASE ROLE %s WITH GRANT OPTION", dbID.FullyQualifiedName(), roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on schema", func(t *testing.T) {
+		schemaID := NewSchemaIdentifier(dbID.Name(), "myschema")
+		opts := &GrantPrivilegesToDatabaseRoleOptions{
+			privileges:   &DatabaseRoleGrantPrivileges{Privileges: []Privilege{PrivilegeUsage}},
+			on:           &DatabaseRoleGrantOn{Schema: &GrantOnSchema{Schema: schemaID}},
+			databaseRole: roleID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT USAGE ON SCHEMA %s TO DATABASE ROLE %s", schemaID.FullyQualifiedName(), roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on future tables in database", func(t *testing.T) {
+		opts := &GrantPrivilegesToDatabaseRoleOptions{
+			privileges: &DatabaseRoleGrantPrivileges{AllPrivileges: Bool(true)},
+			on: &DatabaseRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					Future: &GrantOnSchemaObjectIn{
+						PluralObjectType: PluralObjectTypeTables,
+						InDatabase:       dbID,
+					},
+				},
+			},
+			databaseRole: roleID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT ALL PRIVILEGES ON FUTURE TABLES IN DATABASE %s TO DATABASE ROLE %s", dbID.FullyQualifiedName(), roleID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: database and schema", func(t *testing.T) {
+		opts := &GrantPrivilegesToDatabaseRoleOptions{
+			privileges: &DatabaseRoleGrantPrivileges{Privileges: []Privilege{PrivilegeUsage}},
+			on: &DatabaseRoleGrantOn{
+				Database: dbID,
+				Schema:   &GrantOnSchema{Schema: NewSchemaIdentifier(dbID.Name(), "myschema")},
+			},
+			databaseRole: roleID,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: role without database", func(t *testing.T) {
+		opts := &GrantPrivilegesToDatabaseRoleOptions{
+			privileges:   &DatabaseRoleGrantPrivileges{Privileges: []Privilege{PrivilegeUsage}},
+			on:           &DatabaseRoleGrantOn{Database: dbID},
+			databaseRole: NewDatabaseObjectIdentifier("", "myrole"),
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestRevokePrivilegesFromDatabaseRole(t *testing.T) {
+	dbID := randomAccountObjectIdentifier(t)
+	roleID := NewDatabaseObjectIdentifier(dbID.Name(), "myrole")
+	schemaID := NewSchemaIdentifier(dbID.Name(), "myschema")
+	opts := &RevokePrivilegesFromDatabaseRoleOptions{
+		privileges: &DatabaseRoleGrantPrivileges{Privileges: []Privilege{PrivilegeSelect}},
+		on: &DatabaseRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				All: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InSchema:         schemaID,
+				},
+			},
+		},
+		databaseRole: roleID,
+		Restrict:     Bool(true),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := fmt.Sprintf("REVOKE SELECT ON ALL TABLES IN SCHEMA %s FROM DATABASE ROLE %s RESTRICT", schemaID.FullyQualifiedName(), roleID.FullyQualifiedName())
+	assert.Equal(t, expected, actual)
+}
+
+func TestGrantShowDatabaseRole(t *testing.T) {
+	roleID := NewDatabaseObjectIdentifier("db", "myrole")
+
+	t.Run("to database role", func(t *testing.T) {
+		opts := &ShowGrantOptions{
+			To: &ShowGrantsTo{DatabaseRole: roleID},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW GRANTS TO DATABASE ROLE "db"."myrole"`, actual)
+	})
+
+	t.Run("future to database role", func(t *testing.T) {
+		opts := &ShowGrantOptions{
+			Future: Bool(true),
+			To:     &ShowGrantsTo{DatabaseRole: roleID},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW FUTURE GRANTS TO DATABASE ROLE "db"."myrole"`, actual)
+		assert.NoError(t, opts.validate())
+	})
+
+	t.Run("of database role", func(t *testing.T) {
+		opts := &ShowGrantOptions{
+			Of: &ShowGrantsOf{DatabaseRole: roleID},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW GRANTS OF DATABASE ROLE "db"."myrole"`, actual)
+	})
+
+	t.Run("row granted to database role", func(t *testing.T) {
+		row := grantRow{
+			Privilege:   "USAGE",
+			GrantedOn:   "DATABASE",
+			Name:        "DB",
+			GrantedTo:   "DATABASE_ROLE",
+			GranteeName: "MYROLE",
+		}
+		grant, err := row.toGrant()
+		require.NoError(t, err)
+		assert.Equal(t, ObjectTypeDatabaseRole, grant.GrantedTo)
+	})
 }
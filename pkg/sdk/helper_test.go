@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/hashicorp/go-uuid"
 	"github.com/stretchr/testify/require"
@@ -101,7 +102,7 @@ func useWarehouse(t *testing.T, client *Client, warehouseID AccountObjectIdentif
 func testClient(t *testing.T) *Client {
 	t.Helper()
 
-	client, err := NewDefaultClient()
+	client, err := NewDefaultClient(WithStrictEnumParsing())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -130,7 +131,7 @@ func testClientFromProfile(t *testing.T, profile string) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewClient(config)
+	return NewClient(config, WithStrictEnumParsing())
 }
 
 func randomUUID(t *testing.T) string {
@@ -312,6 +313,32 @@ func createSchema(t *testing.T, client *Client, database *Database) (*Schema, fu
 		}
 }
 
+// createStage creates an internal stage, the SDK does not manage stages yet.
+func createStage(t *testing.T, client *Client, database *Database, schema *Schema) (SchemaObjectIdentifier, func()) {
+	t.Helper()
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	ctx := context.Background()
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE STAGE %s", id.FullyQualifiedName()))
+	require.NoError(t, err)
+	return id, func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP STAGE %s", id.FullyQualifiedName()))
+		require.NoError(t, err)
+	}
+}
+
+// createTable creates a table with the columns ID NUMBER and EMAIL VARCHAR.
+func createTable(t *testing.T, client *Client, database *Database, schema *Schema) (SchemaObjectIdentifier, func()) {
+	t.Helper()
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	ctx := context.Background()
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE TABLE %s (ID NUMBER, EMAIL VARCHAR)", id.FullyQualifiedName()))
+	require.NoError(t, err)
+	return id, func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP TABLE %s", id.FullyQualifiedName()))
+		require.NoError(t, err)
+	}
+}
+
 func createTag(t *testing.T, client *Client, database *Database, schema *Schema) (*Tag, func()) {
 	t.Helper()
 	return createTagWithOptions(t, client, database, schema, &TagCreateOptions{})
@@ -434,3 +461,12 @@ func createMaskingPolicy(t *testing.T, client *Client, database *Database, schem
 	expression := "REPLACE('X', 1, 2)"
 	return createMaskingPolicyWithOptions(t, client, database, schema, signature, DataTypeVARCHAR, expression, &CreateMaskingPolicyOptions{})
 }
+
+// newMockClient returns a client on top of a sqlmock database, which is closed when the test ends.
+func newMockClient(t *testing.T, opts ...ClientOption) (*Client, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return NewClientFromDB(db, opts...), mock
+}
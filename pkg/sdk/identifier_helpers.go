@@ -14,7 +14,156 @@ type ObjectIdentifier interface {
 	FullyQualifiedName() string
 }
 
+// ParseIdentifierParts splits a fully qualified name into its parts. Double-quoted parts can contain dots and
+// double quotes escaped by doubling them, e.g. "my.database"."say ""hi""".TABLE_NAME.
+func ParseIdentifierParts(fullyQualifiedName string) ([]string, error) {
+	parts, err := splitIdentifierParts(fullyQualifiedName)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(parts))
+	for i, part := range parts {
+		names[i] = part.name
+	}
+	return names, nil
+}
+
+// ResolveFullyQualifiedName returns a fully qualified name the way Snowflake resolves it, with the unquoted
+// parts upper-cased and every part double-quoted, e.g. db.PUBLIC."events" becomes "DB"."PUBLIC"."events".
+func ResolveFullyQualifiedName(fullyQualifiedName string) (string, error) {
+	parts, err := splitIdentifierParts(fullyQualifiedName)
+	if err != nil {
+		return "", err
+	}
+	quoted := make([]string, len(parts))
+	for i, part := range parts {
+		name := part.name
+		if !part.quoted {
+			name = strings.ToUpper(name)
+		}
+		quoted[i] = quoteIdentifierPart(name)
+	}
+	return strings.Join(quoted, "."), nil
+}
+
+type identifierPart struct {
+	name   string
+	quoted bool
+}
+
+func splitIdentifierParts(fullyQualifiedName string) ([]identifierPart, error) {
+	var parts []identifierPart
+	rest := fullyQualifiedName
+	for {
+		var part string
+		quoted := strings.HasPrefix(rest, `"`)
+		if quoted {
+			var b strings.Builder
+			i := 1
+			for {
+				j := strings.IndexByte(rest[i:], '"')
+				if j < 0 {
+					return nil, fmt.Errorf("unterminated quoted identifier in %s", fullyQualifiedName)
+				}
+				b.WriteString(rest[i : i+j])
+				i += j + 1
+				if !strings.HasPrefix(rest[i:], `"`) {
+					break
+				}
+				b.WriteByte('"')
+				i++
+			}
+			part, rest = b.String(), rest[i:]
+		} else {
+			j := strings.IndexByte(rest, '.')
+			if j < 0 {
+				j = len(rest)
+			}
+			part, rest = rest[:j], rest[j:]
+			if strings.Contains(part, `"`) {
+				return nil, fmt.Errorf("unexpected double quote in unquoted identifier %s", part)
+			}
+		}
+		if part == "" {
+			return nil, fmt.Errorf("empty identifier part in %s", fullyQualifiedName)
+		}
+		parts = append(parts, identifierPart{name: part, quoted: quoted})
+		if rest == "" {
+			return parts, nil
+		}
+		if rest[0] != '.' {
+			return nil, fmt.Errorf("unexpected %s after quoted identifier in %s", rest, fullyQualifiedName)
+		}
+		rest = rest[1:]
+	}
+}
+
+func parseIdentifierParts(fullyQualifiedName string, expectedParts int) ([]string, error) {
+	parts, err := ParseIdentifierParts(fullyQualifiedName)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != expectedParts {
+		return nil, fmt.Errorf("expected %d parts in identifier %s, got %d", expectedParts, fullyQualifiedName, len(parts))
+	}
+	return parts, nil
+}
+
+// ParseObjectIdentifier parses a fully qualified name with one to four parts, see ParseIdentifierParts.
+func ParseObjectIdentifier(fullyQualifiedName string) (ObjectIdentifier, error) {
+	parts, err := ParseIdentifierParts(fullyQualifiedName)
+	if err != nil {
+		return nil, err
+	}
+	switch len(parts) {
+	case 1:
+		return AccountObjectIdentifier{name: parts[0]}, nil
+	case 2:
+		return SchemaIdentifier{databaseName: parts[0], schemaName: parts[1]}, nil
+	case 3:
+		return SchemaObjectIdentifier{databaseName: parts[0], schemaName: parts[1], name: parts[2]}, nil
+	case 4:
+		return TableColumnIdentifier{databaseName: parts[0], schemaName: parts[1], tableName: parts[2], columnName: parts[3]}, nil
+	}
+	return nil, fmt.Errorf("unexpected number of parts %d in identifier %s", len(parts), fullyQualifiedName)
+}
+
+// ParseAccountObjectIdentifier parses a name like "my.warehouse", see ParseIdentifierParts.
+func ParseAccountObjectIdentifier(fullyQualifiedName string) (AccountObjectIdentifier, error) {
+	parts, err := parseIdentifierParts(fullyQualifiedName, 1)
+	if err != nil {
+		return AccountObjectIdentifier{}, err
+	}
+	return AccountObjectIdentifier{name: parts[0]}, nil
+}
+
+// ParseSchemaIdentifier parses a fully qualified name like "db"."schema", see ParseIdentifierParts.
+func ParseSchemaIdentifier(fullyQualifiedName string) (SchemaIdentifier, error) {
+	parts, err := parseIdentifierParts(fullyQualifiedName, 2)
+	if err != nil {
+		return SchemaIdentifier{}, err
+	}
+	return SchemaIdentifier{databaseName: parts[0], schemaName: parts[1]}, nil
+}
+
+// ParseSchemaObjectIdentifier parses a fully qualified name like "db"."schema"."table", see ParseIdentifierParts.
+func ParseSchemaObjectIdentifier(fullyQualifiedName string) (SchemaObjectIdentifier, error) {
+	parts, err := parseIdentifierParts(fullyQualifiedName, 3)
+	if err != nil {
+		return SchemaObjectIdentifier{}, err
+	}
+	return SchemaObjectIdentifier{databaseName: parts[0], schemaName: parts[1], name: parts[2]}, nil
+}
+
+// quoteIdentifierPart double-quotes a part of an identifier, escaping the double quotes it contains.
+func quoteIdentifierPart(part string) string {
+	return `"` + strings.ReplaceAll(part, `"`, `""`) + `"`
+}
+
 func NewObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) ObjectIdentifier {
+	if id, err := ParseObjectIdentifier(fullyQualifiedName); err == nil {
+		return id
+	}
 	parts := strings.Split(fullyQualifiedName, ".")
 	switch len(parts) {
 	case 1:
@@ -122,7 +271,46 @@ func (i AccountObjectIdentifier) FullyQualifiedName() string {
 	if i.name == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"`, i.name)
+	return quoteIdentifierPart(i.name)
+}
+
+// DatabaseObjectIdentifier identifies objects that live directly in a database, e.g. database roles.
+type DatabaseObjectIdentifier struct {
+	databaseName string
+	name         string
+}
+
+func NewDatabaseObjectIdentifier(databaseName, name string) DatabaseObjectIdentifier {
+	return DatabaseObjectIdentifier{
+		databaseName: strings.Trim(databaseName, `"`),
+		name:         strings.Trim(name, `"`),
+	}
+}
+
+func NewDatabaseObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) DatabaseObjectIdentifier {
+	if parts, err := parseIdentifierParts(fullyQualifiedName, 2); err == nil {
+		return DatabaseObjectIdentifier{databaseName: parts[0], name: parts[1]}
+	}
+	parts := strings.Split(fullyQualifiedName, ".")
+	return DatabaseObjectIdentifier{
+		databaseName: strings.Trim(parts[0], `"`),
+		name:         strings.Trim(parts[1], `"`),
+	}
+}
+
+func (i DatabaseObjectIdentifier) DatabaseName() string {
+	return i.databaseName
+}
+
+func (i DatabaseObjectIdentifier) Name() string {
+	return i.name
+}
+
+func (i DatabaseObjectIdentifier) FullyQualifiedName() string {
+	if i.name == "" && i.databaseName == "" {
+		return ""
+	}
+	return quoteIdentifierPart(i.databaseName) + "." + quoteIdentifierPart(i.name)
 }
 
 type SchemaIdentifier struct {
@@ -138,6 +326,9 @@ func NewSchemaIdentifier(databaseName, schemaName string) SchemaIdentifier {
 }
 
 func NewSchemaIdentifierFromFullyQualifiedName(fullyQualifiedName string) SchemaIdentifier {
+	if id, err := ParseSchemaIdentifier(fullyQualifiedName); err == nil {
+		return id
+	}
 	parts := strings.Split(fullyQualifiedName, ".")
 	return SchemaIdentifier{
 		databaseName: strings.Trim(parts[0], `"`),
@@ -157,7 +348,7 @@ func (i SchemaIdentifier) FullyQualifiedName() string {
 	if i.schemaName == "" && i.databaseName == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"."%v"`, i.databaseName, i.schemaName)
+	return quoteIdentifierPart(i.databaseName) + "." + quoteIdentifierPart(i.schemaName)
 }
 
 type SchemaObjectIdentifier struct {
@@ -175,6 +366,9 @@ func NewSchemaObjectIdentifier(databaseName, schemaName, name string) SchemaObje
 }
 
 func NewSchemaObjectIdentifierFromFullyQualifiedName(fullyQualifiedName string) SchemaObjectIdentifier {
+	if id, err := ParseSchemaObjectIdentifier(fullyQualifiedName); err == nil {
+		return id
+	}
 	parts := strings.Split(fullyQualifiedName, ".")
 	return SchemaObjectIdentifier{
 		databaseName: strings.Trim(parts[0], `"`),
@@ -199,7 +393,7 @@ func (i SchemaObjectIdentifier) FullyQualifiedName() string {
 	if i.schemaName == "" && i.databaseName == "" && i.name == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"`, i.databaseName, i.schemaName, i.name)
+	return quoteIdentifierPart(i.databaseName) + "." + quoteIdentifierPart(i.schemaName) + "." + quoteIdentifierPart(i.name)
 }
 
 type TableColumnIdentifier struct {
@@ -214,6 +408,9 @@ func NewTableColumnIdentifier(databaseName, schemaName, tableName, columnName st
 }
 
 func NewTableColumnIdentifierFromFullyQualifiedName(fullyQualifiedName string) TableColumnIdentifier {
+	if parts, err := parseIdentifierParts(fullyQualifiedName, 4); err == nil {
+		return TableColumnIdentifier{databaseName: parts[0], schemaName: parts[1], tableName: parts[2], columnName: parts[3]}
+	}
 	parts := strings.Split(fullyQualifiedName, ".")
 	return TableColumnIdentifier{
 		databaseName: strings.Trim(parts[0], `"`),
@@ -243,5 +440,5 @@ func (i TableColumnIdentifier) FullyQualifiedName() string {
 	if i.schemaName == "" && i.databaseName == "" && i.tableName == "" && i.columnName == "" {
 		return ""
 	}
-	return fmt.Sprintf(`"%v"."%v"."%v"."%v"`, i.databaseName, i.schemaName, i.tableName, i.columnName)
+	return quoteIdentifierPart(i.databaseName) + "." + quoteIdentifierPart(i.schemaName) + "." + quoteIdentifierPart(i.tableName) + "." + quoteIdentifierPart(i.columnName)
 }
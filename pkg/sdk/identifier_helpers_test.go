@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIdentifierParts(t *testing.T) {
+	t.Run("unquoted parts", func(t *testing.T) {
+		parts, err := ParseIdentifierParts("db.schema.table")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"db", "schema", "table"}, parts)
+	})
+
+	t.Run("quoted parts with dots and quotes", func(t *testing.T) {
+		parts, err := ParseIdentifierParts(`"my.db"."say ""hi"""."table"`)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my.db", `say "hi"`, "table"}, parts)
+	})
+
+	t.Run("mixed parts", func(t *testing.T) {
+		parts, err := ParseIdentifierParts(`DB."my.schema".TABLE`)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"DB", "my.schema", "TABLE"}, parts)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, fullyQualifiedName := range []string{``, `"db`, `"db"x.schema`, `db..table`, `db.sch"ema`, `""`} {
+			_, err := ParseIdentifierParts(fullyQualifiedName)
+			assert.Error(t, err, fullyQualifiedName)
+		}
+	})
+}
+
+func TestParseObjectIdentifier(t *testing.T) {
+	t.Run("by number of parts", func(t *testing.T) {
+		id, err := ParseObjectIdentifier(`"warehouse.1"`)
+		require.NoError(t, err)
+		assert.Equal(t, NewAccountObjectIdentifier("warehouse.1"), id)
+
+		id, err = ParseObjectIdentifier(`"db"."schema"`)
+		require.NoError(t, err)
+		assert.Equal(t, NewSchemaIdentifier("db", "schema"), id)
+
+		id, err = ParseObjectIdentifier(`"db"."schema"."table"."column"`)
+		require.NoError(t, err)
+		assert.Equal(t, NewTableColumnIdentifier("db", "schema", "table", "column"), id)
+	})
+
+	t.Run("too many parts", func(t *testing.T) {
+		_, err := ParseObjectIdentifier("a.b.c.d.e")
+		assert.Error(t, err)
+	})
+
+	t.Run("schema object with wrong number of parts", func(t *testing.T) {
+		_, err := ParseSchemaObjectIdentifier(`"db"."schema"`)
+		assert.Error(t, err)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		expected := NewSchemaObjectIdentifier("my.db", `say "hi" now`, "table")
+		assert.Equal(t, `"my.db"."say ""hi"" now"."table"`, expected.FullyQualifiedName())
+		id, err := ParseSchemaObjectIdentifier(expected.FullyQualifiedName())
+		require.NoError(t, err)
+		assert.Equal(t, expected, id)
+		assert.Equal(t, expected, NewSchemaObjectIdentifierFromFullyQualifiedName(expected.FullyQualifiedName()))
+	})
+}
+
+func TestResolveFullyQualifiedName(t *testing.T) {
+	resolved, err := ResolveFullyQualifiedName(`db.Public."events"`)
+	require.NoError(t, err)
+	assert.Equal(t, `"DB"."PUBLIC"."events"`, resolved)
+
+	resolved, err = ResolveFullyQualifiedName(`"say ""hi"""`)
+	require.NoError(t, err)
+	assert.Equal(t, `"say ""hi"""`, resolved)
+
+	_, err = ResolveFullyQualifiedName(`"unterminated`)
+	require.Error(t, err)
+}
@@ -0,0 +1,115 @@
+package sdk
+
+import (
+	"regexp"
+	"strings"
+)
+
+// IdentifierQuoting controls how the SDK renders identifiers in the statements it builds.
+type IdentifierQuoting string
+
+const (
+	// IdentifierQuotingAlways double-quotes every identifier part, so names are case-sensitive. This is the default.
+	IdentifierQuotingAlways IdentifierQuoting = "ALWAYS"
+	// IdentifierQuotingWhenRequired leaves parts unquoted when Snowflake resolves them to the same name anyway,
+	// i.e. upper-case names made of letters, digits, underscores and dollar signs that are not reserved keywords.
+	IdentifierQuotingWhenRequired IdentifierQuoting = "WHEN_REQUIRED"
+	// IdentifierQuotingNone renders the parts as they are, so unquoted names resolve to upper case. Names that
+	// need quotes have to be quoted by the caller.
+	IdentifierQuotingNone IdentifierQuoting = "NONE"
+)
+
+var unquotedIdentifierPattern = regexp.MustCompile(`^[A-Z_][A-Z0-9_$]*$`)
+
+// reservedKeywords cannot be used as unquoted identifiers,
+// see https://docs.snowflake.com/en/sql-reference/reserved-keywords.
+var reservedKeywords = map[string]struct{}{
+	"ACCOUNT": {}, "ALL": {}, "ALTER": {}, "AND": {}, "ANY": {}, "AS": {}, "BETWEEN": {}, "BY": {}, "CASE": {},
+	"CAST": {}, "CHECK": {}, "COLUMN": {}, "CONNECT": {}, "CONNECTION": {}, "CONSTRAINT": {}, "CREATE": {},
+	"CROSS": {}, "CURRENT": {}, "CURRENT_DATE": {}, "CURRENT_TIME": {}, "CURRENT_TIMESTAMP": {}, "CURRENT_USER": {},
+	"DATABASE": {}, "DELETE": {}, "DISTINCT": {}, "DROP": {}, "ELSE": {}, "EXISTS": {}, "FALSE": {},
+	"FOLLOWING": {}, "FOR": {}, "FROM": {}, "FULL": {}, "GRANT": {}, "GROUP": {}, "GSCLUSTER": {}, "HAVING": {},
+	"ILIKE": {}, "IN": {}, "INCREMENT": {}, "INNER": {}, "INSERT": {}, "INTERSECT": {}, "INTO": {}, "IS": {},
+	"ISSUE": {}, "JOIN": {}, "LATERAL": {}, "LEFT": {}, "LIKE": {}, "LOCALTIME": {}, "LOCALTIMESTAMP": {},
+	"MINUS": {}, "NATURAL": {}, "NOT": {}, "NULL": {}, "OF": {}, "ON": {}, "OR": {}, "ORDER": {},
+	"ORGANIZATION": {}, "QUALIFY": {}, "REGEXP": {}, "REVOKE": {}, "RIGHT": {}, "RLIKE": {}, "ROW": {}, "ROWS": {},
+	"SAMPLE": {}, "SCHEMA": {}, "SELECT": {}, "SET": {}, "SOME": {}, "START": {}, "TABLE": {}, "TABLESAMPLE": {},
+	"THEN": {}, "TO": {}, "TRIGGER": {}, "TRUE": {}, "TRY_CAST": {}, "UNION": {}, "UNIQUE": {}, "UPDATE": {},
+	"USING": {}, "VALUES": {}, "VIEW": {}, "WHEN": {}, "WHENEVER": {}, "WHERE": {}, "WITH": {},
+}
+
+// requiresQuotes reports whether a name resolves to a different name when it is not double-quoted.
+func requiresQuotes(name string) bool {
+	if !unquotedIdentifierPattern.MatchString(name) {
+		return true
+	}
+	_, reserved := reservedKeywords[name]
+	return reserved
+}
+
+func (q IdentifierQuoting) quotePart(part string) string {
+	switch q {
+	case IdentifierQuotingNone:
+		return part
+	case IdentifierQuotingWhenRequired:
+		if !requiresQuotes(part) {
+			return part
+		}
+	}
+	return quoteIdentifierPart(part)
+}
+
+// Name renders a single identifier part.
+func (q IdentifierQuoting) Name(name string) string {
+	return q.quotePart(name)
+}
+
+// FullyQualifiedName renders an object identifier part by part. Identifiers that cannot be split into parts,
+// e.g. with an empty database name, are rendered as by their FullyQualifiedName method.
+func (q IdentifierQuoting) FullyQualifiedName(id ObjectIdentifier) string {
+	fullyQualifiedName := id.FullyQualifiedName()
+	if q == "" || q == IdentifierQuotingAlways || fullyQualifiedName == "" {
+		return fullyQualifiedName
+	}
+	parts, err := ParseIdentifierParts(fullyQualifiedName)
+	if err != nil {
+		return fullyQualifiedName
+	}
+	for i, part := range parts {
+		parts[i] = q.quotePart(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// NormalizeIdentifierName returns the name Snowflake stores for a name rendered with the given quoting, which is
+// the name SHOW and DESCRIBE return. Unquoted names are stored in upper case, and so are quoted names when the
+// QUOTED_IDENTIFIERS_IGNORE_CASE parameter is set. With IdentifierQuotingNone a name the caller double-quoted
+// keeps its case.
+func NormalizeIdentifierName(quoting IdentifierQuoting, name string, quotedIdentifiersIgnoreCase bool) string {
+	if quoting == IdentifierQuotingNone {
+		if !strings.HasPrefix(name, `"`) {
+			return strings.ToUpper(name)
+		}
+		if parts, err := ParseIdentifierParts(name); err == nil && len(parts) == 1 {
+			name = parts[0]
+		}
+	}
+	if quotedIdentifiersIgnoreCase {
+		return strings.ToUpper(name)
+	}
+	return name
+}
+
+// NormalizeAccountObjectIdentifier normalizes the name of id, see NormalizeIdentifierName.
+func NormalizeAccountObjectIdentifier(quoting IdentifierQuoting, id AccountObjectIdentifier, quotedIdentifiersIgnoreCase bool) AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(NormalizeIdentifierName(quoting, id.Name(), quotedIdentifiersIgnoreCase))
+}
+
+// NormalizeSchemaObjectIdentifier normalizes every part of id, see NormalizeIdentifierName.
+func NormalizeSchemaObjectIdentifier(quoting IdentifierQuoting, id SchemaObjectIdentifier, quotedIdentifiersIgnoreCase bool) SchemaObjectIdentifier {
+	return SchemaObjectIdentifier{
+		databaseName: NormalizeIdentifierName(quoting, id.DatabaseName(), quotedIdentifiersIgnoreCase),
+		schemaName:   NormalizeIdentifierName(quoting, id.SchemaName(), quotedIdentifiersIgnoreCase),
+		name:         NormalizeIdentifierName(quoting, id.Name(), quotedIdentifiersIgnoreCase),
+	}
+}
@@ -0,0 +1,65 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifierQuoting(t *testing.T) {
+	id := NewSchemaObjectIdentifier("DB", "my schema", "TABLE")
+
+	t.Run("always", func(t *testing.T) {
+		assert.Equal(t, `"DB"."my schema"."TABLE"`, IdentifierQuotingAlways.FullyQualifiedName(id))
+		assert.Equal(t, `"WH"`, IdentifierQuotingAlways.Name("WH"))
+	})
+
+	t.Run("when required", func(t *testing.T) {
+		assert.Equal(t, `DB."my schema"."TABLE"`, IdentifierQuotingWhenRequired.FullyQualifiedName(id))
+		assert.Equal(t, `WH_1$`, IdentifierQuotingWhenRequired.Name("WH_1$"))
+		assert.Equal(t, `"wh"`, IdentifierQuotingWhenRequired.Name("wh"))
+		assert.Equal(t, `"1WH"`, IdentifierQuotingWhenRequired.Name("1WH"))
+		assert.Equal(t, `"say ""hi"""`, IdentifierQuotingWhenRequired.Name(`say "hi"`))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		assert.Equal(t, `DB.my schema.TABLE`, IdentifierQuotingNone.FullyQualifiedName(id))
+		assert.Equal(t, `wh`, IdentifierQuotingNone.Name("wh"))
+	})
+
+	t.Run("empty identifier", func(t *testing.T) {
+		assert.Equal(t, "", IdentifierQuotingWhenRequired.FullyQualifiedName(NewAccountObjectIdentifier("")))
+	})
+
+	t.Run("structToSQL", func(t *testing.T) {
+		opts := &DropWarehouseOptions{name: NewAccountObjectIdentifier("WH")}
+		sql, err := sqlBuilder{quoting: IdentifierQuotingWhenRequired}.structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `DROP WAREHOUSE WH`, sql)
+
+		sql, err = structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `DROP WAREHOUSE "WH"`, sql)
+	})
+
+	t.Run("client", func(t *testing.T) {
+		client := &Client{}
+		assert.Equal(t, IdentifierQuotingAlways, client.IdentifierQuoting())
+		WithIdentifierQuoting(IdentifierQuotingNone)(client)
+		assert.Equal(t, IdentifierQuotingNone, client.IdentifierQuoting())
+	})
+}
+
+func TestNormalizeIdentifierName(t *testing.T) {
+	assert.Equal(t, "my_wh", NormalizeIdentifierName(IdentifierQuotingAlways, "my_wh", false))
+	assert.Equal(t, "MY_WH", NormalizeIdentifierName(IdentifierQuotingAlways, "my_wh", true))
+	assert.Equal(t, "my_wh", NormalizeIdentifierName(IdentifierQuotingWhenRequired, "my_wh", false))
+	assert.Equal(t, "MY_WH", NormalizeIdentifierName(IdentifierQuotingNone, "my_wh", false))
+	assert.Equal(t, "my_wh", NormalizeIdentifierName(IdentifierQuotingNone, `"my_wh"`, false))
+	assert.Equal(t, "MY_WH", NormalizeIdentifierName(IdentifierQuotingNone, `"my_wh"`, true))
+
+	id := NormalizeSchemaObjectIdentifier(IdentifierQuotingAlways, NewSchemaObjectIdentifier("db", "Schema", "table"), true)
+	assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "TABLE"), id)
+	assert.Equal(t, NewAccountObjectIdentifier("WH"), NormalizeAccountObjectIdentifier(IdentifierQuotingNone, NewAccountObjectIdentifier("wh"), false))
+}
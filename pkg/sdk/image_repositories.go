@@ -0,0 +1,261 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ImageRepositories = (*imageRepositories)(nil)
+
+// ImageRepositories are OCI registries in a schema that store the images Snowpark Container Services run.
+type ImageRepositories interface {
+	// Create creates a new image repository.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateImageRepositoryOptions) error
+	// Drop removes an image repository and all the images in it.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropImageRepositoryOptions) error
+	// Show returns a list of image repositories.
+	Show(ctx context.Context, opts *ShowImageRepositoryOptions) ([]*ImageRepository, error)
+	// ShowByID returns an image repository by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ImageRepository, error)
+	// ShowImages returns the images pushed to an image repository.
+	ShowImages(ctx context.Context, id SchemaObjectIdentifier) ([]*Image, error)
+}
+
+// imageRepositories implements ImageRepositories.
+type imageRepositories struct {
+	client *Client
+}
+
+type CreateImageRepositoryOptions struct {
+	create          bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace       *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	imageRepository bool                   `ddl:"static" sql:"IMAGE REPOSITORY"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists     *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+	Comment         *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateImageRepositoryOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	return nil
+}
+
+func (v *imageRepositories) Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateImageRepositoryOptions) error {
+	if opts == nil {
+		opts = &CreateImageRepositoryOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropImageRepositoryOptions struct {
+	drop            bool                   `ddl:"static" sql:"DROP"`             //lint:ignore U1000 This is used in the ddl tag
+	imageRepository bool                   `ddl:"static" sql:"IMAGE REPOSITORY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists        *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropImageRepositoryOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *imageRepositories) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropImageRepositoryOptions) error {
+	if opts == nil {
+		opts = &DropImageRepositoryOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowImageRepositoryOptions struct {
+	show              bool  `ddl:"static" sql:"SHOW"`               //lint:ignore U1000 This is used in the ddl tag
+	imageRepositories bool  `ddl:"static" sql:"IMAGE REPOSITORIES"` //lint:ignore U1000 This is used in the ddl tag
+	Like              *Like `ddl:"keyword" sql:"LIKE"`
+	In                *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowImageRepositoryOptions) validate() error {
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ImageRepository struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	// RepositoryURL is the registry hostname and path images are pushed to, e.g. with docker push.
+	RepositoryURL            string
+	PrivatelinkRepositoryURL string
+	Owner                    string
+	OwnerRoleType            string
+	Comment                  string
+}
+
+func (v *ImageRepository) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *ImageRepository) ObjectType() ObjectType {
+	return ObjectTypeImageRepository
+}
+
+type imageRepositoryRow struct {
+	CreatedOn                time.Time      `db:"created_on"`
+	Name                     string         `db:"name"`
+	DatabaseName             string         `db:"database_name"`
+	SchemaName               string         `db:"schema_name"`
+	RepositoryURL            string         `db:"repository_url"`
+	PrivatelinkRepositoryURL sql.NullString `db:"privatelink_repository_url"`
+	Owner                    sql.NullString `db:"owner"`
+	OwnerRoleType            sql.NullString `db:"owner_role_type"`
+	Comment                  sql.NullString `db:"comment"`
+}
+
+func (row imageRepositoryRow) toImageRepository() *ImageRepository {
+	return &ImageRepository{
+		CreatedOn:                row.CreatedOn,
+		Name:                     row.Name,
+		DatabaseName:             row.DatabaseName,
+		SchemaName:               row.SchemaName,
+		RepositoryURL:            row.RepositoryURL,
+		PrivatelinkRepositoryURL: row.PrivatelinkRepositoryURL.String,
+		Owner:                    row.Owner.String,
+		OwnerRoleType:            row.OwnerRoleType.String,
+		Comment:                  row.Comment.String,
+	}
+}
+
+func (v *imageRepositories) Show(ctx context.Context, opts *ShowImageRepositoryOptions) ([]*ImageRepository, error) {
+	if opts == nil {
+		opts = &ShowImageRepositoryOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []imageRepositoryRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ImageRepository, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toImageRepository()
+	}
+	return resultList, nil
+}
+
+func (v *imageRepositories) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ImageRepository, error) {
+	repositories, err := v.Show(ctx, &ShowImageRepositoryOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, repository := range repositories {
+		if repository.Name == id.Name() {
+			return repository, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type showImagesOptions struct {
+	show              bool                   `ddl:"static" sql:"SHOW IMAGES"` //lint:ignore U1000 This is used in the ddl tag
+	inImageRepository SchemaObjectIdentifier `ddl:"identifier" sql:"IN IMAGE REPOSITORY"`
+}
+
+func (opts *showImagesOptions) validate() error {
+	if !validObjectidentifier(opts.inImageRepository) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type Image struct {
+	CreatedOn time.Time
+	ImageName string
+	Tags      string
+	Digest    string
+	// ImagePath is the path to reference the image by in a service specification.
+	ImagePath string
+}
+
+type imageRow struct {
+	CreatedOn time.Time      `db:"created_on"`
+	ImageName string         `db:"image_name"`
+	Tags      sql.NullString `db:"tags"`
+	Digest    sql.NullString `db:"digest"`
+	ImagePath sql.NullString `db:"image_path"`
+}
+
+func (row imageRow) toImage() *Image {
+	return &Image{
+		CreatedOn: row.CreatedOn,
+		ImageName: row.ImageName,
+		Tags:      row.Tags.String,
+		Digest:    row.Digest.String,
+		ImagePath: row.ImagePath.String,
+	}
+}
+
+func (v *imageRepositories) ShowImages(ctx context.Context, id SchemaObjectIdentifier) ([]*Image, error) {
+	opts := &showImagesOptions{
+		inImageRepository: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []imageRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Image, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toImage()
+	}
+	return resultList, nil
+}
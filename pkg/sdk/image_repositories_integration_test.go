@@ -0,0 +1,47 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ImageRepositories(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err := client.ImageRepositories.Create(ctx, id, &CreateImageRepositoryOptions{Comment: String("some comment")})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ImageRepositories.Drop(ctx, id, &DropImageRepositoryOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		repository, err := client.ImageRepositories.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, repository.ID())
+		assert.NotEmpty(t, repository.RepositoryURL)
+		assert.Equal(t, "some comment", repository.Comment)
+	})
+
+	t.Run("show images", func(t *testing.T) {
+		images, err := client.ImageRepositories.ShowImages(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, images)
+	})
+
+	t.Run("show in schema", func(t *testing.T) {
+		repositories, err := client.ImageRepositories.Show(ctx, &ShowImageRepositoryOptions{In: &In{Schema: schema.ID()}})
+		require.NoError(t, err)
+		assert.Len(t, repositories, 1)
+	})
+}
@@ -0,0 +1,59 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageRepositoryCreate(t *testing.T) {
+	t.Run("with comment", func(t *testing.T) {
+		opts := &CreateImageRepositoryOptions{
+			IfNotExists: Bool(true),
+			name:        NewSchemaObjectIdentifier("db", "schema", "myrepo"),
+			Comment:     String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE IMAGE REPOSITORY IF NOT EXISTS "db"."schema"."myrepo" COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateImageRepositoryOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        NewSchemaObjectIdentifier("db", "schema", "myrepo"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestImageRepositoryDrop(t *testing.T) {
+	opts := &DropImageRepositoryOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "myrepo"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP IMAGE REPOSITORY IF EXISTS "db"."schema"."myrepo"`, actual)
+}
+
+func TestImageRepositoryShow(t *testing.T) {
+	opts := &ShowImageRepositoryOptions{
+		Like: &Like{Pattern: String("myrepo")},
+		In:   &In{Schema: NewSchemaIdentifier("db", "schema")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW IMAGE REPOSITORIES LIKE 'myrepo' IN SCHEMA "db"."schema"`, actual)
+}
+
+func TestImageRepositoryShowImages(t *testing.T) {
+	opts := &showImagesOptions{
+		inImageRepository: NewSchemaObjectIdentifier("db", "schema", "myrepo"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW IMAGES IN IMAGE REPOSITORY "db"."schema"."myrepo"`, actual)
+}
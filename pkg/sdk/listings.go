@@ -0,0 +1,428 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Listings = (*listings)(nil)
+
+// Listings describes all the listing related methods that the Snowflake API supports.
+// Listings offer a share or an application package on the Snowflake Marketplace or privately
+// to the target accounts named in the manifest.
+type Listings interface {
+	// Create creates a new listing.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateListingOptions) error
+	// Alter modifies an existing listing.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterListingOptions) error
+	// Drop removes a listing.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropListingOptions) error
+	// Show returns a list of listings.
+	Show(ctx context.Context, opts *ShowListingOptions) ([]*Listing, error)
+	// ShowByID returns a listing by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Listing, error)
+	// Describe returns the details of a listing, including its manifest.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*ListingDetails, error)
+}
+
+// listings implements Listings.
+type listings struct {
+	client *Client
+}
+
+type ListingState string
+
+const (
+	ListingStateDraft       ListingState = "DRAFT"
+	ListingStatePublished   ListingState = "PUBLISHED"
+	ListingStateUnpublished ListingState = "UNPUBLISHED"
+)
+
+var allListingStates = []ListingState{
+	ListingStateDraft,
+	ListingStatePublished,
+	ListingStateUnpublished,
+}
+
+type CreateListingOptions struct {
+	create          bool                    `ddl:"static" sql:"CREATE"`           //lint:ignore U1000 This is used in the ddl tag
+	externalListing bool                    `ddl:"static" sql:"EXTERNAL LISTING"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists     *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name            AccountObjectIdentifier `ddl:"identifier"`
+
+	// Share and ApplicationPackage are the data product of the listing; at most one can be set.
+	// A listing without either can only be created as a draft and attached later through the manifest.
+	Share              AccountObjectIdentifier `ddl:"identifier" sql:"SHARE"`
+	ApplicationPackage AccountObjectIdentifier `ddl:"identifier" sql:"APPLICATION PACKAGE"`
+
+	// Manifest is the YAML manifest of the listing, including its title, description and targets.
+	Manifest string  `ddl:"parameter,single_quotes,no_equals" sql:"AS"`
+	Publish  *bool   `ddl:"parameter" sql:"PUBLISH"`
+	Review   *bool   `ddl:"parameter" sql:"REVIEW"`
+	Comment  *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateListingOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.Manifest == "" {
+		return errors.New("Manifest is required")
+	}
+	if opts.Share.Name() != "" && opts.ApplicationPackage.Name() != "" {
+		return errors.New("Share and ApplicationPackage cannot both be set")
+	}
+	return nil
+}
+
+func (v *listings) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateListingOptions) error {
+	if opts == nil {
+		opts = &CreateListingOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterListingOptions struct {
+	alter    bool                    `ddl:"static" sql:"ALTER"`   //lint:ignore U1000 This is used in the ddl tag
+	listing  bool                    `ddl:"static" sql:"LISTING"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+
+	// Publish, Unpublish and Review move the listing between its states.
+	Publish   *bool `ddl:"keyword" sql:"PUBLISH"`
+	Unpublish *bool `ddl:"keyword" sql:"UNPUBLISH"`
+	Review    *bool `ddl:"keyword" sql:"REVIEW"`
+
+	AlterManifest *ListingManifest        `ddl:"keyword"`
+	NewName       AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set           *ListingSet             `ddl:"keyword" sql:"SET"`
+}
+
+func (opts *AlterListingOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Publish, opts.Unpublish, opts.Review, opts.AlterManifest, opts.NewName, opts.Set) {
+		return errors.New("exactly one of Publish, Unpublish, Review, AlterManifest, NewName, Set must be set")
+	}
+	if valueSet(opts.AlterManifest) && opts.AlterManifest.Manifest == "" {
+		return errors.New("AlterManifest.Manifest is required")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListingManifest replaces the manifest of a listing.
+type ListingManifest struct {
+	Manifest string `ddl:"parameter,single_quotes,no_equals" sql:"AS"`
+	Publish  *bool  `ddl:"parameter" sql:"PUBLISH"`
+	Review   *bool  `ddl:"parameter" sql:"REVIEW"`
+}
+
+type ListingSet struct {
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *ListingSet) validate() error {
+	if !anyValueSet(v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	return nil
+}
+
+func (v *listings) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterListingOptions) error {
+	if opts == nil {
+		opts = &AlterListingOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropListingOptions struct {
+	drop     bool                    `ddl:"static" sql:"DROP"`    //lint:ignore U1000 This is used in the ddl tag
+	listing  bool                    `ddl:"static" sql:"LISTING"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropListingOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *listings) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropListingOptions) error {
+	if opts == nil {
+		opts = &DropListingOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowListingOptions represents the options for listing listings.
+type ShowListingOptions struct {
+	show     bool  `ddl:"static" sql:"SHOW"`     //lint:ignore U1000 This is used in the ddl tag
+	listings bool  `ddl:"static" sql:"LISTINGS"` //lint:ignore U1000 This is used in the ddl tag
+	Like     *Like `ddl:"keyword" sql:"LIKE"`
+	ShowOptions
+}
+
+func (opts *ShowListingOptions) validate() error {
+	return nil
+}
+
+type Listing struct {
+	GlobalName     string
+	Name           string
+	Title          string
+	Subtitle       string
+	Profile        string
+	CreatedOn      time.Time
+	UpdatedOn      time.Time
+	PublishedOn    time.Time
+	State          ListingState
+	ReviewState    string
+	Comment        string
+	Owner          string
+	OwnerRoleType  string
+	Regions        string
+	TargetAccounts string
+	IsMonetized    bool
+	IsApplication  bool
+	IsTargeted     bool
+}
+
+func (v *Listing) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *Listing) ObjectType() ObjectType {
+	return ObjectTypeListing
+}
+
+type listingRow struct {
+	GlobalName     string         `db:"global_name"`
+	Name           string         `db:"name"`
+	Title          sql.NullString `db:"title"`
+	Subtitle       sql.NullString `db:"subtitle"`
+	Profile        sql.NullString `db:"profile"`
+	CreatedOn      time.Time      `db:"created_on"`
+	UpdatedOn      sql.NullTime   `db:"updated_on"`
+	PublishedOn    sql.NullTime   `db:"published_on"`
+	State          string         `db:"state"`
+	ReviewState    sql.NullString `db:"review_state"`
+	Comment        sql.NullString `db:"comment"`
+	Owner          string         `db:"owner"`
+	OwnerRoleType  string         `db:"owner_role_type"`
+	Regions        sql.NullString `db:"regions"`
+	TargetAccounts sql.NullString `db:"target_accounts"`
+	IsMonetized    bool           `db:"is_monetized"`
+	IsApplication  bool           `db:"is_application"`
+	IsTargeted     bool           `db:"is_targeted"`
+}
+
+func (row *listingRow) toListing(strict bool) (*Listing, error) {
+	state, err := toEnum(strict, "listing state", row.State, allListingStates)
+	if err != nil {
+		return nil, err
+	}
+	v := &Listing{
+		GlobalName:     row.GlobalName,
+		Name:           row.Name,
+		Title:          row.Title.String,
+		Subtitle:       row.Subtitle.String,
+		Profile:        row.Profile.String,
+		CreatedOn:      row.CreatedOn,
+		State:          state,
+		ReviewState:    row.ReviewState.String,
+		Comment:        row.Comment.String,
+		Owner:          row.Owner,
+		OwnerRoleType:  row.OwnerRoleType,
+		Regions:        row.Regions.String,
+		TargetAccounts: row.TargetAccounts.String,
+		IsMonetized:    row.IsMonetized,
+		IsApplication:  row.IsApplication,
+		IsTargeted:     row.IsTargeted,
+	}
+	if row.UpdatedOn.Valid {
+		v.UpdatedOn = row.UpdatedOn.Time
+	}
+	if row.PublishedOn.Valid {
+		v.PublishedOn = row.PublishedOn.Time
+	}
+	return v, nil
+}
+
+func (v *listings) Show(ctx context.Context, opts *ShowListingOptions) ([]*Listing, error) {
+	if opts == nil {
+		opts = &ShowListingOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []listingRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Listing, 0, len(dest))
+	for _, row := range dest {
+		listing, err := row.toListing(v.client.strictEnumParsing)
+		if err != nil {
+			return nil, err
+		}
+		resultList = append(resultList, listing)
+	}
+	return resultList, nil
+}
+
+func (v *listings) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Listing, error) {
+	listings, err := v.Show(ctx, &ShowListingOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, listing := range listings {
+		if listing.Name == id.Name() {
+			return listing, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeListingOptions struct {
+	describe bool                    `ddl:"static" sql:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	listing  bool                    `ddl:"static" sql:"LISTING"`  //lint:ignore U1000 This is used in the ddl tag
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeListingOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ListingDetails contains the output of DESCRIBE LISTING.
+type ListingDetails struct {
+	GlobalName         string
+	Name               string
+	Owner              string
+	Title              string
+	Subtitle           string
+	Description        string
+	State              ListingState
+	ReviewState        string
+	RejectionReason    string
+	Share              string
+	ApplicationPackage string
+	TargetAccounts     string
+	Regions            string
+	ManifestYAML       string
+	Comment            string
+	CreatedOn          time.Time
+	UpdatedOn          time.Time
+	PublishedOn        time.Time
+}
+
+type listingDetailsRow struct {
+	GlobalName         string         `db:"global_name"`
+	Name               string         `db:"name"`
+	Owner              string         `db:"owner"`
+	Title              sql.NullString `db:"title"`
+	Subtitle           sql.NullString `db:"subtitle"`
+	Description        sql.NullString `db:"description"`
+	State              string         `db:"state"`
+	ReviewState        sql.NullString `db:"review_state"`
+	RejectionReason    sql.NullString `db:"rejection_reason"`
+	Share              sql.NullString `db:"share"`
+	ApplicationPackage sql.NullString `db:"application_package"`
+	TargetAccounts     sql.NullString `db:"target_accounts"`
+	Regions            sql.NullString `db:"regions"`
+	ManifestYAML       sql.NullString `db:"manifest_yaml"`
+	Comment            sql.NullString `db:"comment"`
+	CreatedOn          time.Time      `db:"created_on"`
+	UpdatedOn          sql.NullTime   `db:"updated_on"`
+	PublishedOn        sql.NullTime   `db:"published_on"`
+}
+
+func (row *listingDetailsRow) toListingDetails(strict bool) (*ListingDetails, error) {
+	state, err := toEnum(strict, "listing state", row.State, allListingStates)
+	if err != nil {
+		return nil, err
+	}
+	v := &ListingDetails{
+		GlobalName:         row.GlobalName,
+		Name:               row.Name,
+		Owner:              row.Owner,
+		Title:              row.Title.String,
+		Subtitle:           row.Subtitle.String,
+		Description:        row.Description.String,
+		State:              state,
+		ReviewState:        row.ReviewState.String,
+		RejectionReason:    row.RejectionReason.String,
+		Share:              row.Share.String,
+		ApplicationPackage: row.ApplicationPackage.String,
+		TargetAccounts:     row.TargetAccounts.String,
+		Regions:            row.Regions.String,
+		ManifestYAML:       row.ManifestYAML.String,
+		Comment:            row.Comment.String,
+		CreatedOn:          row.CreatedOn,
+	}
+	if row.UpdatedOn.Valid {
+		v.UpdatedOn = row.UpdatedOn.Time
+	}
+	if row.PublishedOn.Valid {
+		v.PublishedOn = row.PublishedOn.Time
+	}
+	return v, nil
+}
+
+func (v *listings) Describe(ctx context.Context, id AccountObjectIdentifier) (*ListingDetails, error) {
+	opts := &describeListingOptions{
+		name: id,
+	}
+	dest, err := describeRow[listingDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toListingDetails(v.client.strictEnumParsing)
+}
@@ -0,0 +1,105 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Listings(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	share, shareCleanup := createShare(t, client)
+	t.Cleanup(shareCleanup)
+	err := client.Grants.GrantPrivilegeToShare(ctx, PrivilegeUsage, &GrantPrivilegeToShareOn{
+		Database: database.ID(),
+	}, share.ID())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Grants.RevokePrivilegeFromShare(ctx, PrivilegeUsage, &RevokePrivilegeFromShareOn{
+			Database: database.ID(),
+		}, share.ID())
+		require.NoError(t, err)
+	})
+
+	target := getAccountIdentifier(t, testSecondaryClient(t))
+	manifest := func(title string) string {
+		return fmt.Sprintf(`title: "%s"
+subtitle: "subtitle"
+description: "description"
+listing_terms:
+  type: "OFFLINE"
+targets:
+  accounts: ["%s"]`, title, target.Name())
+	}
+
+	id := randomAccountObjectIdentifier(t)
+	err = client.Listings.Create(ctx, id, &CreateListingOptions{
+		Share:    share.ID(),
+		Manifest: manifest("test listing"),
+		Publish:  Bool(false),
+		Review:   Bool(false),
+		Comment:  String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Listings.Drop(ctx, id, &DropListingOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		listing, err := client.Listings.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), listing.Name)
+		assert.Equal(t, "test listing", listing.Title)
+		assert.Equal(t, ListingStateDraft, listing.State)
+		assert.Equal(t, "some comment", listing.Comment)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		details, err := client.Listings.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), details.Name)
+		assert.Equal(t, share.ID().Name(), details.Share)
+		assert.Contains(t, details.ManifestYAML, "test listing")
+	})
+
+	t.Run("alter manifest and publish", func(t *testing.T) {
+		err := client.Listings.Alter(ctx, id, &AlterListingOptions{
+			AlterManifest: &ListingManifest{Manifest: manifest("updated listing"), Review: Bool(false)},
+		})
+		require.NoError(t, err)
+		err = client.Listings.Alter(ctx, id, &AlterListingOptions{Publish: Bool(true)})
+		require.NoError(t, err)
+
+		listing, err := client.Listings.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "updated listing", listing.Title)
+		assert.Equal(t, ListingStatePublished, listing.State)
+
+		err = client.Listings.Alter(ctx, id, &AlterListingOptions{Unpublish: Bool(true)})
+		require.NoError(t, err)
+		listing, err = client.Listings.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, ListingStateUnpublished, listing.State)
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		err := client.Listings.Alter(ctx, id, &AlterListingOptions{Set: &ListingSet{Comment: String("new comment")}})
+		require.NoError(t, err)
+		listing, err := client.Listings.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", listing.Comment)
+	})
+
+	t.Run("show by id: not existing", func(t *testing.T) {
+		_, err := client.Listings.ShowByID(ctx, randomAccountObjectIdentifier(t))
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,189 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testListingManifest = `title: "test listing"
+subtitle: "subtitle"
+description: "description"
+listing_terms:
+  type: "OFFLINE"
+targets:
+  accounts: ["ORG.ACCOUNT"]`
+
+func TestListingsCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("with share", func(t *testing.T) {
+		opts := &CreateListingOptions{
+			name:     id,
+			Share:    NewAccountObjectIdentifier("share"),
+			Manifest: "title: 'test'",
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE EXTERNAL LISTING ` + id.FullyQualifiedName() + ` SHARE "share" AS 'title: \'test\''`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := &CreateListingOptions{
+			IfNotExists:        Bool(true),
+			name:               id,
+			ApplicationPackage: NewAccountObjectIdentifier("package"),
+			Manifest:           testListingManifest,
+			Publish:            Bool(false),
+			Review:             Bool(false),
+			Comment:            String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE EXTERNAL LISTING IF NOT EXISTS ` + id.FullyQualifiedName() + ` APPLICATION PACKAGE "package" AS '` + testListingManifest + `' PUBLISH = false REVIEW = false COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: no manifest", func(t *testing.T) {
+		opts := &CreateListingOptions{
+			name:  id,
+			Share: NewAccountObjectIdentifier("share"),
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: share and application package", func(t *testing.T) {
+		opts := &CreateListingOptions{
+			name:               id,
+			Share:              NewAccountObjectIdentifier("share"),
+			ApplicationPackage: NewAccountObjectIdentifier("package"),
+			Manifest:           testListingManifest,
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestListingsAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("state changes", func(t *testing.T) {
+		for keyword, opts := range map[string]*AlterListingOptions{
+			"PUBLISH":   {name: id, Publish: Bool(true)},
+			"UNPUBLISH": {name: id, Unpublish: Bool(true)},
+			"REVIEW":    {name: id, Review: Bool(true)},
+		} {
+			require.NoError(t, opts.validate())
+			actual, err := structToSQL(opts)
+			require.NoError(t, err)
+			assert.Equal(t, `ALTER LISTING `+id.FullyQualifiedName()+` `+keyword, actual)
+		}
+	})
+
+	t.Run("manifest", func(t *testing.T) {
+		opts := &AlterListingOptions{
+			IfExists: Bool(true),
+			name:     id,
+			AlterManifest: &ListingManifest{
+				Manifest: testListingManifest,
+				Publish:  Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER LISTING IF EXISTS ` + id.FullyQualifiedName() + ` AS '` + testListingManifest + `' PUBLISH = true`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterListingOptions{
+			name:    id,
+			NewName: NewAccountObjectIdentifier("new_name"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER LISTING `+id.FullyQualifiedName()+` RENAME TO "new_name"`, actual)
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := &AlterListingOptions{
+			name: id,
+			Set:  &ListingSet{Comment: String("some comment")},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER LISTING `+id.FullyQualifiedName()+` SET COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterListingOptions{name: id}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: more than one action", func(t *testing.T) {
+		opts := &AlterListingOptions{name: id, Publish: Bool(true), Review: Bool(true)}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: empty manifest", func(t *testing.T) {
+		opts := &AlterListingOptions{name: id, AlterManifest: &ListingManifest{}}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestListingsDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &DropListingOptions{IfExists: Bool(true), name: id}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP LISTING IF EXISTS `+id.FullyQualifiedName(), actual)
+}
+
+func TestListingsShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&ShowListingOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW LISTINGS`, actual)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := &ShowListingOptions{
+			Like: &Like{Pattern: String("test%")},
+			ShowOptions: ShowOptions{
+				StartsWith: String("test"),
+				Limit:      &LimitFrom{Rows: Int(10), From: String("test_a")},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW LISTINGS LIKE 'test%' STARTS WITH 'test' LIMIT 10 FROM 'test_a'`, actual)
+	})
+}
+
+func TestListingsDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	actual, err := structToSQL(&describeListingOptions{name: id})
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE LISTING `+id.FullyQualifiedName(), actual)
+}
+
+func TestListingRowState(t *testing.T) {
+	row := listingRow{Name: "listing", State: "PUBLISHED"}
+	listing, err := row.toListing(true)
+	require.NoError(t, err)
+	assert.Equal(t, ListingStatePublished, listing.State)
+
+	row.State = "ARCHIVED"
+	_, err = row.toListing(true)
+	require.ErrorIs(t, err, ErrUnknownEnumValue)
+	listing, err = row.toListing(false)
+	require.NoError(t, err)
+	assert.Equal(t, ListingState("ARCHIVED"), listing.State)
+}
@@ -0,0 +1,203 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ManagedAccounts = (*managedAccounts)(nil)
+
+// ManagedAccounts describes all the managed account related methods that the Snowflake API supports.
+// Managed accounts are reader accounts through which shares are consumed by parties without a Snowflake account.
+type ManagedAccounts interface {
+	// Create creates a new reader account.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateManagedAccountOptions) error
+	// Drop removes a reader account.
+	Drop(ctx context.Context, id AccountObjectIdentifier) error
+	// Show returns a list of managed accounts.
+	Show(ctx context.Context, opts *ShowManagedAccountOptions) ([]*ManagedAccount, error)
+	// ShowByID returns a managed account by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ManagedAccount, error)
+}
+
+// managedAccounts implements ManagedAccounts.
+type managedAccounts struct {
+	client *Client
+}
+
+type CreateManagedAccountOptions struct {
+	create         bool                    `ddl:"static" sql:"CREATE"`          //lint:ignore U1000 This is used in the ddl tag
+	managedAccount bool                    `ddl:"static" sql:"MANAGED ACCOUNT"` //lint:ignore U1000 This is used in the ddl tag
+	name           AccountObjectIdentifier `ddl:"identifier"`
+
+	// Properties are required, CREATE MANAGED ACCOUNT takes them as a comma separated list.
+	Properties *ManagedAccountProperties `ddl:"list,no_parentheses"`
+}
+
+type ManagedAccountProperties struct {
+	AdminName     string  `ddl:"parameter,single_quotes" sql:"ADMIN_NAME"`
+	AdminPassword string  `ddl:"parameter,single_quotes" sql:"ADMIN_PASSWORD"`
+	readerType    bool    `ddl:"static" sql:"TYPE = READER"` //lint:ignore U1000 This is used in the ddl tag
+	Comment       *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateManagedAccountOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.Properties == nil {
+		return errors.New("Properties are required")
+	}
+	if opts.Properties.AdminName == "" {
+		return errors.New("AdminName is required")
+	}
+	if opts.Properties.AdminPassword == "" {
+		return errors.New("AdminPassword is required")
+	}
+	return nil
+}
+
+func (v *managedAccounts) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateManagedAccountOptions) error {
+	if opts == nil {
+		opts = &CreateManagedAccountOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type dropManagedAccountOptions struct {
+	drop           bool                    `ddl:"static" sql:"DROP"`            //lint:ignore U1000 This is used in the ddl tag
+	managedAccount bool                    `ddl:"static" sql:"MANAGED ACCOUNT"` //lint:ignore U1000 This is used in the ddl tag
+	name           AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *dropManagedAccountOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *managedAccounts) Drop(ctx context.Context, id AccountObjectIdentifier) error {
+	opts := &dropManagedAccountOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowManagedAccountOptions represents the options for listing managed accounts.
+type ShowManagedAccountOptions struct {
+	show            bool  `ddl:"static" sql:"SHOW"`             //lint:ignore U1000 This is used in the ddl tag
+	managedAccounts bool  `ddl:"static" sql:"MANAGED ACCOUNTS"` //lint:ignore U1000 This is used in the ddl tag
+	Like            *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowManagedAccountOptions) validate() error {
+	return nil
+}
+
+type ManagedAccount struct {
+	Name      string
+	Cloud     string
+	Region    string
+	Locator   string
+	CreatedOn time.Time
+	// URL is the URL through which the reader account is accessed.
+	URL      string
+	Comment  string
+	IsReader bool
+}
+
+func (v *ManagedAccount) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+// AccountID returns the identifier of the reader account, e.g. for adding it to a share.
+func (v *ManagedAccount) AccountID() AccountIdentifier {
+	return NewAccountIdentifierFromAccountLocator(v.Locator)
+}
+
+func (v *ManagedAccount) ObjectType() ObjectType {
+	return ObjectTypeManagedAccount
+}
+
+type managedAccountRow struct {
+	Name      string         `db:"name"`
+	Cloud     sql.NullString `db:"cloud"`
+	Region    sql.NullString `db:"region"`
+	Locator   sql.NullString `db:"locator"`
+	CreatedOn time.Time      `db:"created_on"`
+	URL       sql.NullString `db:"url"`
+	Comment   sql.NullString `db:"comment"`
+	IsReader  bool           `db:"is_reader"`
+}
+
+func (row *managedAccountRow) toManagedAccount() *ManagedAccount {
+	return &ManagedAccount{
+		Name:      row.Name,
+		Cloud:     row.Cloud.String,
+		Region:    row.Region.String,
+		Locator:   row.Locator.String,
+		CreatedOn: row.CreatedOn,
+		URL:       row.URL.String,
+		Comment:   row.Comment.String,
+		IsReader:  row.IsReader,
+	}
+}
+
+func (v *managedAccounts) Show(ctx context.Context, opts *ShowManagedAccountOptions) ([]*ManagedAccount, error) {
+	if opts == nil {
+		opts = &ShowManagedAccountOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []managedAccountRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ManagedAccount, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toManagedAccount()
+	}
+	return resultList, nil
+}
+
+func (v *managedAccounts) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ManagedAccount, error) {
+	managedAccounts, err := v.Show(ctx, &ShowManagedAccountOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, managedAccount := range managedAccounts {
+		if managedAccount.Name == id.Name() {
+			return managedAccount, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
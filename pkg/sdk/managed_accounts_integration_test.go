@@ -0,0 +1,44 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ManagedAccounts(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.ManagedAccounts.Create(ctx, id, &CreateManagedAccountOptions{
+		Properties: &ManagedAccountProperties{
+			AdminName:     "someadmin",
+			AdminPassword: randomStringN(t, 12) + "aA1",
+			Comment:       String("some comment"),
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ManagedAccounts.Drop(ctx, id)
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		managedAccount, err := client.ManagedAccounts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), managedAccount.Name)
+		assert.True(t, managedAccount.IsReader)
+		assert.Equal(t, "some comment", managedAccount.Comment)
+		assert.NotEmpty(t, managedAccount.Locator)
+		assert.NotEmpty(t, managedAccount.URL)
+		assert.Equal(t, managedAccount.Locator, managedAccount.AccountID().Name())
+	})
+
+	t.Run("show by id: not existing", func(t *testing.T) {
+		_, err := client.ManagedAccounts.ShowByID(ctx, randomAccountObjectIdentifier(t))
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagedAccountsCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("reader1")
+
+	t.Run("all options", func(t *testing.T) {
+		opts := &CreateManagedAccountOptions{
+			name: id,
+			Properties: &ManagedAccountProperties{
+				AdminName:     "admin",
+				AdminPassword: "Passw0rd",
+				Comment:       String("some comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE MANAGED ACCOUNT "reader1" ADMIN_NAME = 'admin', ADMIN_PASSWORD = 'Passw0rd', TYPE = READER, COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: no admin password", func(t *testing.T) {
+		opts := &CreateManagedAccountOptions{
+			name:       id,
+			Properties: &ManagedAccountProperties{AdminName: "admin"},
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no properties", func(t *testing.T) {
+		opts := &CreateManagedAccountOptions{name: id}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestManagedAccountsDropAndShow(t *testing.T) {
+	actual, err := structToSQL(&dropManagedAccountOptions{name: NewAccountObjectIdentifier("reader1")})
+	require.NoError(t, err)
+	assert.Equal(t, `DROP MANAGED ACCOUNT "reader1"`, actual)
+
+	actual, err = structToSQL(&ShowManagedAccountOptions{Like: &Like{Pattern: String("reader%")}})
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW MANAGED ACCOUNTS LIKE 'reader%'`, actual)
+}
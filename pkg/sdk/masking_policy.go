@@ -71,7 +71,7 @@ func (v *maskingPolicies) Create(ctx context.Context, id SchemaObjectIdentifier,
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -152,7 +152,7 @@ func (v *maskingPolicies) Alter(ctx context.Context, id SchemaObjectIdentifier,
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -181,7 +181,7 @@ func (v *maskingPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier) e
 	if err := opts.validate(); err != nil {
 		return fmt.Errorf("validate drop options: %w", err)
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -194,11 +194,11 @@ func (v *maskingPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier) e
 
 // ShowMaskingPolicyOptions represents the options for listing masking policies.
 type ShowMaskingPolicyOptions struct {
-	show            bool  `ddl:"static" sql:"SHOW"`             //lint:ignore U1000 This is used in the ddl tag
-	maskingPolicies bool  `ddl:"static" sql:"MASKING POLICIES"` //lint:ignore U1000 This is used in the ddl tag
-	Like            *Like `ddl:"keyword" sql:"LIKE"`
-	In              *In   `ddl:"keyword" sql:"IN"`
-	Limit           *int  `ddl:"parameter,no_equals" sql:"LIMIT"`
+	show            bool       `ddl:"static" sql:"SHOW"`             //lint:ignore U1000 This is used in the ddl tag
+	maskingPolicies bool       `ddl:"static" sql:"MASKING POLICIES"` //lint:ignore U1000 This is used in the ddl tag
+	Like            *Like      `ddl:"keyword" sql:"LIKE"`
+	In              *In        `ddl:"keyword" sql:"IN"`
+	Limit           *LimitFrom `ddl:"keyword" sql:"LIMIT"`
 }
 
 func (input *ShowMaskingPolicyOptions) validate() error {
@@ -263,7 +263,7 @@ func (v *maskingPolicies) Show(ctx context.Context, opts *ShowMaskingPolicyOptio
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -283,9 +283,7 @@ func (v *maskingPolicies) Show(ctx context.Context, opts *ShowMaskingPolicyOptio
 
 func (v *maskingPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*MaskingPolicy, error) {
 	maskingPolicies, err := v.Show(ctx, &ShowMaskingPolicyOptions{
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+		Like: likeExactly(id.Name()),
 		In: &In{
 			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
 		},
@@ -364,19 +362,9 @@ func (v *maskingPolicies) Describe(ctx context.Context, id SchemaObjectIdentifie
 	opts := &describeMaskingPolicyOptions{
 		name: id,
 	}
-	if err := opts.validate(); err != nil {
-		return nil, err
-	}
-
-	sql, err := structToSQL(opts)
+	dest, err := describeRow[maskingPolicyDetailsRow](ctx, v.client, opts)
 	if err != nil {
 		return nil, err
 	}
-	dest := maskingPolicyDetailsRow{}
-	err = v.client.queryOne(ctx, &dest, sql)
-	if err != nil {
-		return nil, err
-	}
-
 	return dest.toMaskingPolicyDetails(), nil
 }
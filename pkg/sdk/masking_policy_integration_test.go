@@ -81,7 +81,7 @@ func TestInt_MaskingPoliciesShow(t *testing.T) {
 				In: &In{
 					Schema: schemaTest.ID(),
 				},
-				Limit: Int(1),
+				Limit: &LimitFrom{Rows: Int(1)},
 			}
 			maskingPolicies, err := client.MaskingPolicies.Show(ctx, showOptions)
 			require.NoError(t, err)
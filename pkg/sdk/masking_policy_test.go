@@ -207,7 +207,7 @@ func TestMaskingPolicyShow(t *testing.T) {
 
 	t.Run("with limit", func(t *testing.T) {
 		opts := &ShowMaskingPolicyOptions{
-			Limit: Int(10),
+			Limit: &LimitFrom{Rows: Int(10)},
 		}
 		actual, err := structToSQL(opts)
 		require.NoError(t, err)
@@ -0,0 +1,334 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var (
+	_ MLForecasts         = (*mlForecasts)(nil)
+	_ MLAnomalyDetections = (*mlAnomalyDetections)(nil)
+)
+
+// MLForecasts are instances of the SNOWFLAKE.ML.FORECAST class, trained on time series data when they are created.
+type MLForecasts interface {
+	// Create creates and trains a new forecast model.
+	Create(ctx context.Context, id SchemaObjectIdentifier, input MLTrainingInput, opts *CreateMLModelOptions) error
+	// Drop removes a forecast model.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropClassInstanceOptions) error
+	// Show returns a list of forecast models.
+	Show(ctx context.Context, opts *ShowClassInstanceOptions) ([]*ClassInstance, error)
+	// ShowByID returns a forecast model by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ClassInstance, error)
+	// Forecast predicts the given number of periods after the end of the training data.
+	Forecast(ctx context.Context, id SchemaObjectIdentifier, periods int, opts *ForecastOptions) ([]*ForecastResult, error)
+}
+
+// MLAnomalyDetections are instances of the SNOWFLAKE.ML.ANOMALY_DETECTION class, trained on time series data when they are created.
+type MLAnomalyDetections interface {
+	// Create creates and trains a new anomaly detection model.
+	Create(ctx context.Context, id SchemaObjectIdentifier, input MLTrainingInput, opts *CreateMLModelOptions) error
+	// Drop removes an anomaly detection model.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropClassInstanceOptions) error
+	// Show returns a list of anomaly detection models.
+	Show(ctx context.Context, opts *ShowClassInstanceOptions) ([]*ClassInstance, error)
+	// ShowByID returns an anomaly detection model by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ClassInstance, error)
+	// DetectAnomalies evaluates new data with the model and flags the values outside of the prediction interval.
+	DetectAnomalies(ctx context.Context, id SchemaObjectIdentifier, input MLInput, opts *DetectAnomaliesOptions) ([]*AnomalyResult, error)
+}
+
+// mlForecasts implements MLForecasts.
+type mlForecasts struct {
+	client *Client
+}
+
+// mlAnomalyDetections implements MLAnomalyDetections.
+type mlAnomalyDetections struct {
+	client *Client
+}
+
+// MLInputData is the data a model reads, either a table or view or a query.
+type MLInputData struct {
+	ObjectType ObjectType
+	ID         SchemaObjectIdentifier
+	Query      *string
+}
+
+func (d MLInputData) validate() error {
+	if valueSet(d.Query) {
+		if d.ObjectType != "" || valueSet(d.ID) {
+			return errors.New("only one of Query or ObjectType and ID can be set in the input data")
+		}
+		return nil
+	}
+	if d.ObjectType != ObjectTypeTable && d.ObjectType != ObjectTypeView {
+		return fmt.Errorf("input data must be a %s, a %s or a query", ObjectTypeTable, ObjectTypeView)
+	}
+	if !validObjectidentifier(d.ID) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// reference returns the SYSTEM$REFERENCE or SYSTEM$QUERY_REFERENCE call giving the model access to the data.
+func (d MLInputData) reference() string {
+	if valueSet(d.Query) {
+		return fmt.Sprintf(`SYSTEM$QUERY_REFERENCE('%s')`, escapeStringLiteral(*d.Query))
+	}
+	return fmt.Sprintf(`SYSTEM$REFERENCE('%s', '%s')`, d.ObjectType, escapeStringLiteral(d.ID.FullyQualifiedName()))
+}
+
+func mlStringArgument(name string, value string) ClassInstanceArgument {
+	return ClassInstanceArgument{Name: name, Value: fmt.Sprintf("'%s'", escapeStringLiteral(value))}
+}
+
+// MLInput is the time series a model is trained on or evaluated with. SeriesColumn is only needed
+// for multi-series data.
+type MLInput struct {
+	Data            MLInputData
+	SeriesColumn    *string
+	TimestampColumn string
+	TargetColumn    string
+}
+
+func (i MLInput) validate() error {
+	if err := i.Data.validate(); err != nil {
+		return err
+	}
+	if i.TimestampColumn == "" || i.TargetColumn == "" {
+		return errors.New("TimestampColumn and TargetColumn must be set")
+	}
+	return nil
+}
+
+func (i MLInput) arguments() []ClassInstanceArgument {
+	args := []ClassInstanceArgument{{Name: "INPUT_DATA", Value: i.Data.reference()}}
+	if valueSet(i.SeriesColumn) {
+		args = append(args, mlStringArgument("SERIES_COLNAME", *i.SeriesColumn))
+	}
+	return append(args,
+		mlStringArgument("TIMESTAMP_COLNAME", i.TimestampColumn),
+		mlStringArgument("TARGET_COLNAME", i.TargetColumn),
+	)
+}
+
+// MLTrainingInput is the training data of a model. LabelColumn is only used by anomaly detection models,
+// which are trained unsupervised when it is not set.
+type MLTrainingInput struct {
+	MLInput
+	LabelColumn *string
+}
+
+// CreateMLModelOptions contains options for creating a forecast or an anomaly detection model.
+type CreateMLModelOptions struct {
+	OrReplace   *bool
+	IfNotExists *bool
+	Comment     *string
+}
+
+func (opts *CreateMLModelOptions) toCreateClassInstanceOptions() *CreateClassInstanceOptions {
+	return &CreateClassInstanceOptions{
+		OrReplace:   opts.OrReplace,
+		IfNotExists: opts.IfNotExists,
+		Comment:     opts.Comment,
+	}
+}
+
+func (v *mlForecasts) Create(ctx context.Context, id SchemaObjectIdentifier, input MLTrainingInput, opts *CreateMLModelOptions) error {
+	if opts == nil {
+		opts = &CreateMLModelOptions{}
+	}
+	if err := input.validate(); err != nil {
+		return err
+	}
+	if valueSet(input.LabelColumn) {
+		return errors.New("LabelColumn is not supported by forecast models")
+	}
+	return v.client.ClassInstances.Create(ctx, ClassForecast, id, input.arguments(), opts.toCreateClassInstanceOptions())
+}
+
+func (v *mlForecasts) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropClassInstanceOptions) error {
+	return v.client.ClassInstances.Drop(ctx, ClassForecast, id, opts)
+}
+
+func (v *mlForecasts) Show(ctx context.Context, opts *ShowClassInstanceOptions) ([]*ClassInstance, error) {
+	return v.client.ClassInstances.Show(ctx, ClassForecast, opts)
+}
+
+func (v *mlForecasts) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ClassInstance, error) {
+	return v.client.ClassInstances.ShowByID(ctx, ClassForecast, id)
+}
+
+// ForecastOptions contains options for forecasting. PredictionInterval is between 0 and 1 exclusive,
+// Snowflake uses 0.95 by default.
+type ForecastOptions struct {
+	PredictionInterval *float64
+}
+
+func validPredictionInterval(interval *float64) bool {
+	return !valueSet(interval) || (*interval > 0 && *interval < 1)
+}
+
+func mlConfigObject(interval *float64) []string {
+	if !valueSet(interval) {
+		return nil
+	}
+	return []string{fmt.Sprintf("CONFIG_OBJECT => {'prediction_interval': %g}", *interval)}
+}
+
+func forecastArguments(periods int, opts *ForecastOptions) []string {
+	args := []string{fmt.Sprintf("FORECASTING_PERIODS => %d", periods)}
+	return append(args, mlConfigObject(opts.PredictionInterval)...)
+}
+
+type ForecastResult struct {
+	Series     string
+	Timestamp  time.Time
+	Forecast   float64
+	LowerBound float64
+	UpperBound float64
+}
+
+type forecastResultRow struct {
+	Series     sql.NullString  `db:"SERIES"`
+	Timestamp  time.Time       `db:"TS"`
+	Forecast   sql.NullFloat64 `db:"FORECAST"`
+	LowerBound sql.NullFloat64 `db:"LOWER_BOUND"`
+	UpperBound sql.NullFloat64 `db:"UPPER_BOUND"`
+}
+
+func (row forecastResultRow) toForecastResult() *ForecastResult {
+	return &ForecastResult{
+		Series:     row.Series.String,
+		Timestamp:  row.Timestamp,
+		Forecast:   row.Forecast.Float64,
+		LowerBound: row.LowerBound.Float64,
+		UpperBound: row.UpperBound.Float64,
+	}
+}
+
+func (v *mlForecasts) Forecast(ctx context.Context, id SchemaObjectIdentifier, periods int, opts *ForecastOptions) ([]*ForecastResult, error) {
+	if opts == nil {
+		opts = &ForecastOptions{}
+	}
+	if !validateIntGreaterThanOrEqual(periods, 1) {
+		return nil, errors.New("periods must be greater than or equal to 1")
+	}
+	if !validPredictionInterval(opts.PredictionInterval) {
+		return nil, errors.New("PredictionInterval must be between 0 and 1")
+	}
+	dest := []forecastResultRow{}
+	if err := v.client.ClassInstances.Query(ctx, &dest, id, "FORECAST", forecastArguments(periods, opts)...); err != nil {
+		return nil, err
+	}
+	resultList := make([]*ForecastResult, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toForecastResult()
+	}
+	return resultList, nil
+}
+
+func (v *mlAnomalyDetections) Create(ctx context.Context, id SchemaObjectIdentifier, input MLTrainingInput, opts *CreateMLModelOptions) error {
+	if opts == nil {
+		opts = &CreateMLModelOptions{}
+	}
+	if err := input.validate(); err != nil {
+		return err
+	}
+	label := ""
+	if valueSet(input.LabelColumn) {
+		label = *input.LabelColumn
+	}
+	args := append(input.arguments(), mlStringArgument("LABEL_COLNAME", label))
+	return v.client.ClassInstances.Create(ctx, ClassAnomalyDetection, id, args, opts.toCreateClassInstanceOptions())
+}
+
+func (v *mlAnomalyDetections) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropClassInstanceOptions) error {
+	return v.client.ClassInstances.Drop(ctx, ClassAnomalyDetection, id, opts)
+}
+
+func (v *mlAnomalyDetections) Show(ctx context.Context, opts *ShowClassInstanceOptions) ([]*ClassInstance, error) {
+	return v.client.ClassInstances.Show(ctx, ClassAnomalyDetection, opts)
+}
+
+func (v *mlAnomalyDetections) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ClassInstance, error) {
+	return v.client.ClassInstances.ShowByID(ctx, ClassAnomalyDetection, id)
+}
+
+// DetectAnomaliesOptions contains options for detecting anomalies. PredictionInterval is between 0 and 1 exclusive,
+// Snowflake uses 0.99 by default.
+type DetectAnomaliesOptions struct {
+	PredictionInterval *float64
+}
+
+func detectAnomaliesArguments(input MLInput, opts *DetectAnomaliesOptions) []string {
+	args := make([]string, 0)
+	for _, arg := range input.arguments() {
+		args = append(args, arg.String())
+	}
+	return append(args, mlConfigObject(opts.PredictionInterval)...)
+}
+
+type AnomalyResult struct {
+	Series     string
+	Timestamp  time.Time
+	Y          float64
+	Forecast   float64
+	LowerBound float64
+	UpperBound float64
+	IsAnomaly  bool
+	Percentile float64
+	Distance   float64
+}
+
+type anomalyResultRow struct {
+	Series     sql.NullString  `db:"SERIES"`
+	Timestamp  time.Time       `db:"TS"`
+	Y          sql.NullFloat64 `db:"Y"`
+	Forecast   sql.NullFloat64 `db:"FORECAST"`
+	LowerBound sql.NullFloat64 `db:"LOWER_BOUND"`
+	UpperBound sql.NullFloat64 `db:"UPPER_BOUND"`
+	IsAnomaly  sql.NullBool    `db:"IS_ANOMALY"`
+	Percentile sql.NullFloat64 `db:"PERCENTILE"`
+	Distance   sql.NullFloat64 `db:"DISTANCE"`
+}
+
+func (row anomalyResultRow) toAnomalyResult() *AnomalyResult {
+	return &AnomalyResult{
+		Series:     row.Series.String,
+		Timestamp:  row.Timestamp,
+		Y:          row.Y.Float64,
+		Forecast:   row.Forecast.Float64,
+		LowerBound: row.LowerBound.Float64,
+		UpperBound: row.UpperBound.Float64,
+		IsAnomaly:  row.IsAnomaly.Bool,
+		Percentile: row.Percentile.Float64,
+		Distance:   row.Distance.Float64,
+	}
+}
+
+func (v *mlAnomalyDetections) DetectAnomalies(ctx context.Context, id SchemaObjectIdentifier, input MLInput, opts *DetectAnomaliesOptions) ([]*AnomalyResult, error) {
+	if opts == nil {
+		opts = &DetectAnomaliesOptions{}
+	}
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	if !validPredictionInterval(opts.PredictionInterval) {
+		return nil, errors.New("PredictionInterval must be between 0 and 1")
+	}
+	dest := []anomalyResultRow{}
+	if err := v.client.ClassInstances.Query(ctx, &dest, id, "DETECT_ANOMALIES", detectAnomaliesArguments(input, opts)...); err != nil {
+		return nil, err
+	}
+	resultList := make([]*AnomalyResult, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toAnomalyResult()
+	}
+	return resultList, nil
+}
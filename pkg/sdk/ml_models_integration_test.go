@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_MLModels(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	tableID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	_, err := client.exec(ctx, fmt.Sprintf(`CREATE TABLE %s AS SELECT DATEADD(DAY, SEQ4(), '2023-01-01')::TIMESTAMP_NTZ AS TS, UNIFORM(1, 100, RANDOM())::FLOAT AS AMOUNT FROM TABLE(GENERATOR(ROWCOUNT => 30))`, tableID.FullyQualifiedName()))
+	require.NoError(t, err)
+	input := MLInput{
+		Data:            MLInputData{ObjectType: ObjectTypeTable, ID: tableID},
+		TimestampColumn: "TS",
+		TargetColumn:    "AMOUNT",
+	}
+
+	t.Run("forecast", func(t *testing.T) {
+		id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+		err := client.MLForecasts.Create(ctx, id, MLTrainingInput{MLInput: input}, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.MLForecasts.Drop(ctx, id, &DropClassInstanceOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+
+		model, err := client.MLForecasts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, model.ID())
+
+		results, err := client.MLForecasts.Forecast(ctx, id, 3, &ForecastOptions{PredictionInterval: Pointer(0.9)})
+		require.NoError(t, err)
+		assert.Len(t, results, 3)
+	})
+
+	t.Run("anomaly detection", func(t *testing.T) {
+		id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+		err := client.MLAnomalyDetections.Create(ctx, id, MLTrainingInput{MLInput: input}, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.MLAnomalyDetections.Drop(ctx, id, &DropClassInstanceOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+
+		newData := MLInput{
+			Data:            MLInputData{Query: String("SELECT DATEADD(DAY, 30 + SEQ4(), '2023-01-01')::TIMESTAMP_NTZ AS TS, 1000::FLOAT AS AMOUNT FROM TABLE(GENERATOR(ROWCOUNT => 3))")},
+			TimestampColumn: "TS",
+			TargetColumn:    "AMOUNT",
+		}
+		results, err := client.MLAnomalyDetections.DetectAnomalies(ctx, id, newData, nil)
+		require.NoError(t, err)
+		assert.Len(t, results, 3)
+	})
+}
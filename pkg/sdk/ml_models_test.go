@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMLInput(t *testing.T) {
+	t.Run("table reference", func(t *testing.T) {
+		input := MLInput{
+			Data:            MLInputData{ObjectType: ObjectTypeTable, ID: NewSchemaObjectIdentifier("db", "schema", "sales")},
+			TimestampColumn: "TS",
+			TargetColumn:    "AMOUNT",
+		}
+		require.NoError(t, input.validate())
+		assert.Equal(t, `(INPUT_DATA => SYSTEM$REFERENCE('TABLE', '"db"."schema"."sales"'), TIMESTAMP_COLNAME => 'TS', TARGET_COLNAME => 'AMOUNT')`, classInstanceArguments(input.arguments()))
+	})
+
+	t.Run("query reference with series", func(t *testing.T) {
+		input := MLInput{
+			Data:            MLInputData{Query: String("SELECT * FROM sales WHERE region = 'EU'")},
+			SeriesColumn:    String("STORE"),
+			TimestampColumn: "TS",
+			TargetColumn:    "AMOUNT",
+		}
+		require.NoError(t, input.validate())
+		assert.Equal(t, `(INPUT_DATA => SYSTEM$QUERY_REFERENCE('SELECT * FROM sales WHERE region = \'EU\''), SERIES_COLNAME => 'STORE', TIMESTAMP_COLNAME => 'TS', TARGET_COLNAME => 'AMOUNT')`, classInstanceArguments(input.arguments()))
+	})
+
+	t.Run("validation: unsupported object type", func(t *testing.T) {
+		input := MLInput{
+			Data:            MLInputData{ObjectType: ObjectTypeStage, ID: NewSchemaObjectIdentifier("db", "schema", "sales")},
+			TimestampColumn: "TS",
+			TargetColumn:    "AMOUNT",
+		}
+		assert.Error(t, input.validate())
+	})
+
+	t.Run("validation: query and table", func(t *testing.T) {
+		input := MLInput{
+			Data:            MLInputData{ObjectType: ObjectTypeTable, ID: NewSchemaObjectIdentifier("db", "schema", "sales"), Query: String("SELECT 1")},
+			TimestampColumn: "TS",
+			TargetColumn:    "AMOUNT",
+		}
+		assert.Error(t, input.validate())
+	})
+
+	t.Run("validation: missing columns", func(t *testing.T) {
+		input := MLInput{Data: MLInputData{Query: String("SELECT 1")}}
+		assert.Error(t, input.validate())
+	})
+}
+
+func TestMLForecastArguments(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "model")
+
+	t.Run("without options", func(t *testing.T) {
+		assert.Equal(t, `CALL "db"."schema"."model"!FORECAST(FORECASTING_PERIODS => 3)`, classInstanceMethodSQL(id, "FORECAST", forecastArguments(3, &ForecastOptions{})...))
+	})
+
+	t.Run("with prediction interval", func(t *testing.T) {
+		assert.Equal(t, `CALL "db"."schema"."model"!FORECAST(FORECASTING_PERIODS => 3, CONFIG_OBJECT => {'prediction_interval': 0.9})`, classInstanceMethodSQL(id, "FORECAST", forecastArguments(3, &ForecastOptions{PredictionInterval: Pointer(0.9)})...))
+	})
+
+	t.Run("validation: prediction interval", func(t *testing.T) {
+		assert.True(t, validPredictionInterval(nil))
+		assert.False(t, validPredictionInterval(Pointer(1.0)))
+	})
+}
+
+func TestMLDetectAnomaliesArguments(t *testing.T) {
+	input := MLInput{
+		Data:            MLInputData{ObjectType: ObjectTypeView, ID: NewSchemaObjectIdentifier("db", "schema", "new_sales")},
+		TimestampColumn: "TS",
+		TargetColumn:    "AMOUNT",
+	}
+	actual := classInstanceMethodSQL(NewSchemaObjectIdentifier("db", "schema", "model"), "DETECT_ANOMALIES", detectAnomaliesArguments(input, &DetectAnomaliesOptions{PredictionInterval: Pointer(0.99)})...)
+	assert.Equal(t, `CALL "db"."schema"."model"!DETECT_ANOMALIES(INPUT_DATA => SYSTEM$REFERENCE('VIEW', '"db"."schema"."new_sales"'), TIMESTAMP_COLNAME => 'TS', TARGET_COLNAME => 'AMOUNT', CONFIG_OBJECT => {'prediction_interval': 0.99})`, actual)
+}
@@ -0,0 +1,363 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Notebooks = (*notebooks)(nil)
+
+// Notebooks are Snowflake notebooks created from a file in a stage.
+type Notebooks interface {
+	// Create creates a new notebook from the file in the stage location.
+	Create(ctx context.Context, id SchemaObjectIdentifier, from string, opts *CreateNotebookOptions) error
+	// Alter modifies an existing notebook.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterNotebookOptions) error
+	// Drop removes a notebook.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropNotebookOptions) error
+	// Show returns a list of notebooks.
+	Show(ctx context.Context, opts *ShowNotebookOptions) ([]*Notebook, error)
+	// ShowByID returns a notebook by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Notebook, error)
+	// Describe returns the details of a notebook.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*NotebookDetails, error)
+}
+
+// notebooks implements Notebooks.
+type notebooks struct {
+	client *Client
+}
+
+type CreateNotebookOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	notebook    bool                   `ddl:"static" sql:"NOTEBOOK"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+
+	// required, e.g. @db.schema.stage/notebooks
+	from string `ddl:"parameter,single_quotes,no_equals" sql:"FROM"`
+
+	// optional
+	MainFile                   *string                   `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	QueryWarehouse             AccountObjectIdentifier   `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	// DefaultVersion is FIRST, LAST, LIVE, a version alias or VERSION$<number>.
+	DefaultVersion *string `ddl:"parameter" sql:"DEFAULT_VERSION"`
+}
+
+func (opts *CreateNotebookOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.from == "" {
+		return errors.New("from is required")
+	}
+	return nil
+}
+
+func (v *notebooks) Create(ctx context.Context, id SchemaObjectIdentifier, from string, opts *CreateNotebookOptions) error {
+	if opts == nil {
+		opts = &CreateNotebookOptions{}
+	}
+	opts.name = id
+	opts.from = from
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterNotebookOptions struct {
+	alter    bool                   `ddl:"static" sql:"ALTER"`    //lint:ignore U1000 This is used in the ddl tag
+	notebook bool                   `ddl:"static" sql:"NOTEBOOK"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	NewName SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set     *NotebookSet           `ddl:"keyword" sql:"SET"`
+	Unset   *NotebookUnset         `ddl:"list,no_parentheses" sql:"UNSET"`
+	// AddLiveVersionFromLast creates a live version from the last committed version, so that it can be edited.
+	AddLiveVersionFromLast *bool `ddl:"keyword" sql:"ADD LIVE VERSION FROM LAST"`
+	// Commit turns the live version into a new version, optionally with an alias.
+	Commit *NotebookCommit `ddl:"keyword" sql:"COMMIT"`
+}
+
+func (opts *AlterNotebookOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Unset, opts.AddLiveVersionFromLast, opts.Commit) {
+		return errors.New("exactly one of NewName, Set, Unset, AddLiveVersionFromLast, Commit must be set")
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.Comment, opts.Set.QueryWarehouse, opts.Set.MainFile, opts.Set.ExternalAccessIntegrations, opts.Set.DefaultVersion) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.Comment, opts.Unset.QueryWarehouse) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+type NotebookSet struct {
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	QueryWarehouse             AccountObjectIdentifier   `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	MainFile                   *string                   `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	DefaultVersion             *string                   `ddl:"parameter" sql:"DEFAULT_VERSION"`
+}
+
+type NotebookUnset struct {
+	Comment        *bool `ddl:"keyword" sql:"COMMENT"`
+	QueryWarehouse *bool `ddl:"keyword" sql:"QUERY_WAREHOUSE"`
+}
+
+type NotebookCommit struct {
+	VersionAlias *string `ddl:"parameter,no_equals" sql:"VERSION"`
+	Comment      *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *notebooks) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterNotebookOptions) error {
+	if opts == nil {
+		opts = &AlterNotebookOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropNotebookOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`     //lint:ignore U1000 This is used in the ddl tag
+	notebook bool                   `ddl:"static" sql:"NOTEBOOK"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropNotebookOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *notebooks) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropNotebookOptions) error {
+	if opts == nil {
+		opts = &DropNotebookOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowNotebookOptions struct {
+	show      bool `ddl:"static" sql:"SHOW"`      //lint:ignore U1000 This is used in the ddl tag
+	notebooks bool `ddl:"static" sql:"NOTEBOOKS"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	In   *In   `ddl:"keyword" sql:"IN"`
+	ShowOptions
+}
+
+func (opts *ShowNotebookOptions) validate() error {
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type Notebook struct {
+	CreatedOn      time.Time
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	Comment        string
+	Owner          string
+	QueryWarehouse string
+	URLID          string
+	OwnerRoleType  string
+}
+
+func (v *Notebook) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Notebook) ObjectType() ObjectType {
+	return ObjectTypeNotebook
+}
+
+type notebookRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	Comment        sql.NullString `db:"comment"`
+	Owner          sql.NullString `db:"owner"`
+	QueryWarehouse sql.NullString `db:"query_warehouse"`
+	URLID          sql.NullString `db:"url_id"`
+	OwnerRoleType  sql.NullString `db:"owner_role_type"`
+}
+
+func (row notebookRow) toNotebook() *Notebook {
+	return &Notebook{
+		CreatedOn:      row.CreatedOn,
+		Name:           row.Name,
+		DatabaseName:   row.DatabaseName,
+		SchemaName:     row.SchemaName,
+		Comment:        row.Comment.String,
+		Owner:          row.Owner.String,
+		QueryWarehouse: row.QueryWarehouse.String,
+		URLID:          row.URLID.String,
+		OwnerRoleType:  row.OwnerRoleType.String,
+	}
+}
+
+func (v *notebooks) Show(ctx context.Context, opts *ShowNotebookOptions) ([]*Notebook, error) {
+	if opts == nil {
+		opts = &ShowNotebookOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []notebookRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Notebook, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toNotebook()
+	}
+	return resultList, nil
+}
+
+func (v *notebooks) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Notebook, error) {
+	notebooks, err := v.Show(ctx, &ShowNotebookOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, notebook := range notebooks {
+		if notebook.Name == id.Name() {
+			return notebook, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeNotebookOptions struct {
+	describe bool                   `ddl:"static" sql:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	notebook bool                   `ddl:"static" sql:"NOTEBOOK"` //lint:ignore U1000 This is used in the ddl tag
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeNotebookOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type NotebookDetails struct {
+	Name                       string
+	Comment                    string
+	Owner                      string
+	MainFile                   string
+	QueryWarehouse             string
+	URLID                      string
+	ExternalAccessIntegrations []string
+	DefaultVersion             string
+	DefaultVersionName         string
+	DefaultVersionAlias        string
+	LastVersionName            string
+	LastVersionAlias           string
+	// LiveVersionLocationURI is empty when the notebook has no live version.
+	LiveVersionLocationURI string
+}
+
+// HasLiveVersion reports whether the notebook has a live version that can be edited and committed.
+func (v *NotebookDetails) HasLiveVersion() bool {
+	return v.LiveVersionLocationURI != ""
+}
+
+type notebookDetailsRow struct {
+	Name                       sql.NullString `db:"name"`
+	Comment                    sql.NullString `db:"comment"`
+	Owner                      sql.NullString `db:"owner"`
+	MainFile                   sql.NullString `db:"main_file"`
+	QueryWarehouse             sql.NullString `db:"query_warehouse"`
+	URLID                      sql.NullString `db:"url_id"`
+	ExternalAccessIntegrations sql.NullString `db:"external_access_integrations"`
+	DefaultVersion             sql.NullString `db:"default_version"`
+	DefaultVersionName         sql.NullString `db:"default_version_name"`
+	DefaultVersionAlias        sql.NullString `db:"default_version_alias"`
+	LastVersionName            sql.NullString `db:"last_version_name"`
+	LastVersionAlias           sql.NullString `db:"last_version_alias"`
+	LiveVersionLocationURI     sql.NullString `db:"live_version_location_uri"`
+}
+
+func (row notebookDetailsRow) toNotebookDetails() *NotebookDetails {
+	return &NotebookDetails{
+		Name:                       row.Name.String,
+		Comment:                    row.Comment.String,
+		Owner:                      row.Owner.String,
+		MainFile:                   row.MainFile.String,
+		QueryWarehouse:             row.QueryWarehouse.String,
+		URLID:                      row.URLID.String,
+		ExternalAccessIntegrations: unquoteList(row.ExternalAccessIntegrations.String),
+		DefaultVersion:             row.DefaultVersion.String,
+		DefaultVersionName:         row.DefaultVersionName.String,
+		DefaultVersionAlias:        row.DefaultVersionAlias.String,
+		LastVersionName:            row.LastVersionName.String,
+		LastVersionAlias:           row.LastVersionAlias.String,
+		LiveVersionLocationURI:     row.LiveVersionLocationURI.String,
+	}
+}
+
+func (v *notebooks) Describe(ctx context.Context, id SchemaObjectIdentifier) (*NotebookDetails, error) {
+	opts := &describeNotebookOptions{
+		name: id,
+	}
+	dest, err := describeRow[notebookDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toNotebookDetails(), nil
+}
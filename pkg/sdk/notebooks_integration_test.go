@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Notebooks(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	stage, stageCleanup := createStage(t, client, database, schema)
+	t.Cleanup(stageCleanup)
+	warehouse, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err := client.Notebooks.Create(ctx, id, fmt.Sprintf("@%s", stage.FullyQualifiedName()), &CreateNotebookOptions{
+		Comment: String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Notebooks.Drop(ctx, id, &DropNotebookOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		notebook, err := client.Notebooks.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, notebook.ID())
+		assert.Equal(t, "some comment", notebook.Comment)
+	})
+
+	t.Run("alter: set query warehouse", func(t *testing.T) {
+		err := client.Notebooks.Alter(ctx, id, &AlterNotebookOptions{Set: &NotebookSet{QueryWarehouse: warehouse.ID()}})
+		require.NoError(t, err)
+		notebook, err := client.Notebooks.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, warehouse.Name, notebook.QueryWarehouse)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		details, err := client.Notebooks.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), details.Name)
+	})
+
+	t.Run("show in schema", func(t *testing.T) {
+		notebooks, err := client.Notebooks.Show(ctx, &ShowNotebookOptions{In: &In{Schema: schema.ID()}})
+		require.NoError(t, err)
+		assert.Len(t, notebooks, 1)
+	})
+}
@@ -0,0 +1,138 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotebookCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "mynotebook")
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateNotebookOptions{
+			OrReplace:      Bool(true),
+			name:           id,
+			from:           "@db.schema.stage/notebooks",
+			MainFile:       String("notebook.ipynb"),
+			Comment:        String("some comment"),
+			QueryWarehouse: NewAccountObjectIdentifier("wh"),
+			DefaultVersion: String("LAST"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE NOTEBOOK "db"."schema"."mynotebook" FROM '@db.schema.stage/notebooks' MAIN_FILE = 'notebook.ipynb' COMMENT = 'some comment' QUERY_WAREHOUSE = "wh" DEFAULT_VERSION = LAST`, actual)
+	})
+
+	t.Run("validation: missing from", func(t *testing.T) {
+		opts := &CreateNotebookOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestNotebookAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "mynotebook")
+
+	t.Run("set query warehouse", func(t *testing.T) {
+		opts := &AlterNotebookOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set:      &NotebookSet{QueryWarehouse: NewAccountObjectIdentifier("wh")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER NOTEBOOK IF EXISTS "db"."schema"."mynotebook" SET QUERY_WAREHOUSE = "wh"`, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterNotebookOptions{
+			name:  id,
+			Unset: &NotebookUnset{Comment: Bool(true), QueryWarehouse: Bool(true)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER NOTEBOOK "db"."schema"."mynotebook" UNSET COMMENT, QUERY_WAREHOUSE`, actual)
+	})
+
+	t.Run("add live version", func(t *testing.T) {
+		opts := &AlterNotebookOptions{
+			name:                   id,
+			AddLiveVersionFromLast: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER NOTEBOOK "db"."schema"."mynotebook" ADD LIVE VERSION FROM LAST`, actual)
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		opts := &AlterNotebookOptions{
+			name:   id,
+			Commit: &NotebookCommit{},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER NOTEBOOK "db"."schema"."mynotebook" COMMIT`, actual)
+	})
+
+	t.Run("commit with version alias", func(t *testing.T) {
+		opts := &AlterNotebookOptions{
+			name:   id,
+			Commit: &NotebookCommit{VersionAlias: String("v1"), Comment: String("first")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER NOTEBOOK "db"."schema"."mynotebook" COMMIT VERSION v1 COMMENT = 'first'`, actual)
+	})
+
+	t.Run("validation: more than one alteration", func(t *testing.T) {
+		opts := &AlterNotebookOptions{
+			name:                   id,
+			AddLiveVersionFromLast: Bool(true),
+			Commit:                 &NotebookCommit{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestNotebookDrop(t *testing.T) {
+	opts := &DropNotebookOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "mynotebook"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP NOTEBOOK IF EXISTS "db"."schema"."mynotebook"`, actual)
+}
+
+func TestNotebookShow(t *testing.T) {
+	opts := &ShowNotebookOptions{
+		Like: &Like{Pattern: String("my%")},
+		In:   &In{Database: NewAccountObjectIdentifier("db")},
+		ShowOptions: ShowOptions{
+			StartsWith: String("my"),
+		},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW NOTEBOOKS LIKE 'my%' IN DATABASE "db" STARTS WITH 'my'`, actual)
+}
+
+func TestNotebookDescribe(t *testing.T) {
+	opts := &describeNotebookOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "mynotebook"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE NOTEBOOK "db"."schema"."mynotebook"`, actual)
+
+	details := notebookDetailsRow{
+		LastVersionAlias:       sql.NullString{String: "v1", Valid: true},
+		LiveVersionLocationURI: sql.NullString{String: "snow://notebook/db.schema.mynotebook/versions/live/", Valid: true},
+	}.toNotebookDetails()
+	assert.Equal(t, "v1", details.LastVersionAlias)
+	assert.True(t, details.HasLiveVersion())
+}
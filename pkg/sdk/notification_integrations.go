@@ -0,0 +1,465 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ NotificationIntegrations = (*notificationIntegrations)(nil)
+
+// NotificationIntegrations describes all the notification integration related methods that the
+// Snowflake API supports.
+type NotificationIntegrations interface {
+	// Create creates a new notification integration.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateNotificationIntegrationOptions) error
+	// Alter modifies an existing notification integration.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterNotificationIntegrationOptions) error
+	// Drop removes a notification integration.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropNotificationIntegrationOptions) error
+	// Show returns a list of notification integrations.
+	Show(ctx context.Context, opts *ShowNotificationIntegrationOptions) ([]*NotificationIntegration, error)
+	// ShowByID returns a notification integration by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*NotificationIntegration, error)
+	// Describe returns the details of a notification integration.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*NotificationIntegrationDetails, error)
+}
+
+// notificationIntegrations implements NotificationIntegrations.
+type notificationIntegrations struct {
+	client *Client
+}
+
+type NotificationRecipient struct {
+	Email string `ddl:"keyword,single_quotes"`
+}
+
+type CreateNotificationIntegrationOptions struct {
+	create                  bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace               *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	notificationIntegration bool                    `ddl:"static" sql:"NOTIFICATION INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists             *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                    AccountObjectIdentifier `ddl:"identifier"`
+	Enabled                 bool                    `ddl:"parameter" sql:"ENABLED"`
+
+	EmailParams              *EmailParams              `ddl:"keyword"`
+	AutomatedDataLoadsParams *AutomatedDataLoadsParams `ddl:"keyword"`
+	PushNotificationParams   *PushNotificationParams   `ddl:"keyword"`
+
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// EmailParams configures an integration used to send emails with SYSTEM$SEND_EMAIL.
+type EmailParams struct {
+	emailType         bool                    `ddl:"static" sql:"TYPE = EMAIL"` //lint:ignore U1000 This is used in the ddl tag
+	AllowedRecipients []NotificationRecipient `ddl:"parameter,parentheses" sql:"ALLOWED_RECIPIENTS"`
+}
+
+// AutomatedDataLoadsParams configures an inbound integration used for auto-ingest and
+// automatic refreshes from GCP Pub/Sub subscriptions and Azure storage queues.
+type AutomatedDataLoadsParams struct {
+	queueType        bool              `ddl:"static" sql:"TYPE = QUEUE"` //lint:ignore U1000 This is used in the ddl tag
+	GoogleAutoParams *GoogleAutoParams `ddl:"keyword"`
+	AzureAutoParams  *AzureAutoParams  `ddl:"keyword"`
+}
+
+type GoogleAutoParams struct {
+	notificationProvider   bool   `ddl:"static" sql:"NOTIFICATION_PROVIDER = GCP_PUBSUB"` //lint:ignore U1000 This is used in the ddl tag
+	GoogleSubscriptionName string `ddl:"parameter,single_quotes" sql:"GCP_PUBSUB_SUBSCRIPTION_NAME"`
+}
+
+type AzureAutoParams struct {
+	notificationProvider        bool   `ddl:"static" sql:"NOTIFICATION_PROVIDER = AZURE_STORAGE_QUEUE"` //lint:ignore U1000 This is used in the ddl tag
+	AzureStorageQueuePrimaryURI string `ddl:"parameter,single_quotes" sql:"AZURE_STORAGE_QUEUE_PRIMARY_URI"`
+	AzureTenantID               string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+}
+
+// PushNotificationParams configures an outbound integration used to send error and task
+// notifications to AWS SNS topics, GCP Pub/Sub topics and Azure Event Grid topics.
+type PushNotificationParams struct {
+	outboundQueueType bool              `ddl:"static" sql:"TYPE = QUEUE DIRECTION = OUTBOUND"` //lint:ignore U1000 This is used in the ddl tag
+	AmazonPushParams  *AmazonPushParams `ddl:"keyword"`
+	GooglePushParams  *GooglePushParams `ddl:"keyword"`
+	AzurePushParams   *AzurePushParams  `ddl:"keyword"`
+}
+
+type AmazonPushParams struct {
+	notificationProvider bool   `ddl:"static" sql:"NOTIFICATION_PROVIDER = AWS_SNS"` //lint:ignore U1000 This is used in the ddl tag
+	AWSSNSTopicARN       string `ddl:"parameter,single_quotes" sql:"AWS_SNS_TOPIC_ARN"`
+	AWSSNSRoleARN        string `ddl:"parameter,single_quotes" sql:"AWS_SNS_ROLE_ARN"`
+}
+
+type GooglePushParams struct {
+	notificationProvider bool   `ddl:"static" sql:"NOTIFICATION_PROVIDER = GCP_PUBSUB"` //lint:ignore U1000 This is used in the ddl tag
+	GoogleTopicName      string `ddl:"parameter,single_quotes" sql:"GCP_PUBSUB_TOPIC_NAME"`
+}
+
+type AzurePushParams struct {
+	notificationProvider        bool   `ddl:"static" sql:"NOTIFICATION_PROVIDER = AZURE_EVENT_GRID"` //lint:ignore U1000 This is used in the ddl tag
+	AzureEventGridTopicEndpoint string `ddl:"parameter,single_quotes" sql:"AZURE_EVENT_GRID_TOPIC_ENDPOINT"`
+	AzureTenantID               string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+}
+
+func (opts *CreateNotificationIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if !exactlyOneValueSet(opts.EmailParams, opts.AutomatedDataLoadsParams, opts.PushNotificationParams) {
+		return errors.New("exactly one of EmailParams, AutomatedDataLoadsParams, PushNotificationParams must be set")
+	}
+	if valueSet(opts.AutomatedDataLoadsParams) {
+		if err := opts.AutomatedDataLoadsParams.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.PushNotificationParams) {
+		if err := opts.PushNotificationParams.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *AutomatedDataLoadsParams) validate() error {
+	if !exactlyOneValueSet(v.GoogleAutoParams, v.AzureAutoParams) {
+		return errors.New("exactly one of GoogleAutoParams, AzureAutoParams must be set")
+	}
+	if valueSet(v.GoogleAutoParams) && v.GoogleAutoParams.GoogleSubscriptionName == "" {
+		return errors.New("GoogleSubscriptionName is required for GCP Pub/Sub notification integrations")
+	}
+	if valueSet(v.AzureAutoParams) && (v.AzureAutoParams.AzureStorageQueuePrimaryURI == "" || v.AzureAutoParams.AzureTenantID == "") {
+		return errors.New("AzureStorageQueuePrimaryURI and AzureTenantID are required for Azure storage queue notification integrations")
+	}
+	return nil
+}
+
+func (v *PushNotificationParams) validate() error {
+	if !exactlyOneValueSet(v.AmazonPushParams, v.GooglePushParams, v.AzurePushParams) {
+		return errors.New("exactly one of AmazonPushParams, GooglePushParams, AzurePushParams must be set")
+	}
+	if valueSet(v.AmazonPushParams) && (v.AmazonPushParams.AWSSNSTopicARN == "" || v.AmazonPushParams.AWSSNSRoleARN == "") {
+		return errors.New("AWSSNSTopicARN and AWSSNSRoleARN are required for AWS SNS notification integrations")
+	}
+	if valueSet(v.GooglePushParams) && v.GooglePushParams.GoogleTopicName == "" {
+		return errors.New("GoogleTopicName is required for GCP Pub/Sub notification integrations")
+	}
+	if valueSet(v.AzurePushParams) && (v.AzurePushParams.AzureEventGridTopicEndpoint == "" || v.AzurePushParams.AzureTenantID == "") {
+		return errors.New("AzureEventGridTopicEndpoint and AzureTenantID are required for Azure Event Grid notification integrations")
+	}
+	return nil
+}
+
+func (v *notificationIntegrations) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateNotificationIntegrationOptions) error {
+	if opts == nil {
+		opts = &CreateNotificationIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterNotificationIntegrationOptions struct {
+	alter                   bool                          `ddl:"static" sql:"ALTER"`                    //lint:ignore U1000 This is used in the ddl tag
+	notificationIntegration bool                          `ddl:"static" sql:"NOTIFICATION INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists                *bool                         `ddl:"keyword" sql:"IF EXISTS"`
+	name                    AccountObjectIdentifier       `ddl:"identifier"`
+	Set                     *NotificationIntegrationSet   `ddl:"keyword" sql:"SET"`
+	Unset                   *NotificationIntegrationUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTag                  []TagAssociation              `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag                []ObjectIdentifier            `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterNotificationIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type NotificationIntegrationSet struct {
+	Enabled           *bool                   `ddl:"parameter" sql:"ENABLED"`
+	AllowedRecipients []NotificationRecipient `ddl:"parameter,parentheses" sql:"ALLOWED_RECIPIENTS"`
+	AWSSNSTopicARN    *string                 `ddl:"parameter,single_quotes" sql:"AWS_SNS_TOPIC_ARN"`
+	AWSSNSRoleARN     *string                 `ddl:"parameter,single_quotes" sql:"AWS_SNS_ROLE_ARN"`
+	Comment           *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *NotificationIntegrationSet) validate() error {
+	if !anyValueSet(v.Enabled, v.AllowedRecipients, v.AWSSNSTopicARN, v.AWSSNSRoleARN, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(v.AllowedRecipients) && anyValueSet(v.AWSSNSTopicARN, v.AWSSNSRoleARN) {
+		return errors.New("AllowedRecipients cannot be set together with the AWS SNS properties")
+	}
+	return nil
+}
+
+type NotificationIntegrationUnset struct {
+	Enabled           *bool `ddl:"keyword" sql:"ENABLED"`
+	AllowedRecipients *bool `ddl:"keyword" sql:"ALLOWED_RECIPIENTS"`
+	Comment           *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *NotificationIntegrationUnset) validate() error {
+	if !anyValueSet(v.Enabled, v.AllowedRecipients, v.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+func (v *notificationIntegrations) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterNotificationIntegrationOptions) error {
+	if opts == nil {
+		opts = &AlterNotificationIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropNotificationIntegrationOptions struct {
+	drop                    bool                    `ddl:"static" sql:"DROP"`                     //lint:ignore U1000 This is used in the ddl tag
+	notificationIntegration bool                    `ddl:"static" sql:"NOTIFICATION INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists                *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name                    AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropNotificationIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *notificationIntegrations) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropNotificationIntegrationOptions) error {
+	if opts == nil {
+		opts = &DropNotificationIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowNotificationIntegrationOptions represents the options for listing notification integrations.
+type ShowNotificationIntegrationOptions struct {
+	show                     bool  `ddl:"static" sql:"SHOW"`                      //lint:ignore U1000 This is used in the ddl tag
+	notificationIntegrations bool  `ddl:"static" sql:"NOTIFICATION INTEGRATIONS"` //lint:ignore U1000 This is used in the ddl tag
+	Like                     *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowNotificationIntegrationOptions) validate() error {
+	return nil
+}
+
+type NotificationIntegration struct {
+	Name             string
+	NotificationType string
+	Category         string
+	Enabled          bool
+	Comment          string
+	CreatedOn        time.Time
+}
+
+func (v *NotificationIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *NotificationIntegration) ObjectType() ObjectType {
+	return ObjectTypeIntegration
+}
+
+type notificationIntegrationRow struct {
+	Name      string         `db:"name"`
+	Type      string         `db:"type"`
+	Category  string         `db:"category"`
+	Enabled   bool           `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
+	CreatedOn time.Time      `db:"created_on"`
+}
+
+func (row *notificationIntegrationRow) toNotificationIntegration() *NotificationIntegration {
+	v := &NotificationIntegration{
+		Name:             row.Name,
+		NotificationType: row.Type,
+		Category:         row.Category,
+		Enabled:          row.Enabled,
+		CreatedOn:        row.CreatedOn,
+	}
+	if row.Comment.Valid {
+		v.Comment = row.Comment.String
+	}
+	return v
+}
+
+func (v *notificationIntegrations) Show(ctx context.Context, opts *ShowNotificationIntegrationOptions) ([]*NotificationIntegration, error) {
+	if opts == nil {
+		opts = &ShowNotificationIntegrationOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []notificationIntegrationRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*NotificationIntegration, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toNotificationIntegration()
+	}
+	return resultList, nil
+}
+
+func (v *notificationIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*NotificationIntegration, error) {
+	notificationIntegrations, err := v.Show(ctx, &ShowNotificationIntegrationOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, notificationIntegration := range notificationIntegrations {
+		if notificationIntegration.Name == id.Name() {
+			return notificationIntegration, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeNotificationIntegrationOptions struct {
+	describe                bool                    `ddl:"static" sql:"DESCRIBE"`                 //lint:ignore U1000 This is used in the ddl tag
+	notificationIntegration bool                    `ddl:"static" sql:"NOTIFICATION INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	name                    AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeNotificationIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// NotificationIntegrationDetails contains the typed output of DESCRIBE NOTIFICATION INTEGRATION. Fields
+// that are not relevant to the integration's type and provider are left empty.
+type NotificationIntegrationDetails struct {
+	Enabled              bool
+	NotificationProvider string
+	Direction            string
+	Comment              string
+
+	// Email
+	AllowedRecipients []string
+
+	// AWS SNS
+	AWSSNSTopicARN         string
+	AWSSNSRoleARN          string
+	SnowflakeAWSIAMUserARN string
+	SnowflakeAWSExternalID string
+
+	// GCP Pub/Sub
+	GoogleSubscriptionName string
+	GoogleTopicName        string
+	GoogleServiceAccount   string
+
+	// Azure
+	AzureStorageQueuePrimaryURI string
+	AzureEventGridTopicEndpoint string
+	AzureTenantID               string
+	AzureConsentURL             string
+	AzureMultiTenantAppName     string
+}
+
+func notificationIntegrationDetailsFromRows(rows []integrationPropertyRow) *NotificationIntegrationDetails {
+	v := &NotificationIntegrationDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "ENABLED":
+			v.Enabled = row.toBool()
+		case "NOTIFICATION_PROVIDER":
+			v.NotificationProvider = row.Value
+		case "DIRECTION":
+			v.Direction = row.Value
+		case "COMMENT":
+			v.Comment = row.Value
+		case "ALLOWED_RECIPIENTS":
+			v.AllowedRecipients = row.toList()
+		case "AWS_SNS_TOPIC_ARN":
+			v.AWSSNSTopicARN = row.Value
+		case "AWS_SNS_ROLE_ARN":
+			v.AWSSNSRoleARN = row.Value
+		case "SF_AWS_IAM_USER_ARN":
+			v.SnowflakeAWSIAMUserARN = row.Value
+		case "SF_AWS_EXTERNAL_ID":
+			v.SnowflakeAWSExternalID = row.Value
+		case "GCP_PUBSUB_SUBSCRIPTION_NAME":
+			v.GoogleSubscriptionName = row.Value
+		case "GCP_PUBSUB_TOPIC_NAME":
+			v.GoogleTopicName = row.Value
+		case "GCP_PUBSUB_SERVICE_ACCOUNT":
+			v.GoogleServiceAccount = row.Value
+		case "AZURE_STORAGE_QUEUE_PRIMARY_URI":
+			v.AzureStorageQueuePrimaryURI = row.Value
+		case "AZURE_EVENT_GRID_TOPIC_ENDPOINT":
+			v.AzureEventGridTopicEndpoint = row.Value
+		case "AZURE_TENANT_ID":
+			v.AzureTenantID = row.Value
+		case "AZURE_CONSENT_URL":
+			v.AzureConsentURL = row.Value
+		case "AZURE_MULTI_TENANT_APP_NAME":
+			v.AzureMultiTenantAppName = row.Value
+		}
+	}
+	return v
+}
+
+func (v *notificationIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) (*NotificationIntegrationDetails, error) {
+	opts := &describeNotificationIntegrationOptions{
+		name: id,
+	}
+	rows, err := describeRows[integrationPropertyRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return notificationIntegrationDetailsFromRows(rows), nil
+}
@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_NotificationIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	createEmailNotificationIntegration := func(t *testing.T) AccountObjectIdentifier {
+		t.Helper()
+		id := randomAccountObjectIdentifier(t)
+		err := client.NotificationIntegrations.Create(ctx, id, &CreateNotificationIntegrationOptions{
+			Enabled:     true,
+			EmailParams: &EmailParams{},
+			Comment:     String("some comment"),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.NotificationIntegrations.Drop(ctx, id, &DropNotificationIntegrationOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("create, show and describe", func(t *testing.T) {
+		id := createEmailNotificationIntegration(t)
+
+		notificationIntegration, err := client.NotificationIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), notificationIntegration.Name)
+		assert.Equal(t, "EMAIL", notificationIntegration.NotificationType)
+		assert.Equal(t, "NOTIFICATION", notificationIntegration.Category)
+		assert.True(t, notificationIntegration.Enabled)
+		assert.Equal(t, "some comment", notificationIntegration.Comment)
+
+		details, err := client.NotificationIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, details.Enabled)
+	})
+
+	t.Run("alter", func(t *testing.T) {
+		id := createEmailNotificationIntegration(t)
+
+		err := client.NotificationIntegrations.Alter(ctx, id, &AlterNotificationIntegrationOptions{
+			Set: &NotificationIntegrationSet{
+				Enabled: Bool(false),
+				Comment: String("changed"),
+			},
+		})
+		require.NoError(t, err)
+		notificationIntegration, err := client.NotificationIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, notificationIntegration.Enabled)
+		assert.Equal(t, "changed", notificationIntegration.Comment)
+
+		err = client.NotificationIntegrations.Alter(ctx, id, &AlterNotificationIntegrationOptions{
+			Unset: &NotificationIntegrationUnset{
+				Comment: Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		notificationIntegration, err = client.NotificationIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", notificationIntegration.Comment)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		id := createEmailNotificationIntegration(t)
+
+		err := client.NotificationIntegrations.Drop(ctx, id, nil)
+		require.NoError(t, err)
+		_, err = client.NotificationIntegrations.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
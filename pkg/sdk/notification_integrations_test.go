@@ -0,0 +1,254 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationIntegrationsCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("email", func(t *testing.T) {
+		opts := &CreateNotificationIntegrationOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			Enabled:   true,
+			EmailParams: &EmailParams{
+				AllowedRecipients: []NotificationRecipient{{Email: "first@example.com"}, {Email: "second@example.com"}},
+			},
+			Comment: String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE NOTIFICATION INTEGRATION ` + id.FullyQualifiedName() + ` ENABLED = true TYPE = EMAIL ALLOWED_RECIPIENTS = ('first@example.com', 'second@example.com') COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("inbound gcp pubsub", func(t *testing.T) {
+		opts := &CreateNotificationIntegrationOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			Enabled:     true,
+			AutomatedDataLoadsParams: &AutomatedDataLoadsParams{
+				GoogleAutoParams: &GoogleAutoParams{
+					GoogleSubscriptionName: "projects/project-1234/subscriptions/sub2",
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE NOTIFICATION INTEGRATION IF NOT EXISTS ` + id.FullyQualifiedName() + ` ENABLED = true TYPE = QUEUE NOTIFICATION_PROVIDER = GCP_PUBSUB GCP_PUBSUB_SUBSCRIPTION_NAME = 'projects/project-1234/subscriptions/sub2'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("inbound azure storage queue", func(t *testing.T) {
+		opts := &CreateNotificationIntegrationOptions{
+			name:    id,
+			Enabled: true,
+			AutomatedDataLoadsParams: &AutomatedDataLoadsParams{
+				AzureAutoParams: &AzureAutoParams{
+					AzureStorageQueuePrimaryURI: "https://myqueue.queue.core.windows.net/mystoragequeue",
+					AzureTenantID:               "a123bcde-1234-5678-abc1-9abc12345678",
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE NOTIFICATION INTEGRATION ` + id.FullyQualifiedName() + ` ENABLED = true TYPE = QUEUE NOTIFICATION_PROVIDER = AZURE_STORAGE_QUEUE AZURE_STORAGE_QUEUE_PRIMARY_URI = 'https://myqueue.queue.core.windows.net/mystoragequeue' AZURE_TENANT_ID = 'a123bcde-1234-5678-abc1-9abc12345678'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("outbound aws sns", func(t *testing.T) {
+		opts := &CreateNotificationIntegrationOptions{
+			name:    id,
+			Enabled: true,
+			PushNotificationParams: &PushNotificationParams{
+				AmazonPushParams: &AmazonPushParams{
+					AWSSNSTopicARN: "arn:aws:sns:us-east-2:111122223333:sns_topic",
+					AWSSNSRoleARN:  "arn:aws:iam::111122223333:role/error_sns_role",
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE NOTIFICATION INTEGRATION ` + id.FullyQualifiedName() + ` ENABLED = true TYPE = QUEUE DIRECTION = OUTBOUND NOTIFICATION_PROVIDER = AWS_SNS AWS_SNS_TOPIC_ARN = 'arn:aws:sns:us-east-2:111122223333:sns_topic' AWS_SNS_ROLE_ARN = 'arn:aws:iam::111122223333:role/error_sns_role'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("outbound gcp pubsub", func(t *testing.T) {
+		opts := &CreateNotificationIntegrationOptions{
+			name: id,
+			PushNotificationParams: &PushNotificationParams{
+				GooglePushParams: &GooglePushParams{
+					GoogleTopicName: "projects/sdm-prod/topics/mytopic",
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE NOTIFICATION INTEGRATION ` + id.FullyQualifiedName() + ` ENABLED = false TYPE = QUEUE DIRECTION = OUTBOUND NOTIFICATION_PROVIDER = GCP_PUBSUB GCP_PUBSUB_TOPIC_NAME = 'projects/sdm-prod/topics/mytopic'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("outbound azure event grid", func(t *testing.T) {
+		opts := &CreateNotificationIntegrationOptions{
+			name:    id,
+			Enabled: true,
+			PushNotificationParams: &PushNotificationParams{
+				AzurePushParams: &AzurePushParams{
+					AzureEventGridTopicEndpoint: "https://myaccount.region-1.eventgrid.azure.net/api/events",
+					AzureTenantID:               "mytenantid",
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE NOTIFICATION INTEGRATION ` + id.FullyQualifiedName() + ` ENABLED = true TYPE = QUEUE DIRECTION = OUTBOUND NOTIFICATION_PROVIDER = AZURE_EVENT_GRID AZURE_EVENT_GRID_TOPIC_ENDPOINT = 'https://myaccount.region-1.eventgrid.azure.net/api/events' AZURE_TENANT_ID = 'mytenantid'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: exactly one type", func(t *testing.T) {
+		opts := &CreateNotificationIntegrationOptions{
+			name:        id,
+			EmailParams: &EmailParams{},
+			PushNotificationParams: &PushNotificationParams{
+				GooglePushParams: &GooglePushParams{GoogleTopicName: "topic"},
+			},
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: exactly one push provider", func(t *testing.T) {
+		opts := &CreateNotificationIntegrationOptions{
+			name:                   id,
+			PushNotificationParams: &PushNotificationParams{},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestNotificationIntegrationsAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterNotificationIntegrationOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &NotificationIntegrationSet{
+				Enabled:           Bool(false),
+				AllowedRecipients: []NotificationRecipient{{Email: "first@example.com"}},
+				Comment:           String("changed"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER NOTIFICATION INTEGRATION IF EXISTS ` + id.FullyQualifiedName() + ` SET ENABLED = false ALLOWED_RECIPIENTS = ('first@example.com') COMMENT = 'changed'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterNotificationIntegrationOptions{
+			name: id,
+			Unset: &NotificationIntegrationUnset{
+				AllowedRecipients: Bool(true),
+				Comment:           Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER NOTIFICATION INTEGRATION ` + id.FullyQualifiedName() + ` UNSET ALLOWED_RECIPIENTS, COMMENT`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset tag", func(t *testing.T) {
+		opts := &AlterNotificationIntegrationOptions{
+			name:     id,
+			UnsetTag: []ObjectIdentifier{NewSchemaObjectIdentifier("db", "schema", "tag")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER NOTIFICATION INTEGRATION ` + id.FullyQualifiedName() + ` UNSET TAG "db"."schema"."tag"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: recipients with sns", func(t *testing.T) {
+		opts := &AlterNotificationIntegrationOptions{
+			name: id,
+			Set: &NotificationIntegrationSet{
+				AllowedRecipients: []NotificationRecipient{{Email: "first@example.com"}},
+				AWSSNSTopicARN:    String("arn"),
+			},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestNotificationIntegrationsDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &DropNotificationIntegrationOptions{
+		IfExists: Bool(true),
+		name:     id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP NOTIFICATION INTEGRATION IF EXISTS `+id.FullyQualifiedName(), actual)
+}
+
+func TestNotificationIntegrationsShow(t *testing.T) {
+	opts := &ShowNotificationIntegrationOptions{
+		Like: &Like{Pattern: String("my_integration")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW NOTIFICATION INTEGRATIONS LIKE 'my_integration'`, actual)
+}
+
+func TestNotificationIntegrationsDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &describeNotificationIntegrationOptions{name: id}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE NOTIFICATION INTEGRATION `+id.FullyQualifiedName(), actual)
+
+	t.Run("details from rows", func(t *testing.T) {
+		rows := []integrationPropertyRow{
+			{Property: "ENABLED", PropertyType: "Boolean", Value: "true"},
+			{Property: "NOTIFICATION_PROVIDER", PropertyType: "String", Value: "AWS_SNS"},
+			{Property: "DIRECTION", PropertyType: "String", Value: "OUTBOUND"},
+			{Property: "AWS_SNS_TOPIC_ARN", PropertyType: "String", Value: "arn:aws:sns:us-east-2:111122223333:sns_topic"},
+			{Property: "AWS_SNS_ROLE_ARN", PropertyType: "String", Value: "arn:aws:iam::111122223333:role/error_sns_role"},
+			{Property: "SF_AWS_IAM_USER_ARN", PropertyType: "String", Value: "arn:aws:iam::123456789001:user/abc"},
+			{Property: "SF_AWS_EXTERNAL_ID", PropertyType: "String", Value: "MYACCOUNT_SFCRole=2_abc"},
+			{Property: "COMMENT", PropertyType: "String", Value: "some comment"},
+		}
+		details := notificationIntegrationDetailsFromRows(rows)
+		assert.True(t, details.Enabled)
+		assert.Equal(t, "AWS_SNS", details.NotificationProvider)
+		assert.Equal(t, "OUTBOUND", details.Direction)
+		assert.Equal(t, "arn:aws:sns:us-east-2:111122223333:sns_topic", details.AWSSNSTopicARN)
+		assert.Equal(t, "arn:aws:iam::111122223333:role/error_sns_role", details.AWSSNSRoleARN)
+		assert.Equal(t, "arn:aws:iam::123456789001:user/abc", details.SnowflakeAWSIAMUserARN)
+		assert.Equal(t, "MYACCOUNT_SFCRole=2_abc", details.SnowflakeAWSExternalID)
+		assert.Equal(t, "some comment", details.Comment)
+	})
+
+	t.Run("email details from rows", func(t *testing.T) {
+		rows := []integrationPropertyRow{
+			{Property: "ENABLED", PropertyType: "Boolean", Value: "false"},
+			{Property: "ALLOWED_RECIPIENTS", PropertyType: "List", Value: "first@example.com,second@example.com"},
+		}
+		details := notificationIntegrationDetailsFromRows(rows)
+		assert.False(t, details.Enabled)
+		assert.Equal(t, []string{"first@example.com", "second@example.com"}, details.AllowedRecipients)
+	})
+}
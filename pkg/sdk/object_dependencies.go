@@ -0,0 +1,172 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Compile-time proof of interface implementation.
+var _ ObjectDependencies = (*objectDependencies)(nil)
+
+// ObjectDependencies reads the SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES view, e.g. to drop views, policies and
+// tasks before the objects they depend on. The view has a latency of up to three hours.
+type ObjectDependencies interface {
+	// Show returns the dependencies matching the options.
+	Show(ctx context.Context, opts *ShowObjectDependenciesOptions) ([]*ObjectDependency, error)
+}
+
+// objectDependencies implements ObjectDependencies.
+type objectDependencies struct {
+	client *Client
+}
+
+// ShowObjectDependenciesOptions filters the dependencies. Referenced returns the objects depending on an object,
+// Referencing returns the objects an object depends on, and Database returns the dependencies of the objects
+// in a database. At most one of them can be set, without any of them all dependencies of the account are returned.
+type ShowObjectDependenciesOptions struct {
+	Referenced  SchemaObjectIdentifier
+	Referencing SchemaObjectIdentifier
+	Database    AccountObjectIdentifier
+}
+
+func (opts *ShowObjectDependenciesOptions) validate() error {
+	if anyValueSet(opts.Referenced, opts.Referencing, opts.Database) && !exactlyOneValueSet(opts.Referenced, opts.Referencing, opts.Database) {
+		return errors.New("only one of Referenced, Referencing or Database can be set")
+	}
+	return nil
+}
+
+type ObjectDependencyType string
+
+const (
+	ObjectDependencyTypeByName      ObjectDependencyType = "BY_NAME"
+	ObjectDependencyTypeByID        ObjectDependencyType = "BY_ID"
+	ObjectDependencyTypeByNameAndID ObjectDependencyType = "BY_NAME_AND_ID"
+)
+
+type ObjectDependency struct {
+	Referenced        SchemaObjectIdentifier
+	ReferencedDomain  string
+	Referencing       SchemaObjectIdentifier
+	ReferencingDomain string
+	DependencyType    ObjectDependencyType
+}
+
+type objectDependencyRow struct {
+	ReferencedDatabase      string         `db:"REFERENCED_DATABASE"`
+	ReferencedSchema        string         `db:"REFERENCED_SCHEMA"`
+	ReferencedObjectName    string         `db:"REFERENCED_OBJECT_NAME"`
+	ReferencedObjectDomain  sql.NullString `db:"REFERENCED_OBJECT_DOMAIN"`
+	ReferencingDatabase     string         `db:"REFERENCING_DATABASE"`
+	ReferencingSchema       string         `db:"REFERENCING_SCHEMA"`
+	ReferencingObjectName   string         `db:"REFERENCING_OBJECT_NAME"`
+	ReferencingObjectDomain sql.NullString `db:"REFERENCING_OBJECT_DOMAIN"`
+	DependencyType          sql.NullString `db:"DEPENDENCY_TYPE"`
+}
+
+func (row objectDependencyRow) toObjectDependency() *ObjectDependency {
+	return &ObjectDependency{
+		Referenced:        NewSchemaObjectIdentifier(row.ReferencedDatabase, row.ReferencedSchema, row.ReferencedObjectName),
+		ReferencedDomain:  row.ReferencedObjectDomain.String,
+		Referencing:       NewSchemaObjectIdentifier(row.ReferencingDatabase, row.ReferencingSchema, row.ReferencingObjectName),
+		ReferencingDomain: row.ReferencingObjectDomain.String,
+		DependencyType:    ObjectDependencyType(row.DependencyType.String),
+	}
+}
+
+func quoteObjectDependencyValue(value string) string {
+	return "'" + escapeStringLiteral(value) + "'"
+}
+
+// objectDependenciesSQL builds the query for the object dependencies.
+func objectDependenciesSQL(opts *ShowObjectDependenciesOptions) string {
+	var where string
+	switch {
+	case valueSet(opts.Referenced):
+		where = fmt.Sprintf(" WHERE REFERENCED_DATABASE = %s AND REFERENCED_SCHEMA = %s AND REFERENCED_OBJECT_NAME = %s",
+			quoteObjectDependencyValue(opts.Referenced.DatabaseName()), quoteObjectDependencyValue(opts.Referenced.SchemaName()), quoteObjectDependencyValue(opts.Referenced.Name()))
+	case valueSet(opts.Referencing):
+		where = fmt.Sprintf(" WHERE REFERENCING_DATABASE = %s AND REFERENCING_SCHEMA = %s AND REFERENCING_OBJECT_NAME = %s",
+			quoteObjectDependencyValue(opts.Referencing.DatabaseName()), quoteObjectDependencyValue(opts.Referencing.SchemaName()), quoteObjectDependencyValue(opts.Referencing.Name()))
+	case valueSet(opts.Database):
+		database := quoteObjectDependencyValue(opts.Database.Name())
+		where = fmt.Sprintf(" WHERE REFERENCED_DATABASE = %s OR REFERENCING_DATABASE = %s", database, database)
+	}
+	return `SELECT * FROM SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES` + where
+}
+
+func (v *objectDependencies) Show(ctx context.Context, opts *ShowObjectDependenciesOptions) ([]*ObjectDependency, error) {
+	if opts == nil {
+		opts = &ShowObjectDependenciesOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	dest := []objectDependencyRow{}
+	err := v.client.query(ctx, &dest, objectDependenciesSQL(opts))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ObjectDependency, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toObjectDependency()
+	}
+	return resultList, nil
+}
+
+// ObjectDependencyDropOrder orders objects so that every object comes before the objects it depends on, which
+// is the order they can be dropped in. Dependencies between objects not in the list are ignored and objects
+// without dependencies keep their relative order.
+func ObjectDependencyDropOrder(objects []SchemaObjectIdentifier, dependencies []*ObjectDependency) ([]SchemaObjectIdentifier, error) {
+	index := make(map[SchemaObjectIdentifier]int, len(objects))
+	for i, object := range objects {
+		index[object] = i
+	}
+	// referencedBy[i] are the objects that depend on objects[i] and have to be dropped first.
+	referencedBy := make([][]int, len(objects))
+	for _, dependency := range dependencies {
+		referenced, ok := index[dependency.Referenced]
+		if !ok {
+			continue
+		}
+		referencing, ok := index[dependency.Referencing]
+		if !ok || referencing == referenced {
+			continue
+		}
+		referencedBy[referenced] = append(referencedBy[referenced], referencing)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(objects))
+	ordered := make([]SchemaObjectIdentifier, 0, len(objects))
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular dependency involving %s", objects[i].FullyQualifiedName())
+		}
+		state[i] = visiting
+		for _, j := range referencedBy[i] {
+			if err := visit(j); err != nil {
+				return err
+			}
+		}
+		state[i] = visited
+		ordered = append(ordered, objects[i])
+		return nil
+	}
+	for i := range objects {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
@@ -0,0 +1,65 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectDependenciesSQL(t *testing.T) {
+	t.Run("without options", func(t *testing.T) {
+		actual := objectDependenciesSQL(&ShowObjectDependenciesOptions{})
+		assert.Equal(t, `SELECT * FROM SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES`, actual)
+	})
+
+	t.Run("referenced", func(t *testing.T) {
+		actual := objectDependenciesSQL(&ShowObjectDependenciesOptions{Referenced: NewSchemaObjectIdentifier("db", "schema", "table")})
+		assert.Equal(t, `SELECT * FROM SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES WHERE REFERENCED_DATABASE = 'db' AND REFERENCED_SCHEMA = 'schema' AND REFERENCED_OBJECT_NAME = 'table'`, actual)
+	})
+
+	t.Run("referencing", func(t *testing.T) {
+		actual := objectDependenciesSQL(&ShowObjectDependenciesOptions{Referencing: NewSchemaObjectIdentifier("db", "schema", "it's a view")})
+		assert.Equal(t, `SELECT * FROM SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES WHERE REFERENCING_DATABASE = 'db' AND REFERENCING_SCHEMA = 'schema' AND REFERENCING_OBJECT_NAME = 'it\'s a view'`, actual)
+	})
+
+	t.Run("database", func(t *testing.T) {
+		actual := objectDependenciesSQL(&ShowObjectDependenciesOptions{Database: NewAccountObjectIdentifier("db")})
+		assert.Equal(t, `SELECT * FROM SNOWFLAKE.ACCOUNT_USAGE.OBJECT_DEPENDENCIES WHERE REFERENCED_DATABASE = 'db' OR REFERENCING_DATABASE = 'db'`, actual)
+	})
+
+	t.Run("validation: more than one filter", func(t *testing.T) {
+		opts := &ShowObjectDependenciesOptions{
+			Referenced: NewSchemaObjectIdentifier("db", "schema", "table"),
+			Database:   NewAccountObjectIdentifier("db"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestObjectDependencyDropOrder(t *testing.T) {
+	table := NewSchemaObjectIdentifier("db", "schema", "table")
+	view := NewSchemaObjectIdentifier("db", "schema", "view")
+	secureView := NewSchemaObjectIdentifier("db", "schema", "secure_view")
+	other := NewSchemaObjectIdentifier("db", "schema", "other")
+
+	t.Run("referencing objects first", func(t *testing.T) {
+		dependencies := []*ObjectDependency{
+			{Referenced: table, Referencing: view},
+			{Referenced: view, Referencing: secureView},
+			{Referenced: NewSchemaObjectIdentifier("db", "schema", "not_listed"), Referencing: other},
+		}
+		actual, err := ObjectDependencyDropOrder([]SchemaObjectIdentifier{table, other, view, secureView}, dependencies)
+		require.NoError(t, err)
+		assert.Equal(t, []SchemaObjectIdentifier{secureView, view, table, other}, actual)
+	})
+
+	t.Run("circular dependency", func(t *testing.T) {
+		dependencies := []*ObjectDependency{
+			{Referenced: table, Referencing: view},
+			{Referenced: view, Referencing: table},
+		}
+		_, err := ObjectDependencyDropOrder([]SchemaObjectIdentifier{table, view}, dependencies)
+		assert.Error(t, err)
+	})
+}
@@ -16,24 +16,49 @@ type Object struct {
 type ObjectType string
 
 const (
-	ObjectTypeAccount          ObjectType = "ACCOUNT"
-	ObjectTypeAccountParameter ObjectType = "ACCOUNT PARAMETER"
-	ObjectTypeDatabase         ObjectType = "DATABASE"
-	ObjectTypeFailoverGroup    ObjectType = "FAILOVER GROUP"
-	ObjectTypeIntegration      ObjectType = "INTEGRATION"
-	ObjectTypeMaskingPolicy    ObjectType = "MASKING POLICY"
-	ObjectTypeNetworkPolicy    ObjectType = "NETWORK POLICY"
-	ObjectTypePasswordPolicy   ObjectType = "PASSWORD POLICY"
-	ObjectTypeResourceMonitor  ObjectType = "RESOURCE MONITOR"
-	ObjectTypeRole             ObjectType = "ROLE"
-	ObjectTypeSchema           ObjectType = "SCHEMA"
-	ObjectTypeSessionPolicy    ObjectType = "SESSION POLICY"
-	ObjectTypeShare            ObjectType = "SHARE"
-	ObjectTypeTable            ObjectType = "TABLE"
-	ObjectTypeTag              ObjectType = "TAG"
-	ObjectTypeTask             ObjectType = "TASK"
-	ObjectTypeUser             ObjectType = "USER"
-	ObjectTypeWarehouse        ObjectType = "WAREHOUSE"
+	ObjectTypeAccount             ObjectType = "ACCOUNT"
+	ObjectTypeAccountParameter    ObjectType = "ACCOUNT PARAMETER"
+	ObjectTypeAggregationPolicy   ObjectType = "AGGREGATION POLICY"
+	ObjectTypeAlert               ObjectType = "ALERT"
+	ObjectTypeApplication         ObjectType = "APPLICATION"
+	ObjectTypeApplicationPackage  ObjectType = "APPLICATION PACKAGE"
+	ObjectTypeApplicationRole     ObjectType = "APPLICATION ROLE"
+	ObjectTypeComputePool         ObjectType = "COMPUTE POOL"
+	ObjectTypeConnection          ObjectType = "CONNECTION"
+	ObjectTypeCortexSearchService ObjectType = "CORTEX SEARCH SERVICE"
+	ObjectTypeDatabase            ObjectType = "DATABASE"
+	ObjectTypeDatabaseRole        ObjectType = "DATABASE ROLE"
+	ObjectTypeExternalVolume      ObjectType = "EXTERNAL VOLUME"
+	ObjectTypeFailoverGroup       ObjectType = "FAILOVER GROUP"
+	ObjectTypeGitRepository       ObjectType = "GIT REPOSITORY"
+	ObjectTypeImageRepository     ObjectType = "IMAGE REPOSITORY"
+	ObjectTypeIntegration         ObjectType = "INTEGRATION"
+	ObjectTypeListing             ObjectType = "LISTING"
+	ObjectTypeManagedAccount      ObjectType = "MANAGED ACCOUNT"
+	ObjectTypeMaskingPolicy       ObjectType = "MASKING POLICY"
+	ObjectTypeNetworkPolicy       ObjectType = "NETWORK POLICY"
+	ObjectTypeNotebook            ObjectType = "NOTEBOOK"
+	ObjectTypePackagesPolicy      ObjectType = "PACKAGES POLICY"
+	ObjectTypePasswordPolicy      ObjectType = "PASSWORD POLICY"
+	ObjectTypePipe                ObjectType = "PIPE"
+	ObjectTypeProjectionPolicy    ObjectType = "PROJECTION POLICY"
+	ObjectTypeReplicationGroup    ObjectType = "REPLICATION GROUP"
+	ObjectTypeResourceMonitor     ObjectType = "RESOURCE MONITOR"
+	ObjectTypeRole                ObjectType = "ROLE"
+	ObjectTypeSchema              ObjectType = "SCHEMA"
+	ObjectTypeSemanticView        ObjectType = "SEMANTIC VIEW"
+	ObjectTypeService             ObjectType = "SERVICE"
+	ObjectTypeSessionPolicy       ObjectType = "SESSION POLICY"
+	ObjectTypeShare               ObjectType = "SHARE"
+	ObjectTypeStage               ObjectType = "STAGE"
+	ObjectTypeStream              ObjectType = "STREAM"
+	ObjectTypeStreamlit           ObjectType = "STREAMLIT"
+	ObjectTypeTable               ObjectType = "TABLE"
+	ObjectTypeTag                 ObjectType = "TAG"
+	ObjectTypeTask                ObjectType = "TASK"
+	ObjectTypeUser                ObjectType = "USER"
+	ObjectTypeView                ObjectType = "VIEW"
+	ObjectTypeWarehouse           ObjectType = "WAREHOUSE"
 )
 
 func (o ObjectType) String() string {
@@ -42,23 +67,48 @@ func (o ObjectType) String() string {
 
 func objectTypeSingularToPluralMap() map[ObjectType]PluralObjectType {
 	return map[ObjectType]PluralObjectType{
-		ObjectTypeAccountParameter: PluralObjectTypeAccountParameters,
-		ObjectTypeDatabase:         PluralObjectTypeDatabases,
-		ObjectTypeFailoverGroup:    PluralObjectTypeTypeFailoverGroups,
-		ObjectTypeIntegration:      PluralObjectTypeIntegrations,
-		ObjectTypeMaskingPolicy:    PluralObjectTypeMaskingPolicies,
-		ObjectTypeNetworkPolicy:    PluralObjectTypeNetworkPolicies,
-		ObjectTypePasswordPolicy:   PluralObjectTypePasswordPolicies,
-		ObjectTypeResourceMonitor:  PluralObjectTypeResourceMonitors,
-		ObjectTypeRole:             PluralObjectTypeRoles,
-		ObjectTypeSchema:           PluralObjectTypeSchemas,
-		ObjectTypeSessionPolicy:    PluralObjectTypeSessionPolicies,
-		ObjectTypeShare:            PluralObjectTypeShares,
-		ObjectTypeTable:            PluralObjectTypeTables,
-		ObjectTypeTag:              PluralObjectTypeTags,
-		ObjectTypeTask:             PluralObjectTypeTasks,
-		ObjectTypeUser:             PluralObjectTypeUsers,
-		ObjectTypeWarehouse:        PluralObjectTypeWarehouses,
+		ObjectTypeAccountParameter:    PluralObjectTypeAccountParameters,
+		ObjectTypeAggregationPolicy:   PluralObjectTypeAggregationPolicies,
+		ObjectTypeAlert:               PluralObjectTypeAlerts,
+		ObjectTypeApplication:         PluralObjectTypeApplications,
+		ObjectTypeApplicationPackage:  PluralObjectTypeApplicationPackages,
+		ObjectTypeApplicationRole:     PluralObjectTypeApplicationRoles,
+		ObjectTypeComputePool:         PluralObjectTypeComputePools,
+		ObjectTypeConnection:          PluralObjectTypeConnections,
+		ObjectTypeCortexSearchService: PluralObjectTypeCortexSearchServices,
+		ObjectTypeDatabase:            PluralObjectTypeDatabases,
+		ObjectTypeDatabaseRole:        PluralObjectTypeDatabaseRoles,
+		ObjectTypeExternalVolume:      PluralObjectTypeExternalVolumes,
+		ObjectTypeFailoverGroup:       PluralObjectTypeTypeFailoverGroups,
+		ObjectTypeGitRepository:       PluralObjectTypeGitRepositories,
+		ObjectTypeImageRepository:     PluralObjectTypeImageRepositories,
+		ObjectTypeIntegration:         PluralObjectTypeIntegrations,
+		ObjectTypeListing:             PluralObjectTypeListings,
+		ObjectTypeManagedAccount:      PluralObjectTypeManagedAccounts,
+		ObjectTypeMaskingPolicy:       PluralObjectTypeMaskingPolicies,
+		ObjectTypeNetworkPolicy:       PluralObjectTypeNetworkPolicies,
+		ObjectTypeNotebook:            PluralObjectTypeNotebooks,
+		ObjectTypePackagesPolicy:      PluralObjectTypePackagesPolicies,
+		ObjectTypePasswordPolicy:      PluralObjectTypePasswordPolicies,
+		ObjectTypePipe:                PluralObjectTypePipes,
+		ObjectTypeProjectionPolicy:    PluralObjectTypeProjectionPolicies,
+		ObjectTypeReplicationGroup:    PluralObjectTypeReplicationGroups,
+		ObjectTypeResourceMonitor:     PluralObjectTypeResourceMonitors,
+		ObjectTypeRole:                PluralObjectTypeRoles,
+		ObjectTypeSchema:              PluralObjectTypeSchemas,
+		ObjectTypeSemanticView:        PluralObjectTypeSemanticViews,
+		ObjectTypeService:             PluralObjectTypeServices,
+		ObjectTypeSessionPolicy:       PluralObjectTypeSessionPolicies,
+		ObjectTypeShare:               PluralObjectTypeShares,
+		ObjectTypeStage:               PluralObjectTypeStages,
+		ObjectTypeStream:              PluralObjectTypeStreams,
+		ObjectTypeStreamlit:           PluralObjectTypeStreamlits,
+		ObjectTypeTable:               PluralObjectTypeTables,
+		ObjectTypeTag:                 PluralObjectTypeTags,
+		ObjectTypeTask:                PluralObjectTypeTasks,
+		ObjectTypeUser:                PluralObjectTypeUsers,
+		ObjectTypeView:                PluralObjectTypeViews,
+		ObjectTypeWarehouse:           PluralObjectTypeWarehouses,
 	}
 }
 
@@ -81,9 +131,17 @@ func (o ObjectType) Plural() PluralObjectType {
 func (o ObjectType) GetObjectIdentifier(fullyQualifiedName string) ObjectIdentifier {
 	accountIdentifiers := []ObjectType{
 		ObjectTypeAccountParameter,
+		ObjectTypeApplication,
+		ObjectTypeApplicationPackage,
+		ObjectTypeComputePool,
+		ObjectTypeConnection,
 		ObjectTypeDatabase,
+		ObjectTypeExternalVolume,
 		ObjectTypeFailoverGroup,
 		ObjectTypeIntegration,
+		ObjectTypeListing,
+		ObjectTypeManagedAccount,
+		ObjectTypeReplicationGroup,
 		ObjectTypeResourceMonitor,
 		ObjectTypeRole,
 		ObjectTypeShare,
@@ -95,6 +153,9 @@ func (o ObjectType) GetObjectIdentifier(fullyQualifiedName string) ObjectIdentif
 	}
 	parts := strings.Split(fullyQualifiedName, ".")
 	dbName := parts[0]
+	if o == ObjectTypeDatabaseRole || o == ObjectTypeApplicationRole {
+		return NewDatabaseObjectIdentifier(dbName, strings.Join(parts[1:], "."))
+	}
 	if o == ObjectTypeSchema {
 		schemaName := strings.Join(parts[1:], ".")
 		return NewSchemaIdentifier(dbName, schemaName)
@@ -107,23 +168,48 @@ func (o ObjectType) GetObjectIdentifier(fullyQualifiedName string) ObjectIdentif
 type PluralObjectType string
 
 const (
-	PluralObjectTypeAccountParameters  PluralObjectType = "ACCOUNT PARAMETERS"
-	PluralObjectTypeDatabases          PluralObjectType = "DATABASES"
-	PluralObjectTypeTypeFailoverGroups PluralObjectType = "FAILOVER GROUPS"
-	PluralObjectTypeIntegrations       PluralObjectType = "INTEGRATIONS"
-	PluralObjectTypeMaskingPolicies    PluralObjectType = "MASKING POLICIES"
-	PluralObjectTypeNetworkPolicies    PluralObjectType = "NETWORK POLICIES"
-	PluralObjectTypePasswordPolicies   PluralObjectType = "PASSWORD POLICIES"
-	PluralObjectTypeResourceMonitors   PluralObjectType = "RESOURCE MONITORS"
-	PluralObjectTypeRoles              PluralObjectType = "ROLES"
-	PluralObjectTypeSchemas            PluralObjectType = "SCHEMAS"
-	PluralObjectTypeSessionPolicies    PluralObjectType = "SESSION POLICIES"
-	PluralObjectTypeShares             PluralObjectType = "SHARES"
-	PluralObjectTypeTables             PluralObjectType = "TABLES"
-	PluralObjectTypeTags               PluralObjectType = "TAGS"
-	PluralObjectTypeTasks              PluralObjectType = "TASKS"
-	PluralObjectTypeUsers              PluralObjectType = "USERS"
-	PluralObjectTypeWarehouses         PluralObjectType = "WAREHOUSES"
+	PluralObjectTypeAccountParameters    PluralObjectType = "ACCOUNT PARAMETERS"
+	PluralObjectTypeAggregationPolicies  PluralObjectType = "AGGREGATION POLICIES"
+	PluralObjectTypeAlerts               PluralObjectType = "ALERTS"
+	PluralObjectTypeApplicationPackages  PluralObjectType = "APPLICATION PACKAGES"
+	PluralObjectTypeApplicationRoles     PluralObjectType = "APPLICATION ROLES"
+	PluralObjectTypeApplications         PluralObjectType = "APPLICATIONS"
+	PluralObjectTypeComputePools         PluralObjectType = "COMPUTE POOLS"
+	PluralObjectTypeConnections          PluralObjectType = "CONNECTIONS"
+	PluralObjectTypeCortexSearchServices PluralObjectType = "CORTEX SEARCH SERVICES"
+	PluralObjectTypeDatabases            PluralObjectType = "DATABASES"
+	PluralObjectTypeDatabaseRoles        PluralObjectType = "DATABASE ROLES"
+	PluralObjectTypeExternalVolumes      PluralObjectType = "EXTERNAL VOLUMES"
+	PluralObjectTypeGitRepositories      PluralObjectType = "GIT REPOSITORIES"
+	PluralObjectTypeImageRepositories    PluralObjectType = "IMAGE REPOSITORIES"
+	PluralObjectTypeNotebooks            PluralObjectType = "NOTEBOOKS"
+	PluralObjectTypePackagesPolicies     PluralObjectType = "PACKAGES POLICIES"
+	PluralObjectTypeProjectionPolicies   PluralObjectType = "PROJECTION POLICIES"
+	PluralObjectTypeServices             PluralObjectType = "SERVICES"
+	PluralObjectTypeStages               PluralObjectType = "STAGES"
+	PluralObjectTypeStreams              PluralObjectType = "STREAMS"
+	PluralObjectTypeStreamlits           PluralObjectType = "STREAMLITS"
+	PluralObjectTypeTypeFailoverGroups   PluralObjectType = "FAILOVER GROUPS"
+	PluralObjectTypeIntegrations         PluralObjectType = "INTEGRATIONS"
+	PluralObjectTypeListings             PluralObjectType = "LISTINGS"
+	PluralObjectTypeManagedAccounts      PluralObjectType = "MANAGED ACCOUNTS"
+	PluralObjectTypeMaskingPolicies      PluralObjectType = "MASKING POLICIES"
+	PluralObjectTypeNetworkPolicies      PluralObjectType = "NETWORK POLICIES"
+	PluralObjectTypePasswordPolicies     PluralObjectType = "PASSWORD POLICIES"
+	PluralObjectTypePipes                PluralObjectType = "PIPES"
+	PluralObjectTypeReplicationGroups    PluralObjectType = "REPLICATION GROUPS"
+	PluralObjectTypeResourceMonitors     PluralObjectType = "RESOURCE MONITORS"
+	PluralObjectTypeRoles                PluralObjectType = "ROLES"
+	PluralObjectTypeSchemas              PluralObjectType = "SCHEMAS"
+	PluralObjectTypeSemanticViews        PluralObjectType = "SEMANTIC VIEWS"
+	PluralObjectTypeSessionPolicies      PluralObjectType = "SESSION POLICIES"
+	PluralObjectTypeShares               PluralObjectType = "SHARES"
+	PluralObjectTypeTables               PluralObjectType = "TABLES"
+	PluralObjectTypeTags                 PluralObjectType = "TAGS"
+	PluralObjectTypeTasks                PluralObjectType = "TASKS"
+	PluralObjectTypeUsers                PluralObjectType = "USERS"
+	PluralObjectTypeViews                PluralObjectType = "VIEWS"
+	PluralObjectTypeWarehouses           PluralObjectType = "WAREHOUSES"
 )
 
 func (p PluralObjectType) String() string {
@@ -0,0 +1,305 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ PackagesPolicies = (*packagesPolicies)(nil)
+
+// PackagesPolicies govern which Python packages from the Anaconda channel Snowpark code in the account can use.
+type PackagesPolicies interface {
+	// Create creates a new packages policy.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreatePackagesPolicyOptions) error
+	// Alter modifies an existing packages policy.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterPackagesPolicyOptions) error
+	// Drop removes a packages policy.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropPackagesPolicyOptions) error
+	// Show returns a list of packages policies.
+	Show(ctx context.Context) ([]*PackagesPolicy, error)
+	// ShowByID returns a packages policy by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicy, error)
+	// Describe returns the details of a packages policy.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicyDetails, error)
+}
+
+// packagesPolicies implements PackagesPolicies.
+type packagesPolicies struct {
+	client *Client
+}
+
+// PackageSpec is a package with an optional version specifier, e.g. numpy or pandas==1.5.3.
+type PackageSpec struct {
+	Spec string `ddl:"keyword,single_quotes"`
+}
+
+type CreatePackagesPolicyOptions struct {
+	create         bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace      *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	packagesPolicy bool                   `ddl:"static" sql:"PACKAGES POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists    *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name           SchemaObjectIdentifier `ddl:"identifier"`
+	// Python is the only language packages policies support.
+	languagePython bool `ddl:"static" sql:"LANGUAGE PYTHON"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Allowlist []PackageSpec `ddl:"parameter,parentheses" sql:"ALLOWLIST"`
+	Blocklist []PackageSpec `ddl:"parameter,parentheses" sql:"BLOCKLIST"`
+	// AdditionalCreationBlocklist only applies when functions and procedures are created, not when they are called.
+	AdditionalCreationBlocklist []PackageSpec `ddl:"parameter,parentheses" sql:"ADDITIONAL_CREATION_BLOCKLIST"`
+	Comment                     *string       `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreatePackagesPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	return nil
+}
+
+func (v *packagesPolicies) Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreatePackagesPolicyOptions) error {
+	if opts == nil {
+		opts = &CreatePackagesPolicyOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterPackagesPolicyOptions struct {
+	alter          bool                   `ddl:"static" sql:"ALTER"`           //lint:ignore U1000 This is used in the ddl tag
+	packagesPolicy bool                   `ddl:"static" sql:"PACKAGES POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists       *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name           SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	Set   *PackagesPolicySet   `ddl:"keyword" sql:"SET"`
+	Unset *PackagesPolicyUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *AlterPackagesPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		return errors.New("exactly one of Set or Unset must be set")
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.Allowlist, opts.Set.Blocklist, opts.Set.AdditionalCreationBlocklist, opts.Set.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.Allowlist, opts.Unset.Blocklist, opts.Unset.AdditionalCreationBlocklist, opts.Unset.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+type PackagesPolicySet struct {
+	Allowlist                   []PackageSpec `ddl:"parameter,parentheses" sql:"ALLOWLIST"`
+	Blocklist                   []PackageSpec `ddl:"parameter,parentheses" sql:"BLOCKLIST"`
+	AdditionalCreationBlocklist []PackageSpec `ddl:"parameter,parentheses" sql:"ADDITIONAL_CREATION_BLOCKLIST"`
+	Comment                     *string       `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type PackagesPolicyUnset struct {
+	Allowlist                   *bool `ddl:"keyword" sql:"ALLOWLIST"`
+	Blocklist                   *bool `ddl:"keyword" sql:"BLOCKLIST"`
+	AdditionalCreationBlocklist *bool `ddl:"keyword" sql:"ADDITIONAL_CREATION_BLOCKLIST"`
+	Comment                     *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *packagesPolicies) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterPackagesPolicyOptions) error {
+	if opts == nil {
+		opts = &AlterPackagesPolicyOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropPackagesPolicyOptions struct {
+	drop           bool                   `ddl:"static" sql:"DROP"`            //lint:ignore U1000 This is used in the ddl tag
+	packagesPolicy bool                   `ddl:"static" sql:"PACKAGES POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists       *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name           SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropPackagesPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *packagesPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropPackagesPolicyOptions) error {
+	if opts == nil {
+		opts = &DropPackagesPolicyOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// showPackagesPolicyOptions has no filters, SHOW PACKAGES POLICIES lists all policies the role can access.
+type showPackagesPolicyOptions struct {
+	show             bool `ddl:"static" sql:"SHOW"`              //lint:ignore U1000 This is used in the ddl tag
+	packagesPolicies bool `ddl:"static" sql:"PACKAGES POLICIES"` //lint:ignore U1000 This is used in the ddl tag
+}
+
+func (opts *showPackagesPolicyOptions) validate() error {
+	return nil
+}
+
+type PackagesPolicy struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Kind         string
+	Owner        string
+	Comment      string
+}
+
+func (v *PackagesPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *PackagesPolicy) ObjectType() ObjectType {
+	return ObjectTypePackagesPolicy
+}
+
+type packagesPolicyRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Kind         sql.NullString `db:"kind"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+func (row packagesPolicyRow) toPackagesPolicy() *PackagesPolicy {
+	return &PackagesPolicy{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Kind:         row.Kind.String,
+		Owner:        row.Owner.String,
+		Comment:      row.Comment.String,
+	}
+}
+
+func (v *packagesPolicies) Show(ctx context.Context) ([]*PackagesPolicy, error) {
+	opts := &showPackagesPolicyOptions{}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []packagesPolicyRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*PackagesPolicy, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toPackagesPolicy()
+	}
+	return resultList, nil
+}
+
+func (v *packagesPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicy, error) {
+	policies, err := v.Show(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		if policy.ID() == id {
+			return policy, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describePackagesPolicyOptions struct {
+	describe       bool                   `ddl:"static" sql:"DESCRIBE"`        //lint:ignore U1000 This is used in the ddl tag
+	packagesPolicy bool                   `ddl:"static" sql:"PACKAGES POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	name           SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describePackagesPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type PackagesPolicyDetails struct {
+	Name                        string
+	Language                    string
+	Allowlist                   []string
+	Blocklist                   []string
+	AdditionalCreationBlocklist []string
+	Comment                     string
+}
+
+type packagesPolicyDetailsRow struct {
+	Name                        string         `db:"name"`
+	Language                    string         `db:"language"`
+	Allowlist                   sql.NullString `db:"allowlist"`
+	Blocklist                   sql.NullString `db:"blocklist"`
+	AdditionalCreationBlocklist sql.NullString `db:"additional_creation_blocklist"`
+	Comment                     sql.NullString `db:"comment"`
+}
+
+// toPackagesPolicyDetails parses the package lists, which are returned as e.g. ['numpy', 'pandas==1.5.3'].
+func (row packagesPolicyDetailsRow) toPackagesPolicyDetails() *PackagesPolicyDetails {
+	return &PackagesPolicyDetails{
+		Name:                        row.Name,
+		Language:                    row.Language,
+		Allowlist:                   unquoteList(row.Allowlist.String),
+		Blocklist:                   unquoteList(row.Blocklist.String),
+		AdditionalCreationBlocklist: unquoteList(row.AdditionalCreationBlocklist.String),
+		Comment:                     row.Comment.String,
+	}
+}
+
+func (v *packagesPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicyDetails, error) {
+	opts := &describePackagesPolicyOptions{
+		name: id,
+	}
+	dest, err := describeRow[packagesPolicyDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toPackagesPolicyDetails(), nil
+}
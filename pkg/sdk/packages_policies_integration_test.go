@@ -0,0 +1,57 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_PackagesPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err := client.PackagesPolicies.Create(ctx, id, &CreatePackagesPolicyOptions{
+		Allowlist: []PackageSpec{{Spec: "numpy"}},
+		Comment:   String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.PackagesPolicies.Drop(ctx, id, &DropPackagesPolicyOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		policy, err := client.PackagesPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, policy.ID())
+		assert.Equal(t, "some comment", policy.Comment)
+	})
+
+	t.Run("alter and describe", func(t *testing.T) {
+		err := client.PackagesPolicies.Alter(ctx, id, &AlterPackagesPolicyOptions{
+			Set: &PackagesPolicySet{Blocklist: []PackageSpec{{Spec: "requests"}}},
+		})
+		require.NoError(t, err)
+		details, err := client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "PYTHON", details.Language)
+		assert.Contains(t, details.Allowlist, "numpy")
+		assert.Contains(t, details.Blocklist, "requests")
+
+		err = client.PackagesPolicies.Alter(ctx, id, &AlterPackagesPolicyOptions{
+			Unset: &PackagesPolicyUnset{Blocklist: Bool(true)},
+		})
+		require.NoError(t, err)
+		details, err = client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, details.Blocklist)
+	})
+}
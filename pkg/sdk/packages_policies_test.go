@@ -0,0 +1,124 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackagesPolicyCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "packpol")
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreatePackagesPolicyOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE PACKAGES POLICY "db"."schema"."packpol" LANGUAGE PYTHON`, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreatePackagesPolicyOptions{
+			OrReplace:                   Bool(true),
+			name:                        id,
+			Allowlist:                   []PackageSpec{{Spec: "numpy"}, {Spec: "pandas==1.5.3"}},
+			Blocklist:                   []PackageSpec{{Spec: "requests"}},
+			AdditionalCreationBlocklist: []PackageSpec{{Spec: "scipy"}},
+			Comment:                     String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE PACKAGES POLICY "db"."schema"."packpol" LANGUAGE PYTHON ALLOWLIST = ('numpy', 'pandas==1.5.3') BLOCKLIST = ('requests') ADDITIONAL_CREATION_BLOCKLIST = ('scipy') COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreatePackagesPolicyOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestPackagesPolicyAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "packpol")
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterPackagesPolicyOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterPackagesPolicyOptions{name: id, Set: &PackagesPolicySet{}}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &AlterPackagesPolicyOptions{
+			name: id,
+			Set: &PackagesPolicySet{
+				Blocklist: []PackageSpec{{Spec: "requests"}},
+				Comment:   String("some comment"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER PACKAGES POLICY "db"."schema"."packpol" SET BLOCKLIST = ('requests') COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &AlterPackagesPolicyOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Unset: &PackagesPolicyUnset{
+				Allowlist:                   Bool(true),
+				AdditionalCreationBlocklist: Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER PACKAGES POLICY IF EXISTS "db"."schema"."packpol" UNSET ALLOWLIST, ADDITIONAL_CREATION_BLOCKLIST`, actual)
+	})
+}
+
+func TestPackagesPolicyDrop(t *testing.T) {
+	opts := &DropPackagesPolicyOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "packpol"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP PACKAGES POLICY IF EXISTS "db"."schema"."packpol"`, actual)
+}
+
+func TestPackagesPolicyShow(t *testing.T) {
+	actual, err := structToSQL(&showPackagesPolicyOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW PACKAGES POLICIES`, actual)
+}
+
+func TestPackagesPolicyDescribe(t *testing.T) {
+	opts := &describePackagesPolicyOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "packpol"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE PACKAGES POLICY "db"."schema"."packpol"`, actual)
+}
+
+func TestPackagesPolicyDetailsRowToPackagesPolicyDetails(t *testing.T) {
+	row := packagesPolicyDetailsRow{
+		Name:      "PACKPOL",
+		Language:  "PYTHON",
+		Allowlist: sql.NullString{String: "['numpy', 'pandas==1.5.3']", Valid: true},
+		Blocklist: sql.NullString{String: "[]", Valid: true},
+	}
+	details := row.toPackagesPolicyDetails()
+	assert.Equal(t, []string{"numpy", "pandas==1.5.3"}, details.Allowlist)
+	assert.Empty(t, details.Blocklist)
+	assert.Empty(t, details.AdditionalCreationBlocklist)
+}
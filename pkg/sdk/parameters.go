@@ -1,6 +1,287 @@
 package sdk
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Compile-time proof of interface implementation.
+var _ Parameters = (*parameters)(nil)
+
+// Parameters manages parameters set on the account level. Account level values are inherited by
+// all users, sessions and objects that do not override them.
+type Parameters interface {
+	// SetAccountParameter sets a single account level parameter. The value is parsed according to
+	// the type of the parameter, e.g. "true" for booleans or "90" for numbers.
+	SetAccountParameter(ctx context.Context, parameter AccountParameter, value string) error
+	// UnsetAccountParameter resets a single account level parameter to its default value.
+	UnsetAccountParameter(ctx context.Context, parameter AccountParameter) error
+	// ShowAccountParameters returns all parameters in the account.
+	ShowAccountParameters(ctx context.Context) ([]*Parameter, error)
+	// ShowAccountParameter returns a single parameter in the account.
+	ShowAccountParameter(ctx context.Context, parameter AccountParameter) (*Parameter, error)
+
+	// SetSessionParameter sets a single parameter for the current session.
+	SetSessionParameter(ctx context.Context, parameter SessionParameter, value string) error
+	// UnsetSessionParameter resets a single parameter of the current session.
+	UnsetSessionParameter(ctx context.Context, parameter SessionParameter) error
+	// SetUserParameter sets a single parameter of a user, overriding the account level value.
+	SetUserParameter(ctx context.Context, user AccountObjectIdentifier, parameter UserParameter, value string) error
+	// UnsetUserParameter resets a single parameter of a user.
+	UnsetUserParameter(ctx context.Context, user AccountObjectIdentifier, parameter UserParameter) error
+	// SetObjectParameter sets a single parameter of a warehouse, database, schema, task or table.
+	SetObjectParameter(ctx context.Context, objectType ObjectType, objectID ObjectIdentifier, parameter ObjectParameter, value string) error
+	// UnsetObjectParameter resets a single parameter of a warehouse, database, schema, task or table.
+	UnsetObjectParameter(ctx context.Context, objectType ObjectType, objectID ObjectIdentifier, parameter ObjectParameter) error
+	// ShowObjectParameters returns all parameters of a user, warehouse, database, schema, task or table.
+	ShowObjectParameters(ctx context.Context, objectType ObjectType, objectID ObjectIdentifier) ([]*Parameter, error)
+}
+
+// parameters implements Parameters.
+type parameters struct {
+	client *Client
+}
+
+func (v *parameters) SetAccountParameter(ctx context.Context, parameter AccountParameter, value string) error {
+	params := &AccountLevelParameters{}
+	accountParameters, sessionParameters, objectParameters, userParameters := &AccountParameters{}, &SessionParameters{}, &ObjectParameters{}, &UserParameters{}
+	var err error
+	switch {
+	case hasParameterField(accountParameters, string(parameter)):
+		err = setParameterField(accountParameters, string(parameter), value)
+		params.AccountParameters = accountParameters
+	case hasParameterField(sessionParameters, string(parameter)):
+		err = setParameterField(sessionParameters, string(parameter), value)
+		params.SessionParameters = sessionParameters
+	case hasParameterField(objectParameters, string(parameter)):
+		err = setParameterField(objectParameters, string(parameter), value)
+		params.ObjectParameters = objectParameters
+	case hasParameterField(userParameters, string(parameter)):
+		err = setParameterField(userParameters, string(parameter), value)
+		params.UserParameters = userParameters
+	default:
+		return fmt.Errorf("unsupported account parameter %s", parameter)
+	}
+	if err != nil {
+		return err
+	}
+	return v.client.Accounts.Alter(ctx, &AlterAccountOptions{Set: &AccountSet{Parameters: params}})
+}
+
+func (v *parameters) UnsetAccountParameter(ctx context.Context, parameter AccountParameter) error {
+	params := &AccountLevelParametersUnset{}
+	accountParameters, sessionParameters, objectParameters, userParameters := &AccountParametersUnset{}, &SessionParametersUnset{}, &ObjectParametersUnset{}, &UserParametersUnset{}
+	switch {
+	case unsetParameterField(accountParameters, string(parameter)):
+		params.AccountParameters = accountParameters
+	case unsetParameterField(sessionParameters, string(parameter)):
+		params.SessionParameters = sessionParameters
+	case unsetParameterField(objectParameters, string(parameter)):
+		params.ObjectParameters = objectParameters
+	case unsetParameterField(userParameters, string(parameter)):
+		params.UserParameters = userParameters
+	default:
+		return fmt.Errorf("unsupported account parameter %s", parameter)
+	}
+	return v.client.Accounts.Alter(ctx, &AlterAccountOptions{Unset: &AccountUnset{Parameters: params}})
+}
+
+func (v *parameters) ShowAccountParameters(ctx context.Context) ([]*Parameter, error) {
+	return v.client.Sessions.ShowParameters(ctx, &ShowParametersOptions{
+		In: &ParametersIn{
+			Account: Bool(true),
+		},
+	})
+}
+
+func (v *parameters) ShowAccountParameter(ctx context.Context, parameter AccountParameter) (*Parameter, error) {
+	return v.client.Sessions.ShowAccountParameter(ctx, parameter)
+}
+
+func (v *parameters) SetSessionParameter(ctx context.Context, parameter SessionParameter, value string) error {
+	sessionParameters := &SessionParameters{}
+	if err := setParameterField(sessionParameters, string(parameter), value); err != nil {
+		return err
+	}
+	return v.client.Sessions.AlterSession(ctx, &AlterSessionOptions{Set: &SessionSet{SessionParameters: sessionParameters}})
+}
+
+func (v *parameters) UnsetSessionParameter(ctx context.Context, parameter SessionParameter) error {
+	sessionParameters := &SessionParametersUnset{}
+	if !unsetParameterField(sessionParameters, string(parameter)) {
+		return fmt.Errorf("unsupported session parameter %s", parameter)
+	}
+	return v.client.Sessions.AlterSession(ctx, &AlterSessionOptions{Unset: &SessionUnset{SessionParametersUnset: sessionParameters}})
+}
+
+func (v *parameters) SetUserParameter(ctx context.Context, user AccountObjectIdentifier, parameter UserParameter, value string) error {
+	set := &UserSet{}
+	userParameters, sessionParameters := &UserParameters{}, &SessionParameters{}
+	var err error
+	switch {
+	case hasParameterField(userParameters, string(parameter)):
+		err = setParameterField(userParameters, string(parameter), value)
+		set.UserParameters = userParameters
+	case hasParameterField(sessionParameters, string(parameter)):
+		err = setParameterField(sessionParameters, string(parameter), value)
+		set.SessionParameters = sessionParameters
+	default:
+		return fmt.Errorf("unsupported user parameter %s", parameter)
+	}
+	if err != nil {
+		return err
+	}
+	return v.client.Users.Alter(ctx, user, &AlterUserOptions{Set: set})
+}
+
+func (v *parameters) UnsetUserParameter(ctx context.Context, user AccountObjectIdentifier, parameter UserParameter) error {
+	unset := &UserUnset{}
+	userParameters, sessionParameters := &UserParametersUnset{}, &SessionParametersUnset{}
+	switch {
+	case unsetParameterField(userParameters, string(parameter)):
+		unset.UserParameters = userParameters
+	case unsetParameterField(sessionParameters, string(parameter)):
+		unset.SessionParameters = sessionParameters
+	default:
+		return fmt.Errorf("unsupported user parameter %s", parameter)
+	}
+	return v.client.Users.Alter(ctx, user, &AlterUserOptions{Unset: unset})
+}
+
+// alterObjectParametersOptions is based on the ALTER <object> SET/UNSET syntax shared by all objects that have parameters.
+type alterObjectParametersOptions struct {
+	alter      bool                   `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	objectType ObjectType             `ddl:"keyword"`
+	name       ObjectIdentifier       `ddl:"identifier"`
+	Set        *ObjectParameters      `ddl:"list,no_parentheses,no_comma" sql:"SET"`
+	Unset      *ObjectParametersUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *alterObjectParametersOptions) validate() error {
+	switch opts.objectType {
+	case ObjectTypeWarehouse, ObjectTypeDatabase, ObjectTypeSchema, ObjectTypeTask, ObjectTypeTable:
+	default:
+		return fmt.Errorf("unsupported object type %s", opts.objectType)
+	}
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		return fmt.Errorf("exactly one of Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		return opts.Set.validate()
+	}
+	return nil
+}
+
+func (v *parameters) alterObjectParameters(ctx context.Context, opts *alterObjectParametersOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *parameters) SetObjectParameter(ctx context.Context, objectType ObjectType, objectID ObjectIdentifier, parameter ObjectParameter, value string) error {
+	objectParameters := &ObjectParameters{}
+	if err := setParameterField(objectParameters, string(parameter), value); err != nil {
+		return err
+	}
+	return v.alterObjectParameters(ctx, &alterObjectParametersOptions{
+		objectType: objectType,
+		name:       objectID,
+		Set:        objectParameters,
+	})
+}
+
+func (v *parameters) UnsetObjectParameter(ctx context.Context, objectType ObjectType, objectID ObjectIdentifier, parameter ObjectParameter) error {
+	objectParameters := &ObjectParametersUnset{}
+	if !unsetParameterField(objectParameters, string(parameter)) {
+		return fmt.Errorf("unsupported object parameter %s", parameter)
+	}
+	return v.alterObjectParameters(ctx, &alterObjectParametersOptions{
+		objectType: objectType,
+		name:       objectID,
+		Unset:      objectParameters,
+	})
+}
+
+func (v *parameters) ShowObjectParameters(ctx context.Context, objectType ObjectType, objectID ObjectIdentifier) ([]*Parameter, error) {
+	in, err := parametersInObject(objectType, objectID)
+	if err != nil {
+		return nil, err
+	}
+	return v.client.Sessions.ShowParameters(ctx, &ShowParametersOptions{In: in})
+}
+
+// parameterField returns the field of the typed parameters struct target whose sql tag is key.
+func parameterField(target any, key string) (reflect.Value, bool) {
+	value := reflect.ValueOf(target).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if value.Type().Field(i).Tag.Get("sql") == key {
+			return value.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func hasParameterField(target any, key string) bool {
+	_, ok := parameterField(target, key)
+	return ok
+}
+
+// setParameterField parses value into the pointer field of target tagged with key.
+func setParameterField(target any, key string, value string) error {
+	field, ok := parameterField(target, key)
+	if !ok {
+		return fmt.Errorf("unsupported parameter %s", key)
+	}
+	parsed := reflect.New(field.Type().Elem())
+	switch parsed.Elem().Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%s must be a boolean, got %q", key, value)
+		}
+		parsed.Elem().SetBool(b)
+	case reflect.Int:
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer, got %q", key, value)
+		}
+		parsed.Elem().SetInt(int64(i))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%s must be a number, got %q", key, value)
+		}
+		parsed.Elem().SetFloat(f)
+	case reflect.String:
+		parsed.Elem().SetString(value)
+	default:
+		return fmt.Errorf("unsupported type %s of parameter %s", parsed.Elem().Kind(), key)
+	}
+	field.Set(parsed)
+	return nil
+}
+
+// unsetParameterField marks the field of the unset struct target tagged with key. It reports
+// whether such a field exists.
+func unsetParameterField(target any, key string) bool {
+	field, ok := parameterField(target, key)
+	if !ok {
+		return false
+	}
+	field.Set(reflect.ValueOf(Bool(true)))
+	return true
+}
 
 type AccountParameter string
 
@@ -227,7 +508,7 @@ type AccountParametersUnset struct {
 	ExternalOAuthAddPrivilegedRolesToBlockedList *bool `ddl:"keyword" sql:"EXTERNAL_OAUTH_ADD_PRIVILEGED_ROLES_TO_BLOCKED_LIST"`
 	InitialReplicationSizeLimitInTB              *bool `ddl:"keyword" sql:"INITIAL_REPLICATION_SIZE_LIMIT_IN_TB"`
 	MinDataRetentionTimeInDays                   *bool `ddl:"keyword" sql:"MIN_DATA_RETENTION_TIME_IN_DAYS"`
-	NetworkPolicy                                *bool `ddl:"keyword,single_quotes" sql:"NETWORK_POLICY"`
+	NetworkPolicy                                *bool `ddl:"keyword" sql:"NETWORK_POLICY"`
 	PeriodicDataRekeying                         *bool `ddl:"keyword" sql:"PERIODIC_DATA_REKEYING"`
 	PreventUnloadToInlineURL                     *bool `ddl:"keyword" sql:"PREVENT_UNLOAD_TO_INLINE_URL"`
 	PreventUnloadToInternalStages                *bool `ddl:"keyword" sql:"PREVENT_UNLOAD_TO_INTERNAL_STAGES"`
@@ -466,7 +747,7 @@ type ObjectParametersUnset struct {
 	PipeExecutionPaused                 *bool `ddl:"keyword" sql:"PIPE_EXECUTION_PAUSED"`
 	PreventUnloadToInternalStages       *bool `ddl:"keyword" sql:"PREVENT_UNLOAD_TO_INTERNAL_STAGES"`
 	StatementQueuedTimeoutInSeconds     *bool `ddl:"keyword" sql:"STATEMENT_QUEUED_TIMEOUT_IN_SECONDS"`
-	NetworkPolicy                       *bool `ddl:"keyword,single_quotes" sql:"NETWORK_POLICY"`
+	NetworkPolicy                       *bool `ddl:"keyword" sql:"NETWORK_POLICY"`
 	ShareRestrictions                   *bool `ddl:"keyword" sql:"SHARE_RESTRICTIONS"`
 	SuspendTaskAfterNumFailures         *bool `ddl:"keyword" sql:"SUSPEND_TASK_AFTER_NUM_FAILURES"`
 	TraceLevel                          *bool `ddl:"keyword" sql:"TRACE_LEVEL"`
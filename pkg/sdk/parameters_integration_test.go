@@ -0,0 +1,119 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_AccountParameters(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("set and unset", func(t *testing.T) {
+		err := client.Parameters.SetAccountParameter(ctx, AccountParameterMinDataRetentionTimeInDays, "7")
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Parameters.UnsetAccountParameter(ctx, AccountParameterMinDataRetentionTimeInDays)
+			require.NoError(t, err)
+		})
+
+		parameter, err := client.Parameters.ShowAccountParameter(ctx, AccountParameterMinDataRetentionTimeInDays)
+		require.NoError(t, err)
+		assert.Equal(t, ParameterTypeAccount, parameter.Level)
+		value, err := parameter.IntValue()
+		require.NoError(t, err)
+		assert.Equal(t, 7, value)
+
+		err = client.Parameters.UnsetAccountParameter(ctx, AccountParameterMinDataRetentionTimeInDays)
+		require.NoError(t, err)
+		parameter, err = client.Parameters.ShowAccountParameter(ctx, AccountParameterMinDataRetentionTimeInDays)
+		require.NoError(t, err)
+		assert.True(t, parameter.IsDefault())
+	})
+
+	t.Run("set invalid value", func(t *testing.T) {
+		err := client.Parameters.SetAccountParameter(ctx, AccountParameterMinDataRetentionTimeInDays, "seven")
+		assert.Error(t, err)
+	})
+
+	t.Run("show", func(t *testing.T) {
+		parameters, err := client.Parameters.ShowAccountParameters(ctx)
+		require.NoError(t, err)
+		assert.NotEmpty(t, parameters)
+	})
+}
+
+func TestInt_SessionParameters(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	err := client.Parameters.SetSessionParameter(ctx, SessionParameterWeekStart, "1")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Parameters.UnsetSessionParameter(ctx, SessionParameterWeekStart)
+		require.NoError(t, err)
+	})
+
+	parameter, err := client.Sessions.ShowSessionParameter(ctx, SessionParameterWeekStart)
+	require.NoError(t, err)
+	assert.Equal(t, "1", parameter.Value)
+	assert.Equal(t, ParameterTypeSession, parameter.Level)
+}
+
+func TestInt_UserParameters(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.Users.Create(ctx, id, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Users.Drop(ctx, id, &DropUserOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	err = client.Parameters.SetUserParameter(ctx, id, UserParameterEnableUnredactedQuerySyntaxError, "true")
+	require.NoError(t, err)
+	err = client.Parameters.SetUserParameter(ctx, id, UserParameterTimezone, "Europe/Warsaw")
+	require.NoError(t, err)
+
+	parameter, err := client.Sessions.ShowUserParameter(ctx, UserParameterTimezone, id)
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Warsaw", parameter.Value)
+	assert.Equal(t, ParameterTypeUser, parameter.Level)
+
+	err = client.Parameters.UnsetUserParameter(ctx, id, UserParameterTimezone)
+	require.NoError(t, err)
+	parameter, err = client.Sessions.ShowUserParameter(ctx, UserParameterTimezone, id)
+	require.NoError(t, err)
+	assert.NotEqual(t, ParameterTypeUser, parameter.Level)
+}
+
+func TestInt_ObjectParameters(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+
+	err := client.Parameters.SetObjectParameter(ctx, ObjectTypeDatabase, database.ID(), ObjectParameterDataRetentionTimeInDays, "5")
+	require.NoError(t, err)
+
+	parameter, err := client.Sessions.ShowObjectParameter(ctx, ObjectParameterDataRetentionTimeInDays, ObjectTypeDatabase, database.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "5", parameter.Value)
+	assert.Equal(t, ParameterTypeObject, parameter.Level)
+
+	parameters, err := client.Parameters.ShowObjectParameters(ctx, ObjectTypeDatabase, database.ID())
+	require.NoError(t, err)
+	assert.NotEmpty(t, parameters)
+
+	err = client.Parameters.UnsetObjectParameter(ctx, ObjectTypeDatabase, database.ID(), ObjectParameterDataRetentionTimeInDays)
+	require.NoError(t, err)
+	parameter, err = client.Sessions.ShowObjectParameter(ctx, ObjectParameterDataRetentionTimeInDays, ObjectTypeDatabase, database.ID())
+	require.NoError(t, err)
+	assert.NotEqual(t, ParameterTypeObject, parameter.Level)
+}
@@ -0,0 +1,152 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetParameterField(t *testing.T) {
+	t.Run("typed values", func(t *testing.T) {
+		accountParameters := &AccountParameters{}
+		require.NoError(t, setParameterField(accountParameters, string(AccountParameterMinDataRetentionTimeInDays), "7"))
+		require.NoError(t, setParameterField(accountParameters, string(AccountParameterAllowIDToken), "true"))
+		require.NoError(t, setParameterField(accountParameters, string(AccountParameterInitialReplicationSizeLimitInTB), "10.5"))
+		require.NoError(t, setParameterField(accountParameters, string(AccountParameterNetworkPolicy), "my_policy"))
+		assert.Equal(t, Int(7), accountParameters.MinDataRetentionTimeInDays)
+		assert.Equal(t, Bool(true), accountParameters.AllowIDToken)
+		assert.Equal(t, Pointer(10.5), accountParameters.InitialReplicationSizeLimitInTB)
+		assert.Equal(t, String("my_policy"), accountParameters.NetworkPolicy)
+
+		objectParameters := &ObjectParameters{}
+		require.NoError(t, setParameterField(objectParameters, string(AccountParameterLogLevel), "DEBUG"))
+		assert.Equal(t, Pointer(LogLevelDebug), objectParameters.LogLevel)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		err := setParameterField(&AccountParameters{}, string(AccountParameterMinDataRetentionTimeInDays), "seven")
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown parameter", func(t *testing.T) {
+		err := setParameterField(&AccountParameters{}, "NOT_A_PARAMETER", "1")
+		assert.Error(t, err)
+	})
+}
+
+func TestAccountParameterStatements(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		sessionParameters := &SessionParameters{}
+		require.NoError(t, setParameterField(sessionParameters, string(AccountParameterTimezone), "Europe/Warsaw"))
+		opts := &AlterAccountOptions{Set: &AccountSet{Parameters: &AccountLevelParameters{SessionParameters: sessionParameters}}}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ACCOUNT SET TIMEZONE = 'Europe/Warsaw'`, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		accountParameters := &AccountParametersUnset{}
+		require.True(t, unsetParameterField(accountParameters, string(AccountParameterNetworkPolicy)))
+		opts := &AlterAccountOptions{Unset: &AccountUnset{Parameters: &AccountLevelParametersUnset{AccountParameters: accountParameters}}}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ACCOUNT UNSET NETWORK_POLICY`, actual)
+	})
+
+	t.Run("unset unknown parameter", func(t *testing.T) {
+		assert.False(t, unsetParameterField(&AccountParametersUnset{}, "NOT_A_PARAMETER"))
+	})
+}
+
+func TestParameterTypedValues(t *testing.T) {
+	parameter := &Parameter{Key: "MIN_DATA_RETENTION_TIME_IN_DAYS", Value: "7", Default: "0", Level: ParameterTypeAccount, Type: ParameterValueTypeNumber}
+	assert.False(t, parameter.IsDefault())
+	value, err := parameter.IntValue()
+	require.NoError(t, err)
+	assert.Equal(t, 7, value)
+
+	parameter = &Parameter{Key: "ALLOW_ID_TOKEN", Value: "false", Default: "false", Type: ParameterValueTypeBoolean}
+	assert.True(t, parameter.IsDefault())
+	b, err := parameter.BoolValue()
+	require.NoError(t, err)
+	assert.False(t, b)
+}
+
+func TestAlterObjectParameters(t *testing.T) {
+	t.Run("set on warehouse", func(t *testing.T) {
+		objectParameters := &ObjectParameters{}
+		require.NoError(t, setParameterField(objectParameters, string(ObjectParameterMaxConcurrencyLevel), "4"))
+		opts := &alterObjectParametersOptions{
+			objectType: ObjectTypeWarehouse,
+			name:       NewAccountObjectIdentifier("wh"),
+			Set:        objectParameters,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER WAREHOUSE "wh" SET MAX_CONCURRENCY_LEVEL = 4`, actual)
+	})
+
+	t.Run("unset on table", func(t *testing.T) {
+		objectParameters := &ObjectParametersUnset{}
+		require.True(t, unsetParameterField(objectParameters, string(ObjectParameterDataRetentionTimeInDays)))
+		opts := &alterObjectParametersOptions{
+			objectType: ObjectTypeTable,
+			name:       NewSchemaObjectIdentifier("db", "schema", "table"),
+			Unset:      objectParameters,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" UNSET DATA_RETENTION_TIME_IN_DAYS`, actual)
+	})
+
+	t.Run("validation: invalid value", func(t *testing.T) {
+		objectParameters := &ObjectParameters{}
+		require.NoError(t, setParameterField(objectParameters, string(ObjectParameterDataRetentionTimeInDays), "365"))
+		opts := &alterObjectParametersOptions{
+			objectType: ObjectTypeDatabase,
+			name:       NewAccountObjectIdentifier("db"),
+			Set:        objectParameters,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: unsupported object type", func(t *testing.T) {
+		opts := &alterObjectParametersOptions{
+			objectType: ObjectTypeRole,
+			name:       NewAccountObjectIdentifier("role"),
+			Set:        &ObjectParameters{MaxConcurrencyLevel: Int(4)},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestShowParametersIn(t *testing.T) {
+	t.Run("in object", func(t *testing.T) {
+		in, err := parametersInObject(ObjectTypeSchema, NewSchemaIdentifier("db", "schema"))
+		require.NoError(t, err)
+		actual, err := structToSQL(&ShowParametersOptions{Like: &Like{Pattern: String("LOG_LEVEL")}, In: in})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW PARAMETERS LIKE 'LOG_LEVEL' IN SCHEMA "db"."schema"`, actual)
+	})
+
+	t.Run("in session", func(t *testing.T) {
+		actual, err := structToSQL(&ShowParametersOptions{In: &ParametersIn{Session: Bool(true)}})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW PARAMETERS IN SESSION`, actual)
+	})
+
+	t.Run("unsupported object type", func(t *testing.T) {
+		_, err := parametersInObject(ObjectTypeRole, NewAccountObjectIdentifier("role"))
+		assert.Error(t, err)
+	})
+
+	t.Run("validation: more than one scope", func(t *testing.T) {
+		opts := &ShowParametersOptions{In: &ParametersIn{Session: Bool(true), Account: Bool(true)}}
+		assert.Error(t, opts.validate())
+	})
+}
@@ -68,7 +68,7 @@ func (v *passwordPolicies) Create(ctx context.Context, id SchemaObjectIdentifier
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -196,7 +196,7 @@ func (v *passwordPolicies) Alter(ctx context.Context, id SchemaObjectIdentifier,
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -226,7 +226,7 @@ func (v *passwordPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier,
 	if err := opts.validate(); err != nil {
 		return fmt.Errorf("validate drop options: %w", err)
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -236,11 +236,11 @@ func (v *passwordPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier,
 
 // PasswordPolicyShowOptions represents the options for listing password policies.
 type PasswordPolicyShowOptions struct {
-	show             bool  `ddl:"static" sql:"SHOW"`              //lint:ignore U1000 This is used in the ddl tag
-	passwordPolicies bool  `ddl:"static" sql:"PASSWORD POLICIES"` //lint:ignore U1000 This is used in the ddl tag
-	Like             *Like `ddl:"keyword" sql:"LIKE"`
-	In               *In   `ddl:"keyword" sql:"IN"`
-	Limit            *int  `ddl:"parameter,no_equals" sql:"LIMIT"`
+	show             bool       `ddl:"static" sql:"SHOW"`              //lint:ignore U1000 This is used in the ddl tag
+	passwordPolicies bool       `ddl:"static" sql:"PASSWORD POLICIES"` //lint:ignore U1000 This is used in the ddl tag
+	Like             *Like      `ddl:"keyword" sql:"LIKE"`
+	In               *In        `ddl:"keyword" sql:"IN"`
+	Limit            *LimitFrom `ddl:"keyword" sql:"LIMIT"`
 }
 
 func (input *PasswordPolicyShowOptions) validate() error {
@@ -299,7 +299,7 @@ func (v *passwordPolicies) Show(ctx context.Context, opts *PasswordPolicyShowOpt
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -319,9 +319,7 @@ func (v *passwordPolicies) Show(ctx context.Context, opts *PasswordPolicyShowOpt
 
 func (v *passwordPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*PasswordPolicy, error) {
 	passwordPolicies, err := v.Show(ctx, &PasswordPolicyShowOptions{
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+		Like: likeExactly(id.Name()),
 		In: &In{
 			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
 		},
@@ -366,56 +364,30 @@ type PasswordPolicyDetails struct {
 	PasswordLockoutTimeMins   *IntProperty
 }
 
-func passwordPolicyDetailsFromRows(rows []propertyRow) *PasswordPolicyDetails {
-	v := &PasswordPolicyDetails{}
-	for _, row := range rows {
-		switch row.Property {
-		case "NAME":
-			v.Name = row.toStringProperty()
-		case "OWNER":
-			v.Owner = row.toStringProperty()
-		case "COMMENT":
-			v.Comment = row.toStringProperty()
-		case "PASSWORD_MIN_LENGTH":
-			v.PasswordMinLength = row.toIntProperty()
-		case "PASSWORD_MAX_LENGTH":
-			v.PasswordMaxLength = row.toIntProperty()
-		case "PASSWORD_MIN_UPPER_CASE_CHARS":
-			v.PasswordMinUpperCaseChars = row.toIntProperty()
-		case "PASSWORD_MIN_LOWER_CASE_CHARS":
-			v.PasswordMinLowerCaseChars = row.toIntProperty()
-		case "PASSWORD_MIN_NUMERIC_CHARS":
-			v.PasswordMinNumericChars = row.toIntProperty()
-		case "PASSWORD_MIN_SPECIAL_CHARS":
-			v.PasswordMinSpecialChars = row.toIntProperty()
-		case "PASSWORD_MAX_AGE_DAYS":
-			v.PasswordMaxAgeDays = row.toIntProperty()
-		case "PASSWORD_MAX_RETRIES":
-			v.PasswordMaxRetries = row.toIntProperty()
-		case "PASSWORD_LOCKOUT_TIME_MINS":
-			v.PasswordLockoutTimeMins = row.toIntProperty()
-		}
+func passwordPolicyDetailsFromProperties(p Properties) *PasswordPolicyDetails {
+	return &PasswordPolicyDetails{
+		Name:                      p.String("NAME"),
+		Owner:                     p.String("OWNER"),
+		Comment:                   p.String("COMMENT"),
+		PasswordMinLength:         p.Int("PASSWORD_MIN_LENGTH"),
+		PasswordMaxLength:         p.Int("PASSWORD_MAX_LENGTH"),
+		PasswordMinUpperCaseChars: p.Int("PASSWORD_MIN_UPPER_CASE_CHARS"),
+		PasswordMinLowerCaseChars: p.Int("PASSWORD_MIN_LOWER_CASE_CHARS"),
+		PasswordMinNumericChars:   p.Int("PASSWORD_MIN_NUMERIC_CHARS"),
+		PasswordMinSpecialChars:   p.Int("PASSWORD_MIN_SPECIAL_CHARS"),
+		PasswordMaxAgeDays:        p.Int("PASSWORD_MAX_AGE_DAYS"),
+		PasswordMaxRetries:        p.Int("PASSWORD_MAX_RETRIES"),
+		PasswordLockoutTimeMins:   p.Int("PASSWORD_LOCKOUT_TIME_MINS"),
 	}
-	return v
 }
 
 func (v *passwordPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*PasswordPolicyDetails, error) {
 	opts := &describePasswordPolicyOptions{
 		name: id,
 	}
-	if err := opts.validate(); err != nil {
-		return nil, err
-	}
-
-	sql, err := structToSQL(opts)
+	properties, err := describeProperties(ctx, v.client, opts)
 	if err != nil {
 		return nil, err
 	}
-	dest := []propertyRow{}
-	err = v.client.query(ctx, &dest, sql)
-	if err != nil {
-		return nil, err
-	}
-
-	return passwordPolicyDetailsFromRows(dest), nil
+	return passwordPolicyDetailsFromProperties(properties), nil
 }
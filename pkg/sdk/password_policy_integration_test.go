@@ -74,7 +74,7 @@ func TestInt_PasswordPoliciesShow(t *testing.T) {
 			In: &In{
 				Schema: String(schemaTest.FullyQualifiedName()),
 			},
-			Limit: Int(1),
+			Limit: &LimitFrom{Rows: Int(1)},
 		}
 		passwordPolicies, err := client.PasswordPolicies.Show(ctx, showOptions)
 		require.NoError(t, err)
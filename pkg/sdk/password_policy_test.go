@@ -220,7 +220,7 @@ func TestPasswordPolicyShow(t *testing.T) {
 
 	t.Run("with limit", func(t *testing.T) {
 		opts := &PasswordPolicyShowOptions{
-			Limit: Int(10),
+			Limit: &LimitFrom{Rows: Int(10)},
 		}
 		actual, err := structToSQL(opts)
 		require.NoError(t, err)
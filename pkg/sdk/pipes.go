@@ -0,0 +1,132 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Pipes = (*pipes)(nil)
+
+// Pipes describes all the pipe related methods that the Snowflake API supports.
+type Pipes interface {
+	// Alter modifies an existing pipe.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterPipeOptions) error
+	// Status returns the result of SYSTEM$PIPE_STATUS for a pipe.
+	Status(ctx context.Context, id SchemaObjectIdentifier) (*PipeStatus, error)
+}
+
+// pipes implements Pipes.
+type pipes struct {
+	client *Client
+}
+
+// AlterPipeOptions contains options for altering a pipe. Exactly one of Set or Refresh can be set.
+type AlterPipeOptions struct {
+	alter    bool                   `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	pipe     bool                   `ddl:"static" sql:"PIPE"`  //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+	Set      *PipeSet               `ddl:"keyword" sql:"SET"`
+	Refresh  *PipeRefresh           `ddl:"keyword" sql:"REFRESH"`
+}
+
+type PipeSet struct {
+	PipeExecutionPaused *bool   `ddl:"parameter" sql:"PIPE_EXECUTION_PAUSED"`
+	Comment             *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// PipeRefresh queues the files staged in the last 7 days for loading. Prefix limits the files to a path
+// and ModifiedAfter to files modified after the given time.
+type PipeRefresh struct {
+	Prefix        *string `ddl:"parameter,single_quotes" sql:"PREFIX"`
+	ModifiedAfter *time.Time
+	modifiedAfter *string `ddl:"parameter,single_quotes" sql:"MODIFIED_AFTER"`
+}
+
+func (opts *AlterPipeOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Refresh) {
+		return errors.New("exactly one of SET or REFRESH must be set")
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.PipeExecutionPaused, opts.Set.Comment) {
+		return errors.New("at least one of PIPE_EXECUTION_PAUSED or COMMENT must be set")
+	}
+	return nil
+}
+
+func (v *pipes) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterPipeOptions) error {
+	if opts == nil {
+		opts = &AlterPipeOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	if valueSet(opts.Refresh) && opts.Refresh.ModifiedAfter != nil {
+		// MODIFIED_AFTER expects an ISO-8601 timestamp.
+		opts.Refresh.modifiedAfter = String(opts.Refresh.ModifiedAfter.Format(time.RFC3339))
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type PipeExecutionState string
+
+const (
+	PipeExecutionStateRunning                  PipeExecutionState = "RUNNING"
+	PipeExecutionStatePaused                   PipeExecutionState = "PAUSED"
+	PipeExecutionStateStoppedStageDropped      PipeExecutionState = "STOPPED_STAGE_DROPPED"
+	PipeExecutionStateStoppedFileFormatDropped PipeExecutionState = "STOPPED_FILE_FORMAT_DROPPED"
+	PipeExecutionStateStoppedMissingTable      PipeExecutionState = "STOPPED_MISSING_TABLE"
+	PipeExecutionStateStalledCompilationError  PipeExecutionState = "STALLED_COMPILATION_ERROR"
+	PipeExecutionStateStalledExecutionError    PipeExecutionState = "STALLED_EXECUTION_ERROR"
+)
+
+// PipeStatus is the JSON object returned by SYSTEM$PIPE_STATUS. Fields that do not apply to a pipe are omitted by Snowflake.
+type PipeStatus struct {
+	ExecutionState                  PipeExecutionState `json:"executionState"`
+	PendingFileCount                int                `json:"pendingFileCount"`
+	LastIngestedTimestamp           *time.Time         `json:"lastIngestedTimestamp,omitempty"`
+	LastIngestedFilePath            string             `json:"lastIngestedFilePath,omitempty"`
+	NotificationChannelName         string             `json:"notificationChannelName,omitempty"`
+	NumOutstandingMessagesOnChannel int                `json:"numOutstandingMessagesOnChannel,omitempty"`
+	LastReceivedMessageTimestamp    *time.Time         `json:"lastReceivedMessageTimestamp,omitempty"`
+	LastForwardedMessageTimestamp   *time.Time         `json:"lastForwardedMessageTimestamp,omitempty"`
+	LastPulledFromChannelTimestamp  *time.Time         `json:"lastPulledFromChannelTimestamp,omitempty"`
+	LastForwardedFilePath           string             `json:"lastForwardedFilePath,omitempty"`
+	Error                           string             `json:"error,omitempty"`
+	Fault                           string             `json:"fault,omitempty"`
+}
+
+// parsePipeStatus parses the JSON object returned by SYSTEM$PIPE_STATUS.
+func parsePipeStatus(raw string) (*PipeStatus, error) {
+	status := &PipeStatus{}
+	if err := json.Unmarshal([]byte(raw), status); err != nil {
+		return nil, fmt.Errorf("parse pipe status: %w", err)
+	}
+	return status, nil
+}
+
+func (v *pipes) Status(ctx context.Context, id SchemaObjectIdentifier) (*PipeStatus, error) {
+	if !validObjectidentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	s := &struct {
+		Status string `db:"STATUS"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$PIPE_STATUS('%s') AS "STATUS"`, escapeStringLiteral(id.FullyQualifiedName()))
+	if err := v.client.queryOne(ctx, s, sql); err != nil {
+		return nil, err
+	}
+	return parsePipeStatus(s.Status)
+}
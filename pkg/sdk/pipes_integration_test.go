@@ -0,0 +1,65 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Pipes(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	stageID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	require.NoError(t, client.Stages.Create(ctx, stageID, nil))
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP STAGE IF EXISTS %s", stageID.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE PIPE %s AS COPY INTO %s FROM @%s", id.FullyQualifiedName(), tableID.FullyQualifiedName(), stageID.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP PIPE IF EXISTS %s", id.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		status, err := client.Pipes.Status(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, PipeExecutionStateRunning, status.ExecutionState)
+	})
+
+	t.Run("pause and resume", func(t *testing.T) {
+		err := client.Pipes.Alter(ctx, id, &AlterPipeOptions{Set: &PipeSet{PipeExecutionPaused: Bool(true)}})
+		require.NoError(t, err)
+		status, err := client.Pipes.Status(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, PipeExecutionStatePaused, status.ExecutionState)
+
+		err = client.Pipes.Alter(ctx, id, &AlterPipeOptions{Set: &PipeSet{PipeExecutionPaused: Bool(false)}})
+		require.NoError(t, err)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		err := client.Pipes.Alter(ctx, id, &AlterPipeOptions{
+			Refresh: &PipeRefresh{
+				Prefix:        String("d1/"),
+				ModifiedAfter: Pointer(time.Now().Add(-time.Hour)),
+			},
+		})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "pipe")
+
+	t.Run("set execution paused", func(t *testing.T) {
+		opts := &AlterPipeOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set:      &PipeSet{PipeExecutionPaused: Bool(true), Comment: String("paused")},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER PIPE IF EXISTS "db"."schema"."pipe" SET PIPE_EXECUTION_PAUSED = true COMMENT = 'paused'`, actual)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := &AlterPipeOptions{
+			name:    id,
+			Refresh: &PipeRefresh{},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER PIPE "db"."schema"."pipe" REFRESH`, actual)
+	})
+
+	t.Run("refresh with prefix and modified after", func(t *testing.T) {
+		opts := &AlterPipeOptions{
+			name: id,
+			Refresh: &PipeRefresh{
+				Prefix:        String("d1/"),
+				modifiedAfter: String(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC).Format(time.RFC3339)),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER PIPE "db"."schema"."pipe" REFRESH PREFIX = 'd1/' MODIFIED_AFTER = '2023-07-01T12:00:00Z'`, actual)
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterPipeOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: set and refresh", func(t *testing.T) {
+		opts := &AlterPipeOptions{
+			name:    id,
+			Set:     &PipeSet{PipeExecutionPaused: Bool(false)},
+			Refresh: &PipeRefresh{},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterPipeOptions{name: id, Set: &PipeSet{}}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestParsePipeStatus(t *testing.T) {
+	t.Run("running pipe", func(t *testing.T) {
+		raw := `{"executionState":"RUNNING","pendingFileCount":2,"lastIngestedTimestamp":"2023-07-01T12:00:00.123Z","lastIngestedFilePath":"d1/file.csv","notificationChannelName":"arn:aws:sqs:us-west-2:1234:sf-snowpipe","numOutstandingMessagesOnChannel":1}`
+		status, err := parsePipeStatus(raw)
+		require.NoError(t, err)
+		assert.Equal(t, PipeExecutionStateRunning, status.ExecutionState)
+		assert.Equal(t, 2, status.PendingFileCount)
+		require.NotNil(t, status.LastIngestedTimestamp)
+		assert.Equal(t, time.Date(2023, 7, 1, 12, 0, 0, 123000000, time.UTC), status.LastIngestedTimestamp.UTC())
+		assert.Equal(t, "d1/file.csv", status.LastIngestedFilePath)
+		assert.Equal(t, "arn:aws:sqs:us-west-2:1234:sf-snowpipe", status.NotificationChannelName)
+		assert.Equal(t, 1, status.NumOutstandingMessagesOnChannel)
+		assert.Nil(t, status.LastForwardedMessageTimestamp)
+	})
+
+	t.Run("stalled pipe", func(t *testing.T) {
+		status, err := parsePipeStatus(`{"executionState":"STALLED_EXECUTION_ERROR","pendingFileCount":0,"error":"table dropped","fault":"user"}`)
+		require.NoError(t, err)
+		assert.Equal(t, PipeExecutionStateStalledExecutionError, status.ExecutionState)
+		assert.Equal(t, "table dropped", status.Error)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := parsePipeStatus(`not json`)
+		assert.Error(t, err)
+	})
+}
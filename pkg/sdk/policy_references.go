@@ -0,0 +1,169 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Compile-time proof of interface implementation.
+var _ PolicyReferences = (*policyReferences)(nil)
+
+// PolicyReferences reads the INFORMATION_SCHEMA.POLICY_REFERENCES table function, which lists the attachments
+// of masking, row access, session, password, aggregation and projection policies.
+type PolicyReferences interface {
+	// ShowByPolicy returns the objects a policy is attached to.
+	ShowByPolicy(ctx context.Context, policy SchemaObjectIdentifier) ([]*PolicyReference, error)
+	// ShowByEntity returns the policies attached to an object, e.g. a table, a view, a user or the account.
+	ShowByEntity(ctx context.Context, entity ObjectIdentifier, domain PolicyEntityDomain) ([]*PolicyReference, error)
+}
+
+// policyReferences implements PolicyReferences.
+type policyReferences struct {
+	client *Client
+}
+
+type PolicyKind string
+
+const (
+	PolicyKindMaskingPolicy     PolicyKind = "MASKING_POLICY"
+	PolicyKindRowAccessPolicy   PolicyKind = "ROW_ACCESS_POLICY"
+	PolicyKindSessionPolicy     PolicyKind = "SESSION_POLICY"
+	PolicyKindPasswordPolicy    PolicyKind = "PASSWORD_POLICY"
+	PolicyKindAggregationPolicy PolicyKind = "AGGREGATION_POLICY"
+	PolicyKindProjectionPolicy  PolicyKind = "PROJECTION_POLICY"
+)
+
+// PolicyEntityDomain is the type of the object a policy is attached to.
+type PolicyEntityDomain string
+
+const (
+	PolicyEntityDomainAccount     PolicyEntityDomain = "ACCOUNT"
+	PolicyEntityDomainIntegration PolicyEntityDomain = "INTEGRATION"
+	PolicyEntityDomainTable       PolicyEntityDomain = "TABLE"
+	PolicyEntityDomainTag         PolicyEntityDomain = "TAG"
+	PolicyEntityDomainUser        PolicyEntityDomain = "USER"
+	PolicyEntityDomainView        PolicyEntityDomain = "VIEW"
+)
+
+type PolicyReference struct {
+	Policy          SchemaObjectIdentifier
+	PolicyKind      PolicyKind
+	RefDatabaseName string
+	RefSchemaName   string
+	RefEntityName   string
+	RefEntityDomain PolicyEntityDomain
+	RefColumnName   string
+	// RefArgColumnNames are the additional columns passed to conditional masking and row access policies.
+	RefArgColumnNames []string
+	// Tag is set when the policy is attached through a tag.
+	Tag          *SchemaObjectIdentifier
+	PolicyStatus string
+}
+
+type policyReferenceRow struct {
+	PolicyDB          string         `db:"POLICY_DB"`
+	PolicySchema      string         `db:"POLICY_SCHEMA"`
+	PolicyName        string         `db:"POLICY_NAME"`
+	PolicyKind        string         `db:"POLICY_KIND"`
+	RefDatabaseName   sql.NullString `db:"REF_DATABASE_NAME"`
+	RefSchemaName     sql.NullString `db:"REF_SCHEMA_NAME"`
+	RefEntityName     string         `db:"REF_ENTITY_NAME"`
+	RefEntityDomain   string         `db:"REF_ENTITY_DOMAIN"`
+	RefColumnName     sql.NullString `db:"REF_COLUMN_NAME"`
+	RefArgColumnNames sql.NullString `db:"REF_ARG_COLUMN_NAMES"`
+	TagDatabase       sql.NullString `db:"TAG_DATABASE"`
+	TagSchema         sql.NullString `db:"TAG_SCHEMA"`
+	TagName           sql.NullString `db:"TAG_NAME"`
+	PolicyStatus      sql.NullString `db:"POLICY_STATUS"`
+}
+
+// parseRefArgColumnNames reads the column names from REF_ARG_COLUMN_NAMES, e.g. [ "COUNTRY", "REGION" ].
+func parseRefArgColumnNames(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	var columns []string
+	if err := json.Unmarshal([]byte(raw), &columns); err != nil {
+		return nil
+	}
+	return columns
+}
+
+func (row policyReferenceRow) toPolicyReference() *PolicyReference {
+	reference := &PolicyReference{
+		Policy:            NewSchemaObjectIdentifier(row.PolicyDB, row.PolicySchema, row.PolicyName),
+		PolicyKind:        PolicyKind(row.PolicyKind),
+		RefDatabaseName:   row.RefDatabaseName.String,
+		RefSchemaName:     row.RefSchemaName.String,
+		RefEntityName:     row.RefEntityName,
+		RefEntityDomain:   PolicyEntityDomain(row.RefEntityDomain),
+		RefColumnName:     row.RefColumnName.String,
+		RefArgColumnNames: parseRefArgColumnNames(row.RefArgColumnNames.String),
+		PolicyStatus:      row.PolicyStatus.String,
+	}
+	if row.TagName.Valid {
+		tag := NewSchemaObjectIdentifier(row.TagDatabase.String, row.TagSchema.String, row.TagName.String)
+		reference.Tag = &tag
+	}
+	return reference
+}
+
+// policyReferencesSQL builds the query for the policy references. Without a database the table function
+// is called from the information schema of the current database.
+func policyReferencesSQL(database AccountObjectIdentifier, args string) string {
+	function := "INFORMATION_SCHEMA.POLICY_REFERENCES"
+	if validObjectidentifier(database) {
+		function = database.FullyQualifiedName() + "." + function
+	}
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s(%s))`, function, args)
+}
+
+func policyReferencesByPolicySQL(policy SchemaObjectIdentifier) string {
+	policyName := escapeStringLiteral(policy.FullyQualifiedName())
+	return policyReferencesSQL(NewAccountObjectIdentifier(policy.DatabaseName()), fmt.Sprintf("POLICY_NAME => '%s'", policyName))
+}
+
+func policyReferencesByEntitySQL(entity ObjectIdentifier, domain PolicyEntityDomain) string {
+	var database AccountObjectIdentifier
+	switch id := entity.(type) {
+	case SchemaObjectIdentifier:
+		database = NewAccountObjectIdentifier(id.DatabaseName())
+	case SchemaIdentifier:
+		database = NewAccountObjectIdentifier(id.DatabaseName())
+	}
+	refEntityName := escapeStringLiteral(entity.FullyQualifiedName())
+	return policyReferencesSQL(database, fmt.Sprintf("REF_ENTITY_NAME => '%s', REF_ENTITY_DOMAIN => '%s'", refEntityName, domain))
+}
+
+func (v *policyReferences) show(ctx context.Context, sql string) ([]*PolicyReference, error) {
+	dest := []policyReferenceRow{}
+	err := v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*PolicyReference, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toPolicyReference()
+	}
+	return resultList, nil
+}
+
+func (v *policyReferences) ShowByPolicy(ctx context.Context, policy SchemaObjectIdentifier) ([]*PolicyReference, error) {
+	if !validObjectidentifier(policy) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	return v.show(ctx, policyReferencesByPolicySQL(policy))
+}
+
+func (v *policyReferences) ShowByEntity(ctx context.Context, entity ObjectIdentifier, domain PolicyEntityDomain) ([]*PolicyReference, error) {
+	if entity == nil || !validObjectidentifier(entity) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	if domain == "" {
+		return nil, errors.New("domain must be set")
+	}
+	return v.show(ctx, policyReferencesByEntitySQL(entity, domain))
+}
@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_PolicyReferences(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	signature := []TableColumnSignature{{Name: "VAL", Type: DataTypeVARCHAR}}
+	maskingPolicy, maskingPolicyCleanup := createMaskingPolicyWithOptions(t, client, database, schema, signature, DataTypeVARCHAR, "'***'", nil)
+	t.Cleanup(maskingPolicyCleanup)
+
+	_, err := client.exec(ctx, fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN EMAIL SET MASKING POLICY %s", tableID.FullyQualifiedName(), maskingPolicy.ID().FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN EMAIL UNSET MASKING POLICY", tableID.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	t.Run("by policy", func(t *testing.T) {
+		references, err := client.PolicyReferences.ShowByPolicy(ctx, maskingPolicy.ID())
+		require.NoError(t, err)
+		require.Len(t, references, 1)
+		assert.Equal(t, PolicyKindMaskingPolicy, references[0].PolicyKind)
+		assert.Equal(t, tableID.Name(), references[0].RefEntityName)
+		assert.Equal(t, "EMAIL", references[0].RefColumnName)
+	})
+
+	t.Run("by entity", func(t *testing.T) {
+		references, err := client.PolicyReferences.ShowByEntity(ctx, tableID, PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Len(t, references, 1)
+		assert.Equal(t, maskingPolicy.ID(), references[0].Policy)
+	})
+}
@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyReferencesSQL(t *testing.T) {
+	t.Run("by policy", func(t *testing.T) {
+		actual := policyReferencesByPolicySQL(NewSchemaObjectIdentifier("db", "schema", "mask"))
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(POLICY_NAME => '"db"."schema"."mask"'))`, actual)
+	})
+
+	t.Run("by table", func(t *testing.T) {
+		actual := policyReferencesByEntitySQL(NewSchemaObjectIdentifier("db", "schema", "table"), PolicyEntityDomainTable)
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"db"."schema"."table"', REF_ENTITY_DOMAIN => 'TABLE'))`, actual)
+	})
+
+	t.Run("by user", func(t *testing.T) {
+		actual := policyReferencesByEntitySQL(NewAccountObjectIdentifier("user"), PolicyEntityDomainUser)
+		assert.Equal(t, `SELECT * FROM TABLE(INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => '"user"', REF_ENTITY_DOMAIN => 'USER'))`, actual)
+	})
+}
+
+func TestPolicyReferenceRow(t *testing.T) {
+	t.Run("column with conditional arguments", func(t *testing.T) {
+		row := policyReferenceRow{
+			PolicyDB:          "DB",
+			PolicySchema:      "SCHEMA",
+			PolicyName:        "MASK",
+			PolicyKind:        "MASKING_POLICY",
+			RefDatabaseName:   sql.NullString{String: "DB", Valid: true},
+			RefSchemaName:     sql.NullString{String: "SCHEMA", Valid: true},
+			RefEntityName:     "TABLE",
+			RefEntityDomain:   "TABLE",
+			RefColumnName:     sql.NullString{String: "EMAIL", Valid: true},
+			RefArgColumnNames: sql.NullString{String: `[ "COUNTRY", "REGION" ]`, Valid: true},
+			PolicyStatus:      sql.NullString{String: "ACTIVE", Valid: true},
+		}
+		reference := row.toPolicyReference()
+		assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "MASK"), reference.Policy)
+		assert.Equal(t, PolicyKindMaskingPolicy, reference.PolicyKind)
+		assert.Equal(t, PolicyEntityDomainTable, reference.RefEntityDomain)
+		assert.Equal(t, "EMAIL", reference.RefColumnName)
+		assert.Equal(t, []string{"COUNTRY", "REGION"}, reference.RefArgColumnNames)
+		assert.Nil(t, reference.Tag)
+	})
+
+	t.Run("attached through a tag", func(t *testing.T) {
+		row := policyReferenceRow{
+			PolicyDB:        "DB",
+			PolicySchema:    "SCHEMA",
+			PolicyName:      "MASK",
+			PolicyKind:      "MASKING_POLICY",
+			RefEntityName:   "TABLE",
+			RefEntityDomain: "TABLE",
+			TagDatabase:     sql.NullString{String: "DB", Valid: true},
+			TagSchema:       sql.NullString{String: "SCHEMA", Valid: true},
+			TagName:         sql.NullString{String: "PII", Valid: true},
+		}
+		reference := row.toPolicyReference()
+		assert.Equal(t, Pointer(NewSchemaObjectIdentifier("DB", "SCHEMA", "PII")), reference.Tag)
+		assert.Empty(t, reference.RefArgColumnNames)
+	})
+}
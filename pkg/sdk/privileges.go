@@ -6,6 +6,14 @@ const (
 	PrivilegeUsage          Privilege = "USAGE"
 	PrivilegeSelect         Privilege = "SELECT"
 	PrivilegeReferenceUsage Privilege = "REFERENCE_USAGE"
+	PrivilegeInsert         Privilege = "INSERT"
+	PrivilegeUpdate         Privilege = "UPDATE"
+	PrivilegeDelete         Privilege = "DELETE"
+	PrivilegeTruncate       Privilege = "TRUNCATE"
+	PrivilegeReferences     Privilege = "REFERENCES"
+	PrivilegeMonitor        Privilege = "MONITOR"
+	PrivilegeOperate        Privilege = "OPERATE"
+	PrivilegeOwnership      Privilege = "OWNERSHIP"
 )
 
 func (p Privilege) String() string {
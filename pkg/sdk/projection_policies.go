@@ -0,0 +1,386 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ ProjectionPolicies = (*projectionPolicies)(nil)
+
+// ProjectionPolicies control whether a column can be projected in the output of a query.
+type ProjectionPolicies interface {
+	// Create creates a new projection policy. The body must evaluate to PROJECTION_CONSTRAINT(ALLOW => ...).
+	Create(ctx context.Context, id SchemaObjectIdentifier, body string, opts *CreateProjectionPolicyOptions) error
+	// Alter modifies an existing projection policy.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterProjectionPolicyOptions) error
+	// Drop removes a projection policy.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropProjectionPolicyOptions) error
+	// Show returns a list of projection policies.
+	Show(ctx context.Context, opts *ShowProjectionPolicyOptions) ([]*ProjectionPolicy, error)
+	// ShowByID returns a projection policy by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error)
+	// Describe returns the details of a projection policy.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicyDetails, error)
+	// SetOnColumn attaches a projection policy to a column of a table or view.
+	SetOnColumn(ctx context.Context, id SchemaObjectIdentifier, column *PolicyColumn, opts *SetProjectionPolicyOnColumnOptions) error
+	// UnsetFromColumn detaches the projection policy from a column of a table or view.
+	UnsetFromColumn(ctx context.Context, column *PolicyColumn) error
+}
+
+// projectionPolicies implements ProjectionPolicies.
+type projectionPolicies struct {
+	client *Client
+}
+
+type CreateProjectionPolicyOptions struct {
+	create           bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace        *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	projectionPolicy bool                   `ddl:"static" sql:"PROJECTION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists      *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name             SchemaObjectIdentifier `ddl:"identifier"`
+	returns          bool                   `ddl:"static" sql:"AS () RETURNS PROJECTION_CONSTRAINT"` //lint:ignore U1000 This is used in the ddl tag
+
+	// required
+	body string `ddl:"parameter,no_equals" sql:"->"`
+
+	// optional
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateProjectionPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.body == "" {
+		return errors.New("body is required")
+	}
+	return nil
+}
+
+func (v *projectionPolicies) Create(ctx context.Context, id SchemaObjectIdentifier, body string, opts *CreateProjectionPolicyOptions) error {
+	if opts == nil {
+		opts = &CreateProjectionPolicyOptions{}
+	}
+	opts.name = id
+	opts.body = body
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterProjectionPolicyOptions struct {
+	alter            bool                   `ddl:"static" sql:"ALTER"`             //lint:ignore U1000 This is used in the ddl tag
+	projectionPolicy bool                   `ddl:"static" sql:"PROJECTION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists         *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name             SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	NewName  SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set      *ProjectionPolicySet   `ddl:"keyword" sql:"SET"`
+	Unset    *ProjectionPolicyUnset `ddl:"keyword" sql:"UNSET"`
+	SetTag   []TagAssociation       `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag []ObjectIdentifier     `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterProjectionPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of NewName, Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) && !exactlyOneValueSet(opts.Set.Body, opts.Set.Comment) {
+		return errors.New("exactly one of Body or Comment must be set")
+	}
+	if valueSet(opts.Unset) && !valueSet(opts.Unset.Comment) {
+		return errors.New("Comment must be unset")
+	}
+	return nil
+}
+
+// ProjectionPolicySet sets either the body or the comment, Snowflake does not accept both at once.
+type ProjectionPolicySet struct {
+	Body    *string `ddl:"parameter,no_equals" sql:"BODY ->"`
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ProjectionPolicyUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *projectionPolicies) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterProjectionPolicyOptions) error {
+	if opts == nil {
+		opts = &AlterProjectionPolicyOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropProjectionPolicyOptions struct {
+	drop             bool                   `ddl:"static" sql:"DROP"`              //lint:ignore U1000 This is used in the ddl tag
+	projectionPolicy bool                   `ddl:"static" sql:"PROJECTION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists         *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name             SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropProjectionPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *projectionPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropProjectionPolicyOptions) error {
+	if opts == nil {
+		opts = &DropProjectionPolicyOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowProjectionPolicyOptions struct {
+	show               bool `ddl:"static" sql:"SHOW"`                //lint:ignore U1000 This is used in the ddl tag
+	projectionPolicies bool `ddl:"static" sql:"PROJECTION POLICIES"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like  *Like      `ddl:"keyword" sql:"LIKE"`
+	In    *In        `ddl:"keyword" sql:"IN"`
+	Limit *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+func (opts *ShowProjectionPolicyOptions) validate() error {
+	return nil
+}
+
+type ProjectionPolicy struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Kind         string
+	Owner        string
+	Comment      string
+}
+
+func (v *ProjectionPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *ProjectionPolicy) ObjectType() ObjectType {
+	return ObjectTypeProjectionPolicy
+}
+
+type projectionPolicyRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Kind         sql.NullString `db:"kind"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+func (row projectionPolicyRow) toProjectionPolicy() *ProjectionPolicy {
+	return &ProjectionPolicy{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Kind:         row.Kind.String,
+		Owner:        row.Owner.String,
+		Comment:      row.Comment.String,
+	}
+}
+
+func (v *projectionPolicies) Show(ctx context.Context, opts *ShowProjectionPolicyOptions) ([]*ProjectionPolicy, error) {
+	if opts == nil {
+		opts = &ShowProjectionPolicyOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []projectionPolicyRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ProjectionPolicy, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toProjectionPolicy()
+	}
+	return resultList, nil
+}
+
+func (v *projectionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error) {
+	policies, err := v.Show(ctx, &ShowProjectionPolicyOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		if policy.Name == id.Name() {
+			return policy, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeProjectionPolicyOptions struct {
+	describe         bool                   `ddl:"static" sql:"DESCRIBE"`          //lint:ignore U1000 This is used in the ddl tag
+	projectionPolicy bool                   `ddl:"static" sql:"PROJECTION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	name             SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeProjectionPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type ProjectionPolicyDetails struct {
+	Name       string
+	Signature  string
+	ReturnType string
+	Body       string
+}
+
+type projectionPolicyDetailsRow struct {
+	Name       string `db:"name"`
+	Signature  string `db:"signature"`
+	ReturnType string `db:"return_type"`
+	Body       string `db:"body"`
+}
+
+func (v *projectionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicyDetails, error) {
+	opts := &describeProjectionPolicyOptions{
+		name: id,
+	}
+	dest, err := describeRow[projectionPolicyDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectionPolicyDetails{
+		Name:       dest.Name,
+		Signature:  dest.Signature,
+		ReturnType: dest.ReturnType,
+		Body:       dest.Body,
+	}, nil
+}
+
+// PolicyColumn is a column of a table or view a column-level policy is attached to.
+// Exactly one of Table or View must be set.
+type PolicyColumn struct {
+	Table  SchemaObjectIdentifier `ddl:"identifier" sql:"TABLE"`
+	View   SchemaObjectIdentifier `ddl:"identifier" sql:"VIEW"`
+	Column string                 `ddl:"parameter,double_quotes,no_equals" sql:"MODIFY COLUMN"`
+}
+
+func (c *PolicyColumn) validate() error {
+	if !exactlyOneValueSet(c.Table, c.View) {
+		return errors.New("exactly one of Table or View must be set")
+	}
+	if c.Column == "" {
+		return errors.New("Column is required")
+	}
+	return nil
+}
+
+type SetProjectionPolicyOnColumnOptions struct {
+	alter            bool                   `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	column           *PolicyColumn          `ddl:"-"`
+	projectionPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"SET PROJECTION POLICY"`
+	// Force replaces a projection policy already attached to the column.
+	Force *bool `ddl:"keyword" sql:"FORCE"`
+}
+
+func (opts *SetProjectionPolicyOnColumnOptions) validate() error {
+	if !validObjectidentifier(opts.projectionPolicy) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.column) {
+		return errors.New("column is required")
+	}
+	return opts.column.validate()
+}
+
+func (v *projectionPolicies) SetOnColumn(ctx context.Context, id SchemaObjectIdentifier, column *PolicyColumn, opts *SetProjectionPolicyOnColumnOptions) error {
+	if opts == nil {
+		opts = &SetProjectionPolicyOnColumnOptions{}
+	}
+	opts.projectionPolicy = id
+	opts.column = column
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type unsetProjectionPolicyFromColumnOptions struct {
+	alter                 bool          `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	column                *PolicyColumn `ddl:"-"`
+	unsetProjectionPolicy bool          `ddl:"static" sql:"UNSET PROJECTION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+}
+
+func (opts *unsetProjectionPolicyFromColumnOptions) validate() error {
+	if !valueSet(opts.column) {
+		return errors.New("column is required")
+	}
+	return opts.column.validate()
+}
+
+func (v *projectionPolicies) UnsetFromColumn(ctx context.Context, column *PolicyColumn) error {
+	opts := &unsetProjectionPolicyFromColumnOptions{
+		column: column,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
@@ -0,0 +1,56 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ProjectionPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	table, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err := client.ProjectionPolicies.Create(ctx, id, "PROJECTION_CONSTRAINT(ALLOW => false)", &CreateProjectionPolicyOptions{Comment: String("some comment")})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ProjectionPolicies.Drop(ctx, id, &DropProjectionPolicyOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		policy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, policy.ID())
+		assert.Equal(t, "some comment", policy.Comment)
+	})
+
+	t.Run("alter body and describe", func(t *testing.T) {
+		body := "PROJECTION_CONSTRAINT(ALLOW => true)"
+		err := client.ProjectionPolicies.Alter(ctx, id, &AlterProjectionPolicyOptions{
+			Set: &ProjectionPolicySet{Body: String(body)},
+		})
+		require.NoError(t, err)
+		details, err := client.ProjectionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, body, details.Body)
+		assert.Equal(t, "PROJECTION_CONSTRAINT", details.ReturnType)
+	})
+
+	t.Run("set on and unset from column", func(t *testing.T) {
+		column := &PolicyColumn{Table: table, Column: "EMAIL"}
+		err := client.ProjectionPolicies.SetOnColumn(ctx, id, column, nil)
+		require.NoError(t, err)
+		err = client.ProjectionPolicies.UnsetFromColumn(ctx, column)
+		require.NoError(t, err)
+	})
+}
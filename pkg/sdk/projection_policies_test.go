@@ -0,0 +1,146 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectionPolicyCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "projpol")
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateProjectionPolicyOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			body:      "CASE WHEN CURRENT_ROLE() = 'ANALYST' THEN PROJECTION_CONSTRAINT(ALLOW => true) ELSE PROJECTION_CONSTRAINT(ALLOW => false) END",
+			Comment:   String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE PROJECTION POLICY "db"."schema"."projpol" AS () RETURNS PROJECTION_CONSTRAINT -> CASE WHEN CURRENT_ROLE() = 'ANALYST' THEN PROJECTION_CONSTRAINT(ALLOW => true) ELSE PROJECTION_CONSTRAINT(ALLOW => false) END COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: no body", func(t *testing.T) {
+		opts := &CreateProjectionPolicyOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestProjectionPolicyAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "projpol")
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterProjectionPolicyOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: body and comment", func(t *testing.T) {
+		opts := &AlterProjectionPolicyOptions{
+			name: id,
+			Set:  &ProjectionPolicySet{Body: String("PROJECTION_CONSTRAINT(ALLOW => true)"), Comment: String("c")},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("with set body", func(t *testing.T) {
+		opts := &AlterProjectionPolicyOptions{
+			name: id,
+			Set:  &ProjectionPolicySet{Body: String("PROJECTION_CONSTRAINT(ALLOW => true)")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER PROJECTION POLICY "db"."schema"."projpol" SET BODY -> PROJECTION_CONSTRAINT(ALLOW => true)`, actual)
+	})
+
+	t.Run("with unset comment", func(t *testing.T) {
+		opts := &AlterProjectionPolicyOptions{
+			name:  id,
+			Unset: &ProjectionPolicyUnset{Comment: Bool(true)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER PROJECTION POLICY "db"."schema"."projpol" UNSET COMMENT`, actual)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterProjectionPolicyOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  NewSchemaObjectIdentifier("db", "schema", "newpol"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER PROJECTION POLICY IF EXISTS "db"."schema"."projpol" RENAME TO "db"."schema"."newpol"`, actual)
+	})
+}
+
+func TestProjectionPolicyDrop(t *testing.T) {
+	opts := &DropProjectionPolicyOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "projpol"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP PROJECTION POLICY IF EXISTS "db"."schema"."projpol"`, actual)
+}
+
+func TestProjectionPolicyShow(t *testing.T) {
+	opts := &ShowProjectionPolicyOptions{
+		Like: &Like{Pattern: String("projpol")},
+		In:   &In{Schema: NewSchemaIdentifier("db", "schema")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW PROJECTION POLICIES LIKE 'projpol' IN SCHEMA "db"."schema"`, actual)
+}
+
+func TestProjectionPolicyDescribe(t *testing.T) {
+	opts := &describeProjectionPolicyOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "projpol"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE PROJECTION POLICY "db"."schema"."projpol"`, actual)
+}
+
+func TestProjectionPolicyColumnAttachment(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "projpol")
+	table := NewSchemaObjectIdentifier("db", "schema", "table")
+
+	t.Run("set on table column", func(t *testing.T) {
+		opts := &SetProjectionPolicyOnColumnOptions{
+			column:           &PolicyColumn{Table: table, Column: "email"},
+			projectionPolicy: id,
+			Force:            Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" MODIFY COLUMN "email" SET PROJECTION POLICY "db"."schema"."projpol" FORCE`, actual)
+	})
+
+	t.Run("unset from view column", func(t *testing.T) {
+		opts := &unsetProjectionPolicyFromColumnOptions{
+			column: &PolicyColumn{View: NewSchemaObjectIdentifier("db", "schema", "view"), Column: "email"},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER VIEW "db"."schema"."view" MODIFY COLUMN "email" UNSET PROJECTION POLICY`, actual)
+	})
+
+	t.Run("validation: table and view", func(t *testing.T) {
+		opts := &SetProjectionPolicyOnColumnOptions{
+			column:           &PolicyColumn{Table: table, View: table, Column: "email"},
+			projectionPolicy: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no column", func(t *testing.T) {
+		opts := &unsetProjectionPolicyFromColumnOptions{
+			column: &PolicyColumn{Table: table},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
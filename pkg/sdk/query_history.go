@@ -0,0 +1,179 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ QueryHistory = (*queryHistory)(nil)
+
+// QueryHistory reads the INFORMATION_SCHEMA.QUERY_HISTORY table functions, e.g. to verify the statements
+// run by the provider. The table functions only return queries of the last 7 days.
+type QueryHistory interface {
+	// Show returns the queries matching the options, most recent first.
+	Show(ctx context.Context, opts *ShowQueryHistoryOptions) ([]*QueryHistoryEntry, error)
+}
+
+// queryHistory implements QueryHistory.
+type queryHistory struct {
+	client *Client
+}
+
+// ShowQueryHistoryOptions filters the query history. At most one of SessionID, User or Warehouse can be set,
+// each of them selects the matching QUERY_HISTORY_BY_* table function. Database selects the information
+// schema the table function is called from, by default the one of the current database.
+type ShowQueryHistoryOptions struct {
+	Database          AccountObjectIdentifier
+	SessionID         *string
+	User              AccountObjectIdentifier
+	Warehouse         AccountObjectIdentifier
+	EndTimeRangeStart *time.Time
+	EndTimeRangeEnd   *time.Time
+	// ResultLimit is at most 10000, Snowflake returns 100 rows by default.
+	ResultLimit *int
+}
+
+func (opts *ShowQueryHistoryOptions) validate() error {
+	if anyValueSet(opts.SessionID, opts.User, opts.Warehouse) && !exactlyOneValueSet(opts.SessionID, opts.User, opts.Warehouse) {
+		return errors.New("only one of SessionID, User or Warehouse can be set")
+	}
+	if valueSet(opts.SessionID) && strings.Trim(*opts.SessionID, "0123456789") != "" {
+		return errors.New("SessionID must be numeric")
+	}
+	if everyValueSet(opts.EndTimeRangeStart, opts.EndTimeRangeEnd) && opts.EndTimeRangeEnd.Before(*opts.EndTimeRangeStart) {
+		return errors.New("EndTimeRangeEnd must not be before EndTimeRangeStart")
+	}
+	if valueSet(opts.ResultLimit) && !validateIntInRange(*opts.ResultLimit, 1, 10000) {
+		return errors.New("ResultLimit must be between 1 and 10000")
+	}
+	return nil
+}
+
+type QueryHistoryEntry struct {
+	QueryID          string
+	QueryText        string
+	DatabaseName     string
+	SchemaName       string
+	QueryType        string
+	SessionID        string
+	UserName         string
+	RoleName         string
+	WarehouseName    string
+	WarehouseSize    string
+	QueryTag         string
+	ExecutionStatus  string
+	ErrorCode        string
+	ErrorMessage     string
+	StartTime        time.Time
+	EndTime          time.Time
+	TotalElapsedTime time.Duration
+	BytesScanned     int64
+	RowsProduced     int64
+}
+
+type queryHistoryRow struct {
+	QueryID          string         `db:"QUERY_ID"`
+	QueryText        sql.NullString `db:"QUERY_TEXT"`
+	DatabaseName     sql.NullString `db:"DATABASE_NAME"`
+	SchemaName       sql.NullString `db:"SCHEMA_NAME"`
+	QueryType        sql.NullString `db:"QUERY_TYPE"`
+	SessionID        sql.NullString `db:"SESSION_ID"`
+	UserName         sql.NullString `db:"USER_NAME"`
+	RoleName         sql.NullString `db:"ROLE_NAME"`
+	WarehouseName    sql.NullString `db:"WAREHOUSE_NAME"`
+	WarehouseSize    sql.NullString `db:"WAREHOUSE_SIZE"`
+	QueryTag         sql.NullString `db:"QUERY_TAG"`
+	ExecutionStatus  sql.NullString `db:"EXECUTION_STATUS"`
+	ErrorCode        sql.NullString `db:"ERROR_CODE"`
+	ErrorMessage     sql.NullString `db:"ERROR_MESSAGE"`
+	StartTime        sql.NullTime   `db:"START_TIME"`
+	EndTime          sql.NullTime   `db:"END_TIME"`
+	TotalElapsedTime sql.NullInt64  `db:"TOTAL_ELAPSED_TIME"`
+	BytesScanned     sql.NullInt64  `db:"BYTES_SCANNED"`
+	RowsProduced     sql.NullInt64  `db:"ROWS_PRODUCED"`
+}
+
+func (row queryHistoryRow) toQueryHistoryEntry() *QueryHistoryEntry {
+	entry := &QueryHistoryEntry{
+		QueryID:          row.QueryID,
+		QueryText:        row.QueryText.String,
+		DatabaseName:     row.DatabaseName.String,
+		SchemaName:       row.SchemaName.String,
+		QueryType:        row.QueryType.String,
+		SessionID:        row.SessionID.String,
+		UserName:         row.UserName.String,
+		RoleName:         row.RoleName.String,
+		WarehouseName:    row.WarehouseName.String,
+		WarehouseSize:    row.WarehouseSize.String,
+		QueryTag:         row.QueryTag.String,
+		ExecutionStatus:  row.ExecutionStatus.String,
+		ErrorCode:        row.ErrorCode.String,
+		ErrorMessage:     row.ErrorMessage.String,
+		TotalElapsedTime: time.Duration(row.TotalElapsedTime.Int64) * time.Millisecond,
+		BytesScanned:     row.BytesScanned.Int64,
+		RowsProduced:     row.RowsProduced.Int64,
+	}
+	if row.StartTime.Valid {
+		entry.StartTime = row.StartTime.Time
+	}
+	if row.EndTime.Valid {
+		entry.EndTime = row.EndTime.Time
+	}
+	return entry
+}
+
+// queryHistorySQL builds the query for the query history. Names are passed as string arguments,
+// so they are unquoted and must match the case stored by Snowflake.
+func queryHistorySQL(opts *ShowQueryHistoryOptions) string {
+	function := "QUERY_HISTORY"
+	var args []string
+	switch {
+	case valueSet(opts.SessionID):
+		function = "QUERY_HISTORY_BY_SESSION"
+		args = append(args, fmt.Sprintf("SESSION_ID => %s", *opts.SessionID))
+	case valueSet(opts.User):
+		function = "QUERY_HISTORY_BY_USER"
+		args = append(args, fmt.Sprintf("USER_NAME => '%s'", escapeStringLiteral(opts.User.Name())))
+	case valueSet(opts.Warehouse):
+		function = "QUERY_HISTORY_BY_WAREHOUSE"
+		args = append(args, fmt.Sprintf("WAREHOUSE_NAME => '%s'", escapeStringLiteral(opts.Warehouse.Name())))
+	}
+	if opts.EndTimeRangeStart != nil {
+		args = append(args, fmt.Sprintf("END_TIME_RANGE_START => TO_TIMESTAMP_LTZ('%s')", opts.EndTimeRangeStart.Format(time.RFC3339)))
+	}
+	if opts.EndTimeRangeEnd != nil {
+		args = append(args, fmt.Sprintf("END_TIME_RANGE_END => TO_TIMESTAMP_LTZ('%s')", opts.EndTimeRangeEnd.Format(time.RFC3339)))
+	}
+	if opts.ResultLimit != nil {
+		args = append(args, fmt.Sprintf("RESULT_LIMIT => %d", *opts.ResultLimit))
+	}
+	schema := "INFORMATION_SCHEMA"
+	if valueSet(opts.Database) {
+		schema = fmt.Sprintf("%s.INFORMATION_SCHEMA", opts.Database.FullyQualifiedName())
+	}
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s.%s(%s)) ORDER BY START_TIME DESC`, schema, function, strings.Join(args, ", "))
+}
+
+func (v *queryHistory) Show(ctx context.Context, opts *ShowQueryHistoryOptions) ([]*QueryHistoryEntry, error) {
+	if opts == nil {
+		opts = &ShowQueryHistoryOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	dest := []queryHistoryRow{}
+	err := v.client.query(ctx, &dest, queryHistorySQL(opts))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*QueryHistoryEntry, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toQueryHistoryEntry()
+	}
+	return resultList, nil
+}
@@ -0,0 +1,35 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_QueryHistory(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+
+	_, err := client.exec(ctx, "SELECT 'query history marker'")
+	require.NoError(t, err)
+	sessionID, err := client.ContextFunctions.CurrentSession(ctx)
+	require.NoError(t, err)
+
+	entries, err := client.QueryHistory.Show(ctx, &ShowQueryHistoryOptions{
+		Database:    database.ID(),
+		SessionID:   String(sessionID),
+		ResultLimit: Int(100),
+	})
+	require.NoError(t, err)
+	texts := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		assert.Equal(t, sessionID, entry.SessionID)
+		texts = append(texts, entry.QueryText)
+	}
+	assert.Contains(t, texts, "SELECT 'query history marker'")
+}
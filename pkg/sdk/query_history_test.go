@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryHistorySQL(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("without options", func(t *testing.T) {
+		actual := queryHistorySQL(&ShowQueryHistoryOptions{})
+		assert.Equal(t, `SELECT * FROM TABLE(INFORMATION_SCHEMA.QUERY_HISTORY()) ORDER BY START_TIME DESC`, actual)
+	})
+
+	t.Run("with database, time range and limit", func(t *testing.T) {
+		actual := queryHistorySQL(&ShowQueryHistoryOptions{
+			Database:          NewAccountObjectIdentifier("db"),
+			EndTimeRangeStart: &start,
+			EndTimeRangeEnd:   &end,
+			ResultLimit:       Int(50),
+		})
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.QUERY_HISTORY(END_TIME_RANGE_START => TO_TIMESTAMP_LTZ('2023-01-01T00:00:00Z'), END_TIME_RANGE_END => TO_TIMESTAMP_LTZ('2023-01-02T00:00:00Z'), RESULT_LIMIT => 50)) ORDER BY START_TIME DESC`, actual)
+	})
+
+	t.Run("by session", func(t *testing.T) {
+		actual := queryHistorySQL(&ShowQueryHistoryOptions{SessionID: String("123456"), ResultLimit: Int(10)})
+		assert.Equal(t, `SELECT * FROM TABLE(INFORMATION_SCHEMA.QUERY_HISTORY_BY_SESSION(SESSION_ID => 123456, RESULT_LIMIT => 10)) ORDER BY START_TIME DESC`, actual)
+	})
+
+	t.Run("by user", func(t *testing.T) {
+		actual := queryHistorySQL(&ShowQueryHistoryOptions{User: NewAccountObjectIdentifier("TERRAFORM")})
+		assert.Equal(t, `SELECT * FROM TABLE(INFORMATION_SCHEMA.QUERY_HISTORY_BY_USER(USER_NAME => 'TERRAFORM')) ORDER BY START_TIME DESC`, actual)
+	})
+
+	t.Run("by warehouse", func(t *testing.T) {
+		actual := queryHistorySQL(&ShowQueryHistoryOptions{Warehouse: NewAccountObjectIdentifier("COMPUTE_WH"), EndTimeRangeStart: &start})
+		assert.Equal(t, `SELECT * FROM TABLE(INFORMATION_SCHEMA.QUERY_HISTORY_BY_WAREHOUSE(WAREHOUSE_NAME => 'COMPUTE_WH', END_TIME_RANGE_START => TO_TIMESTAMP_LTZ('2023-01-01T00:00:00Z'))) ORDER BY START_TIME DESC`, actual)
+	})
+
+	t.Run("validation: session and user", func(t *testing.T) {
+		opts := &ShowQueryHistoryOptions{SessionID: String("1"), User: NewAccountObjectIdentifier("TERRAFORM")}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: non numeric session", func(t *testing.T) {
+		opts := &ShowQueryHistoryOptions{SessionID: String("1) OR (1")}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: inverted time range", func(t *testing.T) {
+		opts := &ShowQueryHistoryOptions{EndTimeRangeStart: &end, EndTimeRangeEnd: &start}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: result limit out of range", func(t *testing.T) {
+		opts := &ShowQueryHistoryOptions{ResultLimit: Int(10001)}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestQueryHistoryRowToQueryHistoryEntry(t *testing.T) {
+	row := queryHistoryRow{
+		QueryID:          "01b0",
+		QueryText:        sql.NullString{String: "SELECT 1", Valid: true},
+		ExecutionStatus:  sql.NullString{String: "SUCCESS", Valid: true},
+		TotalElapsedTime: sql.NullInt64{Int64: 1500, Valid: true},
+		StartTime:        sql.NullTime{Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+	}
+	entry := row.toQueryHistoryEntry()
+	assert.Equal(t, "01b0", entry.QueryID)
+	assert.Equal(t, "SELECT 1", entry.QueryText)
+	assert.Equal(t, "SUCCESS", entry.ExecutionStatus)
+	assert.Equal(t, 1500*time.Millisecond, entry.TotalElapsedTime)
+	assert.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), entry.StartTime)
+	assert.True(t, entry.EndTime.IsZero())
+}
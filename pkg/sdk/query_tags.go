@@ -0,0 +1,40 @@
+package sdk
+
+import (
+	"context"
+)
+
+type queryTagContextKey struct{}
+
+// queryTagSeparator separates the query tag of the client from the tag of a call.
+const queryTagSeparator = "/"
+
+// WithQueryTag sets the QUERY_TAG of the sessions of the client, so that its statements can be found in the query
+// history, e.g. "terraform". NewClient sets it when logging in, clients created with NewClientFromDB only apply it
+// to statements with a per-call tag, as the connections of the pool are opened by the caller. Their DSN should set
+// the query_tag parameter instead.
+func WithQueryTag(tag string) ClientOption {
+	return func(c *Client) {
+		c.queryTag = tag
+	}
+}
+
+// ContextWithQueryTag returns a context whose statements are tagged with the query tag of the client followed by
+// tag, e.g. the address of a Terraform resource and the operation. It overrides QUERY_TAG set with
+// ContextWithSessionParameters, see there for the cost.
+func ContextWithQueryTag(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, queryTagContextKey{}, tag)
+}
+
+func queryTagFromContext(ctx context.Context) (string, bool) {
+	tag, ok := ctx.Value(queryTagContextKey{}).(string)
+	return tag, ok && tag != ""
+}
+
+// combinedQueryTag joins the query tag of the client and the tag of a call.
+func combinedQueryTag(clientTag string, callTag string) string {
+	if clientTag == "" {
+		return callTag
+	}
+	return clientTag + queryTagSeparator + callTag
+}
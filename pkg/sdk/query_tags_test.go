@@ -0,0 +1,44 @@
+package sdk
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryTags(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("per-call tag", func(t *testing.T) {
+		client, mock := newMockClient(t, WithQueryTag("terraform"))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION SET QUERY_TAG = 'terraform/snowflake_role.r:delete'`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DROP ROLE "ROLE1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION SET QUERY_TAG = 'terraform'`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := client.Roles.Drop(ContextWithQueryTag(ctx, "snowflake_role.r:delete"), NewAccountObjectIdentifier("ROLE1"), nil)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("per-call tag without a client tag", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION SET QUERY_TAG = 'snowflake_role.r:read'`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SHOW ROLES`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION UNSET QUERY_TAG`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := client.Roles.Show(ContextWithQueryTag(ctx, "snowflake_role.r:read"), nil)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no per-call tag", func(t *testing.T) {
+		client, mock := newMockClient(t, WithQueryTag("terraform"))
+		mock.ExpectExec(`DROP ROLE "ROLE1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		require.NoError(t, client.Roles.Drop(ctx, NewAccountObjectIdentifier("ROLE1"), nil))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+)
+
+// logUnmanagedStatement marks statements that were not built by the SDK in the logs. Only the number of bind
+// parameters is logged, as their values may be secrets.
+func logUnmanagedStatement(statement string, args []interface{}) {
+	log.Printf("[DEBUG] unmanaged statement: %s (%d bind parameters)\n", statement, len(args))
+}
+
+// ExecUnsafe executes a statement that does not return rows, for features the SDK does not model yet.
+// Values should be passed as bind parameters for the ? placeholders of the statement instead of being
+// formatted into it. The statement is neither validated nor rendered by the SDK, so identifiers have to be
+// quoted by the caller, e.g. with ObjectIdentifier.FullyQualifiedName. Like the statements built by the SDK,
+// it is retried on transient errors according to the retry policy of the client (see WithRetryPolicy), so it
+// should be safe to run more than once.
+func (c *Client) ExecUnsafe(ctx context.Context, statement string, args ...interface{}) (sql.Result, error) {
+	if strings.TrimSpace(statement) == "" {
+		return nil, ErrEmptyStatement
+	}
+	logUnmanagedStatement(statement, args)
+	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
+	var result sql.Result
+	err := c.run(ctx, func(e executor) (err error) {
+		result, err = e.ExecContext(ctx, statement, args...)
+		return err
+	})
+	return result, decodeDriverError(err)
+}
+
+// QueryUnsafe runs a query the SDK does not model yet and scans every row into dest, which is expected to be
+// a pointer to a slice of structs with db tags matching the column names, e.g.
+//
+//	rows := []struct {
+//		Name string `db:"TABLE_NAME"`
+//	}{}
+//	err := client.QueryUnsafe(ctx, &rows, `SELECT TABLE_NAME FROM "DB".INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = ?`, "PUBLIC")
+//
+// See ExecUnsafe for bind parameters and retries.
+func (c *Client) QueryUnsafe(ctx context.Context, dest interface{}, statement string, args ...interface{}) error {
+	if strings.TrimSpace(statement) == "" {
+		return ErrEmptyStatement
+	}
+	logUnmanagedStatement(statement, args)
+	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
+	return decodeDriverError(c.run(ctx, func(e executor) error {
+		resetRows(dest)
+		return e.SelectContext(ctx, dest, statement, args...)
+	}))
+}
@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnsafeStatements(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("exec with bind parameters", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(`ALTER WAREHOUSE "WH" SET COMMENT = \?`).WithArgs("it's new").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		_, err := client.ExecUnsafe(ctx, `ALTER WAREHOUSE "WH" SET COMMENT = ?`, "it's new")
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query into typed rows", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(`SELECT NAME, SIZE FROM OBJECTS WHERE NAME LIKE \?`).WithArgs("W%").
+			WillReturnRows(sqlmock.NewRows([]string{"NAME", "SIZE"}).AddRow("WH1", 1).AddRow("WH2", 2))
+
+		rows := []struct {
+			Name string `db:"NAME"`
+			Size int    `db:"SIZE"`
+		}{}
+		err := client.QueryUnsafe(ctx, &rows, `SELECT NAME, SIZE FROM OBJECTS WHERE NAME LIKE ?`, "W%")
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "WH2", rows[1].Name)
+		assert.Equal(t, 2, rows[1].Size)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("retries transient errors", func(t *testing.T) {
+		client, mock := newMockClient(t, WithRetryPolicy(RetryPolicy{MaxAttempts: 2}))
+		transient := &gosnowflake.SnowflakeError{Number: 390114}
+		mock.ExpectExec(`ALTER WAREHOUSE "WH" RESUME`).WillReturnError(transient)
+		mock.ExpectExec(`ALTER WAREHOUSE "WH" RESUME`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT NAME FROM OBJECTS`).WillReturnError(transient)
+		mock.ExpectQuery(`SELECT NAME FROM OBJECTS`).WillReturnRows(sqlmock.NewRows([]string{"NAME"}).AddRow("WH1"))
+
+		_, err := client.ExecUnsafe(ctx, `ALTER WAREHOUSE "WH" RESUME`)
+		require.NoError(t, err)
+		rows := []struct {
+			Name string `db:"NAME"`
+		}{}
+		require.NoError(t, client.QueryUnsafe(ctx, &rows, `SELECT NAME FROM OBJECTS`))
+		assert.Len(t, rows, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("empty statement", func(t *testing.T) {
+		client, _ := newMockClient(t)
+		_, err := client.ExecUnsafe(ctx, " ")
+		assert.ErrorIs(t, err, ErrEmptyStatement)
+		err = client.QueryUnsafe(ctx, &[]struct{}{}, "")
+		assert.ErrorIs(t, err, ErrEmptyStatement)
+	})
+}
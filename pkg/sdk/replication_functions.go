@@ -8,6 +8,7 @@ import (
 type ReplicationFunctions interface {
 	ShowReplicationAcccounts(ctx context.Context) ([]*ReplicationAccount, error)
 	// todo: ShowReplicationDatabases(ctx context.Context, opts *ShowReplicationDatabasesOptions) ([]*ReplicationDatabase, error)
+	// ShowRegions returns the regions accounts of the organization can be created in or replicated to.
 	ShowRegions(ctx context.Context, opts *ShowRegionsOptions) ([]*Region, error)
 }
 
@@ -101,7 +102,7 @@ func (c *replicationFunctions) ShowRegions(ctx context.Context, opts *ShowRegion
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := c.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
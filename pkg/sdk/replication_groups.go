@@ -1,4 +1,482 @@
 package sdk
 
-// note: once Replication Groups is implemented, Databases Integration test for CreateSecondary needs to be implemented
-// also: TestInt_AlterReplication
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// Compile-time proof of interface implementation.
+var _ ReplicationGroups = (*replicationGroups)(nil)
+
+// ReplicationGroups describes all the replication group related methods that the
+// Snowflake API supports.
+type ReplicationGroups interface {
+	// Create creates a new replication group.
+	Create(ctx context.Context, id AccountObjectIdentifier, objectTypes []PluralObjectType, allowedAccounts []AccountIdentifier, opts *CreateReplicationGroupOptions) error
+	// CreateSecondary creates a secondary replication group as a replica of a primary replication group in another account.
+	CreateSecondary(ctx context.Context, id AccountObjectIdentifier, primaryReplicationGroupID ExternalObjectIdentifier, opts *CreateSecondaryReplicationGroupOptions) error
+	// Alter modifies an existing replication group.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterReplicationGroupOptions) error
+	// Drop removes a replication group.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropReplicationGroupOptions) error
+	// Show returns a list of replication groups.
+	Show(ctx context.Context, opts *ShowReplicationGroupOptions) ([]*ReplicationGroup, error)
+	// ShowByID returns a replication group by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error)
+	// ShowDatabases returns a list of databases in a replication group.
+	ShowDatabases(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error)
+	// ShowShares returns a list of shares in a replication group.
+	ShowShares(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error)
+}
+
+// replicationGroups implements ReplicationGroups.
+type replicationGroups struct {
+	client *Client
+}
+
+type CreateReplicationGroupOptions struct {
+	create           bool                    `ddl:"static" sql:"CREATE"`            //lint:ignore U1000 This is used in the ddl tag
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists      *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+
+	objectTypes             []PluralObjectType        `ddl:"parameter" sql:"OBJECT_TYPES"`
+	AllowedDatabases        []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_DATABASES"`
+	AllowedShares           []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_SHARES"`
+	AllowedIntegrationTypes []IntegrationType         `ddl:"parameter" sql:"ALLOWED_INTEGRATION_TYPES"`
+	allowedAccounts         []AccountIdentifier       `ddl:"parameter" sql:"ALLOWED_ACCOUNTS"`
+	IgnoreEditionCheck      *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+	ReplicationSchedule     *string                   `ddl:"parameter,single_quotes" sql:"REPLICATION_SCHEDULE"`
+}
+
+func (opts *CreateReplicationGroupOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if len(opts.objectTypes) == 0 {
+		return errors.New("at least one object type must be set")
+	}
+	if len(opts.allowedAccounts) == 0 {
+		return errors.New("at least one allowed account must be set")
+	}
+	if len(opts.AllowedDatabases) > 0 && !slices.Contains(opts.objectTypes, PluralObjectTypeDatabases) {
+		return errors.New("DATABASES must be set in OBJECT_TYPES when setting allowed databases")
+	}
+	if len(opts.AllowedShares) > 0 && !slices.Contains(opts.objectTypes, PluralObjectTypeShares) {
+		return errors.New("SHARES must be set in OBJECT_TYPES when setting allowed shares")
+	}
+	if len(opts.AllowedIntegrationTypes) > 0 && !slices.Contains(opts.objectTypes, PluralObjectTypeIntegrations) {
+		return errors.New("INTEGRATIONS must be set in OBJECT_TYPES when setting allowed integration types")
+	}
+	return nil
+}
+
+func (v *replicationGroups) Create(ctx context.Context, id AccountObjectIdentifier, objectTypes []PluralObjectType, allowedAccounts []AccountIdentifier, opts *CreateReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &CreateReplicationGroupOptions{}
+	}
+	opts.name = id
+	opts.objectTypes = objectTypes
+	opts.allowedAccounts = allowedAccounts
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type createSecondaryReplicationGroupOptions struct {
+	create                  bool                     `ddl:"static" sql:"CREATE"`            //lint:ignore U1000 This is used in the ddl tag
+	replicationGroup        bool                     `ddl:"static" sql:"REPLICATION GROUP"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists             *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                    AccountObjectIdentifier  `ddl:"identifier"`
+	primaryReplicationGroup ExternalObjectIdentifier `ddl:"identifier" sql:"AS REPLICA OF"`
+}
+
+func (opts *createSecondaryReplicationGroupOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !validObjectidentifier(opts.primaryReplicationGroup) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// CreateSecondary takes the same options as FailoverGroups.CreateSecondaryReplicationGroup, only IfNotExists applies.
+func (v *replicationGroups) CreateSecondary(ctx context.Context, id AccountObjectIdentifier, primaryReplicationGroupID ExternalObjectIdentifier, opts *CreateSecondaryReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &CreateSecondaryReplicationGroupOptions{}
+	}
+	createOpts := &createSecondaryReplicationGroupOptions{
+		IfNotExists:             opts.IfNotExists,
+		name:                    id,
+		primaryReplicationGroup: primaryReplicationGroupID,
+	}
+	if err := createOpts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(createOpts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterReplicationGroupOptions struct {
+	alter            bool                    `ddl:"static" sql:"ALTER"`             //lint:ignore U1000 This is used in the ddl tag
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+
+	// Only in the source account.
+	NewName AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set     *ReplicationGroupSet    `ddl:"keyword" sql:"SET"`
+	Add     *ReplicationGroupAdd    `ddl:"keyword" sql:"ADD"`
+	Move    *ReplicationGroupMove   `ddl:"keyword" sql:"MOVE"`
+	Remove  *ReplicationGroupRemove `ddl:"keyword" sql:"REMOVE"`
+
+	// Only in a target account.
+	Refresh *bool `ddl:"keyword" sql:"REFRESH"`
+	Suspend *bool `ddl:"keyword" sql:"SUSPEND"`
+	Resume  *bool `ddl:"keyword" sql:"RESUME"`
+}
+
+func (opts *AlterReplicationGroupOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Add, opts.Move, opts.Remove, opts.Refresh, opts.Suspend, opts.Resume) {
+		return errors.New("exactly one of NewName, Set, Add, Move, Remove, Refresh, Suspend, Resume must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Add) {
+		if err := opts.Add.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Move) {
+		if err := opts.Move.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Remove) {
+		if err := opts.Remove.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ReplicationGroupSet struct {
+	ObjectTypes             []PluralObjectType        `ddl:"parameter" sql:"OBJECT_TYPES"`
+	AllowedDatabases        []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_DATABASES"`
+	AllowedShares           []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_SHARES"`
+	AllowedIntegrationTypes []IntegrationType         `ddl:"parameter" sql:"ALLOWED_INTEGRATION_TYPES"`
+	ReplicationSchedule     *string                   `ddl:"parameter,single_quotes" sql:"REPLICATION_SCHEDULE"`
+}
+
+func (v *ReplicationGroupSet) validate() error {
+	if !anyValueSet(v.ObjectTypes, v.AllowedDatabases, v.AllowedShares, v.AllowedIntegrationTypes, v.ReplicationSchedule) {
+		return errors.New("at least one property must be set")
+	}
+	if len(v.AllowedIntegrationTypes) > 0 && !slices.Contains(v.ObjectTypes, PluralObjectTypeIntegrations) {
+		return errors.New("INTEGRATIONS must be set in OBJECT_TYPES when setting allowed integration types")
+	}
+	return nil
+}
+
+type ReplicationGroupAdd struct {
+	AllowedDatabases   []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_DATABASES"`
+	AllowedShares      []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"TO ALLOWED_SHARES"`
+	AllowedAccounts    []AccountIdentifier       `ddl:"parameter,reverse" sql:"TO ALLOWED_ACCOUNTS"`
+	IgnoreEditionCheck *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+}
+
+func (v *ReplicationGroupAdd) validate() error {
+	if !exactlyOneValueSet(v.AllowedDatabases, v.AllowedShares, v.AllowedAccounts) {
+		return errors.New("exactly one of AllowedDatabases, AllowedShares, AllowedAccounts must be set")
+	}
+	if valueSet(v.IgnoreEditionCheck) && !valueSet(v.AllowedAccounts) {
+		return errors.New("IgnoreEditionCheck can only be set together with AllowedAccounts")
+	}
+	return nil
+}
+
+type ReplicationGroupMove struct {
+	Databases []AccountObjectIdentifier `ddl:"parameter,no_equals" sql:"DATABASES"`
+	Shares    []AccountObjectIdentifier `ddl:"parameter,no_equals" sql:"SHARES"`
+	To        AccountObjectIdentifier   `ddl:"identifier" sql:"TO REPLICATION GROUP"`
+}
+
+func (v *ReplicationGroupMove) validate() error {
+	if !exactlyOneValueSet(v.Databases, v.Shares) {
+		return errors.New("exactly one of Databases, Shares must be set")
+	}
+	if !validObjectidentifier(v.To) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type ReplicationGroupRemove struct {
+	AllowedDatabases []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"FROM ALLOWED_DATABASES"`
+	AllowedShares    []AccountObjectIdentifier `ddl:"parameter,reverse" sql:"FROM ALLOWED_SHARES"`
+	AllowedAccounts  []AccountIdentifier       `ddl:"parameter,reverse" sql:"FROM ALLOWED_ACCOUNTS"`
+}
+
+func (v *ReplicationGroupRemove) validate() error {
+	if !exactlyOneValueSet(v.AllowedDatabases, v.AllowedShares, v.AllowedAccounts) {
+		return errors.New("exactly one of AllowedDatabases, AllowedShares, AllowedAccounts must be set")
+	}
+	return nil
+}
+
+func (v *replicationGroups) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &AlterReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropReplicationGroupOptions struct {
+	drop             bool                    `ddl:"static" sql:"DROP"`              //lint:ignore U1000 This is used in the ddl tag
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropReplicationGroupOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *replicationGroups) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &DropReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowReplicationGroupOptions represents the options for listing replication groups.
+type ShowReplicationGroupOptions struct {
+	show              bool              `ddl:"static" sql:"SHOW"`               //lint:ignore U1000 This is used in the ddl tag
+	replicationGroups bool              `ddl:"static" sql:"REPLICATION GROUPS"` //lint:ignore U1000 This is used in the ddl tag
+	InAccount         AccountIdentifier `ddl:"identifier" sql:"IN ACCOUNT"`
+}
+
+func (opts *ShowReplicationGroupOptions) validate() error {
+	return nil
+}
+
+// ReplicationGroup is a user friendly result for a SHOW REPLICATION GROUPS query.
+// SHOW REPLICATION GROUPS lists failover groups as well, they can be told apart by Type.
+type ReplicationGroup struct {
+	RegionGroup             string
+	SnowflakeRegion         string
+	CreatedOn               time.Time
+	AccountName             string
+	Name                    string
+	Type                    string
+	Comment                 string
+	IsPrimary               bool
+	Primary                 ExternalObjectIdentifier
+	ObjectTypes             []PluralObjectType
+	AllowedIntegrationTypes []IntegrationType
+	AllowedAccounts         []AccountIdentifier
+	OrganizationName        string
+	AccountLocator          string
+	ReplicationSchedule     string
+	SecondaryState          FailoverGroupSecondaryState
+	NextScheduledRefresh    string
+	Owner                   string
+}
+
+func (v *ReplicationGroup) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ReplicationGroup) ExternalID() ExternalObjectIdentifier {
+	return NewExternalObjectIdentifier(AccountIdentifier{
+		organizationName: v.OrganizationName,
+		accountName:      v.AccountName,
+		accountLocator:   v.AccountLocator,
+	}, v.ID())
+}
+
+func (v *ReplicationGroup) ObjectType() ObjectType {
+	return ObjectTypeReplicationGroup
+}
+
+// SHOW REPLICATION GROUPS returns the same columns as SHOW FAILOVER GROUPS.
+func (row failoverGroupDBRow) toReplicationGroup() *ReplicationGroup {
+	fg := row.toFailoverGroup()
+	return &ReplicationGroup{
+		RegionGroup:             fg.RegionGroup,
+		SnowflakeRegion:         fg.SnowflakeRegion,
+		CreatedOn:               fg.CreatedOn,
+		AccountName:             fg.AccountName,
+		Name:                    fg.Name,
+		Type:                    fg.Type,
+		Comment:                 fg.Comment,
+		IsPrimary:               fg.IsPrimary,
+		Primary:                 fg.Primary,
+		ObjectTypes:             fg.ObjectTypes,
+		AllowedIntegrationTypes: fg.AllowedIntegrationTypes,
+		AllowedAccounts:         fg.AllowedAccounts,
+		OrganizationName:        fg.OrganizationName,
+		AccountLocator:          fg.AccountLocator,
+		ReplicationSchedule:     fg.ReplicationSchedule,
+		SecondaryState:          fg.SecondaryState,
+		NextScheduledRefresh:    fg.NextScheduledRefresh,
+		Owner:                   fg.Owner,
+	}
+}
+
+func (v *replicationGroups) Show(ctx context.Context, opts *ShowReplicationGroupOptions) ([]*ReplicationGroup, error) {
+	if opts == nil {
+		opts = &ShowReplicationGroupOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []failoverGroupDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*ReplicationGroup, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toReplicationGroup()
+	}
+	return resultList, nil
+}
+
+func (v *replicationGroups) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error) {
+	currentAccount, err := v.client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	replicationGroups, err := v.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, replicationGroup := range replicationGroups {
+		if replicationGroup.ID() == id && replicationGroup.AccountLocator == currentAccount {
+			return replicationGroup, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type showReplicationGroupDatabasesOptions struct {
+	show      bool                    `ddl:"static" sql:"SHOW"`      //lint:ignore U1000 This is used in the ddl tag
+	databases bool                    `ddl:"static" sql:"DATABASES"` //lint:ignore U1000 This is used in the ddl tag
+	in        AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupDatabasesOptions) validate() error {
+	if !validObjectidentifier(opts.in) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowDatabases(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupDatabasesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewAccountObjectIdentifier(row.Name)
+	}
+	return resultList, nil
+}
+
+type showReplicationGroupSharesOptions struct {
+	show   bool                    `ddl:"static" sql:"SHOW"`   //lint:ignore U1000 This is used in the ddl tag
+	shares bool                    `ddl:"static" sql:"SHARES"` //lint:ignore U1000 This is used in the ddl tag
+	in     AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupSharesOptions) validate() error {
+	if !validObjectidentifier(opts.in) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowShares(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupSharesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewExternalObjectIdentifierFromFullyQualifiedName(row.Name).objectIdentifier.(AccountObjectIdentifier)
+	}
+	return resultList, nil
+}
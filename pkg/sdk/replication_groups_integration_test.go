@@ -0,0 +1,92 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ReplicationGroups(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	shareTest, shareCleanup := createShare(t, client)
+	t.Cleanup(shareCleanup)
+
+	id := randomAccountObjectIdentifier(t)
+	objectTypes := []PluralObjectType{PluralObjectTypeDatabases, PluralObjectTypeShares}
+	allowedAccounts := []AccountIdentifier{getSecondaryAccountIdentifier(t)}
+	err := client.ReplicationGroups.Create(ctx, id, objectTypes, allowedAccounts, &CreateReplicationGroupOptions{
+		AllowedDatabases:    []AccountObjectIdentifier{databaseTest.ID()},
+		ReplicationSchedule: String("10 MINUTE"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ReplicationGroups.Drop(ctx, id, &DropReplicationGroupOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), replicationGroup.Name)
+		assert.True(t, replicationGroup.IsPrimary)
+		assert.Equal(t, "10 MINUTE", replicationGroup.ReplicationSchedule)
+		for _, allowedAccount := range allowedAccounts {
+			assert.Contains(t, replicationGroup.AllowedAccounts, allowedAccount)
+		}
+	})
+
+	t.Run("add and remove share", func(t *testing.T) {
+		err := client.ReplicationGroups.Alter(ctx, id, &AlterReplicationGroupOptions{
+			Add: &ReplicationGroupAdd{AllowedShares: []AccountObjectIdentifier{shareTest.ID()}},
+		})
+		require.NoError(t, err)
+		shares, err := client.ReplicationGroups.ShowShares(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, shares, 1)
+		assert.Equal(t, shareTest.ID().Name(), shares[0].Name())
+
+		err = client.ReplicationGroups.Alter(ctx, id, &AlterReplicationGroupOptions{
+			Remove: &ReplicationGroupRemove{AllowedShares: []AccountObjectIdentifier{shareTest.ID()}},
+		})
+		require.NoError(t, err)
+		shares, err = client.ReplicationGroups.ShowShares(ctx, id)
+		require.NoError(t, err)
+		assert.Len(t, shares, 0)
+	})
+
+	t.Run("show databases", func(t *testing.T) {
+		databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, databases, 1)
+		assert.Equal(t, databaseTest.ID().Name(), databases[0].Name())
+	})
+
+	t.Run("create secondary, refresh, suspend and resume", func(t *testing.T) {
+		primary, err := client.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		secondaryClient := testSecondaryClient(t)
+		err = secondaryClient.ReplicationGroups.CreateSecondary(ctx, id, primary.ExternalID(), nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := secondaryClient.ReplicationGroups.Drop(ctx, id, nil)
+			require.NoError(t, err)
+		})
+
+		err = secondaryClient.ReplicationGroups.Alter(ctx, id, &AlterReplicationGroupOptions{Refresh: Bool(true)})
+		require.NoError(t, err)
+		err = secondaryClient.ReplicationGroups.Alter(ctx, id, &AlterReplicationGroupOptions{Suspend: Bool(true)})
+		require.NoError(t, err)
+		secondary, err := secondaryClient.ReplicationGroups.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, FailoverGroupSecondaryStateSuspended, secondary.SecondaryState)
+		err = secondaryClient.ReplicationGroups.Alter(ctx, id, &AlterReplicationGroupOptions{Resume: Bool(true)})
+		require.NoError(t, err)
+	})
+}
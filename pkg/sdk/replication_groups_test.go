@@ -0,0 +1,193 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationGroupsCreate(t *testing.T) {
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			IfNotExists: Bool(true),
+			name:        NewAccountObjectIdentifier("rg1"),
+			objectTypes: []PluralObjectType{
+				PluralObjectTypeDatabases,
+				PluralObjectTypeShares,
+				PluralObjectTypeIntegrations,
+			},
+			AllowedDatabases:        []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+			AllowedShares:           []AccountObjectIdentifier{NewAccountObjectIdentifier("share1")},
+			AllowedIntegrationTypes: []IntegrationType{IntegrationTypeSecurityIntegrations},
+			allowedAccounts:         []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+			IgnoreEditionCheck:      Bool(true),
+			ReplicationSchedule:     String("10 MINUTE"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE REPLICATION GROUP IF NOT EXISTS "rg1" OBJECT_TYPES = DATABASES, SHARES, INTEGRATIONS ALLOWED_DATABASES = "db1" ALLOWED_SHARES = "share1" ALLOWED_INTEGRATION_TYPES = SECURITY INTEGRATIONS ALLOWED_ACCOUNTS = "MY_ORG.MY_ACCOUNT" IGNORE EDITION CHECK REPLICATION_SCHEDULE = '10 MINUTE'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			name:            NewAccountObjectIdentifier("rg1"),
+			objectTypes:     []PluralObjectType{PluralObjectTypeRoles},
+			allowedAccounts: []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE REPLICATION GROUP "rg1" OBJECT_TYPES = ROLES ALLOWED_ACCOUNTS = "MY_ORG.MY_ACCOUNT"`, actual)
+	})
+
+	t.Run("validation: allowed databases without object type", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			name:             NewAccountObjectIdentifier("rg1"),
+			objectTypes:      []PluralObjectType{PluralObjectTypeRoles},
+			AllowedDatabases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+			allowedAccounts:  []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no allowed accounts", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			name:        NewAccountObjectIdentifier("rg1"),
+			objectTypes: []PluralObjectType{PluralObjectTypeRoles},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestReplicationGroupsCreateSecondary(t *testing.T) {
+	opts := &createSecondaryReplicationGroupOptions{
+		IfNotExists:             Bool(true),
+		name:                    NewAccountObjectIdentifier("rg1"),
+		primaryReplicationGroup: NewExternalObjectIdentifierFromFullyQualifiedName("myorg.myaccount.rg1"),
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `CREATE REPLICATION GROUP IF NOT EXISTS "rg1" AS REPLICA OF myorg.myaccount."rg1"`, actual)
+}
+
+func TestReplicationGroupsAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("rg1")
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterReplicationGroupOptions{
+			name: id,
+			Set: &ReplicationGroupSet{
+				ObjectTypes:         []PluralObjectType{PluralObjectTypeDatabases},
+				ReplicationSchedule: String("USING CRON 0 0 * * * UTC"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER REPLICATION GROUP "rg1" SET OBJECT_TYPES = DATABASES REPLICATION_SCHEDULE = 'USING CRON 0 0 * * * UTC'`, actual)
+	})
+
+	t.Run("add accounts", func(t *testing.T) {
+		opts := &AlterReplicationGroupOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Add: &ReplicationGroupAdd{
+				AllowedAccounts:    []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+				IgnoreEditionCheck: Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER REPLICATION GROUP IF EXISTS "rg1" ADD "MY_ORG.MY_ACCOUNT" TO ALLOWED_ACCOUNTS IGNORE EDITION CHECK`, actual)
+	})
+
+	t.Run("remove databases", func(t *testing.T) {
+		opts := &AlterReplicationGroupOptions{
+			name:   id,
+			Remove: &ReplicationGroupRemove{AllowedDatabases: []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER REPLICATION GROUP "rg1" REMOVE "db1" FROM ALLOWED_DATABASES`, actual)
+	})
+
+	t.Run("move shares", func(t *testing.T) {
+		opts := &AlterReplicationGroupOptions{
+			name: id,
+			Move: &ReplicationGroupMove{
+				Shares: []AccountObjectIdentifier{NewAccountObjectIdentifier("share1")},
+				To:     NewAccountObjectIdentifier("rg2"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER REPLICATION GROUP "rg1" MOVE SHARES "share1" TO REPLICATION GROUP "rg2"`, actual)
+	})
+
+	t.Run("refresh, suspend and resume", func(t *testing.T) {
+		for keyword, opts := range map[string]*AlterReplicationGroupOptions{
+			"REFRESH": {name: id, Refresh: Bool(true)},
+			"SUSPEND": {name: id, Suspend: Bool(true)},
+			"RESUME":  {name: id, Resume: Bool(true)},
+		} {
+			require.NoError(t, opts.validate())
+			actual, err := structToSQL(opts)
+			require.NoError(t, err)
+			assert.Equal(t, `ALTER REPLICATION GROUP "rg1" `+keyword, actual)
+		}
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterReplicationGroupOptions{name: id}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: ignore edition check without accounts", func(t *testing.T) {
+		opts := &AlterReplicationGroupOptions{
+			name: id,
+			Add: &ReplicationGroupAdd{
+				AllowedDatabases:   []AccountObjectIdentifier{NewAccountObjectIdentifier("db1")},
+				IgnoreEditionCheck: Bool(true),
+			},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestReplicationGroupsDrop(t *testing.T) {
+	opts := &DropReplicationGroupOptions{IfExists: Bool(true), name: NewAccountObjectIdentifier("rg1")}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP REPLICATION GROUP IF EXISTS "rg1"`, actual)
+}
+
+func TestReplicationGroupsShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&ShowReplicationGroupOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW REPLICATION GROUPS`, actual)
+	})
+
+	t.Run("in account", func(t *testing.T) {
+		actual, err := structToSQL(&ShowReplicationGroupOptions{InAccount: NewAccountIdentifierFromAccountLocator("abcd123")})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW REPLICATION GROUPS IN ACCOUNT "abcd123"`, actual)
+	})
+
+	t.Run("databases and shares", func(t *testing.T) {
+		actual, err := structToSQL(&showReplicationGroupDatabasesOptions{in: NewAccountObjectIdentifier("rg1")})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW DATABASES IN REPLICATION GROUP "rg1"`, actual)
+		actual, err = structToSQL(&showReplicationGroupSharesOptions{in: NewAccountObjectIdentifier("rg1")})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW SHARES IN REPLICATION GROUP "rg1"`, actual)
+	})
+}
@@ -2,6 +2,13 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type ResourceMonitors interface {
@@ -15,6 +22,25 @@ type ResourceMonitors interface {
 	Show(ctx context.Context, opts *ShowResourceMonitorOptions) ([]*ResourceMonitor, error)
 	// ShowByID returns a resource monitor by ID
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ResourceMonitor, error)
+	// ShowByIDs returns the resource monitors with the given IDs using a single SHOW, IDs that do not exist are skipped.
+	ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*ResourceMonitor, error)
+	// SetOnWarehouse assigns a resource monitor to a warehouse, replacing the one assigned before.
+	SetOnWarehouse(ctx context.Context, id AccountObjectIdentifier, warehouse AccountObjectIdentifier) error
+	// UnsetFromWarehouse removes the resource monitor assigned to a warehouse.
+	UnsetFromWarehouse(ctx context.Context, warehouse AccountObjectIdentifier) error
+	// ShowWarehouses returns the warehouses a resource monitor is assigned to.
+	ShowWarehouses(ctx context.Context, id AccountObjectIdentifier) ([]*Warehouse, error)
+	// SetOnAccount assigns a resource monitor to the account, replacing the one assigned before.
+	SetOnAccount(ctx context.Context, id AccountObjectIdentifier) error
+	// UnsetOnAccount removes the resource monitor assigned to the account.
+	UnsetOnAccount(ctx context.Context) error
+	// ShowOnAccount returns the resource monitor assigned to the account.
+	ShowOnAccount(ctx context.Context) (*ResourceMonitor, error)
+	// ClearTriggers removes all triggers of a resource monitor. It re-creates the resource monitor, which resets
+	// its used credits and drops its grants, and restores its assignments and owner non-atomically.
+	ClearTriggers(ctx context.Context, id AccountObjectIdentifier) error
+	// GrantOwnership transfers the ownership of a resource monitor to an account role.
+	GrantOwnership(ctx context.Context, id AccountObjectIdentifier, role AccountObjectIdentifier, opts *GrantResourceMonitorOwnershipOptions) error
 }
 
 var _ ResourceMonitors = (*resourceMonitors)(nil)
@@ -23,18 +49,147 @@ type resourceMonitors struct {
 	client *Client
 }
 
+// ResourceMonitorLevelAccount is the level of the resource monitor assigned to the account.
+const ResourceMonitorLevelAccount = "ACCOUNT"
+
 type ResourceMonitor struct {
 	Name string
+	// CreditQuota is nil when the resource monitor has no quota.
+	CreditQuota          *float64
+	UsedCredits          float64
+	RemainingCredits     float64
+	Level                string
+	Frequency            ResourceMonitorFrequency
+	StartTime            *time.Time
+	EndTime              *time.Time
+	NotifyAt             string
+	SuspendAt            string
+	SuspendImmediatelyAt string
+	Owner                string
+	Comment              string
+	NotifyUsers          []string
 }
 
 type resourceMonitorRow struct {
-	Name string `db:"name"`
+	Name                 string         `db:"name"`
+	CreditQuota          sql.NullString `db:"credit_quota"`
+	UsedCredits          sql.NullString `db:"used_credits"`
+	RemainingCredits     sql.NullString `db:"remaining_credits"`
+	Level                sql.NullString `db:"level"`
+	Frequency            sql.NullString `db:"frequency"`
+	StartTime            sql.NullString `db:"start_time"`
+	EndTime              sql.NullString `db:"end_time"`
+	NotifyAt             sql.NullString `db:"notify_at"`
+	SuspendAt            sql.NullString `db:"suspend_at"`
+	SuspendImmediatelyAt sql.NullString `db:"suspend_immediately_at"`
+	Owner                sql.NullString `db:"owner"`
+	Comment              sql.NullString `db:"comment"`
+	NotifyUsers          sql.NullString `db:"notify_users"`
+}
+
+// resourceMonitorTimeLayouts are the formats of start_time and end_time in SHOW RESOURCE MONITORS with an offset.
+var resourceMonitorTimeLayouts = []string{
+	"2006-01-02 15:04:05.000 -0700",
+	"2006-01-02 15:04:05 -0700",
 }
 
-func (row *resourceMonitorRow) toResourceMonitor() *ResourceMonitor {
-	return &ResourceMonitor{
-		Name: row.Name,
+// resourceMonitorLocalTimeLayouts are the formats of start_time and end_time without an offset, which are in the
+// session TIMEZONE.
+var resourceMonitorLocalTimeLayouts = []string{
+	"2006-01-02 15:04:05.000",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// parseResourceMonitorTime parses start_time or end_time. The offset is kept for values that have one, values
+// without it are interpreted in the session TIMEZONE, which is only looked up with location in that case.
+func parseResourceMonitorTime(raw string, location func() (*time.Location, error)) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	for _, layout := range resourceMonitorTimeLayouts {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return &t, nil
+		}
 	}
+	for _, layout := range resourceMonitorLocalTimeLayouts {
+		if _, err := time.Parse(layout, raw); err != nil {
+			continue
+		}
+		loc, err := location()
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.ParseInLocation(layout, raw, loc)
+		if err != nil {
+			return nil, err
+		}
+		return &t, nil
+	}
+	return nil, fmt.Errorf("invalid resource monitor timestamp %q", raw)
+}
+
+// sessionLocation returns a function loading the location of the session TIMEZONE once. Hosts without tzdata
+// fall back to UTC.
+func (v *resourceMonitors) sessionLocation(ctx context.Context) func() (*time.Location, error) {
+	var location *time.Location
+	return func() (*time.Location, error) {
+		if location != nil {
+			return location, nil
+		}
+		parameter, err := v.client.Sessions.ShowSessionParameter(ctx, SessionParameterTimezone)
+		if err != nil {
+			return nil, err
+		}
+		location, err = time.LoadLocation(parameter.Value)
+		if err != nil {
+			log.Printf("[WARN] could not load the session timezone %s, using UTC: %v\n", parameter.Value, err)
+			location = time.UTC
+		}
+		return location, nil
+	}
+}
+
+func (row *resourceMonitorRow) toResourceMonitor(strict bool, location func() (*time.Location, error)) (*ResourceMonitor, error) {
+	frequency, err := toEnum(strict, "resource monitor frequency", row.Frequency.String, allResourceMonitorFrequencies)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := parseResourceMonitorTime(row.StartTime.String, location)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := parseResourceMonitorTime(row.EndTime.String, location)
+	if err != nil {
+		return nil, err
+	}
+	resourceMonitor := &ResourceMonitor{
+		Name:                 row.Name,
+		Level:                row.Level.String,
+		Frequency:            frequency,
+		StartTime:            startTime,
+		EndTime:              endTime,
+		NotifyAt:             row.NotifyAt.String,
+		SuspendAt:            row.SuspendAt.String,
+		SuspendImmediatelyAt: row.SuspendImmediatelyAt.String,
+		Owner:                row.Owner.String,
+		Comment:              row.Comment.String,
+	}
+	if quota, err := strconv.ParseFloat(row.CreditQuota.String, 64); err == nil {
+		resourceMonitor.CreditQuota = &quota
+	}
+	if used, err := strconv.ParseFloat(row.UsedCredits.String, 64); err == nil {
+		resourceMonitor.UsedCredits = used
+	}
+	if remaining, err := strconv.ParseFloat(row.RemainingCredits.String, 64); err == nil {
+		resourceMonitor.RemainingCredits = remaining
+	}
+	if row.NotifyUsers.String != "" {
+		for _, user := range strings.Split(row.NotifyUsers.String, ",") {
+			resourceMonitor.NotifyUsers = append(resourceMonitor.NotifyUsers, strings.TrimSpace(user))
+		}
+	}
+	return resourceMonitor, nil
 }
 
 func (v *ResourceMonitor) ID() AccountObjectIdentifier {
@@ -47,15 +202,111 @@ func (v *ResourceMonitor) ObjectType() ObjectType {
 
 // CreateResourceMonitorOptions contains options for creating a resource monitor.
 type CreateResourceMonitorOptions struct {
-	create          bool                    `ddl:"static" sql:"CREATE"`           //lint:ignore U1000 This is used in the ddl tag
+	create          bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace       *bool                   `ddl:"keyword" sql:"OR REPLACE"`
 	resourceMonitor bool                    `ddl:"static" sql:"RESOURCE MONITOR"` //lint:ignore U1000 This is used in the ddl tag
 	name            AccountObjectIdentifier `ddl:"identifier"`
+	With            *ResourceMonitorWith    `ddl:"keyword" sql:"WITH"`
+	Triggers        []TriggerDefinition     `ddl:"keyword,no_comma" sql:"TRIGGERS"`
 }
 
 func (opts *CreateResourceMonitorOptions) validate() error {
 	if !validObjectidentifier(opts.name) {
 		return ErrInvalidObjectIdentifier
 	}
+	if valueSet(opts.With) {
+		if err := opts.With.validate(); err != nil {
+			return err
+		}
+	}
+	return validateTriggers(opts.Triggers)
+}
+
+type TriggerAction string
+
+const (
+	TriggerActionSuspend          TriggerAction = "SUSPEND"
+	TriggerActionSuspendImmediate TriggerAction = "SUSPEND_IMMEDIATE"
+	TriggerActionNotify           TriggerAction = "NOTIFY"
+)
+
+// TriggerDefinition performs an action when the used credits reach a percentage of the credit quota. The
+// threshold can be above 100, e.g. to be notified when warehouses keep running after the quota is used up.
+type TriggerDefinition struct {
+	Threshold     int           `ddl:"parameter,no_equals" sql:"ON"`
+	TriggerAction TriggerAction `ddl:"parameter,no_equals" sql:"PERCENT DO"`
+}
+
+// Limits of the triggers of a resource monitor.
+const (
+	maxNotifyTriggers           = 5
+	maxSuspendTriggers          = 1
+	maxSuspendImmediateTriggers = 1
+)
+
+// validateTriggers checks the triggers against the limits of Snowflake: up to five NOTIFY, one SUSPEND and one
+// SUSPEND_IMMEDIATE trigger with unique thresholds, and SUSPEND_IMMEDIATE not before SUSPEND. The returned
+// errors wrap ErrInvalidTriggers.
+func validateTriggers(triggers []TriggerDefinition) error {
+	counts := make(map[TriggerAction]int)
+	thresholds := make(map[int]bool)
+	var suspendAt, suspendImmediatelyAt int
+	for _, trigger := range triggers {
+		if trigger.Threshold <= 0 {
+			return fmt.Errorf("%w: threshold %d must be greater than 0", ErrInvalidTriggers, trigger.Threshold)
+		}
+		if thresholds[trigger.Threshold] {
+			return fmt.Errorf("%w: threshold %d is used more than once", ErrInvalidTriggers, trigger.Threshold)
+		}
+		thresholds[trigger.Threshold] = true
+		switch trigger.TriggerAction {
+		case TriggerActionSuspend:
+			suspendAt = trigger.Threshold
+		case TriggerActionSuspendImmediate:
+			suspendImmediatelyAt = trigger.Threshold
+		case TriggerActionNotify:
+		default:
+			return fmt.Errorf("%w: unknown action %q", ErrInvalidTriggers, trigger.TriggerAction)
+		}
+		counts[trigger.TriggerAction]++
+	}
+	if counts[TriggerActionNotify] > maxNotifyTriggers {
+		return fmt.Errorf("%w: at most %d %s triggers can be set", ErrInvalidTriggers, maxNotifyTriggers, TriggerActionNotify)
+	}
+	if counts[TriggerActionSuspend] > maxSuspendTriggers {
+		return fmt.Errorf("%w: at most %d %s trigger can be set", ErrInvalidTriggers, maxSuspendTriggers, TriggerActionSuspend)
+	}
+	if counts[TriggerActionSuspendImmediate] > maxSuspendImmediateTriggers {
+		return fmt.Errorf("%w: at most %d %s trigger can be set", ErrInvalidTriggers, maxSuspendImmediateTriggers, TriggerActionSuspendImmediate)
+	}
+	if suspendAt != 0 && suspendImmediatelyAt != 0 && suspendImmediatelyAt < suspendAt {
+		return fmt.Errorf("%w: %s threshold %d is lower than %s threshold %d", ErrInvalidTriggers, TriggerActionSuspendImmediate, suspendImmediatelyAt, TriggerActionSuspend, suspendAt)
+	}
+	return nil
+}
+
+type ResourceMonitorWith struct {
+	CreditQuota    *float64                  `ddl:"parameter" sql:"CREDIT_QUOTA" validate:"at_least_one_of=CreditQuota,Frequency,StartTimestamp,EndTimestamp,NotifyUsers"`
+	Frequency      *ResourceMonitorFrequency `ddl:"parameter" sql:"FREQUENCY" validate:"all_of=Frequency,StartTimestamp"`
+	StartTimestamp *ResourceMonitorTimestamp `ddl:"parameter,single_quotes" sql:"START_TIMESTAMP"`
+	EndTimestamp   *ResourceMonitorTimestamp `ddl:"parameter,single_quotes" sql:"END_TIMESTAMP"`
+	NotifyUsers    []NotifiedUser            `ddl:"parameter,parentheses" sql:"NOTIFY_USERS"`
+}
+
+func (v *ResourceMonitorWith) validate() error {
+	if err := validateTags(v); err != nil {
+		return err
+	}
+	if valueSet(v.EndTimestamp) && *v.EndTimestamp == ResourceMonitorTimestampImmediately {
+		return errors.New("EndTimestamp cannot be IMMEDIATELY")
+	}
+	return validateCreditQuota(v.CreditQuota)
+}
+
+func validateCreditQuota(creditQuota *float64) error {
+	if creditQuota != nil && *creditQuota <= 0 {
+		return errors.New("CreditQuota must be greater than 0")
+	}
 	return nil
 }
 
@@ -67,7 +318,7 @@ func (v *resourceMonitors) Create(ctx context.Context, id AccountObjectIdentifie
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -76,10 +327,118 @@ func (v *resourceMonitors) Create(ctx context.Context, id AccountObjectIdentifie
 }
 
 // AlterResourceMonitorOptions contains options for altering a resource monitor.
-type AlterResourceMonitorOptions struct{}
+type AlterResourceMonitorOptions struct {
+	alter           bool                    `ddl:"static" sql:"ALTER"`            //lint:ignore U1000 This is used in the ddl tag
+	resourceMonitor bool                    `ddl:"static" sql:"RESOURCE MONITOR"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists        *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name            AccountObjectIdentifier `ddl:"identifier"`
+
+	Set   *ResourceMonitorSet   `ddl:"keyword" sql:"SET" validate:"at_least_one_of=Set,Unset,Triggers;at_most_one_of=Set,Unset"`
+	Unset *ResourceMonitorUnset `ddl:"keyword" sql:"SET"`
+	// Triggers replace all triggers of the resource monitor. ALTER cannot remove all of them, use ClearTriggers.
+	Triggers []TriggerDefinition `ddl:"keyword,no_comma" sql:"TRIGGERS"`
+}
+
+func (opts *AlterResourceMonitorOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if err := validateTags(opts); err != nil {
+		return err
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return validateTriggers(opts.Triggers)
+}
+
+type ResourceMonitorFrequency string
+
+const (
+	ResourceMonitorFrequencyMonthly ResourceMonitorFrequency = "MONTHLY"
+	ResourceMonitorFrequencyDaily   ResourceMonitorFrequency = "DAILY"
+	ResourceMonitorFrequencyWeekly  ResourceMonitorFrequency = "WEEKLY"
+	ResourceMonitorFrequencyYearly  ResourceMonitorFrequency = "YEARLY"
+	ResourceMonitorFrequencyNever   ResourceMonitorFrequency = "NEVER"
+)
+
+var allResourceMonitorFrequencies = []ResourceMonitorFrequency{
+	ResourceMonitorFrequencyMonthly,
+	ResourceMonitorFrequencyDaily,
+	ResourceMonitorFrequencyWeekly,
+	ResourceMonitorFrequencyYearly,
+	ResourceMonitorFrequencyNever,
+}
+
+// ResourceMonitorTimestamp is the start or end of a resource monitor, either a point in time created with
+// NewResourceMonitorTimestamp or ResourceMonitorTimestampImmediately.
+type ResourceMonitorTimestamp string
+
+// ResourceMonitorTimestampImmediately starts a resource monitor right away.
+const ResourceMonitorTimestampImmediately ResourceMonitorTimestamp = "IMMEDIATELY"
+
+// NewResourceMonitorTimestamp returns the timestamp of a point in time. It is rendered with its offset, so that
+// it does not depend on the session TIMEZONE.
+func NewResourceMonitorTimestamp(t time.Time) ResourceMonitorTimestamp {
+	return ResourceMonitorTimestamp(t.Format("2006-01-02 15:04:05 -0700"))
+}
+
+type NotifiedUser struct {
+	Name string `ddl:"keyword,double_quotes"`
+}
+
+type ResourceMonitorSet struct {
+	CreditQuota *float64 `ddl:"parameter" sql:"CREDIT_QUOTA" validate:"at_least_one_of=CreditQuota,Frequency,StartTimestamp,EndTimestamp,NotifyUsers"`
+	// Frequency and StartTimestamp have to be set together.
+	Frequency      *ResourceMonitorFrequency `ddl:"parameter" sql:"FREQUENCY" validate:"all_of=Frequency,StartTimestamp"`
+	StartTimestamp *ResourceMonitorTimestamp `ddl:"parameter,single_quotes" sql:"START_TIMESTAMP"`
+	EndTimestamp   *ResourceMonitorTimestamp `ddl:"parameter,single_quotes" sql:"END_TIMESTAMP"`
+	NotifyUsers    []NotifiedUser            `ddl:"parameter,parentheses" sql:"NOTIFY_USERS"`
+}
+
+func (v *ResourceMonitorSet) validate() error {
+	if err := validateTags(v); err != nil {
+		return err
+	}
+	if valueSet(v.EndTimestamp) && *v.EndTimestamp == ResourceMonitorTimestampImmediately {
+		return errors.New("EndTimestamp cannot be IMMEDIATELY")
+	}
+	return validateCreditQuota(v.CreditQuota)
+}
+
+// ResourceMonitorUnset clears properties of a resource monitor. Snowflake has no UNSET for resource monitors,
+// so the properties are set to NULL, or to an empty list for the notified users.
+type ResourceMonitorUnset struct {
+	CreditQuota  *bool `ddl:"keyword" sql:"CREDIT_QUOTA = NULL" validate:"at_least_one_of=CreditQuota,EndTimestamp,NotifyUsers"`
+	EndTimestamp *bool `ddl:"keyword" sql:"END_TIMESTAMP = NULL"`
+	NotifyUsers  *bool `ddl:"keyword" sql:"NOTIFY_USERS = ()"`
+}
+
+func (v *ResourceMonitorUnset) validate() error {
+	return validateTags(v)
+}
 
 func (v *resourceMonitors) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterResourceMonitorOptions) error {
-	return nil
+	if opts == nil {
+		opts = &AlterResourceMonitorOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
 }
 
 // resourceMonitorDropOptions contains options for dropping a resource monitor.
@@ -103,7 +462,7 @@ func (v *resourceMonitors) Drop(ctx context.Context, id AccountObjectIdentifier)
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -129,7 +488,7 @@ func (v *resourceMonitors) Show(ctx context.Context, opts *ShowResourceMonitorOp
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -138,18 +497,21 @@ func (v *resourceMonitors) Show(ctx context.Context, opts *ShowResourceMonitorOp
 	if err != nil {
 		return nil, err
 	}
+	location := v.sessionLocation(ctx)
 	resourceMonitors := make([]*ResourceMonitor, 0, len(rows))
 	for _, row := range rows {
-		resourceMonitors = append(resourceMonitors, row.toResourceMonitor())
+		resourceMonitor, err := row.toResourceMonitor(v.client.strictEnumParsing, location)
+		if err != nil {
+			return nil, err
+		}
+		resourceMonitors = append(resourceMonitors, resourceMonitor)
 	}
 	return resourceMonitors, nil
 }
 
 func (v *resourceMonitors) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ResourceMonitor, error) {
 	resourceMonitors, err := v.Show(ctx, &ShowResourceMonitorOptions{
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+		Like: likeExactly(id.Name()),
 	})
 	if err != nil {
 		return nil, err
@@ -161,3 +523,190 @@ func (v *resourceMonitors) ShowByID(ctx context.Context, id AccountObjectIdentif
 	}
 	return nil, ErrObjectNotExistOrAuthorized
 }
+
+func (v *resourceMonitors) ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*ResourceMonitor, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	resourceMonitors, err := v.Show(ctx, &ShowResourceMonitorOptions{
+		Like: likeIDs(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filterByIDs(resourceMonitors, ids), nil
+}
+
+func (v *resourceMonitors) SetOnWarehouse(ctx context.Context, id AccountObjectIdentifier, warehouse AccountObjectIdentifier) error {
+	if !validObjectidentifier(id) {
+		return ErrInvalidObjectIdentifier
+	}
+	return v.client.Warehouses.Alter(ctx, warehouse, &AlterWarehouseOptions{
+		Set: &WarehouseSet{
+			ResourceMonitor: id,
+		},
+	})
+}
+
+func (v *resourceMonitors) UnsetFromWarehouse(ctx context.Context, warehouse AccountObjectIdentifier) error {
+	return v.client.Warehouses.Alter(ctx, warehouse, &AlterWarehouseOptions{
+		Unset: &WarehouseUnset{
+			ResourceMonitor: Bool(true),
+		},
+	})
+}
+
+func (v *resourceMonitors) ShowWarehouses(ctx context.Context, id AccountObjectIdentifier) ([]*Warehouse, error) {
+	if !validObjectidentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	warehouses, err := v.client.Warehouses.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resultList []*Warehouse
+	for _, warehouse := range warehouses {
+		if warehouse.ResourceMonitor == id.Name() {
+			resultList = append(resultList, warehouse)
+		}
+	}
+	return resultList, nil
+}
+
+func (v *resourceMonitors) SetOnAccount(ctx context.Context, id AccountObjectIdentifier) error {
+	if !validObjectidentifier(id) {
+		return ErrInvalidObjectIdentifier
+	}
+	return v.client.Accounts.Alter(ctx, &AlterAccountOptions{
+		Set: &AccountSet{
+			ResourceMonitor: id,
+		},
+	})
+}
+
+// unsetAccountResourceMonitorOptions removes the resource monitor of the account, which ALTER ACCOUNT UNSET
+// does not support.
+type unsetAccountResourceMonitorOptions struct {
+	alterAccount bool `ddl:"static" sql:"ALTER ACCOUNT"`               //lint:ignore U1000 This is used in the ddl tag
+	set          bool `ddl:"static" sql:"SET RESOURCE_MONITOR = NULL"` //lint:ignore U1000 This is used in the ddl tag
+}
+
+func (v *resourceMonitors) UnsetOnAccount(ctx context.Context) error {
+	sql, err := v.client.structToSQL(&unsetAccountResourceMonitorOptions{})
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *resourceMonitors) ShowOnAccount(ctx context.Context) (*ResourceMonitor, error) {
+	resourceMonitors, err := v.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, resourceMonitor := range resourceMonitors {
+		if resourceMonitor.Level == ResourceMonitorLevelAccount {
+			return resourceMonitor, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// ClearTriggers re-creates the resource monitor with CREATE OR REPLACE, as Snowflake cannot remove all triggers
+// with ALTER RESOURCE MONITOR. The other properties, the assignments to the account and to warehouses, and the
+// owner are restored afterwards. This has side effects:
+//   - the used credits of the current interval are reset;
+//   - it is not atomic, warehouses and the account are unmonitored until they are re-assigned, and if restoring
+//     fails the resource monitor is left without (some of) its assignments or owned by the current role;
+//   - grants to other roles than the owner are dropped.
+func (v *resourceMonitors) ClearTriggers(ctx context.Context, id AccountObjectIdentifier) error {
+	resourceMonitor, err := v.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	warehouses, err := v.ShowWarehouses(ctx, id)
+	if err != nil {
+		return err
+	}
+	with := &ResourceMonitorWith{
+		CreditQuota: resourceMonitor.CreditQuota,
+	}
+	if resourceMonitor.StartTime != nil {
+		with.Frequency = Pointer(resourceMonitor.Frequency)
+		with.StartTimestamp = Pointer(NewResourceMonitorTimestamp(*resourceMonitor.StartTime))
+	}
+	if resourceMonitor.EndTime != nil {
+		with.EndTimestamp = Pointer(NewResourceMonitorTimestamp(*resourceMonitor.EndTime))
+	}
+	for _, user := range resourceMonitor.NotifyUsers {
+		with.NotifyUsers = append(with.NotifyUsers, NotifiedUser{Name: user})
+	}
+	opts := &CreateResourceMonitorOptions{
+		OrReplace: Bool(true),
+	}
+	if anyValueSet(with.CreditQuota, with.Frequency, with.EndTimestamp, with.NotifyUsers) {
+		opts.With = with
+	}
+	if err := v.Create(ctx, id, opts); err != nil {
+		return err
+	}
+	if resourceMonitor.Level == ResourceMonitorLevelAccount {
+		if err := v.SetOnAccount(ctx, id); err != nil {
+			return fmt.Errorf("resource monitor %s was re-created, but setting it on the account failed: %w", id.FullyQualifiedName(), err)
+		}
+	}
+	for _, warehouse := range warehouses {
+		if err := v.SetOnWarehouse(ctx, id, warehouse.ID()); err != nil {
+			return fmt.Errorf("resource monitor %s was re-created, but setting it on warehouse %s failed: %w", id.FullyQualifiedName(), warehouse.ID().FullyQualifiedName(), err)
+		}
+	}
+	if resourceMonitor.Owner == "" {
+		return nil
+	}
+	currentRole, err := v.client.ContextFunctions.CurrentRole(ctx)
+	if err != nil {
+		return err
+	}
+	if resourceMonitor.Owner != currentRole {
+		owner := NewAccountObjectIdentifier(resourceMonitor.Owner)
+		if err := v.GrantOwnership(ctx, id, owner, nil); err != nil {
+			return fmt.Errorf("resource monitor %s was re-created, but restoring its owner %s failed: %w", id.FullyQualifiedName(), owner.FullyQualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+// GrantResourceMonitorOwnershipOptions contains options for transferring the ownership of a resource monitor.
+// Resource monitors are created by ACCOUNTADMIN, this hands them over to a custom role.
+type GrantResourceMonitorOwnershipOptions struct {
+	grantOwnership      bool                    `ddl:"static" sql:"GRANT OWNERSHIP ON RESOURCE MONITOR"` //lint:ignore U1000 This is used in the ddl tag
+	name                AccountObjectIdentifier `ddl:"identifier"`
+	role                AccountObjectIdentifier `ddl:"identifier" sql:"TO ROLE"`
+	RevokeCurrentGrants *bool                   `ddl:"keyword" sql:"REVOKE CURRENT GRANTS" validate:"at_most_one_of=RevokeCurrentGrants,CopyCurrentGrants"`
+	CopyCurrentGrants   *bool                   `ddl:"keyword" sql:"COPY CURRENT GRANTS"`
+}
+
+func (opts *GrantResourceMonitorOwnershipOptions) validate() error {
+	if !validObjectidentifier(opts.name) || !validObjectidentifier(opts.role) {
+		return ErrInvalidObjectIdentifier
+	}
+	return validateTags(opts)
+}
+
+func (v *resourceMonitors) GrantOwnership(ctx context.Context, id AccountObjectIdentifier, role AccountObjectIdentifier, opts *GrantResourceMonitorOwnershipOptions) error {
+	if opts == nil {
+		opts = &GrantResourceMonitorOwnershipOptions{}
+	}
+	opts.name = id
+	opts.role = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
@@ -0,0 +1,190 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ResourceMonitorCreate(t *testing.T) {
+	client := testClient(t)
+
+	resourceMonitor, resourceMonitorCleanup := createResourceMonitorWithOptions(t, client, &CreateResourceMonitorOptions{
+		With: &ResourceMonitorWith{CreditQuota: Float64(0.5)},
+	})
+	t.Cleanup(resourceMonitorCleanup)
+
+	assert.Equal(t, Float64(0.5), resourceMonitor.CreditQuota)
+}
+
+func TestInt_ResourceMonitorAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	resourceMonitor, resourceMonitorCleanup := createResourceMonitor(t, client)
+	t.Cleanup(resourceMonitorCleanup)
+
+	t.Run("set", func(t *testing.T) {
+		err := client.ResourceMonitors.Alter(ctx, resourceMonitor.ID(), &AlterResourceMonitorOptions{
+			Set: &ResourceMonitorSet{CreditQuota: Float64(2.5)},
+		})
+		require.NoError(t, err)
+		resourceMonitor, err := client.ResourceMonitors.ShowByID(ctx, resourceMonitor.ID())
+		require.NoError(t, err)
+		assert.Equal(t, Float64(2.5), resourceMonitor.CreditQuota)
+	})
+
+	t.Run("set timestamps", func(t *testing.T) {
+		start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+		end := start.Add(30 * 24 * time.Hour)
+		err := client.ResourceMonitors.Alter(ctx, resourceMonitor.ID(), &AlterResourceMonitorOptions{
+			Set: &ResourceMonitorSet{
+				Frequency:      Pointer(ResourceMonitorFrequencyDaily),
+				StartTimestamp: Pointer(NewResourceMonitorTimestamp(start)),
+				EndTimestamp:   Pointer(NewResourceMonitorTimestamp(end)),
+			},
+		})
+		require.NoError(t, err)
+		resourceMonitor, err := client.ResourceMonitors.ShowByID(ctx, resourceMonitor.ID())
+		require.NoError(t, err)
+		require.NotNil(t, resourceMonitor.StartTime)
+		require.NotNil(t, resourceMonitor.EndTime)
+		assert.True(t, start.Equal(*resourceMonitor.StartTime))
+		assert.True(t, end.Equal(*resourceMonitor.EndTime))
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		err := client.ResourceMonitors.Alter(ctx, resourceMonitor.ID(), &AlterResourceMonitorOptions{
+			Unset: &ResourceMonitorUnset{CreditQuota: Bool(true), EndTimestamp: Bool(true), NotifyUsers: Bool(true)},
+		})
+		require.NoError(t, err)
+		resourceMonitor, err := client.ResourceMonitors.ShowByID(ctx, resourceMonitor.ID())
+		require.NoError(t, err)
+		assert.Nil(t, resourceMonitor.CreditQuota)
+		assert.Nil(t, resourceMonitor.EndTime)
+	})
+}
+
+func TestInt_ResourceMonitorWarehouses(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	resourceMonitor, resourceMonitorCleanup := createResourceMonitor(t, client)
+	t.Cleanup(resourceMonitorCleanup)
+	warehouse, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+
+	err := client.ResourceMonitors.SetOnWarehouse(ctx, resourceMonitor.ID(), warehouse.ID())
+	require.NoError(t, err)
+	warehouses, err := client.ResourceMonitors.ShowWarehouses(ctx, resourceMonitor.ID())
+	require.NoError(t, err)
+	require.Len(t, warehouses, 1)
+	assert.Equal(t, warehouse.ID(), warehouses[0].ID())
+
+	err = client.ResourceMonitors.UnsetFromWarehouse(ctx, warehouse.ID())
+	require.NoError(t, err)
+	warehouses, err = client.ResourceMonitors.ShowWarehouses(ctx, resourceMonitor.ID())
+	require.NoError(t, err)
+	assert.Empty(t, warehouses)
+}
+
+func TestInt_ResourceMonitorAccount(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	previous, err := client.ResourceMonitors.ShowOnAccount(ctx)
+	if err != nil {
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	}
+	resourceMonitor, resourceMonitorCleanup := createResourceMonitor(t, client)
+	t.Cleanup(resourceMonitorCleanup)
+
+	err = client.ResourceMonitors.SetOnAccount(ctx, resourceMonitor.ID())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if previous != nil {
+			err := client.ResourceMonitors.SetOnAccount(ctx, previous.ID())
+			require.NoError(t, err)
+			return
+		}
+		err := client.ResourceMonitors.UnsetOnAccount(ctx)
+		require.NoError(t, err)
+	})
+
+	onAccount, err := client.ResourceMonitors.ShowOnAccount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, resourceMonitor.ID(), onAccount.ID())
+}
+
+func TestInt_ResourceMonitorClearTriggers(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	resourceMonitor, resourceMonitorCleanup := createResourceMonitorWithOptions(t, client, &CreateResourceMonitorOptions{
+		With:     &ResourceMonitorWith{CreditQuota: Float64(10)},
+		Triggers: []TriggerDefinition{{Threshold: 80, TriggerAction: TriggerActionNotify}, {Threshold: 100, TriggerAction: TriggerActionSuspend}},
+	})
+	t.Cleanup(resourceMonitorCleanup)
+	warehouse, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+	err := client.ResourceMonitors.SetOnWarehouse(ctx, resourceMonitor.ID(), warehouse.ID())
+	require.NoError(t, err)
+
+	err = client.ResourceMonitors.ClearTriggers(ctx, resourceMonitor.ID())
+	require.NoError(t, err)
+
+	cleared, err := client.ResourceMonitors.ShowByID(ctx, resourceMonitor.ID())
+	require.NoError(t, err)
+	assert.Equal(t, Float64(10), cleared.CreditQuota)
+	assert.Equal(t, resourceMonitor.Owner, cleared.Owner)
+	assert.Empty(t, cleared.NotifyAt)
+	assert.Empty(t, cleared.SuspendAt)
+	warehouses, err := client.ResourceMonitors.ShowWarehouses(ctx, resourceMonitor.ID())
+	require.NoError(t, err)
+	require.Len(t, warehouses, 1)
+	assert.Equal(t, warehouse.ID(), warehouses[0].ID())
+}
+
+func TestInt_ResourceMonitorGrantOwnership(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	resourceMonitor, resourceMonitorCleanup := createResourceMonitor(t, client)
+	t.Cleanup(resourceMonitorCleanup)
+	roleID := randomAccountObjectIdentifier(t)
+	err := client.Roles.Create(ctx, roleID, nil)
+	require.NoError(t, err)
+	// dropping the role hands the ownership back to the current role before the resource monitor is dropped
+	t.Cleanup(func() {
+		err := client.Roles.Drop(ctx, roleID, nil)
+		require.NoError(t, err)
+	})
+
+	err = client.ResourceMonitors.GrantOwnership(ctx, resourceMonitor.ID(), roleID, &GrantResourceMonitorOwnershipOptions{
+		CopyCurrentGrants: Bool(true),
+	})
+	require.NoError(t, err)
+
+	resourceMonitor, err = client.ResourceMonitors.ShowByID(ctx, resourceMonitor.ID())
+	require.NoError(t, err)
+	assert.Equal(t, roleID.Name(), resourceMonitor.Owner)
+}
+
+func TestInt_ResourceMonitorShowByIDs(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	first, firstCleanup := createResourceMonitor(t, client)
+	t.Cleanup(firstCleanup)
+	second, secondCleanup := createResourceMonitor(t, client)
+	t.Cleanup(secondCleanup)
+
+	resourceMonitors, err := client.ResourceMonitors.ShowByIDs(ctx, []AccountObjectIdentifier{second.ID(), randomAccountObjectIdentifier(t), first.ID()})
+	require.NoError(t, err)
+	require.Len(t, resourceMonitors, 2)
+	assert.Equal(t, second.ID(), resourceMonitors[0].ID())
+	assert.Equal(t, first.ID(), resourceMonitors[1].ID())
+}
@@ -0,0 +1,438 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceMonitorCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("monitor")
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreateResourceMonitorOptions{name: id}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE RESOURCE MONITOR "monitor"`, actual)
+	})
+
+	t.Run("with fractional credit quota", func(t *testing.T) {
+		opts := &CreateResourceMonitorOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			With: &ResourceMonitorWith{
+				CreditQuota:    Float64(0.5),
+				Frequency:      Pointer(ResourceMonitorFrequencyMonthly),
+				StartTimestamp: Pointer(ResourceMonitorTimestampImmediately),
+				NotifyUsers:    []NotifiedUser{{Name: "JOHN"}},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE RESOURCE MONITOR "monitor" WITH CREDIT_QUOTA = 0.5 FREQUENCY = MONTHLY START_TIMESTAMP = 'IMMEDIATELY' NOTIFY_USERS = ("JOHN")`, actual)
+	})
+
+	t.Run("validation: credit quota not positive", func(t *testing.T) {
+		opts := &CreateResourceMonitorOptions{
+			name: id,
+			With: &ResourceMonitorWith{CreditQuota: Float64(0)},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("with triggers", func(t *testing.T) {
+		opts := &CreateResourceMonitorOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			With:      &ResourceMonitorWith{CreditQuota: Float64(10)},
+			Triggers:  []TriggerDefinition{{Threshold: 90, TriggerAction: TriggerActionSuspend}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE RESOURCE MONITOR "monitor" WITH CREDIT_QUOTA = 10 TRIGGERS ON 90 PERCENT DO SUSPEND`, actual)
+	})
+}
+
+func TestResourceMonitorRow(t *testing.T) {
+	t.Run("with quota", func(t *testing.T) {
+		row := &resourceMonitorRow{
+			Name:             "MONITOR",
+			CreditQuota:      sql.NullString{String: "0.50", Valid: true},
+			UsedCredits:      sql.NullString{String: "0.25", Valid: true},
+			RemainingCredits: sql.NullString{String: "0.25", Valid: true},
+			Level:            sql.NullString{String: "ACCOUNT", Valid: true},
+			Frequency:        sql.NullString{String: "MONTHLY", Valid: true},
+			Owner:            sql.NullString{String: "ACCOUNTADMIN", Valid: true},
+			NotifyUsers:      sql.NullString{String: "JOHN, JANE", Valid: true},
+		}
+		resourceMonitor, err := row.toResourceMonitor(true, utcLocation)
+		require.NoError(t, err)
+		assert.Equal(t, Float64(0.5), resourceMonitor.CreditQuota)
+		assert.Equal(t, 0.25, resourceMonitor.UsedCredits)
+		assert.Equal(t, 0.25, resourceMonitor.RemainingCredits)
+		assert.Equal(t, ResourceMonitorLevelAccount, resourceMonitor.Level)
+		assert.Equal(t, "ACCOUNTADMIN", resourceMonitor.Owner)
+		assert.Equal(t, ResourceMonitorFrequencyMonthly, resourceMonitor.Frequency)
+		assert.Equal(t, []string{"JOHN", "JANE"}, resourceMonitor.NotifyUsers)
+	})
+
+	t.Run("without quota", func(t *testing.T) {
+		row := &resourceMonitorRow{Name: "MONITOR"}
+		resourceMonitor, err := row.toResourceMonitor(true, utcLocation)
+		require.NoError(t, err)
+		assert.Nil(t, resourceMonitor.CreditQuota)
+		assert.Empty(t, resourceMonitor.NotifyUsers)
+		assert.Nil(t, resourceMonitor.StartTime)
+	})
+
+	t.Run("unknown frequency", func(t *testing.T) {
+		row := &resourceMonitorRow{Name: "MONITOR", Frequency: sql.NullString{String: "HOURLY", Valid: true}}
+		resourceMonitor, err := row.toResourceMonitor(false, utcLocation)
+		require.NoError(t, err)
+		assert.Equal(t, ResourceMonitorFrequency("HOURLY"), resourceMonitor.Frequency)
+
+		_, err = row.toResourceMonitor(true, utcLocation)
+		require.ErrorIs(t, err, ErrUnknownEnumValue)
+	})
+}
+
+func utcLocation() (*time.Location, error) {
+	return time.UTC, nil
+}
+
+func TestParseResourceMonitorTime(t *testing.T) {
+	session := time.FixedZone("PST", -8*60*60)
+	expected := time.Date(2030, 1, 1, 0, 0, 0, 0, session)
+	sessionLocation := func() (*time.Location, error) { return session, nil }
+	unusedLocation := func() (*time.Location, error) { return nil, errors.New("the session timezone should not be looked up") }
+
+	t.Run("with offset", func(t *testing.T) {
+		actual, err := parseResourceMonitorTime("2030-01-01 00:00:00.000 -0800", unusedLocation)
+		require.NoError(t, err)
+		require.NotNil(t, actual)
+		assert.True(t, expected.Equal(*actual))
+		_, offset := actual.Zone()
+		assert.Equal(t, -8*60*60, offset)
+	})
+
+	t.Run("without offset", func(t *testing.T) {
+		actual, err := parseResourceMonitorTime("2030-01-01 00:00", sessionLocation)
+		require.NoError(t, err)
+		require.NotNil(t, actual)
+		assert.True(t, expected.Equal(*actual))
+		assert.Equal(t, session, actual.Location())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		actual, err := parseResourceMonitorTime(string(NewResourceMonitorTimestamp(expected)), unusedLocation)
+		require.NoError(t, err)
+		require.NotNil(t, actual)
+		assert.True(t, expected.Equal(*actual))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		actual, err := parseResourceMonitorTime("", unusedLocation)
+		require.NoError(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseResourceMonitorTime("soon", unusedLocation)
+		assert.ErrorContains(t, err, `invalid resource monitor timestamp "soon"`)
+	})
+}
+
+func TestResourceMonitorShowTimestamps(t *testing.T) {
+	ctx := context.Background()
+	columns := []string{"name", "frequency", "start_time", "end_time"}
+	showSQL := regexp.QuoteMeta(`SHOW RESOURCE MONITORS`)
+	timezoneSQL := regexp.QuoteMeta(`SHOW PARAMETERS LIKE 'TIMEZONE' IN SESSION`)
+
+	t.Run("timestamps with offset", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(showSQL).WillReturnRows(sqlmock.NewRows(columns).AddRow("MONITOR", "MONTHLY", "2030-01-01 00:00:00.000 -0800", nil))
+
+		resourceMonitors, err := client.ResourceMonitors.Show(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, resourceMonitors, 1)
+		assert.True(t, time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC).Equal(*resourceMonitors[0].StartTime))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("timestamps without offset look the session timezone up once", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(showSQL).WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("MONITOR1", "MONTHLY", "2030-01-01 00:00", nil).
+			AddRow("MONITOR2", "MONTHLY", "2030-01-01 00:00", "2030-02-01 00:00"))
+		mock.ExpectQuery(timezoneSQL).WillReturnRows(sqlmock.NewRows([]string{"key", "value"}).AddRow("TIMEZONE", "UTC"))
+
+		resourceMonitors, err := client.ResourceMonitors.Show(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, resourceMonitors, 2)
+		assert.Equal(t, time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC), *resourceMonitors[1].EndTime)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid timestamp", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(showSQL).WillReturnRows(sqlmock.NewRows(columns).AddRow("MONITOR", "MONTHLY", "soon", nil))
+
+		_, err := client.ResourceMonitors.Show(ctx, nil)
+		require.ErrorContains(t, err, "invalid resource monitor timestamp")
+	})
+}
+
+func TestResourceMonitorAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("monitor")
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &ResourceMonitorSet{
+				CreditQuota:    Float64(100),
+				Frequency:      Pointer(ResourceMonitorFrequencyWeekly),
+				StartTimestamp: Pointer(NewResourceMonitorTimestamp(time.Date(2030, 1, 1, 0, 0, 0, 0, time.FixedZone("", -8*60*60)))),
+				NotifyUsers:    []NotifiedUser{{Name: "JOHN"}, {Name: "JANE"}},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER RESOURCE MONITOR IF EXISTS "monitor" SET CREDIT_QUOTA = 100 FREQUENCY = WEEKLY START_TIMESTAMP = '2030-01-01 00:00:00 -0800' NOTIFY_USERS = ("JOHN", "JANE")`, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name: id,
+			Unset: &ResourceMonitorUnset{
+				CreditQuota:  Bool(true),
+				EndTimestamp: Bool(true),
+				NotifyUsers:  Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER RESOURCE MONITOR "monitor" SET CREDIT_QUOTA = NULL END_TIMESTAMP = NULL NOTIFY_USERS = ()`, actual)
+	})
+
+	t.Run("set fractional credit quota", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name: id,
+			Set:  &ResourceMonitorSet{CreditQuota: Float64(2.5)},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER RESOURCE MONITOR "monitor" SET CREDIT_QUOTA = 2.5`, actual)
+	})
+
+	t.Run("triggers", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name: id,
+			Set:  &ResourceMonitorSet{CreditQuota: Float64(100)},
+			Triggers: []TriggerDefinition{
+				{Threshold: 80, TriggerAction: TriggerActionNotify},
+				{Threshold: 100, TriggerAction: TriggerActionSuspendImmediate},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER RESOURCE MONITOR "monitor" SET CREDIT_QUOTA = 100 TRIGGERS ON 80 PERCENT DO NOTIFY ON 100 PERCENT DO SUSPEND_IMMEDIATE`, actual)
+	})
+
+	t.Run("validation: no changes", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: invalid trigger", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name:     id,
+			Triggers: []TriggerDefinition{{Threshold: 0, TriggerAction: TriggerActionSuspend}},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: set and unset", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name:  id,
+			Set:   &ResourceMonitorSet{CreditQuota: Float64(100)},
+			Unset: &ResourceMonitorUnset{EndTimestamp: Bool(true)},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: frequency without start timestamp", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name: id,
+			Set:  &ResourceMonitorSet{Frequency: Pointer(ResourceMonitorFrequencyDaily)},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: end timestamp immediately", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name: id,
+			Set:  &ResourceMonitorSet{EndTimestamp: Pointer(ResourceMonitorTimestampImmediately)},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: empty unset", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name:  id,
+			Unset: &ResourceMonitorUnset{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestResourceMonitorUnsetOnAccount(t *testing.T) {
+	actual, err := structToSQL(&unsetAccountResourceMonitorOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, `ALTER ACCOUNT SET RESOURCE_MONITOR = NULL`, actual)
+}
+
+func TestResourceMonitorShow(t *testing.T) {
+	t.Run("by id escapes wildcards", func(t *testing.T) {
+		opts := &ShowResourceMonitorOptions{Like: likeExactly("my_monitor")}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW RESOURCE MONITORS LIKE 'my\\_monitor'`, actual)
+	})
+}
+
+func TestResourceMonitorTriggers(t *testing.T) {
+	notify := func(threshold int) TriggerDefinition {
+		return TriggerDefinition{Threshold: threshold, TriggerAction: TriggerActionNotify}
+	}
+
+	t.Run("notify above 100 percent", func(t *testing.T) {
+		opts := &CreateResourceMonitorOptions{
+			name:     NewAccountObjectIdentifier("monitor"),
+			Triggers: []TriggerDefinition{notify(50), notify(100), notify(150), {Threshold: 110, TriggerAction: TriggerActionSuspend}, {Threshold: 200, TriggerAction: TriggerActionSuspendImmediate}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE RESOURCE MONITOR "monitor" TRIGGERS ON 50 PERCENT DO NOTIFY ON 100 PERCENT DO NOTIFY ON 150 PERCENT DO NOTIFY ON 110 PERCENT DO SUSPEND ON 200 PERCENT DO SUSPEND_IMMEDIATE`, actual)
+	})
+
+	t.Run("validation: too many notify triggers", func(t *testing.T) {
+		err := validateTriggers([]TriggerDefinition{notify(10), notify(20), notify(30), notify(40), notify(50), notify(60)})
+		assert.ErrorIs(t, err, ErrInvalidTriggers)
+	})
+
+	t.Run("validation: more than one suspend trigger", func(t *testing.T) {
+		err := validateTriggers([]TriggerDefinition{{Threshold: 90, TriggerAction: TriggerActionSuspend}, {Threshold: 100, TriggerAction: TriggerActionSuspend}})
+		assert.ErrorIs(t, err, ErrInvalidTriggers)
+	})
+
+	t.Run("validation: suspend immediately before suspend", func(t *testing.T) {
+		err := validateTriggers([]TriggerDefinition{{Threshold: 100, TriggerAction: TriggerActionSuspend}, {Threshold: 90, TriggerAction: TriggerActionSuspendImmediate}})
+		assert.ErrorIs(t, err, ErrInvalidTriggers)
+	})
+
+	t.Run("validation: duplicate threshold", func(t *testing.T) {
+		err := validateTriggers([]TriggerDefinition{notify(100), {Threshold: 100, TriggerAction: TriggerActionSuspend}})
+		assert.ErrorIs(t, err, ErrInvalidTriggers)
+	})
+
+	t.Run("validation: unknown action", func(t *testing.T) {
+		err := validateTriggers([]TriggerDefinition{{Threshold: 100, TriggerAction: "STOP"}})
+		assert.ErrorIs(t, err, ErrInvalidTriggers)
+	})
+}
+
+func TestResourceMonitorGrantOwnership(t *testing.T) {
+	id := NewAccountObjectIdentifier("monitor")
+	role := NewAccountObjectIdentifier("monitor_admin")
+
+	t.Run("with copy current grants", func(t *testing.T) {
+		opts := &GrantResourceMonitorOwnershipOptions{
+			name:              id,
+			role:              role,
+			CopyCurrentGrants: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `GRANT OWNERSHIP ON RESOURCE MONITOR "monitor" TO ROLE "monitor_admin" COPY CURRENT GRANTS`, actual)
+	})
+
+	t.Run("validation: copy and revoke current grants", func(t *testing.T) {
+		opts := &GrantResourceMonitorOwnershipOptions{
+			name:                id,
+			role:                role,
+			RevokeCurrentGrants: Bool(true),
+			CopyCurrentGrants:   Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: invalid role", func(t *testing.T) {
+		opts := &GrantResourceMonitorOwnershipOptions{name: id}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestResourceMonitorClearTriggers(t *testing.T) {
+	ctx := context.Background()
+	id := NewAccountObjectIdentifier("MONITOR")
+	monitorColumns := []string{"name", "credit_quota", "frequency", "level", "owner", "notify_at"}
+	warehouseColumns := []string{"name", "resource_monitor"}
+
+	t.Run("restores the assignments and the owner", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SHOW RESOURCE MONITORS LIKE 'MONITOR'`)).
+			WillReturnRows(sqlmock.NewRows(monitorColumns).AddRow("MONITOR", "10.00", "MONTHLY", "ACCOUNT", "MONITOR_ADMIN", "80%"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SHOW WAREHOUSES`)).
+			WillReturnRows(sqlmock.NewRows(warehouseColumns).AddRow("WH1", "MONITOR").AddRow("WH2", "OTHER"))
+		mock.ExpectExec(regexp.QuoteMeta(`CREATE OR REPLACE RESOURCE MONITOR "MONITOR" WITH CREDIT_QUOTA = 10`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER ACCOUNT SET RESOURCE_MONITOR = "MONITOR"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER WAREHOUSE "WH1" SET RESOURCE_MONITOR = "MONITOR"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT CURRENT_ROLE()`)).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE"}).AddRow("ACCOUNTADMIN"))
+		mock.ExpectExec(regexp.QuoteMeta(`GRANT OWNERSHIP ON RESOURCE MONITOR "MONITOR" TO ROLE "MONITOR_ADMIN"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		require.NoError(t, client.ResourceMonitors.ClearTriggers(ctx, id))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("keeps the owner when it is the current role", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SHOW RESOURCE MONITORS LIKE 'MONITOR'`)).
+			WillReturnRows(sqlmock.NewRows(monitorColumns).AddRow("MONITOR", nil, "MONTHLY", nil, "ACCOUNTADMIN", "80%"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SHOW WAREHOUSES`)).WillReturnRows(sqlmock.NewRows(warehouseColumns))
+		mock.ExpectExec(regexp.QuoteMeta(`CREATE OR REPLACE RESOURCE MONITOR "MONITOR"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT CURRENT_ROLE()`)).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ROLE"}).AddRow("ACCOUNTADMIN"))
+
+		require.NoError(t, client.ResourceMonitors.ClearTriggers(ctx, id))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("reports a partially restored resource monitor", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SHOW RESOURCE MONITORS LIKE 'MONITOR'`)).
+			WillReturnRows(sqlmock.NewRows(monitorColumns).AddRow("MONITOR", nil, "MONTHLY", nil, "ACCOUNTADMIN", "80%"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SHOW WAREHOUSES`)).WillReturnRows(sqlmock.NewRows(warehouseColumns).AddRow("WH1", "MONITOR"))
+		mock.ExpectExec(regexp.QuoteMeta(`CREATE OR REPLACE RESOURCE MONITOR "MONITOR"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER WAREHOUSE "WH1" SET RESOURCE_MONITOR = "MONITOR"`)).WillReturnError(errors.New("insufficient privileges"))
+
+		err := client.ResourceMonitors.ClearTriggers(ctx, id)
+		require.ErrorContains(t, err, `resource monitor "MONITOR" was re-created, but setting it on warehouse "WH1" failed`)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
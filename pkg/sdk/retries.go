@@ -0,0 +1,116 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+const (
+	// errorCodeTokenExpired is returned when the session token expired between two statements.
+	errorCodeTokenExpired = 390114
+	// errorCodeStatementCanceled is returned for statements canceled by Snowflake, e.g. during a service restart.
+	errorCodeStatementCanceled = 604
+)
+
+// RetryPolicy configures the retries of statements that failed with a transient error, see WithRetryPolicy.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts including the first one. Statements are not retried when it is
+	// lower than 2.
+	MaxAttempts int
+	// InitialBackoff is the maximum wait before the first retry. It doubles with every further retry up to
+	// MaxBackoff, and the actual wait is a random duration up to it.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Retryable reports whether an error is transient, by default IsTransientError is used.
+	Retryable func(err error) bool
+}
+
+// DefaultRetryPolicy retries transient errors up to 4 times, waiting at most 500ms, 1s, 2s and 4s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+	}
+}
+
+// WithRetryPolicy makes the client retry statements that failed with a transient error. Statements in a
+// transaction are never retried, as the failure may have ended the transaction.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = &policy
+	}
+}
+
+// IsTransientError reports whether an error is likely to go away when the statement is retried: expired session
+// tokens, statements canceled by Snowflake and network errors.
+func IsTransientError(err error) bool {
+	var snowflakeErr *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeErr) {
+		return snowflakeErr.Number == errorCodeTokenExpired || snowflakeErr.Number == errorCodeStatementCanceled
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsTransientError(err)
+}
+
+// backoff returns the jittered wait before the given retry, starting at 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || backoff < p.MaxBackoff); i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(backoff))) //nolint:gosec // the jitter does not need a secure random source
+}
+
+// run calls fn with a statement slot (see WithMaxConcurrentStatements) and the executor for the query tag of ctx
+// (see ContextWithQueryTag), and retries it according to the retry policy of the client. The last error is
+// returned when the attempts are exhausted or ctx is done.
+func (c *Client) run(ctx context.Context, fn func(e executor) error) error {
+	try := func() error {
+		return c.withStatementSlot(ctx, func() error {
+			return c.withExecutor(ctx, fn)
+		})
+	}
+	err := try()
+	if c.retryPolicy == nil || c.tx != nil {
+		return err
+	}
+	policy := *c.retryPolicy
+	for attempt := 1; err != nil && attempt < policy.MaxAttempts && policy.retryable(err); attempt++ {
+		backoff := policy.backoff(attempt)
+		log.Printf("[DEBUG] retrying in %s after transient error (attempt %d of %d): %v\n", backoff, attempt, policy.MaxAttempts, err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		err = try()
+	}
+	return err
+}
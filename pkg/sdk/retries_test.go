@@ -0,0 +1,98 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransientError(t *testing.T) {
+	assert.True(t, IsTransientError(&gosnowflake.SnowflakeError{Number: 390114}))
+	assert.True(t, IsTransientError(fmt.Errorf("exec: %w", &gosnowflake.SnowflakeError{Number: 604})))
+	assert.True(t, IsTransientError(fmt.Errorf("read: %w", syscall.ECONNRESET)))
+	assert.False(t, IsTransientError(&gosnowflake.SnowflakeError{Number: 2003}))
+	assert.False(t, IsTransientError(errors.New("syntax error")))
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+	for retry := 1; retry <= 5; retry++ {
+		backoff := policy.backoff(retry)
+		assert.GreaterOrEqual(t, backoff, time.Duration(0))
+		assert.Less(t, backoff, 300*time.Millisecond)
+	}
+	assert.Less(t, policy.backoff(1), 100*time.Millisecond)
+	assert.Equal(t, time.Duration(0), RetryPolicy{}.backoff(1))
+}
+
+func TestRetries(t *testing.T) {
+	ctx := context.Background()
+	transient := &gosnowflake.SnowflakeError{Number: 390114, Message: "Authentication token has expired."}
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	t.Run("retries transient errors", func(t *testing.T) {
+		client, mock := newMockClient(t, WithRetryPolicy(policy))
+		mock.ExpectExec("DROP ROLE").WillReturnError(transient)
+		mock.ExpectExec("DROP ROLE").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		require.NoError(t, client.Roles.Drop(ctx, NewAccountObjectIdentifier("ROLE1"), nil))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		client, mock := newMockClient(t, WithRetryPolicy(policy))
+		mock.ExpectExec("DROP ROLE").WillReturnError(errors.New("syntax error"))
+
+		require.Error(t, client.Roles.Drop(ctx, NewAccountObjectIdentifier("ROLE1"), nil))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("gives up after the last attempt", func(t *testing.T) {
+		client, mock := newMockClient(t, WithRetryPolicy(policy))
+		for i := 0; i < 3; i++ {
+			mock.ExpectQuery("SHOW ROLES").WillReturnError(transient)
+		}
+
+		_, err := client.Roles.Show(ctx, nil)
+		require.ErrorIs(t, err, transient)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("drops rows of failed attempts", func(t *testing.T) {
+		client, mock := newMockClient(t, WithRetryPolicy(policy))
+		mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"A"}).AddRow(1).AddRow(2).RowError(1, transient))
+		mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"A"}).AddRow(1).AddRow(2))
+
+		rows := []struct {
+			A int `db:"A"`
+		}{}
+		require.NoError(t, client.query(ctx, &rows, "SELECT A"))
+		assert.Len(t, rows, 2)
+	})
+
+	t.Run("without a policy", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec("DROP ROLE").WillReturnError(transient)
+
+		require.Error(t, client.Roles.Drop(ctx, NewAccountObjectIdentifier("ROLE1"), nil))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		client, mock := newMockClient(t, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour}))
+		mock.ExpectExec("DROP ROLE").WillReturnError(transient)
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		require.Error(t, client.Roles.Drop(ctx, NewAccountObjectIdentifier("ROLE1"), nil))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
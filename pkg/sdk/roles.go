@@ -2,30 +2,33 @@ package sdk
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
+	"errors"
+	"time"
 )
 
 type Roles interface {
 	// Create creates a role.
-	Create(ctx context.Context, id AccountObjectIdentifier, opts *RoleCreateOptions) error
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateRoleOptions) error
 	// Alter modifies an existing role
-	Alter(ctx context.Context, id AccountObjectIdentifier, opts *RoleAlterOptions) error
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterRoleOptions) error
 	// Drop removes a role.
-	Drop(ctx context.Context, id AccountObjectIdentifier, opts *RoleDropOptions) error
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropRoleOptions) error
 	// Show returns a list of roles.
-	Show(ctx context.Context, opts *RoleShowOptions) ([]*Role, error)
-	// ShowByID returns a user by ID
+	Show(ctx context.Context, opts *ShowRoleOptions) ([]*Role, error)
+	// ShowByID returns a role by ID
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Role, error)
-	// Grant grants privileges on a role.
-	// Grant(ctx context.Context, id AccountObjectIdentifier, opts *RoleGrantOptions) error
-	// Revoke revokes privileges on a role.
-	// Revoke(ctx context.Context, id AccountObjectIdentifier, opts *RoleRevokeOptions) error
-	// Use sets the active role for the current session.
-	// Use(ctx context.Context, id AccountObjectIdentifier) error
-	// UseSecondary specifies the active/current secondary roles for the session.
-	// UseSecondary(ctx context.Context, opts *RolesUseSecondaryOptions) error
+	// ShowByIDs returns the roles with the given IDs using a single SHOW, IDs that do not exist are skipped.
+	ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*Role, error)
+	// Grant grants a role to another role or to a user.
+	Grant(ctx context.Context, id AccountObjectIdentifier, opts *GrantRoleOptions) error
+	// Revoke revokes a role from another role or from a user.
+	Revoke(ctx context.Context, id AccountObjectIdentifier, opts *RevokeRoleOptions) error
+	// Use sets the active role for the current session, see Sessions.UseRole.
+	Use(ctx context.Context, id AccountObjectIdentifier) error
 }
 
+// Compile-time proof of interface implementation.
 var _ Roles = (*roles)(nil)
 
 type roles struct {
@@ -33,7 +36,16 @@ type roles struct {
 }
 
 type Role struct {
-	Name string `db:"name"`
+	CreatedOn       time.Time
+	Name            string
+	IsDefault       bool
+	IsCurrent       bool
+	IsInherited     bool
+	AssignedToUsers int
+	GrantedToRoles  int
+	GrantedRoles    int
+	Owner           string
+	Comment         string
 }
 
 func (v *Role) ID() AccountObjectIdentifier {
@@ -44,51 +56,293 @@ func (v *Role) ObjectType() ObjectType {
 	return ObjectTypeRole
 }
 
-// RoleCreateOptions contains options for creating a role.
-type RoleCreateOptions struct{}
+type roleDBRow struct {
+	CreatedOn       time.Time      `db:"created_on"`
+	Name            string         `db:"name"`
+	IsDefault       sql.NullString `db:"is_default"`
+	IsCurrent       sql.NullString `db:"is_current"`
+	IsInherited     sql.NullString `db:"is_inherited"`
+	AssignedToUsers sql.NullInt64  `db:"assigned_to_users"`
+	GrantedToRoles  sql.NullInt64  `db:"granted_to_roles"`
+	GrantedRoles    sql.NullInt64  `db:"granted_roles"`
+	Owner           sql.NullString `db:"owner"`
+	Comment         sql.NullString `db:"comment"`
+}
+
+func (row roleDBRow) toRole() *Role {
+	return &Role{
+		CreatedOn:       row.CreatedOn,
+		Name:            row.Name,
+		IsDefault:       row.IsDefault.String == "Y",
+		IsCurrent:       row.IsCurrent.String == "Y",
+		IsInherited:     row.IsInherited.String == "Y",
+		AssignedToUsers: int(row.AssignedToUsers.Int64),
+		GrantedToRoles:  int(row.GrantedToRoles.Int64),
+		GrantedRoles:    int(row.GrantedRoles.Int64),
+		Owner:           row.Owner.String,
+		Comment:         row.Comment.String,
+	}
+}
+
+// CreateRoleOptions contains options for creating a role.
+type CreateRoleOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	role        bool                    `ddl:"static" sql:"ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	Comment     *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag         []TagAssociation        `ddl:"keyword,parentheses" sql:"WITH TAG"`
+}
 
-func (v *roles) Create(ctx context.Context, id AccountObjectIdentifier, opts *RoleCreateOptions) error {
-	sql := fmt.Sprintf(`CREATE ROLE %v`, id)
-	_, err := v.client.exec(ctx, sql)
+func (opts *CreateRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	return nil
+}
+
+func (v *roles) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateRoleOptions) error {
+	if opts == nil {
+		opts = &CreateRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
 	return err
 }
 
-// RoleAlterOptions contains options for altering a user.
-type RoleAlterOptions struct{}
+// AlterRoleOptions contains options for altering a role.
+type AlterRoleOptions struct {
+	alter    bool                    `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	role     bool                    `ddl:"static" sql:"ROLE"`  //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+	NewName  AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set      *RoleSet                `ddl:"keyword" sql:"SET"`
+	Unset    *RoleUnset              `ddl:"keyword" sql:"UNSET"`
+	SetTag   []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+}
 
-func (v *roles) Alter(ctx context.Context, id AccountObjectIdentifier, opts *RoleAlterOptions) error {
+func (opts *AlterRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of NewName, Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) && !valueSet(opts.Set.Comment) {
+		return errors.New("Comment must be set")
+	}
+	if valueSet(opts.Unset) && !valueSet(opts.Unset.Comment) {
+		return errors.New("Comment must be set")
+	}
 	return nil
 }
 
-// RoleDropOptions contains options for dropping a role.
-type RoleDropOptions struct{}
+type RoleSet struct {
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type RoleUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *roles) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterRoleOptions) error {
+	if opts == nil {
+		opts = &AlterRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropRoleOptions contains options for dropping a role.
+type DropRoleOptions struct {
+	drop     bool                    `ddl:"static" sql:"DROP"` //lint:ignore U1000 This is used in the ddl tag
+	role     bool                    `ddl:"static" sql:"ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
 
-func (v *roles) Drop(ctx context.Context, id AccountObjectIdentifier, opts *RoleDropOptions) error {
-	sql := fmt.Sprintf(`DROP ROLE %v`, id.FullyQualifiedName())
-	_, err := v.client.exec(ctx, sql)
+func (opts *DropRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *roles) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropRoleOptions) error {
+	if opts == nil {
+		opts = &DropRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
 	return err
 }
 
-// RoleShowOptions contains options for listing roles.
-type RoleShowOptions struct{}
+// ShowRoleOptions contains options for listing roles.
+type ShowRoleOptions struct {
+	show  bool  `ddl:"static" sql:"SHOW"`  //lint:ignore U1000 This is used in the ddl tag
+	roles bool  `ddl:"static" sql:"ROLES"` //lint:ignore U1000 This is used in the ddl tag
+	Like  *Like `ddl:"keyword" sql:"LIKE"`
+	ShowOptions
+}
+
+func (opts *ShowRoleOptions) validate() error {
+	return nil
+}
+
+func (v *roles) Show(ctx context.Context, opts *ShowRoleOptions) ([]*Role, error) {
+	if opts == nil {
+		opts = &ShowRoleOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []roleDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Role, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toRole()
+	}
+	return resultList, nil
+}
+
+func (v *roles) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Role, error) {
+	roles, err := v.Show(ctx, &ShowRoleOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if role.Name == id.Name() {
+			return role, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
 
-func (v *roles) Show(ctx context.Context, opts *RoleShowOptions) ([]*Role, error) {
-	var rows []Role
-	sql := `SHOW ROLES`
-	err := v.client.query(ctx, &rows, sql)
+func (v *roles) ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*Role, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	roles, err := v.Show(ctx, &ShowRoleOptions{
+		Like: likeIDs(ids),
+	})
 	if err != nil {
 		return nil, err
 	}
+	return filterByIDs(roles, ids), nil
+}
+
+// RoleGrantee is the role or the user a role is granted to or revoked from.
+type RoleGrantee struct {
+	Role AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
+	User AccountObjectIdentifier `ddl:"identifier" sql:"USER"`
+}
+
+func (v *RoleGrantee) validate() error {
+	if !exactlyOneValueSet(v.Role, v.User) {
+		return errors.New("exactly one of Role, User must be set")
+	}
+	return nil
+}
+
+// GrantRoleOptions contains options for granting a role.
+type GrantRoleOptions struct {
+	grant bool                    `ddl:"static" sql:"GRANT"` //lint:ignore U1000 This is used in the ddl tag
+	role  bool                    `ddl:"static" sql:"ROLE"`  //lint:ignore U1000 This is used in the ddl tag
+	name  AccountObjectIdentifier `ddl:"identifier"`
+	To    RoleGrantee             `ddl:"keyword" sql:"TO"`
+}
 
-	roles := make([]*Role, len(rows))
-	for i, row := range rows {
-		var role Role = row
-		roles[i] = &role
+func (opts *GrantRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
 	}
+	return opts.To.validate()
+}
 
-	return roles, nil
+func (v *roles) Grant(ctx context.Context, id AccountObjectIdentifier, opts *GrantRoleOptions) error {
+	if opts == nil {
+		opts = &GrantRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
 }
 
-func (v *roles) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Role, error) {
-	return nil, nil
+// RevokeRoleOptions contains options for revoking a role.
+type RevokeRoleOptions struct {
+	revoke bool                    `ddl:"static" sql:"REVOKE"` //lint:ignore U1000 This is used in the ddl tag
+	role   bool                    `ddl:"static" sql:"ROLE"`   //lint:ignore U1000 This is used in the ddl tag
+	name   AccountObjectIdentifier `ddl:"identifier"`
+	From   RoleGrantee             `ddl:"keyword" sql:"FROM"`
+}
+
+func (opts *RevokeRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.From.validate()
+}
+
+func (v *roles) Revoke(ctx context.Context, id AccountObjectIdentifier, opts *RevokeRoleOptions) error {
+	if opts == nil {
+		opts = &RevokeRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *roles) Use(ctx context.Context, id AccountObjectIdentifier) error {
+	return v.client.Sessions.UseRole(ctx, id)
 }
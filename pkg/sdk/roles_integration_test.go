@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Roles(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.Roles.Create(ctx, id, &CreateRoleOptions{Comment: String("some comment")})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Roles.Drop(ctx, id, &DropRoleOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		role, err := client.Roles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), role.Name)
+		assert.Equal(t, "some comment", role.Comment)
+		assert.False(t, role.IsCurrent)
+	})
+
+	t.Run("show with starts with and limit", func(t *testing.T) {
+		roles, err := client.Roles.Show(ctx, &ShowRoleOptions{
+			ShowOptions: ShowOptions{
+				StartsWith: String(id.Name()),
+				Limit:      &LimitFrom{Rows: Int(1)},
+			},
+		})
+		require.NoError(t, err)
+		assert.Len(t, roles, 1)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		err := client.Roles.Alter(ctx, id, &AlterRoleOptions{Set: &RoleSet{Comment: String("new comment")}})
+		require.NoError(t, err)
+		role, err := client.Roles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", role.Comment)
+
+		err = client.Roles.Alter(ctx, id, &AlterRoleOptions{Unset: &RoleUnset{Comment: Bool(true)}})
+		require.NoError(t, err)
+		role, err = client.Roles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", role.Comment)
+	})
+
+	t.Run("grant and revoke to role", func(t *testing.T) {
+		parentID := randomAccountObjectIdentifier(t)
+		err := client.Roles.Create(ctx, parentID, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Roles.Drop(ctx, parentID, nil)
+			require.NoError(t, err)
+		})
+
+		err = client.Roles.Grant(ctx, id, &GrantRoleOptions{To: RoleGrantee{Role: parentID}})
+		require.NoError(t, err)
+		role, err := client.Roles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, role.GrantedToRoles)
+
+		err = client.Roles.Revoke(ctx, id, &RevokeRoleOptions{From: RoleGrantee{Role: parentID}})
+		require.NoError(t, err)
+		role, err = client.Roles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 0, role.GrantedToRoles)
+	})
+
+	t.Run("use", func(t *testing.T) {
+		currentRole, err := client.ContextFunctions.CurrentRole(ctx)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Roles.Use(ctx, NewAccountObjectIdentifier(currentRole))
+			require.NoError(t, err)
+		})
+
+		err = client.Roles.Grant(ctx, id, &GrantRoleOptions{To: RoleGrantee{Role: NewAccountObjectIdentifier(currentRole)}})
+		require.NoError(t, err)
+		err = client.Roles.Use(ctx, id)
+		require.NoError(t, err)
+		role, err := client.ContextFunctions.CurrentRole(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), role)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		newID := randomAccountObjectIdentifier(t)
+		err := client.Roles.Alter(ctx, id, &AlterRoleOptions{NewName: newID})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Roles.Drop(ctx, newID, &DropRoleOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+		_, err = client.Roles.ShowByID(ctx, newID)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,202 @@
+package sdk
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleCreate(t *testing.T) {
+	t.Run("only name", func(t *testing.T) {
+		opts := &CreateRoleOptions{
+			name: NewAccountObjectIdentifier("myrole"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE ROLE "myrole"`, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateRoleOptions{
+			IfNotExists: Bool(true),
+			name:        NewAccountObjectIdentifier("myrole"),
+			Comment:     String("some comment"),
+			Tag: []TagAssociation{
+				{
+					Name:  NewSchemaObjectIdentifier("db", "schema", "tag"),
+					Value: "v1",
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE ROLE IF NOT EXISTS "myrole" COMMENT = 'some comment' WITH TAG ("db"."schema"."tag" = 'v1')`, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateRoleOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        NewAccountObjectIdentifier("myrole"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestRoleAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("myrole")
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterRoleOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  NewAccountObjectIdentifier("newrole"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ROLE IF EXISTS "myrole" RENAME TO "newrole"`, actual)
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := &AlterRoleOptions{
+			name: id,
+			Set:  &RoleSet{Comment: String("some comment")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ROLE "myrole" SET COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := &AlterRoleOptions{
+			name:  id,
+			Unset: &RoleUnset{Comment: Bool(true)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER ROLE "myrole" UNSET COMMENT`, actual)
+	})
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterRoleOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterRoleOptions{
+			name: id,
+			Set:  &RoleSet{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestRoleDrop(t *testing.T) {
+	opts := &DropRoleOptions{
+		IfExists: Bool(true),
+		name:     NewAccountObjectIdentifier("myrole"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP ROLE IF EXISTS "myrole"`, actual)
+}
+
+func TestRoleShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&ShowRoleOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW ROLES`, actual)
+	})
+
+	t.Run("with like, starts with and limit", func(t *testing.T) {
+		opts := &ShowRoleOptions{
+			Like: &Like{Pattern: String("my%")},
+			ShowOptions: ShowOptions{
+				StartsWith: String("my"),
+				Limit:      &LimitFrom{Rows: Int(10)},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW ROLES LIKE 'my%' STARTS WITH 'my' LIMIT 10`, actual)
+	})
+}
+
+func TestRoleGrant(t *testing.T) {
+	id := NewAccountObjectIdentifier("myrole")
+
+	t.Run("to role", func(t *testing.T) {
+		opts := &GrantRoleOptions{
+			name: id,
+			To:   RoleGrantee{Role: NewAccountObjectIdentifier("parent")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `GRANT ROLE "myrole" TO ROLE "parent"`, actual)
+	})
+
+	t.Run("to user", func(t *testing.T) {
+		opts := &GrantRoleOptions{
+			name: id,
+			To:   RoleGrantee{User: NewAccountObjectIdentifier("myuser")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `GRANT ROLE "myrole" TO USER "myuser"`, actual)
+	})
+
+	t.Run("validation: role and user", func(t *testing.T) {
+		opts := &GrantRoleOptions{
+			name: id,
+			To: RoleGrantee{
+				Role: NewAccountObjectIdentifier("parent"),
+				User: NewAccountObjectIdentifier("myuser"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no grantee", func(t *testing.T) {
+		opts := &GrantRoleOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestRoleRevoke(t *testing.T) {
+	opts := &RevokeRoleOptions{
+		name: NewAccountObjectIdentifier("myrole"),
+		From: RoleGrantee{User: NewAccountObjectIdentifier("myuser")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `REVOKE ROLE "myrole" FROM USER "myuser"`, actual)
+}
+
+func TestRoleUse(t *testing.T) {
+	ctx := context.Background()
+	id := NewAccountObjectIdentifier("MYROLE")
+
+	t.Run("default quoting", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(regexp.QuoteMeta(`USE ROLE "MYROLE"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		require.NoError(t, client.Roles.Use(ctx, id))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("quoting of the client", func(t *testing.T) {
+		client, mock := newMockClient(t, WithIdentifierQuoting(IdentifierQuotingWhenRequired))
+		mock.ExpectExec(regexp.QuoteMeta(`USE ROLE MYROLE`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		require.NoError(t, client.Roles.Use(ctx, id))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -1,6 +1,39 @@
 package sdk
 
-// placeholder for the real implementation.
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// Compile-time proof of interface implementation.
+var _ Schemas = (*schemas)(nil)
+
+// Schemas describes all the schema related methods that the Snowflake API supports.
+type Schemas interface {
+	// Create creates a new schema.
+	Create(ctx context.Context, id SchemaIdentifier, opts *CreateSchemaOptions) error
+	// CloneWithGrants creates a schema as a clone of opts.Clone.SourceObject and then grants role the
+	// privileges it holds on the source schema and the objects in it on the corresponding cloned objects.
+	// Cloning does not copy the grants on the cloned schema itself, so without this the role loses access.
+	// A failing grant does not stop the others, the failures are returned as a *SchemaCloneGrantsError, as the
+	// clone exists at that point.
+	CloneWithGrants(ctx context.Context, id SchemaIdentifier, role AccountObjectIdentifier, opts *CreateSchemaOptions) error
+	// Alter modifies an existing schema.
+	Alter(ctx context.Context, id SchemaIdentifier, opts *AlterSchemaOptions) error
+	// Drop removes a schema.
+	Drop(ctx context.Context, id SchemaIdentifier, opts *DropSchemaOptions) error
+	// Undrop restores the most recent version of a dropped schema.
+	Undrop(ctx context.Context, id SchemaIdentifier) error
+}
+
+// schemas implements Schemas.
+type schemas struct {
+	client *Client
+}
+
 type Schema struct {
 	DatabaseName string
 	Name         string
@@ -13,3 +46,265 @@ func (v *Schema) ID() SchemaIdentifier {
 func (v *Schema) ObjectType() ObjectType {
 	return ObjectTypeSchema
 }
+
+type CreateSchemaOptions struct {
+	create                     bool             `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace                  *bool            `ddl:"keyword" sql:"OR REPLACE"`
+	Transient                  *bool            `ddl:"keyword" sql:"TRANSIENT"`
+	schema                     bool             `ddl:"static" sql:"SCHEMA"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists                *bool            `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       SchemaIdentifier `ddl:"identifier"`
+	Clone                      *Clone           `ddl:"-"`
+	WithManagedAccess          *bool            `ddl:"keyword" sql:"WITH MANAGED ACCESS"`
+	DataRetentionTimeInDays    *int             `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int             `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *string          `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	Tag                        []TagAssociation `ddl:"keyword,parentheses" sql:"TAG"`
+	Comment                    *string          `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateSchemaOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if valueSet(opts.Clone) {
+		if err := opts.Clone.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *schemas) Create(ctx context.Context, id SchemaIdentifier, opts *CreateSchemaOptions) error {
+	if opts == nil {
+		opts = &CreateSchemaOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// SchemaCloneGrantsError is returned by CloneWithGrants when the schema was cloned, but some of the grants could
+// not be re-applied to it.
+type SchemaCloneGrantsError struct {
+	Schema SchemaIdentifier
+	// Grants is the number of grants that were re-applied or failed.
+	Grants int
+	Errors []error
+}
+
+func (e *SchemaCloneGrantsError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("schema %s was cloned, but %d of %d grants could not be re-applied: %s", e.Schema.FullyQualifiedName(), len(e.Errors), e.Grants, strings.Join(messages, "; "))
+}
+
+func (e *SchemaCloneGrantsError) Unwrap() error {
+	return e.Errors[0]
+}
+
+// schemaCloneGrantsSkippedObjectTypes are the object types whose grants are not re-applied after cloning.
+// SHOW GRANTS returns functions and procedures with their return types, which GRANT does not accept.
+var schemaCloneGrantsSkippedObjectTypes = []string{"FUNCTION", "PROCEDURE"}
+
+// clonedGrants maps the grants a role holds on the source schema and the objects in it
+// to the corresponding grants on the clone.
+func clonedGrants(rows []grantRow, source SchemaIdentifier, target SchemaIdentifier, role AccountObjectIdentifier) []*GrantPrivilegesToAccountRoleOptions {
+	var result []*GrantPrivilegesToAccountRoleOptions
+	for _, row := range rows {
+		if row.Privilege == "OWNERSHIP" {
+			continue
+		}
+		skipped := false
+		for _, t := range schemaCloneGrantsSkippedObjectTypes {
+			if strings.EqualFold(row.GrantedOn, t) {
+				skipped = true
+			}
+		}
+		if skipped {
+			log.Printf("[DEBUG] not re-applying %s on %s %s after cloning\n", row.Privilege, row.GrantedOn, row.Name)
+			continue
+		}
+		parts, err := ParseIdentifierParts(row.Name)
+		if err != nil || len(parts) < 2 || parts[0] != source.DatabaseName() || parts[1] != source.Name() {
+			continue
+		}
+		on := &AccountRoleGrantOn{}
+		switch {
+		case strings.EqualFold(row.GrantedOn, "SCHEMA") && len(parts) == 2:
+			on.Schema = &GrantOnSchema{Schema: target}
+		case !strings.EqualFold(row.GrantedOn, "SCHEMA") && len(parts) == 3:
+			on.SchemaObject = &GrantOnSchemaObject{
+				SchemaObject: &Object{
+					ObjectType: ObjectType(strings.ReplaceAll(row.GrantedOn, "_", " ")),
+					Name:       NewSchemaObjectIdentifier(target.DatabaseName(), target.Name(), parts[2]),
+				},
+			}
+		default:
+			continue
+		}
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges:  &AccountRoleGrantPrivileges{Privileges: []Privilege{Privilege(row.Privilege)}},
+			on:          on,
+			accountRole: role,
+		}
+		if row.GrantOption {
+			opts.WithGrantOption = Bool(true)
+		}
+		result = append(result, opts)
+	}
+	return result
+}
+
+func (v *schemas) CloneWithGrants(ctx context.Context, id SchemaIdentifier, role AccountObjectIdentifier, opts *CreateSchemaOptions) error {
+	if opts == nil || opts.Clone == nil {
+		return errors.New("Clone must be set")
+	}
+	source, ok := opts.Clone.SourceObject.(SchemaIdentifier)
+	if !ok {
+		return fmt.Errorf("clone source must be a schema identifier, got %T", opts.Clone.SourceObject)
+	}
+	if !validObjectidentifier(role) {
+		return ErrInvalidObjectIdentifier
+	}
+
+	// The grants are read before cloning, so that a clone replacing the source does not lose them.
+	showOpts := &ShowGrantOptions{To: &ShowGrantsTo{Role: role}}
+	sql, err := v.client.structToSQL(showOpts)
+	if err != nil {
+		return err
+	}
+	var rows []grantRow
+	if err := v.client.query(ctx, &rows, sql); err != nil {
+		return err
+	}
+
+	if err := v.Create(ctx, id, opts); err != nil {
+		return err
+	}
+
+	grants := clonedGrants(rows, source, id, role)
+	grantsErr := &SchemaCloneGrantsError{Schema: id, Grants: len(grants)}
+	for _, grant := range grants {
+		err := v.client.Grants.GrantPrivilegesToAccountRole(ctx, grant.privileges, grant.on, grant.accountRole, &GrantPrivilegesToAccountRoleOptions{
+			WithGrantOption: grant.WithGrantOption,
+		})
+		if err != nil {
+			grantsErr.Errors = append(grantsErr.Errors, err)
+		}
+	}
+	if len(grantsErr.Errors) > 0 {
+		return grantsErr
+	}
+	return nil
+}
+
+type AlterSchemaOptions struct {
+	alter    bool             `ddl:"static" sql:"ALTER"`  //lint:ignore U1000 This is used in the ddl tag
+	schema   bool             `ddl:"static" sql:"SCHEMA"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool            `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaIdentifier `ddl:"identifier"`
+	// SwapWith swaps all objects and metadata, including identifiers, between the two schemas.
+	SwapWith SchemaIdentifier `ddl:"identifier" sql:"SWAP WITH"`
+}
+
+func (opts *AlterSchemaOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.SwapWith) {
+		return errors.New("exactly one action must be set")
+	}
+	return nil
+}
+
+func (v *schemas) Alter(ctx context.Context, id SchemaIdentifier, opts *AlterSchemaOptions) error {
+	if opts == nil {
+		opts = &AlterSchemaOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropSchemaOptions struct {
+	drop     bool             `ddl:"static" sql:"DROP"`   //lint:ignore U1000 This is used in the ddl tag
+	schema   bool             `ddl:"static" sql:"SCHEMA"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool            `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaIdentifier `ddl:"identifier"`
+	Cascade  *bool            `ddl:"keyword" sql:"CASCADE"`
+	Restrict *bool            `ddl:"keyword" sql:"RESTRICT"`
+}
+
+func (opts *DropSchemaOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.Cascade, opts.Restrict) {
+		return errors.New("only one of CASCADE or RESTRICT can be set")
+	}
+	return nil
+}
+
+func (v *schemas) Drop(ctx context.Context, id SchemaIdentifier, opts *DropSchemaOptions) error {
+	if opts == nil {
+		opts = &DropSchemaOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type undropSchemaOptions struct {
+	undrop bool             `ddl:"static" sql:"UNDROP"` //lint:ignore U1000 This is used in the ddl tag
+	schema bool             `ddl:"static" sql:"SCHEMA"` //lint:ignore U1000 This is used in the ddl tag
+	name   SchemaIdentifier `ddl:"identifier"`
+}
+
+func (opts *undropSchemaOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *schemas) Undrop(ctx context.Context, id SchemaIdentifier) error {
+	opts := &undropSchemaOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
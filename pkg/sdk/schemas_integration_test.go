@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_SchemasCloneWithGrants(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	source, sourceCleanup := createSchema(t, client, database)
+	t.Cleanup(sourceCleanup)
+
+	roleID := randomAccountObjectIdentifier(t)
+	err := client.Roles.Create(ctx, roleID, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Roles.Drop(ctx, roleID, nil)
+		require.NoError(t, err)
+	})
+
+	tableID := NewSchemaObjectIdentifier(database.Name, source.Name, "EVENTS")
+	_, err = client.exec(ctx, fmt.Sprintf("CREATE TABLE %s (ID NUMBER)", tableID.FullyQualifiedName()))
+	require.NoError(t, err)
+	_, err = client.exec(ctx, fmt.Sprintf("GRANT USAGE ON SCHEMA %s TO ROLE %s", source.ID().FullyQualifiedName(), roleID.FullyQualifiedName()))
+	require.NoError(t, err)
+	_, err = client.exec(ctx, fmt.Sprintf("GRANT SELECT ON TABLE %s TO ROLE %s", tableID.FullyQualifiedName(), roleID.FullyQualifiedName()))
+	require.NoError(t, err)
+
+	id := NewSchemaIdentifier(database.Name, randomStringRange(t, 8, 28))
+	err = client.Schemas.CloneWithGrants(ctx, id, roleID, &CreateSchemaOptions{
+		Clone: &Clone{SourceObject: source.ID()},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Schemas.Drop(ctx, id, &DropSchemaOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	grants, err := client.Grants.Show(ctx, &ShowGrantOptions{To: &ShowGrantsTo{Role: roleID}})
+	require.NoError(t, err)
+	var granted []string
+	for _, grant := range grants {
+		granted = append(granted, fmt.Sprintf("%s %s %s", grant.Privilege, grant.GrantedOn, grant.Name.FullyQualifiedName()))
+	}
+	assert.Contains(t, granted, fmt.Sprintf("USAGE %s %s", ObjectTypeSchema, id.FullyQualifiedName()))
+	assert.Contains(t, granted, fmt.Sprintf("SELECT %s %s", ObjectTypeTable, NewSchemaObjectIdentifier(database.Name, id.Name(), "EVENTS").FullyQualifiedName()))
+}
+
+func TestInt_SchemasUndrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	err := client.Schemas.Drop(ctx, schema.ID(), nil)
+	require.NoError(t, err)
+	err = client.Schemas.Undrop(ctx, schema.ID())
+	require.NoError(t, err)
+	_, err = client.exec(ctx, fmt.Sprintf("USE SCHEMA %s", schema.ID().FullyQualifiedName()))
+	require.NoError(t, err)
+}
+
+func TestInt_SchemasAlterSwapWith(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	staging, stagingCleanup := createSchema(t, client, database)
+	t.Cleanup(stagingCleanup)
+
+	tableID := NewSchemaObjectIdentifier(database.Name, staging.Name, "EVENTS")
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE TABLE %s (ID NUMBER)", tableID.FullyQualifiedName()))
+	require.NoError(t, err)
+
+	err = client.Schemas.Alter(ctx, schema.ID(), &AlterSchemaOptions{SwapWith: staging.ID()})
+	require.NoError(t, err)
+	swappedTableID := NewSchemaObjectIdentifier(database.Name, schema.Name, "EVENTS")
+	_, err = client.exec(ctx, fmt.Sprintf("SELECT ID FROM %s", swappedTableID.FullyQualifiedName()))
+	require.NoError(t, err)
+}
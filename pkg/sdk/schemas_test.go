@@ -0,0 +1,159 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemasCreate(t *testing.T) {
+	id := NewSchemaIdentifier("db", "schema")
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateSchemaOptions{
+			OrReplace:                  Bool(true),
+			Transient:                  Bool(true),
+			name:                       id,
+			WithManagedAccess:          Bool(true),
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(2),
+			DefaultDDLCollation:        String("en_US-trim"),
+			Tag: []TagAssociation{
+				{Name: NewSchemaObjectIdentifier("db", "schema", "tag1"), Value: "v1"},
+			},
+			Comment: String("comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE TRANSIENT SCHEMA "db"."schema" WITH MANAGED ACCESS DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 2 DEFAULT_DDL_COLLATION = 'en_US-trim' TAG ("db"."schema"."tag1" = 'v1') COMMENT = 'comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		opts := &CreateSchemaOptions{
+			name: NewSchemaIdentifier("db", "dev"),
+			Clone: &Clone{
+				SourceObject: id,
+				Before: &TimeTravel{
+					Offset: Int(-3600),
+				},
+			},
+			Comment: String("dev copy"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE SCHEMA "db"."dev" CLONE "db"."schema" BEFORE (OFFSET => -3600) COMMENT = 'dev copy'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateSchemaOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        id,
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestSchemasAlter(t *testing.T) {
+	t.Run("swap with", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			IfExists: Bool(true),
+			name:     NewSchemaIdentifier("db", "schema"),
+			SwapWith: NewSchemaIdentifier("db", "schema_staging"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER SCHEMA IF EXISTS "db"."schema" SWAP WITH "db"."schema_staging"`, actual)
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name: NewSchemaIdentifier("db", "schema"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSchemasDrop(t *testing.T) {
+	opts := &DropSchemaOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaIdentifier("db", "schema"),
+		Cascade:  Bool(true),
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP SCHEMA IF EXISTS "db"."schema" CASCADE`, actual)
+}
+
+func TestSchemasUndrop(t *testing.T) {
+	opts := &undropSchemaOptions{
+		name: NewSchemaIdentifier("db", "schema"),
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `UNDROP SCHEMA "db"."schema"`, actual)
+}
+
+func TestSchemasClonedGrants(t *testing.T) {
+	source := NewSchemaIdentifier("DB", "PROD")
+	target := NewSchemaIdentifier("DB", "DEV")
+	role := NewAccountObjectIdentifier("ANALYST")
+	rows := []grantRow{
+		{Privilege: "USAGE", GrantedOn: "DATABASE", Name: "DB"},
+		{Privilege: "USAGE", GrantedOn: "SCHEMA", Name: "DB.PROD"},
+		{Privilege: "SELECT", GrantedOn: "TABLE", Name: "DB.PROD.EVENTS", GrantOption: true},
+		{Privilege: "SELECT", GrantedOn: "VIEW", Name: `DB.PROD."my.view"`},
+		{Privilege: "USAGE", GrantedOn: "FILE_FORMAT", Name: "DB.PROD.CSV"},
+		{Privilege: "USAGE", GrantedOn: "FUNCTION", Name: `DB.PROD."FN(A NUMBER):NUMBER(38,0)"`},
+		{Privilege: "OWNERSHIP", GrantedOn: "TABLE", Name: "DB.PROD.OWNED"},
+		{Privilege: "SELECT", GrantedOn: "TABLE", Name: "DB.PRODUCTION.EVENTS"},
+	}
+
+	var actual []string
+	for _, opts := range clonedGrants(rows, source, target, role) {
+		sql, err := structToSQL(opts)
+		require.NoError(t, err)
+		actual = append(actual, sql)
+	}
+	expected := []string{
+		`GRANT USAGE ON SCHEMA "DB"."DEV" TO ROLE "ANALYST"`,
+		`GRANT SELECT ON TABLE "DB"."DEV"."EVENTS" TO ROLE "ANALYST" WITH GRANT OPTION`,
+		`GRANT SELECT ON VIEW "DB"."DEV"."my.view" TO ROLE "ANALYST"`,
+		`GRANT USAGE ON FILE FORMAT "DB"."DEV"."CSV" TO ROLE "ANALYST"`,
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestSchemasCloneWithGrantsPartialFailure(t *testing.T) {
+	client, mock := newMockClient(t)
+
+	source := NewSchemaIdentifier("DB", "PROD")
+	target := NewSchemaIdentifier("DB", "DEV")
+	mock.ExpectQuery(`SHOW GRANTS TO ROLE "ANALYST"`).WillReturnRows(sqlmock.NewRows([]string{"privilege", "granted_on", "name", "grant_option"}).
+		AddRow("USAGE", "SCHEMA", "DB.PROD", false).
+		AddRow("SELECT", "TABLE", "DB.PROD.EVENTS", false))
+	mock.ExpectExec(`CREATE SCHEMA "DB"."DEV" CLONE "DB"."PROD"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`GRANT USAGE ON SCHEMA "DB"."DEV" TO ROLE "ANALYST"`).WillReturnError(errors.New("insufficient privileges"))
+	mock.ExpectExec(`GRANT SELECT ON TABLE "DB"."DEV"."EVENTS" TO ROLE "ANALYST"`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := client.Schemas.CloneWithGrants(context.Background(), target, NewAccountObjectIdentifier("ANALYST"), &CreateSchemaOptions{
+		Clone: &Clone{SourceObject: source},
+	})
+	var grantsErr *SchemaCloneGrantsError
+	require.ErrorAs(t, err, &grantsErr)
+	assert.Equal(t, target, grantsErr.Schema)
+	assert.Equal(t, 2, grantsErr.Grants)
+	assert.Len(t, grantsErr.Errors, 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,176 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ScriptResult is the output of one statement of a script. DDL statements return a single status column.
+type ScriptResult struct {
+	Statement string
+	Columns   []string
+	Rows      [][]sql.NullString
+}
+
+// SplitScript splits a script into its statements at the semicolons outside of string literals, quoted
+// identifiers, $$ blocks and comments. Snowflake Scripting blocks have to be wrapped in
+// EXECUTE IMMEDIATE $$ ... $$, as their semicolons would split them otherwise.
+func SplitScript(script string) ([]string, error) {
+	var statements []string
+	start := 0
+	hasContent := false
+	add := func(end int) {
+		if hasContent {
+			statements = append(statements, strings.TrimSpace(script[start:end]))
+		}
+		start, hasContent = end+1, false
+	}
+	for i := 0; i < len(script); i++ {
+		switch {
+		case script[i] == ';':
+			add(i)
+		case strings.HasPrefix(script[i:], "--"), strings.HasPrefix(script[i:], "//"):
+			j := strings.IndexByte(script[i:], '\n')
+			if j < 0 {
+				j = len(script) - i
+			}
+			i += j
+		case strings.HasPrefix(script[i:], "/*"):
+			j := strings.Index(script[i+2:], "*/")
+			if j < 0 {
+				return nil, fmt.Errorf("unterminated comment at position %d", i)
+			}
+			i += j + 3
+		case strings.HasPrefix(script[i:], "$$"):
+			j := strings.Index(script[i+2:], "$$")
+			if j < 0 {
+				return nil, fmt.Errorf("unterminated $$ block at position %d", i)
+			}
+			i += j + 3
+			hasContent = true
+		case script[i] == '\'' || script[i] == '"':
+			j, err := skipQuoted(script, i)
+			if err != nil {
+				return nil, err
+			}
+			i = j
+			hasContent = true
+		case script[i] != ' ' && script[i] != '\t' && script[i] != '\n' && script[i] != '\r':
+			hasContent = true
+		}
+	}
+	add(len(script))
+	return statements, nil
+}
+
+// skipQuoted returns the position of the quote closing the string literal or quoted identifier starting at i.
+// Quotes are escaped by doubling them, string literals also accept backslash escapes.
+func skipQuoted(script string, i int) (int, error) {
+	quote := script[i]
+	for j := i + 1; j < len(script); j++ {
+		switch {
+		case quote == '\'' && script[j] == '\\':
+			j++
+		case script[j] == quote && j+1 < len(script) && script[j+1] == quote:
+			j++
+		case script[j] == quote:
+			return j, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated %c quote at position %d", quote, i)
+}
+
+// ExecScript runs the statements of a script in order and returns the output of every statement, e.g. for setup
+// scripts. All statements run in the same session, or in the transaction of the client, so USE and SET statements
+// apply to the following ones, like in a request with MULTI_STATEMENT_COUNT. The query tag and the session parameters
+// of ctx, see ContextWithQueryTag and ContextWithSessionParameters, are set once for the whole script. The first
+// failing statement stops the script, the results of the statements before it are returned together with the error.
+// See SplitScript for Snowflake Scripting blocks.
+func (c *Client) ExecScript(ctx context.Context, script string) ([]*ScriptResult, error) {
+	statements, err := SplitScript(script)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) == 0 {
+		return nil, ErrEmptyStatement
+	}
+	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
+	results := make([]*ScriptResult, 0, len(statements))
+	err = c.withExecutor(ctx, func(e executor) error {
+		if db, ok := e.(*sqlx.DB); ok {
+			conn, err := db.Connx(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			e = conn
+		}
+		queryer, ok := e.(scriptQueryer)
+		if !ok {
+			return fmt.Errorf("executor %T cannot run scripts", e)
+		}
+		for i, statement := range statements {
+			var result *ScriptResult
+			err := c.withStatementSlot(ctx, func() (err error) {
+				result, err = queryScriptResult(ctx, queryer, statement)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("statement %d of %d: %w", i+1, len(statements), err)
+			}
+			results = append(results, result)
+		}
+		return nil
+	})
+	return results, err
+}
+
+// ExecuteImmediate runs a Snowflake Scripting block or a single statement with EXECUTE IMMEDIATE $$ ... $$.
+func (c *Client) ExecuteImmediate(ctx context.Context, block string) (*ScriptResult, error) {
+	if strings.TrimSpace(block) == "" {
+		return nil, ErrEmptyStatement
+	}
+	if strings.Contains(block, "$$") {
+		return nil, errors.New("block must not contain $$")
+	}
+	results, err := c.ExecScript(ctx, "EXECUTE IMMEDIATE "+DollarQuotes.Modify(block))
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// scriptQueryer is implemented by *sqlx.Conn and *sqlx.Tx, which run all statements in the same session.
+type scriptQueryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func queryScriptResult(ctx context.Context, queryer scriptQueryer, statement string) (*ScriptResult, error) {
+	rows, err := queryer.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, decodeDriverError(err)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	result := &ScriptResult{Statement: statement, Columns: columns, Rows: [][]sql.NullString{}}
+	for rows.Next() {
+		row := make([]sql.NullString, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result, decodeDriverError(rows.Err())
+}
@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitScript(t *testing.T) {
+	t.Run("statements", func(t *testing.T) {
+		statements, err := SplitScript("USE ROLE SYSADMIN;\nCREATE DATABASE DB;  \n\nSELECT 1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"USE ROLE SYSADMIN", "CREATE DATABASE DB", "SELECT 1"}, statements)
+	})
+
+	t.Run("semicolons in literals, identifiers, blocks and comments", func(t *testing.T) {
+		script := `SELECT 'a;b', 'it''s;', 'c\';' AS "x;y";
+-- comment; here
+/* block; comment */ EXECUTE IMMEDIATE $$ BEGIN CREATE TABLE T (A INT); END; $$;
+// only a comment;
+`
+		statements, err := SplitScript(script)
+		require.NoError(t, err)
+		require.Len(t, statements, 2)
+		assert.Equal(t, `SELECT 'a;b', 'it''s;', 'c\';' AS "x;y"`, statements[0])
+		assert.Equal(t, "-- comment; here\n/* block; comment */ EXECUTE IMMEDIATE $$ BEGIN CREATE TABLE T (A INT); END; $$", statements[1])
+	})
+
+	t.Run("unterminated", func(t *testing.T) {
+		for _, script := range []string{`SELECT 'a`, `SELECT "a`, `SELECT 1 /* a`, `EXECUTE IMMEDIATE $$ BEGIN`} {
+			_, err := SplitScript(script)
+			assert.Error(t, err, script)
+		}
+	})
+}
+
+func TestExecScript(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("results per statement", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery("CREATE DATABASE DB").WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("Database DB successfully created."))
+		mock.ExpectQuery("SELECT 1 AS A, NULL AS B").WillReturnRows(sqlmock.NewRows([]string{"A", "B"}).AddRow("1", nil))
+
+		results, err := client.ExecScript(ctx, "CREATE DATABASE DB; SELECT 1 AS A, NULL AS B;")
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "Database DB successfully created.", results[0].Rows[0][0].String)
+		assert.Equal(t, []string{"A", "B"}, results[1].Columns)
+		assert.Equal(t, "1", results[1].Rows[0][0].String)
+		assert.False(t, results[1].Rows[0][1].Valid)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow("1"))
+		mock.ExpectQuery("SELECT X").WillReturnError(errors.New("invalid identifier 'X'"))
+
+		results, err := client.ExecScript(ctx, "SELECT 1; SELECT X; SELECT 3")
+		require.ErrorContains(t, err, "statement 2 of 3: invalid identifier 'X'")
+		assert.Len(t, results, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("execute immediate", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(regexp.QuoteMeta("EXECUTE IMMEDIATE $$BEGIN RETURN 1; END;$$")).WillReturnRows(sqlmock.NewRows([]string{"anonymous block"}).AddRow("1"))
+
+		result, err := client.ExecuteImmediate(ctx, "BEGIN RETURN 1; END;")
+		require.NoError(t, err)
+		assert.Equal(t, "1", result.Rows[0][0].String)
+
+		_, err = client.ExecuteImmediate(ctx, "SELECT $$a$$")
+		require.Error(t, err)
+	})
+
+	t.Run("query tag and session parameters", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION SET QUERY_TAG = 'setup'`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("USE ROLE R").WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("Statement executed successfully."))
+		mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow("1"))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION UNSET QUERY_TAG`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		results, err := client.ExecScript(ContextWithQueryTag(ctx, "setup"), "USE ROLE R; SELECT 1")
+		require.NoError(t, err)
+		assert.Len(t, results, 2)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("empty script", func(t *testing.T) {
+		client, _ := newMockClient(t)
+		_, err := client.ExecScript(ctx, " -- nothing\n;")
+		require.ErrorIs(t, err, ErrEmptyStatement)
+	})
+}
@@ -0,0 +1,1217 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ SecurityIntegrations = (*securityIntegrations)(nil)
+
+// SecurityIntegrations describes all the security integration related methods that the
+// Snowflake API supports.
+type SecurityIntegrations interface {
+	// CreateSAML2 creates a new SAML2 security integration.
+	CreateSAML2(ctx context.Context, id AccountObjectIdentifier, opts *CreateSAML2SecurityIntegrationOptions) error
+	// AlterSAML2 modifies an existing SAML2 security integration.
+	AlterSAML2(ctx context.Context, id AccountObjectIdentifier, opts *AlterSAML2SecurityIntegrationOptions) error
+	// DescribeSAML2 returns the details of a SAML2 security integration.
+	DescribeSAML2(ctx context.Context, id AccountObjectIdentifier) (*SAML2IntegrationDetails, error)
+	// CreateSCIM creates a new SCIM security integration.
+	CreateSCIM(ctx context.Context, id AccountObjectIdentifier, opts *CreateSCIMSecurityIntegrationOptions) error
+	// AlterSCIM modifies an existing SCIM security integration.
+	AlterSCIM(ctx context.Context, id AccountObjectIdentifier, opts *AlterSCIMSecurityIntegrationOptions) error
+	// DescribeSCIM returns the details of a SCIM security integration.
+	DescribeSCIM(ctx context.Context, id AccountObjectIdentifier) (*SCIMIntegrationDetails, error)
+	// GenerateSCIMAccessToken returns a new access token the SCIM client authenticates with. It is valid for six months.
+	GenerateSCIMAccessToken(ctx context.Context, id AccountObjectIdentifier) (string, error)
+	// CreateOAuth creates a new Snowflake OAuth security integration for a partner application or a custom client.
+	CreateOAuth(ctx context.Context, id AccountObjectIdentifier, opts *CreateOAuthSecurityIntegrationOptions) error
+	// AlterOAuth modifies an existing Snowflake OAuth security integration.
+	AlterOAuth(ctx context.Context, id AccountObjectIdentifier, opts *AlterOAuthSecurityIntegrationOptions) error
+	// DescribeOAuth returns the details of a Snowflake OAuth security integration.
+	DescribeOAuth(ctx context.Context, id AccountObjectIdentifier) (*OAuthIntegrationDetails, error)
+	// ShowOAuthClientSecrets returns the client ID and secrets of a custom Snowflake OAuth security integration.
+	ShowOAuthClientSecrets(ctx context.Context, id AccountObjectIdentifier) (*OAuthClientSecrets, error)
+	// CreateExternalOAuth creates a new External OAuth security integration.
+	CreateExternalOAuth(ctx context.Context, id AccountObjectIdentifier, opts *CreateExternalOAuthSecurityIntegrationOptions) error
+	// AlterExternalOAuth modifies an existing External OAuth security integration.
+	AlterExternalOAuth(ctx context.Context, id AccountObjectIdentifier, opts *AlterExternalOAuthSecurityIntegrationOptions) error
+	// DescribeExternalOAuth returns the details of an External OAuth security integration.
+	DescribeExternalOAuth(ctx context.Context, id AccountObjectIdentifier) (*ExternalOAuthIntegrationDetails, error)
+	// Drop removes a security integration.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropSecurityIntegrationOptions) error
+	// Show returns a list of security integrations.
+	Show(ctx context.Context, opts *ShowSecurityIntegrationOptions) ([]*SecurityIntegration, error)
+	// ShowByID returns a security integration by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*SecurityIntegration, error)
+}
+
+// securityIntegrations implements SecurityIntegrations.
+type securityIntegrations struct {
+	client *Client
+}
+
+// SecurityIntegrationType is the type of a security integration as reported by SHOW SECURITY INTEGRATIONS,
+// without the client or provider suffix, e.g. SCIM for "SCIM - OKTA".
+type SecurityIntegrationType string
+
+const (
+	SecurityIntegrationTypeSAML2         SecurityIntegrationType = "SAML2"
+	SecurityIntegrationTypeSCIM          SecurityIntegrationType = "SCIM"
+	SecurityIntegrationTypeOAuth         SecurityIntegrationType = "OAUTH"
+	SecurityIntegrationTypeExternalOAuth SecurityIntegrationType = "EXTERNAL_OAUTH"
+)
+
+type SAML2Provider string
+
+const (
+	SAML2ProviderOkta   SAML2Provider = "OKTA"
+	SAML2ProviderADFS   SAML2Provider = "ADFS"
+	SAML2ProviderCustom SAML2Provider = "CUSTOM"
+)
+
+type UserDomain struct {
+	Domain string `ddl:"keyword,single_quotes"`
+}
+
+type EmailPattern struct {
+	Pattern string `ddl:"keyword,single_quotes"`
+}
+
+type CreateSAML2SecurityIntegrationOptions struct {
+	create              bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace           *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists         *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                AccountObjectIdentifier `ddl:"identifier"`
+	integrationType     bool                    `ddl:"static" sql:"TYPE = SAML2"` //lint:ignore U1000 This is used in the ddl tag
+
+	Enabled                        bool           `ddl:"parameter" sql:"ENABLED"`
+	SAML2Issuer                    string         `ddl:"parameter,single_quotes" sql:"SAML2_ISSUER"`
+	SAML2SSOURL                    string         `ddl:"parameter,single_quotes" sql:"SAML2_SSO_URL"`
+	SAML2Provider                  SAML2Provider  `ddl:"parameter,single_quotes" sql:"SAML2_PROVIDER"`
+	SAML2X509Cert                  string         `ddl:"parameter,single_quotes" sql:"SAML2_X509_CERT"`
+	AllowedUserDomains             []UserDomain   `ddl:"parameter,parentheses" sql:"ALLOWED_USER_DOMAINS"`
+	AllowedEmailPatterns           []EmailPattern `ddl:"parameter,parentheses" sql:"ALLOWED_EMAIL_PATTERNS"`
+	SAML2SPInitiatedLoginPageLabel *string        `ddl:"parameter,single_quotes" sql:"SAML2_SP_INITIATED_LOGIN_PAGE_LABEL"`
+	SAML2EnableSPInitiated         *bool          `ddl:"parameter" sql:"SAML2_ENABLE_SP_INITIATED"`
+	SAML2SnowflakeX509Cert         *string        `ddl:"parameter,single_quotes" sql:"SAML2_SNOWFLAKE_X509_CERT"`
+	SAML2SignRequest               *bool          `ddl:"parameter" sql:"SAML2_SIGN_REQUEST"`
+	SAML2RequestedNameIDFormat     *string        `ddl:"parameter,single_quotes" sql:"SAML2_REQUESTED_NAMEID_FORMAT"`
+	SAML2PostLogoutRedirectURL     *string        `ddl:"parameter,single_quotes" sql:"SAML2_POST_LOGOUT_REDIRECT_URL"`
+	SAML2ForceAuthn                *bool          `ddl:"parameter" sql:"SAML2_FORCE_AUTHN"`
+	SAML2SnowflakeIssuerURL        *string        `ddl:"parameter,single_quotes" sql:"SAML2_SNOWFLAKE_ISSUER_URL"`
+	SAML2SnowflakeACSURL           *string        `ddl:"parameter,single_quotes" sql:"SAML2_SNOWFLAKE_ACS_URL"`
+	Comment                        *string        `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateSAML2SecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.SAML2Issuer == "" || opts.SAML2SSOURL == "" || opts.SAML2Provider == "" || opts.SAML2X509Cert == "" {
+		return errors.New("SAML2Issuer, SAML2SSOURL, SAML2Provider and SAML2X509Cert are required")
+	}
+	return nil
+}
+
+func (v *securityIntegrations) CreateSAML2(ctx context.Context, id AccountObjectIdentifier, opts *CreateSAML2SecurityIntegrationOptions) error {
+	if opts == nil {
+		opts = &CreateSAML2SecurityIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterSAML2SecurityIntegrationOptions struct {
+	alter               bool                    `ddl:"static" sql:"ALTER"`                //lint:ignore U1000 This is used in the ddl tag
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists            *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name                AccountObjectIdentifier `ddl:"identifier"`
+	Set                 *SAML2IntegrationSet    `ddl:"keyword" sql:"SET"`
+	Unset               *SAML2IntegrationUnset  `ddl:"list,no_parentheses" sql:"UNSET"`
+	RefreshPrivateKey   *bool                   `ddl:"keyword" sql:"REFRESH SAML2_SNOWFLAKE_PRIVATE_KEY"`
+	SetTag              []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag            []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterSAML2SecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.RefreshPrivateKey, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, RefreshPrivateKey, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SAML2IntegrationSet struct {
+	Enabled                        *bool          `ddl:"parameter" sql:"ENABLED"`
+	SAML2Issuer                    *string        `ddl:"parameter,single_quotes" sql:"SAML2_ISSUER"`
+	SAML2SSOURL                    *string        `ddl:"parameter,single_quotes" sql:"SAML2_SSO_URL"`
+	SAML2Provider                  *SAML2Provider `ddl:"parameter,single_quotes" sql:"SAML2_PROVIDER"`
+	SAML2X509Cert                  *string        `ddl:"parameter,single_quotes" sql:"SAML2_X509_CERT"`
+	AllowedUserDomains             []UserDomain   `ddl:"parameter,parentheses" sql:"ALLOWED_USER_DOMAINS"`
+	AllowedEmailPatterns           []EmailPattern `ddl:"parameter,parentheses" sql:"ALLOWED_EMAIL_PATTERNS"`
+	SAML2SPInitiatedLoginPageLabel *string        `ddl:"parameter,single_quotes" sql:"SAML2_SP_INITIATED_LOGIN_PAGE_LABEL"`
+	SAML2EnableSPInitiated         *bool          `ddl:"parameter" sql:"SAML2_ENABLE_SP_INITIATED"`
+	SAML2SnowflakeX509Cert         *string        `ddl:"parameter,single_quotes" sql:"SAML2_SNOWFLAKE_X509_CERT"`
+	SAML2SignRequest               *bool          `ddl:"parameter" sql:"SAML2_SIGN_REQUEST"`
+	SAML2RequestedNameIDFormat     *string        `ddl:"parameter,single_quotes" sql:"SAML2_REQUESTED_NAMEID_FORMAT"`
+	SAML2PostLogoutRedirectURL     *string        `ddl:"parameter,single_quotes" sql:"SAML2_POST_LOGOUT_REDIRECT_URL"`
+	SAML2ForceAuthn                *bool          `ddl:"parameter" sql:"SAML2_FORCE_AUTHN"`
+	SAML2SnowflakeIssuerURL        *string        `ddl:"parameter,single_quotes" sql:"SAML2_SNOWFLAKE_ISSUER_URL"`
+	SAML2SnowflakeACSURL           *string        `ddl:"parameter,single_quotes" sql:"SAML2_SNOWFLAKE_ACS_URL"`
+	Comment                        *string        `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *SAML2IntegrationSet) validate() error {
+	if !anyValueSet(v.Enabled, v.SAML2Issuer, v.SAML2SSOURL, v.SAML2Provider, v.SAML2X509Cert, v.AllowedUserDomains,
+		v.AllowedEmailPatterns, v.SAML2SPInitiatedLoginPageLabel, v.SAML2EnableSPInitiated, v.SAML2SnowflakeX509Cert,
+		v.SAML2SignRequest, v.SAML2RequestedNameIDFormat, v.SAML2PostLogoutRedirectURL, v.SAML2ForceAuthn,
+		v.SAML2SnowflakeIssuerURL, v.SAML2SnowflakeACSURL, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	return nil
+}
+
+type SAML2IntegrationUnset struct {
+	Enabled                    *bool `ddl:"keyword" sql:"ENABLED"`
+	AllowedUserDomains         *bool `ddl:"keyword" sql:"ALLOWED_USER_DOMAINS"`
+	AllowedEmailPatterns       *bool `ddl:"keyword" sql:"ALLOWED_EMAIL_PATTERNS"`
+	SAML2ForceAuthn            *bool `ddl:"keyword" sql:"SAML2_FORCE_AUTHN"`
+	SAML2RequestedNameIDFormat *bool `ddl:"keyword" sql:"SAML2_REQUESTED_NAMEID_FORMAT"`
+	SAML2PostLogoutRedirectURL *bool `ddl:"keyword" sql:"SAML2_POST_LOGOUT_REDIRECT_URL"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *SAML2IntegrationUnset) validate() error {
+	if !anyValueSet(v.Enabled, v.AllowedUserDomains, v.AllowedEmailPatterns, v.SAML2ForceAuthn, v.SAML2RequestedNameIDFormat,
+		v.SAML2PostLogoutRedirectURL, v.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+func (v *securityIntegrations) AlterSAML2(ctx context.Context, id AccountObjectIdentifier, opts *AlterSAML2SecurityIntegrationOptions) error {
+	if opts == nil {
+		opts = &AlterSAML2SecurityIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type describeSecurityIntegrationOptions struct {
+	describe            bool                    `ddl:"static" sql:"DESCRIBE"`             //lint:ignore U1000 This is used in the ddl tag
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	name                AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeSecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *securityIntegrations) describe(ctx context.Context, id AccountObjectIdentifier) ([]integrationPropertyRow, error) {
+	opts := &describeSecurityIntegrationOptions{
+		name: id,
+	}
+	return describeRows[integrationPropertyRow](ctx, v.client, opts)
+}
+
+// SAML2IntegrationDetails contains the typed output of DESCRIBE SECURITY INTEGRATION for SAML2
+// integrations, including the service provider values generated by Snowflake.
+type SAML2IntegrationDetails struct {
+	Enabled                        bool
+	SAML2Issuer                    string
+	SAML2SSOURL                    string
+	SAML2Provider                  string
+	SAML2X509Cert                  string
+	AllowedUserDomains             []string
+	AllowedEmailPatterns           []string
+	SAML2SPInitiatedLoginPageLabel string
+	SAML2EnableSPInitiated         bool
+	SAML2SignRequest               bool
+	SAML2RequestedNameIDFormat     string
+	SAML2PostLogoutRedirectURL     string
+	SAML2ForceAuthn                bool
+	Comment                        string
+
+	// Generated by Snowflake.
+	SAML2SnowflakeX509Cert    string
+	SAML2SnowflakeIssuerURL   string
+	SAML2SnowflakeACSURL      string
+	SAML2SnowflakeMetadata    string
+	SAML2DigestMethodsUsed    string
+	SAML2SignatureMethodsUsed string
+}
+
+func saml2IntegrationDetailsFromRows(rows []integrationPropertyRow) *SAML2IntegrationDetails {
+	v := &SAML2IntegrationDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "ENABLED":
+			v.Enabled = row.toBool()
+		case "SAML2_ISSUER":
+			v.SAML2Issuer = row.Value
+		case "SAML2_SSO_URL":
+			v.SAML2SSOURL = row.Value
+		case "SAML2_PROVIDER":
+			v.SAML2Provider = row.Value
+		case "SAML2_X509_CERT":
+			v.SAML2X509Cert = row.Value
+		case "ALLOWED_USER_DOMAINS":
+			v.AllowedUserDomains = row.toList()
+		case "ALLOWED_EMAIL_PATTERNS":
+			v.AllowedEmailPatterns = row.toList()
+		case "SAML2_SP_INITIATED_LOGIN_PAGE_LABEL":
+			v.SAML2SPInitiatedLoginPageLabel = row.Value
+		case "SAML2_ENABLE_SP_INITIATED":
+			v.SAML2EnableSPInitiated = row.toBool()
+		case "SAML2_SIGN_REQUEST":
+			v.SAML2SignRequest = row.toBool()
+		case "SAML2_REQUESTED_NAMEID_FORMAT":
+			v.SAML2RequestedNameIDFormat = row.Value
+		case "SAML2_POST_LOGOUT_REDIRECT_URL":
+			v.SAML2PostLogoutRedirectURL = row.Value
+		case "SAML2_FORCE_AUTHN":
+			v.SAML2ForceAuthn = row.toBool()
+		case "COMMENT":
+			v.Comment = row.Value
+		case "SAML2_SNOWFLAKE_X509_CERT":
+			v.SAML2SnowflakeX509Cert = row.Value
+		case "SAML2_SNOWFLAKE_ISSUER_URL":
+			v.SAML2SnowflakeIssuerURL = row.Value
+		case "SAML2_SNOWFLAKE_ACS_URL":
+			v.SAML2SnowflakeACSURL = row.Value
+		case "SAML2_SNOWFLAKE_METADATA":
+			v.SAML2SnowflakeMetadata = row.Value
+		case "SAML2_DIGEST_METHODS_USED":
+			v.SAML2DigestMethodsUsed = row.Value
+		case "SAML2_SIGNATURE_METHODS_USED":
+			v.SAML2SignatureMethodsUsed = row.Value
+		}
+	}
+	return v
+}
+
+func (v *securityIntegrations) DescribeSAML2(ctx context.Context, id AccountObjectIdentifier) (*SAML2IntegrationDetails, error) {
+	rows, err := v.describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return saml2IntegrationDetailsFromRows(rows), nil
+}
+
+type SCIMClient string
+
+const (
+	SCIMClientOkta    SCIMClient = "OKTA"
+	SCIMClientAzure   SCIMClient = "AZURE"
+	SCIMClientGeneric SCIMClient = "GENERIC"
+)
+
+// SCIMRunAsRole is the role SCIM provisioning runs as. Each SCIM client has its own dedicated role.
+type SCIMRunAsRole string
+
+const (
+	SCIMRunAsRoleOktaProvisioner    SCIMRunAsRole = "OKTA_PROVISIONER"
+	SCIMRunAsRoleAADProvisioner     SCIMRunAsRole = "AAD_PROVISIONER"
+	SCIMRunAsRoleGenericProvisioner SCIMRunAsRole = "GENERIC_SCIM_PROVISIONER"
+)
+
+type CreateSCIMSecurityIntegrationOptions struct {
+	create              bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace           *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists         *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                AccountObjectIdentifier `ddl:"identifier"`
+	integrationType     bool                    `ddl:"static" sql:"TYPE = SCIM"` //lint:ignore U1000 This is used in the ddl tag
+
+	Enabled       *bool         `ddl:"parameter" sql:"ENABLED"`
+	SCIMClient    SCIMClient    `ddl:"parameter,single_quotes" sql:"SCIM_CLIENT"`
+	RunAsRole     SCIMRunAsRole `ddl:"parameter,single_quotes" sql:"RUN_AS_ROLE"`
+	NetworkPolicy *string       `ddl:"parameter,single_quotes" sql:"NETWORK_POLICY"`
+	SyncPassword  *bool         `ddl:"parameter" sql:"SYNC_PASSWORD"`
+	Comment       *string       `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateSCIMSecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.SCIMClient == "" || opts.RunAsRole == "" {
+		return errors.New("SCIMClient and RunAsRole are required")
+	}
+	return nil
+}
+
+func (v *securityIntegrations) CreateSCIM(ctx context.Context, id AccountObjectIdentifier, opts *CreateSCIMSecurityIntegrationOptions) error {
+	if opts == nil {
+		opts = &CreateSCIMSecurityIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterSCIMSecurityIntegrationOptions struct {
+	alter               bool                    `ddl:"static" sql:"ALTER"`                //lint:ignore U1000 This is used in the ddl tag
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists            *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name                AccountObjectIdentifier `ddl:"identifier"`
+	Set                 *SCIMIntegrationSet     `ddl:"keyword" sql:"SET"`
+	Unset               *SCIMIntegrationUnset   `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTag              []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag            []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterSCIMSecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SCIMIntegrationSet struct {
+	Enabled       *bool   `ddl:"parameter" sql:"ENABLED"`
+	NetworkPolicy *string `ddl:"parameter,single_quotes" sql:"NETWORK_POLICY"`
+	SyncPassword  *bool   `ddl:"parameter" sql:"SYNC_PASSWORD"`
+	Comment       *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *SCIMIntegrationSet) validate() error {
+	if !anyValueSet(v.Enabled, v.NetworkPolicy, v.SyncPassword, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	return nil
+}
+
+type SCIMIntegrationUnset struct {
+	Enabled       *bool `ddl:"keyword" sql:"ENABLED"`
+	NetworkPolicy *bool `ddl:"keyword" sql:"NETWORK_POLICY"`
+	SyncPassword  *bool `ddl:"keyword" sql:"SYNC_PASSWORD"`
+	Comment       *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *SCIMIntegrationUnset) validate() error {
+	if !anyValueSet(v.Enabled, v.NetworkPolicy, v.SyncPassword, v.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+func (v *securityIntegrations) AlterSCIM(ctx context.Context, id AccountObjectIdentifier, opts *AlterSCIMSecurityIntegrationOptions) error {
+	if opts == nil {
+		opts = &AlterSCIMSecurityIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// SCIMIntegrationDetails contains the typed output of DESCRIBE SECURITY INTEGRATION for SCIM integrations.
+type SCIMIntegrationDetails struct {
+	Enabled       bool
+	SCIMClient    string
+	RunAsRole     string
+	NetworkPolicy string
+	SyncPassword  bool
+	Comment       string
+}
+
+func scimIntegrationDetailsFromRows(rows []integrationPropertyRow) *SCIMIntegrationDetails {
+	v := &SCIMIntegrationDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "ENABLED":
+			v.Enabled = row.toBool()
+		case "SCIM_CLIENT":
+			v.SCIMClient = row.Value
+		case "RUN_AS_ROLE":
+			v.RunAsRole = row.Value
+		case "NETWORK_POLICY":
+			v.NetworkPolicy = row.Value
+		case "SYNC_PASSWORD":
+			v.SyncPassword = row.toBool()
+		case "COMMENT":
+			v.Comment = row.Value
+		}
+	}
+	return v
+}
+
+func (v *securityIntegrations) DescribeSCIM(ctx context.Context, id AccountObjectIdentifier) (*SCIMIntegrationDetails, error) {
+	rows, err := v.describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return scimIntegrationDetailsFromRows(rows), nil
+}
+
+func (v *securityIntegrations) GenerateSCIMAccessToken(ctx context.Context, id AccountObjectIdentifier) (string, error) {
+	if !validObjectidentifier(id) {
+		return "", ErrInvalidObjectIdentifier
+	}
+	s := &struct {
+		Token string `db:"TOKEN"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$GENERATE_SCIM_ACCESS_TOKEN('%s') AS "TOKEN"`, escapeStringLiteral(id.Name()))
+	if err := v.client.queryOne(ctx, s, sql); err != nil {
+		return "", err
+	}
+	return s.Token, nil
+}
+
+type OAuthClient string
+
+const (
+	OAuthClientTableauDesktop OAuthClient = "TABLEAU_DESKTOP"
+	OAuthClientTableauServer  OAuthClient = "TABLEAU_SERVER"
+	OAuthClientLooker         OAuthClient = "LOOKER"
+	OAuthClientCustom         OAuthClient = "CUSTOM"
+)
+
+type OAuthClientType string
+
+const (
+	OAuthClientTypePublic       OAuthClientType = "PUBLIC"
+	OAuthClientTypeConfidential OAuthClientType = "CONFIDENTIAL"
+)
+
+type OAuthUseSecondaryRoles string
+
+const (
+	OAuthUseSecondaryRolesImplicit OAuthUseSecondaryRoles = "IMPLICIT"
+	OAuthUseSecondaryRolesNone     OAuthUseSecondaryRoles = "NONE"
+)
+
+// OAuthRole is a role in one of the role lists of an OAuth or External OAuth integration,
+// e.g. BLOCKED_ROLES_LIST.
+type OAuthRole struct {
+	Name string `ddl:"keyword,single_quotes"`
+}
+
+type CreateOAuthSecurityIntegrationOptions struct {
+	create              bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace           *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists         *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                AccountObjectIdentifier `ddl:"identifier"`
+	integrationType     bool                    `ddl:"static" sql:"TYPE = OAUTH"` //lint:ignore U1000 This is used in the ddl tag
+
+	OAuthClient      OAuthClient      `ddl:"parameter" sql:"OAUTH_CLIENT"`
+	OAuthClientType  *OAuthClientType `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_TYPE"`
+	OAuthRedirectURI *string          `ddl:"parameter,single_quotes" sql:"OAUTH_REDIRECT_URI"`
+	Enabled          *bool            `ddl:"parameter" sql:"ENABLED"`
+
+	// Custom clients only.
+	OAuthAllowNonTLSRedirectURI *bool       `ddl:"parameter" sql:"OAUTH_ALLOW_NON_TLS_REDIRECT_URI"`
+	OAuthEnforcePKCE            *bool       `ddl:"parameter" sql:"OAUTH_ENFORCE_PKCE"`
+	PreAuthorizedRolesList      []OAuthRole `ddl:"parameter,parentheses" sql:"PRE_AUTHORIZED_ROLES_LIST"`
+
+	OAuthIssueRefreshTokens   *bool                   `ddl:"parameter" sql:"OAUTH_ISSUE_REFRESH_TOKENS"`
+	OAuthRefreshTokenValidity *int                    `ddl:"parameter" sql:"OAUTH_REFRESH_TOKEN_VALIDITY"`
+	OAuthUseSecondaryRoles    *OAuthUseSecondaryRoles `ddl:"parameter" sql:"OAUTH_USE_SECONDARY_ROLES"`
+	BlockedRolesList          []OAuthRole             `ddl:"parameter,parentheses" sql:"BLOCKED_ROLES_LIST"`
+
+	// Custom clients only.
+	NetworkPolicy            *string `ddl:"parameter,single_quotes" sql:"NETWORK_POLICY"`
+	OAuthClientRSAPublicKey  *string `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_RSA_PUBLIC_KEY"`
+	OAuthClientRSAPublicKey2 *string `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_RSA_PUBLIC_KEY_2"`
+
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateOAuthSecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	switch opts.OAuthClient {
+	case OAuthClientCustom:
+		if !everyValueSet(opts.OAuthClientType, opts.OAuthRedirectURI) {
+			return errors.New("OAuthClientType and OAuthRedirectURI are required for custom clients")
+		}
+	case OAuthClientTableauDesktop, OAuthClientTableauServer, OAuthClientLooker:
+		if anyValueSet(opts.OAuthClientType, opts.OAuthAllowNonTLSRedirectURI, opts.OAuthEnforcePKCE, opts.PreAuthorizedRolesList,
+			opts.NetworkPolicy, opts.OAuthClientRSAPublicKey, opts.OAuthClientRSAPublicKey2) {
+			return fmt.Errorf("OAuthClientType, OAuthAllowNonTLSRedirectURI, OAuthEnforcePKCE, PreAuthorizedRolesList, NetworkPolicy and OAuthClientRSAPublicKey(2) can only be set for custom clients, not %s", opts.OAuthClient)
+		}
+		if opts.OAuthClient == OAuthClientLooker && !valueSet(opts.OAuthRedirectURI) {
+			return errors.New("OAuthRedirectURI is required for LOOKER")
+		}
+	default:
+		return fmt.Errorf("OAuthClient must be one of %s, %s, %s, %s", OAuthClientTableauDesktop, OAuthClientTableauServer, OAuthClientLooker, OAuthClientCustom)
+	}
+	return nil
+}
+
+func (v *securityIntegrations) CreateOAuth(ctx context.Context, id AccountObjectIdentifier, opts *CreateOAuthSecurityIntegrationOptions) error {
+	if opts == nil {
+		opts = &CreateOAuthSecurityIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterOAuthSecurityIntegrationOptions struct {
+	alter               bool                    `ddl:"static" sql:"ALTER"`                //lint:ignore U1000 This is used in the ddl tag
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists            *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name                AccountObjectIdentifier `ddl:"identifier"`
+	Set                 *OAuthIntegrationSet    `ddl:"keyword" sql:"SET"`
+	Unset               *OAuthIntegrationUnset  `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTag              []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag            []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterOAuthSecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type OAuthIntegrationSet struct {
+	Enabled                     *bool                   `ddl:"parameter" sql:"ENABLED"`
+	OAuthRedirectURI            *string                 `ddl:"parameter,single_quotes" sql:"OAUTH_REDIRECT_URI"`
+	OAuthAllowNonTLSRedirectURI *bool                   `ddl:"parameter" sql:"OAUTH_ALLOW_NON_TLS_REDIRECT_URI"`
+	OAuthEnforcePKCE            *bool                   `ddl:"parameter" sql:"OAUTH_ENFORCE_PKCE"`
+	PreAuthorizedRolesList      []OAuthRole             `ddl:"parameter,parentheses" sql:"PRE_AUTHORIZED_ROLES_LIST"`
+	OAuthIssueRefreshTokens     *bool                   `ddl:"parameter" sql:"OAUTH_ISSUE_REFRESH_TOKENS"`
+	OAuthRefreshTokenValidity   *int                    `ddl:"parameter" sql:"OAUTH_REFRESH_TOKEN_VALIDITY"`
+	OAuthUseSecondaryRoles      *OAuthUseSecondaryRoles `ddl:"parameter" sql:"OAUTH_USE_SECONDARY_ROLES"`
+	BlockedRolesList            []OAuthRole             `ddl:"parameter,parentheses" sql:"BLOCKED_ROLES_LIST"`
+	NetworkPolicy               *string                 `ddl:"parameter,single_quotes" sql:"NETWORK_POLICY"`
+	OAuthClientRSAPublicKey     *string                 `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_RSA_PUBLIC_KEY"`
+	OAuthClientRSAPublicKey2    *string                 `ddl:"parameter,single_quotes" sql:"OAUTH_CLIENT_RSA_PUBLIC_KEY_2"`
+	Comment                     *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *OAuthIntegrationSet) validate() error {
+	if !anyValueSet(v.Enabled, v.OAuthRedirectURI, v.OAuthAllowNonTLSRedirectURI, v.OAuthEnforcePKCE, v.PreAuthorizedRolesList,
+		v.OAuthIssueRefreshTokens, v.OAuthRefreshTokenValidity, v.OAuthUseSecondaryRoles, v.BlockedRolesList, v.NetworkPolicy,
+		v.OAuthClientRSAPublicKey, v.OAuthClientRSAPublicKey2, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	return nil
+}
+
+type OAuthIntegrationUnset struct {
+	Enabled                  *bool `ddl:"keyword" sql:"ENABLED"`
+	NetworkPolicy            *bool `ddl:"keyword" sql:"NETWORK_POLICY"`
+	OAuthClientRSAPublicKey  *bool `ddl:"keyword" sql:"OAUTH_CLIENT_RSA_PUBLIC_KEY"`
+	OAuthClientRSAPublicKey2 *bool `ddl:"keyword" sql:"OAUTH_CLIENT_RSA_PUBLIC_KEY_2"`
+	OAuthUseSecondaryRoles   *bool `ddl:"keyword" sql:"OAUTH_USE_SECONDARY_ROLES"`
+	Comment                  *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *OAuthIntegrationUnset) validate() error {
+	if !anyValueSet(v.Enabled, v.NetworkPolicy, v.OAuthClientRSAPublicKey, v.OAuthClientRSAPublicKey2, v.OAuthUseSecondaryRoles, v.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+func (v *securityIntegrations) AlterOAuth(ctx context.Context, id AccountObjectIdentifier, opts *AlterOAuthSecurityIntegrationOptions) error {
+	if opts == nil {
+		opts = &AlterOAuthSecurityIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// OAuthIntegrationDetails contains the typed output of DESCRIBE SECURITY INTEGRATION for Snowflake OAuth integrations.
+type OAuthIntegrationDetails struct {
+	Enabled                     bool
+	OAuthClientType             string
+	OAuthRedirectURI            string
+	OAuthAllowNonTLSRedirectURI bool
+	OAuthEnforcePKCE            bool
+	PreAuthorizedRolesList      []string
+	BlockedRolesList            []string
+	OAuthIssueRefreshTokens     bool
+	OAuthRefreshTokenValidity   int
+	OAuthUseSecondaryRoles      string
+	NetworkPolicy               string
+	OAuthClientRSAPublicKeyFP   string
+	OAuthClientRSAPublicKey2FP  string
+	Comment                     string
+
+	// Generated by Snowflake.
+	OAuthClientID                      string
+	OAuthAuthorizationEndpoint         string
+	OAuthTokenEndpoint                 string
+	OAuthAllowedAuthorizationEndpoints []string
+	OAuthAllowedTokenEndpoints         []string
+}
+
+func oauthIntegrationDetailsFromRows(rows []integrationPropertyRow) *OAuthIntegrationDetails {
+	v := &OAuthIntegrationDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "ENABLED":
+			v.Enabled = row.toBool()
+		case "OAUTH_CLIENT_TYPE":
+			v.OAuthClientType = row.Value
+		case "OAUTH_REDIRECT_URI":
+			v.OAuthRedirectURI = row.Value
+		case "OAUTH_ALLOW_NON_TLS_REDIRECT_URI":
+			v.OAuthAllowNonTLSRedirectURI = row.toBool()
+		case "OAUTH_ENFORCE_PKCE":
+			v.OAuthEnforcePKCE = row.toBool()
+		case "PRE_AUTHORIZED_ROLES_LIST":
+			v.PreAuthorizedRolesList = row.toList()
+		case "BLOCKED_ROLES_LIST":
+			v.BlockedRolesList = row.toList()
+		case "OAUTH_ISSUE_REFRESH_TOKENS":
+			v.OAuthIssueRefreshTokens = row.toBool()
+		case "OAUTH_REFRESH_TOKEN_VALIDITY":
+			if i, err := strconv.Atoi(row.Value); err == nil {
+				v.OAuthRefreshTokenValidity = i
+			}
+		case "OAUTH_USE_SECONDARY_ROLES":
+			v.OAuthUseSecondaryRoles = row.Value
+		case "NETWORK_POLICY":
+			v.NetworkPolicy = row.Value
+		case "OAUTH_CLIENT_RSA_PUBLIC_KEY_FP":
+			v.OAuthClientRSAPublicKeyFP = row.Value
+		case "OAUTH_CLIENT_RSA_PUBLIC_KEY_2_FP":
+			v.OAuthClientRSAPublicKey2FP = row.Value
+		case "COMMENT":
+			v.Comment = row.Value
+		case "OAUTH_CLIENT_ID":
+			v.OAuthClientID = row.Value
+		case "OAUTH_AUTHORIZATION_ENDPOINT":
+			v.OAuthAuthorizationEndpoint = row.Value
+		case "OAUTH_TOKEN_ENDPOINT":
+			v.OAuthTokenEndpoint = row.Value
+		case "OAUTH_ALLOWED_AUTHORIZATION_ENDPOINTS":
+			v.OAuthAllowedAuthorizationEndpoints = row.toList()
+		case "OAUTH_ALLOWED_TOKEN_ENDPOINTS":
+			v.OAuthAllowedTokenEndpoints = row.toList()
+		}
+	}
+	return v
+}
+
+func (v *securityIntegrations) DescribeOAuth(ctx context.Context, id AccountObjectIdentifier) (*OAuthIntegrationDetails, error) {
+	rows, err := v.describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return oauthIntegrationDetailsFromRows(rows), nil
+}
+
+// OAuthClientSecrets contains the client credentials of a custom Snowflake OAuth integration.
+type OAuthClientSecrets struct {
+	OAuthClientID      string `json:"OAUTH_CLIENT_ID"`
+	OAuthClientSecret  string `json:"OAUTH_CLIENT_SECRET"`
+	OAuthClientSecret2 string `json:"OAUTH_CLIENT_SECRET_2"`
+}
+
+func (v *securityIntegrations) ShowOAuthClientSecrets(ctx context.Context, id AccountObjectIdentifier) (*OAuthClientSecrets, error) {
+	if !validObjectidentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	s := &struct {
+		Secrets string `db:"SECRETS"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$SHOW_OAUTH_CLIENT_SECRETS('%s') AS "SECRETS"`, escapeStringLiteral(id.Name()))
+	if err := v.client.queryOne(ctx, s, sql); err != nil {
+		return nil, err
+	}
+	secrets := &OAuthClientSecrets{}
+	if err := json.Unmarshal([]byte(s.Secrets), secrets); err != nil {
+		return nil, fmt.Errorf("parse client secrets of %s: %w", id.FullyQualifiedName(), err)
+	}
+	return secrets, nil
+}
+
+type ExternalOAuthType string
+
+const (
+	ExternalOAuthTypeOkta         ExternalOAuthType = "OKTA"
+	ExternalOAuthTypeAzure        ExternalOAuthType = "AZURE"
+	ExternalOAuthTypePingFederate ExternalOAuthType = "PING_FEDERATE"
+	ExternalOAuthTypeCustom       ExternalOAuthType = "CUSTOM"
+)
+
+type ExternalOAuthSnowflakeUserMappingAttribute string
+
+const (
+	ExternalOAuthSnowflakeUserMappingAttributeLoginName    ExternalOAuthSnowflakeUserMappingAttribute = "LOGIN_NAME"
+	ExternalOAuthSnowflakeUserMappingAttributeEmailAddress ExternalOAuthSnowflakeUserMappingAttribute = "EMAIL_ADDRESS"
+)
+
+type ExternalOAuthAnyRoleMode string
+
+const (
+	ExternalOAuthAnyRoleModeDisable            ExternalOAuthAnyRoleMode = "DISABLE"
+	ExternalOAuthAnyRoleModeEnable             ExternalOAuthAnyRoleMode = "ENABLE"
+	ExternalOAuthAnyRoleModeEnableForPrivilege ExternalOAuthAnyRoleMode = "ENABLE_FOR_PRIVILEGE"
+)
+
+type TokenUserMappingClaim struct {
+	Claim string `ddl:"keyword,single_quotes"`
+}
+
+type JWSKeysURL struct {
+	URL string `ddl:"keyword,single_quotes"`
+}
+
+type AudienceListItem struct {
+	Item string `ddl:"keyword,single_quotes"`
+}
+
+type CreateExternalOAuthSecurityIntegrationOptions struct {
+	create              bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace           *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists         *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                AccountObjectIdentifier `ddl:"identifier"`
+	integrationType     bool                    `ddl:"static" sql:"TYPE = EXTERNAL_OAUTH"` //lint:ignore U1000 This is used in the ddl tag
+
+	Enabled                                    bool                                       `ddl:"parameter" sql:"ENABLED"`
+	ExternalOAuthType                          ExternalOAuthType                          `ddl:"parameter" sql:"EXTERNAL_OAUTH_TYPE"`
+	ExternalOAuthIssuer                        string                                     `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_ISSUER"`
+	ExternalOAuthTokenUserMappingClaim         []TokenUserMappingClaim                    `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM"`
+	ExternalOAuthSnowflakeUserMappingAttribute ExternalOAuthSnowflakeUserMappingAttribute `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE"`
+	ExternalOAuthJWSKeysURL                    []JWSKeysURL                               `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_JWS_KEYS_URL"`
+	ExternalOAuthBlockedRolesList              []OAuthRole                                `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_BLOCKED_ROLES_LIST"`
+	ExternalOAuthAllowedRolesList              []OAuthRole                                `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_ALLOWED_ROLES_LIST"`
+	ExternalOAuthRSAPublicKey                  *string                                    `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_RSA_PUBLIC_KEY"`
+	ExternalOAuthRSAPublicKey2                 *string                                    `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2"`
+	ExternalOAuthAudienceList                  []AudienceListItem                         `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_AUDIENCE_LIST"`
+	ExternalOAuthAnyRoleMode                   *ExternalOAuthAnyRoleMode                  `ddl:"parameter" sql:"EXTERNAL_OAUTH_ANY_ROLE_MODE"`
+	ExternalOAuthScopeDelimiter                *string                                    `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_SCOPE_DELIMITER"`
+	ExternalOAuthScopeMappingAttribute         *string                                    `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE"`
+	Comment                                    *string                                    `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateExternalOAuthSecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.ExternalOAuthType == "" || opts.ExternalOAuthIssuer == "" || len(opts.ExternalOAuthTokenUserMappingClaim) == 0 || opts.ExternalOAuthSnowflakeUserMappingAttribute == "" {
+		return errors.New("ExternalOAuthType, ExternalOAuthIssuer, ExternalOAuthTokenUserMappingClaim and ExternalOAuthSnowflakeUserMappingAttribute are required")
+	}
+	if valueSet(opts.ExternalOAuthJWSKeysURL) && anyValueSet(opts.ExternalOAuthRSAPublicKey, opts.ExternalOAuthRSAPublicKey2) {
+		return errors.New("ExternalOAuthJWSKeysURL and ExternalOAuthRSAPublicKey(2) are incompatible")
+	}
+	if everyValueSet(opts.ExternalOAuthBlockedRolesList, opts.ExternalOAuthAllowedRolesList) {
+		return errors.New("ExternalOAuthBlockedRolesList and ExternalOAuthAllowedRolesList are incompatible")
+	}
+	return nil
+}
+
+func (v *securityIntegrations) CreateExternalOAuth(ctx context.Context, id AccountObjectIdentifier, opts *CreateExternalOAuthSecurityIntegrationOptions) error {
+	if opts == nil {
+		opts = &CreateExternalOAuthSecurityIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterExternalOAuthSecurityIntegrationOptions struct {
+	alter               bool                           `ddl:"static" sql:"ALTER"`                //lint:ignore U1000 This is used in the ddl tag
+	securityIntegration bool                           `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists            *bool                          `ddl:"keyword" sql:"IF EXISTS"`
+	name                AccountObjectIdentifier        `ddl:"identifier"`
+	Set                 *ExternalOAuthIntegrationSet   `ddl:"keyword" sql:"SET"`
+	Unset               *ExternalOAuthIntegrationUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTag              []TagAssociation               `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag            []ObjectIdentifier             `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterExternalOAuthSecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ExternalOAuthIntegrationSet struct {
+	Enabled                                    *bool                                       `ddl:"parameter" sql:"ENABLED"`
+	ExternalOAuthType                          *ExternalOAuthType                          `ddl:"parameter" sql:"EXTERNAL_OAUTH_TYPE"`
+	ExternalOAuthIssuer                        *string                                     `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_ISSUER"`
+	ExternalOAuthTokenUserMappingClaim         []TokenUserMappingClaim                     `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM"`
+	ExternalOAuthSnowflakeUserMappingAttribute *ExternalOAuthSnowflakeUserMappingAttribute `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE"`
+	ExternalOAuthJWSKeysURL                    []JWSKeysURL                                `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_JWS_KEYS_URL"`
+	ExternalOAuthBlockedRolesList              []OAuthRole                                 `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_BLOCKED_ROLES_LIST"`
+	ExternalOAuthAllowedRolesList              []OAuthRole                                 `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_ALLOWED_ROLES_LIST"`
+	ExternalOAuthRSAPublicKey                  *string                                     `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_RSA_PUBLIC_KEY"`
+	ExternalOAuthRSAPublicKey2                 *string                                     `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2"`
+	ExternalOAuthAudienceList                  []AudienceListItem                          `ddl:"parameter,parentheses" sql:"EXTERNAL_OAUTH_AUDIENCE_LIST"`
+	ExternalOAuthAnyRoleMode                   *ExternalOAuthAnyRoleMode                   `ddl:"parameter" sql:"EXTERNAL_OAUTH_ANY_ROLE_MODE"`
+	ExternalOAuthScopeDelimiter                *string                                     `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_SCOPE_DELIMITER"`
+	ExternalOAuthScopeMappingAttribute         *string                                     `ddl:"parameter,single_quotes" sql:"EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE"`
+	Comment                                    *string                                     `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *ExternalOAuthIntegrationSet) validate() error {
+	if !anyValueSet(v.Enabled, v.ExternalOAuthType, v.ExternalOAuthIssuer, v.ExternalOAuthTokenUserMappingClaim,
+		v.ExternalOAuthSnowflakeUserMappingAttribute, v.ExternalOAuthJWSKeysURL, v.ExternalOAuthBlockedRolesList,
+		v.ExternalOAuthAllowedRolesList, v.ExternalOAuthRSAPublicKey, v.ExternalOAuthRSAPublicKey2, v.ExternalOAuthAudienceList,
+		v.ExternalOAuthAnyRoleMode, v.ExternalOAuthScopeDelimiter, v.ExternalOAuthScopeMappingAttribute, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	if everyValueSet(v.ExternalOAuthBlockedRolesList, v.ExternalOAuthAllowedRolesList) {
+		return errors.New("ExternalOAuthBlockedRolesList and ExternalOAuthAllowedRolesList are incompatible")
+	}
+	return nil
+}
+
+type ExternalOAuthIntegrationUnset struct {
+	Enabled                    *bool `ddl:"keyword" sql:"ENABLED"`
+	ExternalOAuthAudienceList  *bool `ddl:"keyword" sql:"EXTERNAL_OAUTH_AUDIENCE_LIST"`
+	ExternalOAuthRSAPublicKey  *bool `ddl:"keyword" sql:"EXTERNAL_OAUTH_RSA_PUBLIC_KEY"`
+	ExternalOAuthRSAPublicKey2 *bool `ddl:"keyword" sql:"EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *ExternalOAuthIntegrationUnset) validate() error {
+	if !anyValueSet(v.Enabled, v.ExternalOAuthAudienceList, v.ExternalOAuthRSAPublicKey, v.ExternalOAuthRSAPublicKey2, v.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+func (v *securityIntegrations) AlterExternalOAuth(ctx context.Context, id AccountObjectIdentifier, opts *AlterExternalOAuthSecurityIntegrationOptions) error {
+	if opts == nil {
+		opts = &AlterExternalOAuthSecurityIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ExternalOAuthIntegrationDetails contains the typed output of DESCRIBE SECURITY INTEGRATION for External OAuth integrations.
+type ExternalOAuthIntegrationDetails struct {
+	Enabled                                    bool
+	ExternalOAuthType                          string
+	ExternalOAuthIssuer                        string
+	ExternalOAuthTokenUserMappingClaim         []string
+	ExternalOAuthSnowflakeUserMappingAttribute string
+	ExternalOAuthJWSKeysURL                    []string
+	ExternalOAuthBlockedRolesList              []string
+	ExternalOAuthAllowedRolesList              []string
+	ExternalOAuthRSAPublicKey                  string
+	ExternalOAuthRSAPublicKey2                 string
+	ExternalOAuthAudienceList                  []string
+	ExternalOAuthAnyRoleMode                   string
+	ExternalOAuthScopeDelimiter                string
+	ExternalOAuthScopeMappingAttribute         string
+	Comment                                    string
+}
+
+func externalOAuthIntegrationDetailsFromRows(rows []integrationPropertyRow) *ExternalOAuthIntegrationDetails {
+	v := &ExternalOAuthIntegrationDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "ENABLED":
+			v.Enabled = row.toBool()
+		case "EXTERNAL_OAUTH_TYPE":
+			v.ExternalOAuthType = row.Value
+		case "EXTERNAL_OAUTH_ISSUER":
+			v.ExternalOAuthIssuer = row.Value
+		case "EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM":
+			v.ExternalOAuthTokenUserMappingClaim = row.toList()
+		case "EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE":
+			v.ExternalOAuthSnowflakeUserMappingAttribute = row.Value
+		case "EXTERNAL_OAUTH_JWS_KEYS_URL":
+			v.ExternalOAuthJWSKeysURL = row.toList()
+		case "EXTERNAL_OAUTH_BLOCKED_ROLES_LIST":
+			v.ExternalOAuthBlockedRolesList = row.toList()
+		case "EXTERNAL_OAUTH_ALLOWED_ROLES_LIST":
+			v.ExternalOAuthAllowedRolesList = row.toList()
+		case "EXTERNAL_OAUTH_RSA_PUBLIC_KEY":
+			v.ExternalOAuthRSAPublicKey = row.Value
+		case "EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2":
+			v.ExternalOAuthRSAPublicKey2 = row.Value
+		case "EXTERNAL_OAUTH_AUDIENCE_LIST":
+			v.ExternalOAuthAudienceList = row.toList()
+		case "EXTERNAL_OAUTH_ANY_ROLE_MODE":
+			v.ExternalOAuthAnyRoleMode = row.Value
+		case "EXTERNAL_OAUTH_SCOPE_DELIMITER":
+			v.ExternalOAuthScopeDelimiter = row.Value
+		case "EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE":
+			v.ExternalOAuthScopeMappingAttribute = row.Value
+		case "COMMENT":
+			v.Comment = row.Value
+		}
+	}
+	return v
+}
+
+func (v *securityIntegrations) DescribeExternalOAuth(ctx context.Context, id AccountObjectIdentifier) (*ExternalOAuthIntegrationDetails, error) {
+	rows, err := v.describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return externalOAuthIntegrationDetailsFromRows(rows), nil
+}
+
+type DropSecurityIntegrationOptions struct {
+	drop                bool                    `ddl:"static" sql:"DROP"`                 //lint:ignore U1000 This is used in the ddl tag
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists            *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name                AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropSecurityIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *securityIntegrations) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropSecurityIntegrationOptions) error {
+	if opts == nil {
+		opts = &DropSecurityIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowSecurityIntegrationOptions represents the options for listing security integrations.
+type ShowSecurityIntegrationOptions struct {
+	show                 bool  `ddl:"static" sql:"SHOW"`                  //lint:ignore U1000 This is used in the ddl tag
+	securityIntegrations bool  `ddl:"static" sql:"SECURITY INTEGRATIONS"` //lint:ignore U1000 This is used in the ddl tag
+	Like                 *Like `ddl:"keyword" sql:"LIKE"`
+	// IntegrationType limits the result to integrations of the given type. SHOW SECURITY INTEGRATIONS
+	// cannot filter by type, so the filter is applied to the returned rows.
+	IntegrationType *SecurityIntegrationType `ddl:"-"`
+}
+
+func (opts *ShowSecurityIntegrationOptions) validate() error {
+	return nil
+}
+
+type SecurityIntegration struct {
+	Name            string
+	IntegrationType string
+	Category        string
+	Enabled         bool
+	Comment         string
+	CreatedOn       time.Time
+}
+
+func (v *SecurityIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *SecurityIntegration) ObjectType() ObjectType {
+	return ObjectTypeIntegration
+}
+
+// HasType reports whether the integration is of the given type, ignoring the client or provider
+// suffix, e.g. "SCIM - AZURE" is of type SCIM.
+func (v *SecurityIntegration) HasType(integrationType SecurityIntegrationType) bool {
+	t := strings.ToUpper(strings.TrimSpace(v.IntegrationType))
+	return t == string(integrationType) || strings.HasPrefix(t, string(integrationType)+" ")
+}
+
+type securityIntegrationRow struct {
+	Name      string         `db:"name"`
+	Type      string         `db:"type"`
+	Category  string         `db:"category"`
+	Enabled   bool           `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
+	CreatedOn time.Time      `db:"created_on"`
+}
+
+func (row *securityIntegrationRow) toSecurityIntegration() *SecurityIntegration {
+	v := &SecurityIntegration{
+		Name:            row.Name,
+		IntegrationType: row.Type,
+		Category:        row.Category,
+		Enabled:         row.Enabled,
+		CreatedOn:       row.CreatedOn,
+	}
+	if row.Comment.Valid {
+		v.Comment = row.Comment.String
+	}
+	return v
+}
+
+func (v *securityIntegrations) Show(ctx context.Context, opts *ShowSecurityIntegrationOptions) ([]*SecurityIntegration, error) {
+	if opts == nil {
+		opts = &ShowSecurityIntegrationOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []securityIntegrationRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*SecurityIntegration, 0, len(dest))
+	for _, row := range dest {
+		securityIntegration := row.toSecurityIntegration()
+		if opts.IntegrationType != nil && !securityIntegration.HasType(*opts.IntegrationType) {
+			continue
+		}
+		resultList = append(resultList, securityIntegration)
+	}
+	return resultList, nil
+}
+
+func (v *securityIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*SecurityIntegration, error) {
+	securityIntegrations, err := v.Show(ctx, &ShowSecurityIntegrationOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, securityIntegration := range securityIntegrations {
+		if securityIntegration.Name == id.Name() {
+			return securityIntegration, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
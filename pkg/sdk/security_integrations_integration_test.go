@@ -0,0 +1,235 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSAML2X509Cert is the certificate used by the SAML integration acceptance tests.
+const testSAML2X509Cert = "MIIERTCCAq2gAwIBAgIJAKmtzjCD1+tqMA0GCSqGSIb3DQEBCwUAMDUxMzAxBgNVBAMTKmlwLTE3Mi0zMS0yOC02NC51cy13ZXN0LTIuY29tcHV0ZS5pbnRlcm5hbDAeFw0xODA4MTgyMzI0MjNaFw0yODA4MTUyMzI0MjNaMDUxMzAxBgNVBAMTKmlwLTE3Mi0zMS0yOC02NC51cy13ZXN0LTIuY29tcHV0ZS5pbnRlcm5hbDCCAaIwDQYJKoZIhvcNAQEBBQADggGPADCCAYoCggGBALhUlY3SkIOze+l8y6dBzM6p7B8OykJWlwizszU16Lih8D7KLhNJfahoVxbPxB3YFM/81PJLOeK2krvJ5zY6CJyQY3sPQAkZKI7I8qq9lmZ2g4QPqybNstXS6YUXJNUt/ixbbK/N97+LKTiSutbD1J7AoFnouMuLjlhN5VRZ43jez4xLSHVZaYuUFKn01Y9oLKbj46LQnZnJCAGpTgPqEQJr6GpVGw43bKyUpGoaPrdDRgRgtPMUWgFDkgcI3QiV1lsKfBs1t1E2UA7ACFnlJZpEuBtwgivzo3VeitiSaF3Jxh25EY5/vABpcgQQRz3RH2l8MMKdRsxb8VT3yh2S+CX55s+cN67LiCPr6f2u+KS1iKfB9mWN6o2S4lcmo82HIBbsuXJV0oA1HrGMyyc4Y9nng/I8iuAp8or1JrWRHQ+8NzO85DWK0rtvtLPxkvw0HK32glyuOP/9F05Z7+tiVIgn67buC0EdoUm1RSpibqmB1ST2PikslOlVbJuy4Ah93wIDAQABo1gwVjA1BgNVHREELjAsgippcC0xNzItMzEtMjgtNjQudXMtd2VzdC0yLmNvbXB1dGUuaW50ZXJuYWwwHQYDVR0OBBYEFAdsTxYfulJ5yunYtgYJHC9IcevzMA0GCSqGSIb3DQEBCwUAA4IBgQB3J6i7KreiHL8NPMglfWLHk1PZOgvIEEpKL+GRebvcbyqgcuc3VVPylq70VvGqhJxp1q/mzLfraUiypzfWFGm9zfwIg0H5TqRZYEPTvgIhIICjaDWRwZBDJG8D5G/KoV60DlUG0crPBlIuCCr/SRa5ZoDQqvucTfr3Rx4Ha6koXFSjoSXllR+jn4GnInhm/WH137a+v35PUcffNxfuehoGn6i4YeXF3cwJK4e35cOFW+dLbnaLk+Ty7HOGvpw86h979C6mJ9qEHYgq9rQyzlSPbLZGZSgVcIezunOaOsWm81BsXRNNJjzHGCqKf8RMhd8oZP55+2/SVRBwnkGyUNCuDPrJcymC95ZT2NW/KeWkz28HF2i31xQmecT2r3lQRSM8acvOXQsNEDCDvJvCzJT9c2AnsnO24r6arPXs/UWAxOI+MjclXPLkLD6uTHV+Oo8XZ7bOjegD5hL6/bKUWnNMurQNGrmi/jvqsCFLDKftl7ajuxKjtodnSuwhoY7NQy8="
+
+func TestInt_SecurityIntegrationsSAML2(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	createSAML2Integration := func(t *testing.T) AccountObjectIdentifier {
+		t.Helper()
+		id := randomAccountObjectIdentifier(t)
+		err := client.SecurityIntegrations.CreateSAML2(ctx, id, &CreateSAML2SecurityIntegrationOptions{
+			Enabled:       true,
+			SAML2Issuer:   "https://example.com/issuer",
+			SAML2SSOURL:   "https://example.com/sso",
+			SAML2Provider: SAML2ProviderCustom,
+			SAML2X509Cert: testSAML2X509Cert,
+			Comment:       String("some comment"),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.SecurityIntegrations.Drop(ctx, id, &DropSecurityIntegrationOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("create, show and describe", func(t *testing.T) {
+		id := createSAML2Integration(t)
+
+		securityIntegration, err := client.SecurityIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), securityIntegration.Name)
+		assert.Equal(t, "SAML2", securityIntegration.IntegrationType)
+		assert.Equal(t, "SECURITY", securityIntegration.Category)
+		assert.True(t, securityIntegration.Enabled)
+
+		details, err := client.SecurityIntegrations.DescribeSAML2(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/issuer", details.SAML2Issuer)
+		assert.NotEmpty(t, details.SAML2SnowflakeACSURL)
+		assert.NotEmpty(t, details.SAML2SnowflakeIssuerURL)
+		assert.NotEmpty(t, details.SAML2SnowflakeMetadata)
+	})
+
+	t.Run("alter", func(t *testing.T) {
+		id := createSAML2Integration(t)
+
+		err := client.SecurityIntegrations.AlterSAML2(ctx, id, &AlterSAML2SecurityIntegrationOptions{
+			Set: &SAML2IntegrationSet{
+				SAML2ForceAuthn:    Bool(true),
+				AllowedUserDomains: []UserDomain{{Domain: "example.com"}},
+			},
+		})
+		require.NoError(t, err)
+		details, err := client.SecurityIntegrations.DescribeSAML2(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, details.SAML2ForceAuthn)
+		assert.Equal(t, []string{"example.com"}, details.AllowedUserDomains)
+
+		err = client.SecurityIntegrations.AlterSAML2(ctx, id, &AlterSAML2SecurityIntegrationOptions{
+			Unset: &SAML2IntegrationUnset{
+				SAML2ForceAuthn: Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		details, err = client.SecurityIntegrations.DescribeSAML2(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, details.SAML2ForceAuthn)
+	})
+
+	t.Run("refresh private key", func(t *testing.T) {
+		id := createSAML2Integration(t)
+
+		err := client.SecurityIntegrations.AlterSAML2(ctx, id, &AlterSAML2SecurityIntegrationOptions{
+			RefreshPrivateKey: Bool(true),
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestInt_SecurityIntegrationsSCIM(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.SecurityIntegrations.CreateSCIM(ctx, id, &CreateSCIMSecurityIntegrationOptions{
+		SCIMClient: SCIMClientGeneric,
+		RunAsRole:  SCIMRunAsRoleGenericProvisioner,
+		Comment:    String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.SecurityIntegrations.Drop(ctx, id, &DropSecurityIntegrationOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by type", func(t *testing.T) {
+		securityIntegrations, err := client.SecurityIntegrations.Show(ctx, &ShowSecurityIntegrationOptions{
+			Like:            &Like{Pattern: String(id.Name())},
+			IntegrationType: Pointer(SecurityIntegrationTypeSCIM),
+		})
+		require.NoError(t, err)
+		require.Len(t, securityIntegrations, 1)
+		assert.Equal(t, id.Name(), securityIntegrations[0].Name)
+
+		securityIntegrations, err = client.SecurityIntegrations.Show(ctx, &ShowSecurityIntegrationOptions{
+			Like:            &Like{Pattern: String(id.Name())},
+			IntegrationType: Pointer(SecurityIntegrationTypeSAML2),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, securityIntegrations)
+	})
+
+	t.Run("alter and describe", func(t *testing.T) {
+		err := client.SecurityIntegrations.AlterSCIM(ctx, id, &AlterSCIMSecurityIntegrationOptions{
+			Set: &SCIMIntegrationSet{
+				SyncPassword: Bool(false),
+			},
+		})
+		require.NoError(t, err)
+		details, err := client.SecurityIntegrations.DescribeSCIM(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "GENERIC_SCIM_PROVISIONER", details.RunAsRole)
+		assert.False(t, details.SyncPassword)
+		assert.Equal(t, "some comment", details.Comment)
+	})
+
+	t.Run("generate access token", func(t *testing.T) {
+		token, err := client.SecurityIntegrations.GenerateSCIMAccessToken(ctx, id)
+		require.NoError(t, err)
+		assert.NotEmpty(t, token)
+	})
+}
+
+func TestInt_SecurityIntegrationsOAuth(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.SecurityIntegrations.CreateOAuth(ctx, id, &CreateOAuthSecurityIntegrationOptions{
+		OAuthClient:      OAuthClientCustom,
+		OAuthClientType:  Pointer(OAuthClientTypeConfidential),
+		OAuthRedirectURI: String("https://example.com/callback"),
+		Enabled:          Bool(true),
+		Comment:          String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.SecurityIntegrations.Drop(ctx, id, &DropSecurityIntegrationOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show and describe", func(t *testing.T) {
+		securityIntegration, err := client.SecurityIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, securityIntegration.HasType(SecurityIntegrationTypeOAuth))
+
+		details, err := client.SecurityIntegrations.DescribeOAuth(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, details.Enabled)
+		assert.Equal(t, "CONFIDENTIAL", details.OAuthClientType)
+		assert.Equal(t, "https://example.com/callback", details.OAuthRedirectURI)
+		assert.NotEmpty(t, details.OAuthClientID)
+	})
+
+	t.Run("alter", func(t *testing.T) {
+		err := client.SecurityIntegrations.AlterOAuth(ctx, id, &AlterOAuthSecurityIntegrationOptions{
+			Set: &OAuthIntegrationSet{
+				OAuthEnforcePKCE: Bool(true),
+				Comment:          String("changed"),
+			},
+		})
+		require.NoError(t, err)
+		details, err := client.SecurityIntegrations.DescribeOAuth(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, details.OAuthEnforcePKCE)
+		assert.Equal(t, "changed", details.Comment)
+	})
+
+	t.Run("client secrets", func(t *testing.T) {
+		details, err := client.SecurityIntegrations.DescribeOAuth(ctx, id)
+		require.NoError(t, err)
+		secrets, err := client.SecurityIntegrations.ShowOAuthClientSecrets(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, details.OAuthClientID, secrets.OAuthClientID)
+		assert.NotEmpty(t, secrets.OAuthClientSecret)
+		assert.NotEmpty(t, secrets.OAuthClientSecret2)
+	})
+}
+
+func TestInt_SecurityIntegrationsExternalOAuth(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.SecurityIntegrations.CreateExternalOAuth(ctx, id, &CreateExternalOAuthSecurityIntegrationOptions{
+		Enabled:                            true,
+		ExternalOAuthType:                  ExternalOAuthTypeCustom,
+		ExternalOAuthIssuer:                "https://example.com",
+		ExternalOAuthTokenUserMappingClaim: []TokenUserMappingClaim{{Claim: "sub"}},
+		ExternalOAuthSnowflakeUserMappingAttribute: ExternalOAuthSnowflakeUserMappingAttributeLoginName,
+		ExternalOAuthJWSKeysURL:                    []JWSKeysURL{{URL: "https://example.com/keys"}},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.SecurityIntegrations.Drop(ctx, id, &DropSecurityIntegrationOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	err = client.SecurityIntegrations.AlterExternalOAuth(ctx, id, &AlterExternalOAuthSecurityIntegrationOptions{
+		Set: &ExternalOAuthIntegrationSet{
+			ExternalOAuthAnyRoleMode: Pointer(ExternalOAuthAnyRoleModeEnable),
+			Comment:                  String("some comment"),
+		},
+	})
+	require.NoError(t, err)
+
+	details, err := client.SecurityIntegrations.DescribeExternalOAuth(ctx, id)
+	require.NoError(t, err)
+	assert.True(t, details.Enabled)
+	assert.Equal(t, "CUSTOM", details.ExternalOAuthType)
+	assert.Equal(t, "https://example.com", details.ExternalOAuthIssuer)
+	assert.Equal(t, "ENABLE", details.ExternalOAuthAnyRoleMode)
+	assert.Equal(t, "some comment", details.Comment)
+}
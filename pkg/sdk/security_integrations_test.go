@@ -0,0 +1,535 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityIntegrationsCreateSAML2(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("required options", func(t *testing.T) {
+		opts := &CreateSAML2SecurityIntegrationOptions{
+			name:          id,
+			Enabled:       true,
+			SAML2Issuer:   "https://example.com/issuer",
+			SAML2SSOURL:   "https://example.com/sso",
+			SAML2Provider: SAML2ProviderCustom,
+			SAML2X509Cert: "MIICr...",
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` TYPE = SAML2 ENABLED = true SAML2_ISSUER = 'https://example.com/issuer' SAML2_SSO_URL = 'https://example.com/sso' SAML2_PROVIDER = 'CUSTOM' SAML2_X509_CERT = 'MIICr...'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := &CreateSAML2SecurityIntegrationOptions{
+			OrReplace:                      Bool(true),
+			name:                           id,
+			Enabled:                        true,
+			SAML2Issuer:                    "https://example.com/issuer",
+			SAML2SSOURL:                    "https://example.com/sso",
+			SAML2Provider:                  SAML2ProviderOkta,
+			SAML2X509Cert:                  "MIICr...",
+			AllowedUserDomains:             []UserDomain{{Domain: "example.com"}, {Domain: "example.org"}},
+			AllowedEmailPatterns:           []EmailPattern{{Pattern: "^(.+dev)@example.com$"}},
+			SAML2SPInitiatedLoginPageLabel: String("Okta"),
+			SAML2EnableSPInitiated:         Bool(true),
+			SAML2SnowflakeX509Cert:         String("MIIDs..."),
+			SAML2SignRequest:               Bool(true),
+			SAML2RequestedNameIDFormat:     String("urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"),
+			SAML2PostLogoutRedirectURL:     String("https://example.com/logout"),
+			SAML2ForceAuthn:                Bool(false),
+			SAML2SnowflakeIssuerURL:        String("https://account.snowflakecomputing.com"),
+			SAML2SnowflakeACSURL:           String("https://account.snowflakecomputing.com/fed/login"),
+			Comment:                        String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` TYPE = SAML2 ENABLED = true SAML2_ISSUER = 'https://example.com/issuer' SAML2_SSO_URL = 'https://example.com/sso' SAML2_PROVIDER = 'OKTA' SAML2_X509_CERT = 'MIICr...' ALLOWED_USER_DOMAINS = ('example.com', 'example.org') ALLOWED_EMAIL_PATTERNS = ('^(.+dev)@example.com$') SAML2_SP_INITIATED_LOGIN_PAGE_LABEL = 'Okta' SAML2_ENABLE_SP_INITIATED = true SAML2_SNOWFLAKE_X509_CERT = 'MIIDs...' SAML2_SIGN_REQUEST = true SAML2_REQUESTED_NAMEID_FORMAT = 'urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress' SAML2_POST_LOGOUT_REDIRECT_URL = 'https://example.com/logout' SAML2_FORCE_AUTHN = false SAML2_SNOWFLAKE_ISSUER_URL = 'https://account.snowflakecomputing.com' SAML2_SNOWFLAKE_ACS_URL = 'https://account.snowflakecomputing.com/fed/login' COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: required options", func(t *testing.T) {
+		opts := &CreateSAML2SecurityIntegrationOptions{
+			name:        id,
+			SAML2Issuer: "https://example.com/issuer",
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestSecurityIntegrationsAlterSAML2(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterSAML2SecurityIntegrationOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &SAML2IntegrationSet{
+				Enabled:            Bool(false),
+				SAML2ForceAuthn:    Bool(true),
+				AllowedUserDomains: []UserDomain{{Domain: "example.com"}},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SECURITY INTEGRATION IF EXISTS ` + id.FullyQualifiedName() + ` SET ENABLED = false ALLOWED_USER_DOMAINS = ('example.com') SAML2_FORCE_AUTHN = true`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterSAML2SecurityIntegrationOptions{
+			name: id,
+			Unset: &SAML2IntegrationUnset{
+				SAML2ForceAuthn:            Bool(true),
+				SAML2PostLogoutRedirectURL: Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` UNSET SAML2_FORCE_AUTHN, SAML2_POST_LOGOUT_REDIRECT_URL`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("refresh private key", func(t *testing.T) {
+		opts := &AlterSAML2SecurityIntegrationOptions{
+			name:              id,
+			RefreshPrivateKey: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` REFRESH SAML2_SNOWFLAKE_PRIVATE_KEY`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: more than one action", func(t *testing.T) {
+		opts := &AlterSAML2SecurityIntegrationOptions{
+			name:              id,
+			RefreshPrivateKey: Bool(true),
+			Set:               &SAML2IntegrationSet{Enabled: Bool(true)},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestSecurityIntegrationsDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &DropSecurityIntegrationOptions{
+		IfExists: Bool(true),
+		name:     id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP SECURITY INTEGRATION IF EXISTS `+id.FullyQualifiedName(), actual)
+}
+
+func TestSecurityIntegrationsShow(t *testing.T) {
+	opts := &ShowSecurityIntegrationOptions{
+		Like: &Like{Pattern: String("my_integration")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW SECURITY INTEGRATIONS LIKE 'my_integration'`, actual)
+}
+
+func TestSecurityIntegrationsDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &describeSecurityIntegrationOptions{name: id}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE SECURITY INTEGRATION `+id.FullyQualifiedName(), actual)
+
+	t.Run("saml2 details from rows", func(t *testing.T) {
+		rows := []integrationPropertyRow{
+			{Property: "ENABLED", PropertyType: "Boolean", Value: "true"},
+			{Property: "SAML2_PROVIDER", PropertyType: "String", Value: "CUSTOM"},
+			{Property: "ALLOWED_USER_DOMAINS", PropertyType: "List", Value: "[example.com, example.org]"},
+			{Property: "ALLOWED_EMAIL_PATTERNS", PropertyType: "List", Value: "[]"},
+			{Property: "SAML2_FORCE_AUTHN", PropertyType: "Boolean", Value: "false"},
+			{Property: "SAML2_SNOWFLAKE_ACS_URL", PropertyType: "String", Value: "https://account.snowflakecomputing.com/fed/login"},
+			{Property: "SAML2_SNOWFLAKE_ISSUER_URL", PropertyType: "String", Value: "https://account.snowflakecomputing.com"},
+			{Property: "SAML2_SNOWFLAKE_METADATA", PropertyType: "String", Value: "<md:EntityDescriptor/>"},
+		}
+		details := saml2IntegrationDetailsFromRows(rows)
+		assert.True(t, details.Enabled)
+		assert.Equal(t, "CUSTOM", details.SAML2Provider)
+		assert.Equal(t, []string{"example.com", "example.org"}, details.AllowedUserDomains)
+		assert.Empty(t, details.AllowedEmailPatterns)
+		assert.False(t, details.SAML2ForceAuthn)
+		assert.Equal(t, "https://account.snowflakecomputing.com/fed/login", details.SAML2SnowflakeACSURL)
+		assert.Equal(t, "https://account.snowflakecomputing.com", details.SAML2SnowflakeIssuerURL)
+		assert.Equal(t, "<md:EntityDescriptor/>", details.SAML2SnowflakeMetadata)
+	})
+}
+
+func TestSecurityIntegrationsCreateSCIM(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("required options", func(t *testing.T) {
+		opts := &CreateSCIMSecurityIntegrationOptions{
+			name:       id,
+			SCIMClient: SCIMClientOkta,
+			RunAsRole:  SCIMRunAsRoleOktaProvisioner,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` TYPE = SCIM SCIM_CLIENT = 'OKTA' RUN_AS_ROLE = 'OKTA_PROVISIONER'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := &CreateSCIMSecurityIntegrationOptions{
+			IfNotExists:   Bool(true),
+			name:          id,
+			Enabled:       Bool(true),
+			SCIMClient:    SCIMClientAzure,
+			RunAsRole:     SCIMRunAsRoleAADProvisioner,
+			NetworkPolicy: String("my_network_policy"),
+			SyncPassword:  Bool(false),
+			Comment:       String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE SECURITY INTEGRATION IF NOT EXISTS ` + id.FullyQualifiedName() + ` TYPE = SCIM ENABLED = true SCIM_CLIENT = 'AZURE' RUN_AS_ROLE = 'AAD_PROVISIONER' NETWORK_POLICY = 'my_network_policy' SYNC_PASSWORD = false COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: required options", func(t *testing.T) {
+		opts := &CreateSCIMSecurityIntegrationOptions{
+			name:       id,
+			SCIMClient: SCIMClientGeneric,
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestSecurityIntegrationsAlterSCIM(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterSCIMSecurityIntegrationOptions{
+			name: id,
+			Set: &SCIMIntegrationSet{
+				NetworkPolicy: String("my_network_policy"),
+				SyncPassword:  Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` SET NETWORK_POLICY = 'my_network_policy' SYNC_PASSWORD = true`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterSCIMSecurityIntegrationOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Unset: &SCIMIntegrationUnset{
+				NetworkPolicy: Bool(true),
+				SyncPassword:  Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SECURITY INTEGRATION IF EXISTS ` + id.FullyQualifiedName() + ` UNSET NETWORK_POLICY, SYNC_PASSWORD`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: nothing set", func(t *testing.T) {
+		opts := &AlterSCIMSecurityIntegrationOptions{
+			name: id,
+			Set:  &SCIMIntegrationSet{},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestSecurityIntegrationHasType(t *testing.T) {
+	assert.True(t, (&SecurityIntegration{IntegrationType: "SCIM - OKTA"}).HasType(SecurityIntegrationTypeSCIM))
+	assert.True(t, (&SecurityIntegration{IntegrationType: "SAML2"}).HasType(SecurityIntegrationTypeSAML2))
+	assert.True(t, (&SecurityIntegration{IntegrationType: "OAUTH - CUSTOM"}).HasType(SecurityIntegrationTypeOAuth))
+	assert.False(t, (&SecurityIntegration{IntegrationType: "EXTERNAL_OAUTH - OKTA"}).HasType(SecurityIntegrationTypeOAuth))
+	assert.False(t, (&SecurityIntegration{IntegrationType: "SAML2"}).HasType(SecurityIntegrationTypeSCIM))
+}
+
+func TestSCIMIntegrationDetailsFromRows(t *testing.T) {
+	rows := []integrationPropertyRow{
+		{Property: "ENABLED", PropertyType: "Boolean", Value: "true"},
+		{Property: "NETWORK_POLICY", PropertyType: "String", Value: "MY_NETWORK_POLICY"},
+		{Property: "RUN_AS_ROLE", PropertyType: "String", Value: "GENERIC_SCIM_PROVISIONER"},
+		{Property: "SYNC_PASSWORD", PropertyType: "Boolean", Value: "false"},
+		{Property: "COMMENT", PropertyType: "String", Value: "some comment"},
+	}
+	details := scimIntegrationDetailsFromRows(rows)
+	assert.True(t, details.Enabled)
+	assert.Equal(t, "MY_NETWORK_POLICY", details.NetworkPolicy)
+	assert.Equal(t, "GENERIC_SCIM_PROVISIONER", details.RunAsRole)
+	assert.False(t, details.SyncPassword)
+	assert.Equal(t, "some comment", details.Comment)
+}
+
+func TestSecurityIntegrationsCreateOAuth(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("partner application", func(t *testing.T) {
+		opts := &CreateOAuthSecurityIntegrationOptions{
+			name:                      id,
+			OAuthClient:               OAuthClientTableauDesktop,
+			Enabled:                   Bool(true),
+			OAuthIssueRefreshTokens:   Bool(true),
+			OAuthRefreshTokenValidity: Int(86400),
+			BlockedRolesList:          []OAuthRole{{Name: "SYSADMIN"}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` TYPE = OAUTH OAUTH_CLIENT = TABLEAU_DESKTOP ENABLED = true OAUTH_ISSUE_REFRESH_TOKENS = true OAUTH_REFRESH_TOKEN_VALIDITY = 86400 BLOCKED_ROLES_LIST = ('SYSADMIN')`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("custom client", func(t *testing.T) {
+		opts := &CreateOAuthSecurityIntegrationOptions{
+			OrReplace:                   Bool(true),
+			name:                        id,
+			OAuthClient:                 OAuthClientCustom,
+			OAuthClientType:             Pointer(OAuthClientTypeConfidential),
+			OAuthRedirectURI:            String("https://example.com/callback"),
+			Enabled:                     Bool(true),
+			OAuthAllowNonTLSRedirectURI: Bool(false),
+			OAuthEnforcePKCE:            Bool(true),
+			PreAuthorizedRolesList:      []OAuthRole{{Name: "ANALYST"}, {Name: "ENGINEER"}},
+			OAuthUseSecondaryRoles:      Pointer(OAuthUseSecondaryRolesImplicit),
+			NetworkPolicy:               String("my_network_policy"),
+			OAuthClientRSAPublicKey:     String("MIIBIj..."),
+			Comment:                     String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` TYPE = OAUTH OAUTH_CLIENT = CUSTOM OAUTH_CLIENT_TYPE = 'CONFIDENTIAL' OAUTH_REDIRECT_URI = 'https://example.com/callback' ENABLED = true OAUTH_ALLOW_NON_TLS_REDIRECT_URI = false OAUTH_ENFORCE_PKCE = true PRE_AUTHORIZED_ROLES_LIST = ('ANALYST', 'ENGINEER') OAUTH_USE_SECONDARY_ROLES = IMPLICIT NETWORK_POLICY = 'my_network_policy' OAUTH_CLIENT_RSA_PUBLIC_KEY = 'MIIBIj...' COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: custom client without redirect uri", func(t *testing.T) {
+		opts := &CreateOAuthSecurityIntegrationOptions{
+			name:            id,
+			OAuthClient:     OAuthClientCustom,
+			OAuthClientType: Pointer(OAuthClientTypePublic),
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: custom client options for a partner application", func(t *testing.T) {
+		opts := &CreateOAuthSecurityIntegrationOptions{
+			name:             id,
+			OAuthClient:      OAuthClientTableauServer,
+			OAuthEnforcePKCE: Bool(true),
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: looker without redirect uri", func(t *testing.T) {
+		opts := &CreateOAuthSecurityIntegrationOptions{
+			name:        id,
+			OAuthClient: OAuthClientLooker,
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestSecurityIntegrationsAlterOAuth(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterOAuthSecurityIntegrationOptions{
+			name: id,
+			Set: &OAuthIntegrationSet{
+				Enabled:          Bool(false),
+				BlockedRolesList: []OAuthRole{{Name: "SYSADMIN"}, {Name: "PUBLIC"}},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` SET ENABLED = false BLOCKED_ROLES_LIST = ('SYSADMIN', 'PUBLIC')`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterOAuthSecurityIntegrationOptions{
+			name: id,
+			Unset: &OAuthIntegrationUnset{
+				OAuthClientRSAPublicKey2: Bool(true),
+				OAuthUseSecondaryRoles:   Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` UNSET OAUTH_CLIENT_RSA_PUBLIC_KEY_2, OAUTH_USE_SECONDARY_ROLES`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: nothing unset", func(t *testing.T) {
+		opts := &AlterOAuthSecurityIntegrationOptions{
+			name:  id,
+			Unset: &OAuthIntegrationUnset{},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestOAuthIntegrationDetailsFromRows(t *testing.T) {
+	rows := []integrationPropertyRow{
+		{Property: "ENABLED", PropertyType: "Boolean", Value: "true"},
+		{Property: "OAUTH_CLIENT_TYPE", PropertyType: "String", Value: "CONFIDENTIAL"},
+		{Property: "OAUTH_REDIRECT_URI", PropertyType: "String", Value: "https://example.com/callback"},
+		{Property: "PRE_AUTHORIZED_ROLES_LIST", PropertyType: "List", Value: "ANALYST,ENGINEER"},
+		{Property: "BLOCKED_ROLES_LIST", PropertyType: "List", Value: "ACCOUNTADMIN,SECURITYADMIN"},
+		{Property: "OAUTH_REFRESH_TOKEN_VALIDITY", PropertyType: "Integer", Value: "7776000"},
+		{Property: "OAUTH_CLIENT_ID", PropertyType: "String", Value: "abc123"},
+		{Property: "OAUTH_ALLOWED_TOKEN_ENDPOINTS", PropertyType: "List", Value: "[https://example.snowflakecomputing.com/oauth/token-request]"},
+	}
+	details := oauthIntegrationDetailsFromRows(rows)
+	assert.True(t, details.Enabled)
+	assert.Equal(t, "CONFIDENTIAL", details.OAuthClientType)
+	assert.Equal(t, "https://example.com/callback", details.OAuthRedirectURI)
+	assert.Equal(t, []string{"ANALYST", "ENGINEER"}, details.PreAuthorizedRolesList)
+	assert.Equal(t, []string{"ACCOUNTADMIN", "SECURITYADMIN"}, details.BlockedRolesList)
+	assert.Equal(t, 7776000, details.OAuthRefreshTokenValidity)
+	assert.Equal(t, "abc123", details.OAuthClientID)
+	assert.Equal(t, []string{"https://example.snowflakecomputing.com/oauth/token-request"}, details.OAuthAllowedTokenEndpoints)
+}
+
+func TestSecurityIntegrationsCreateExternalOAuth(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("required options", func(t *testing.T) {
+		opts := &CreateExternalOAuthSecurityIntegrationOptions{
+			name:                               id,
+			Enabled:                            true,
+			ExternalOAuthType:                  ExternalOAuthTypeOkta,
+			ExternalOAuthIssuer:                "https://example.okta.com/oauth2/default",
+			ExternalOAuthTokenUserMappingClaim: []TokenUserMappingClaim{{Claim: "sub"}},
+			ExternalOAuthSnowflakeUserMappingAttribute: ExternalOAuthSnowflakeUserMappingAttributeLoginName,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` TYPE = EXTERNAL_OAUTH ENABLED = true EXTERNAL_OAUTH_TYPE = OKTA EXTERNAL_OAUTH_ISSUER = 'https://example.okta.com/oauth2/default' EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM = ('sub') EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE = 'LOGIN_NAME'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := &CreateExternalOAuthSecurityIntegrationOptions{
+			IfNotExists:                        Bool(true),
+			name:                               id,
+			Enabled:                            true,
+			ExternalOAuthType:                  ExternalOAuthTypeAzure,
+			ExternalOAuthIssuer:                "https://sts.windows.net/tenant/",
+			ExternalOAuthTokenUserMappingClaim: []TokenUserMappingClaim{{Claim: "upn"}, {Claim: "email"}},
+			ExternalOAuthSnowflakeUserMappingAttribute: ExternalOAuthSnowflakeUserMappingAttributeEmailAddress,
+			ExternalOAuthJWSKeysURL:                    []JWSKeysURL{{URL: "https://login.windows.net/common/discovery/keys"}},
+			ExternalOAuthAllowedRolesList:              []OAuthRole{{Name: "ANALYST"}},
+			ExternalOAuthAudienceList:                  []AudienceListItem{{Item: "https://example.snowflakecomputing.com"}},
+			ExternalOAuthAnyRoleMode:                   Pointer(ExternalOAuthAnyRoleModeEnable),
+			ExternalOAuthScopeDelimiter:                String(","),
+			ExternalOAuthScopeMappingAttribute:         String("scp"),
+			Comment:                                    String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE SECURITY INTEGRATION IF NOT EXISTS ` + id.FullyQualifiedName() + ` TYPE = EXTERNAL_OAUTH ENABLED = true EXTERNAL_OAUTH_TYPE = AZURE EXTERNAL_OAUTH_ISSUER = 'https://sts.windows.net/tenant/' EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM = ('upn', 'email') EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE = 'EMAIL_ADDRESS' EXTERNAL_OAUTH_JWS_KEYS_URL = ('https://login.windows.net/common/discovery/keys') EXTERNAL_OAUTH_ALLOWED_ROLES_LIST = ('ANALYST') EXTERNAL_OAUTH_AUDIENCE_LIST = ('https://example.snowflakecomputing.com') EXTERNAL_OAUTH_ANY_ROLE_MODE = ENABLE EXTERNAL_OAUTH_SCOPE_DELIMITER = ',' EXTERNAL_OAUTH_SCOPE_MAPPING_ATTRIBUTE = 'scp' COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: jws keys url and rsa public key", func(t *testing.T) {
+		opts := &CreateExternalOAuthSecurityIntegrationOptions{
+			name:                               id,
+			ExternalOAuthType:                  ExternalOAuthTypeCustom,
+			ExternalOAuthIssuer:                "https://example.com",
+			ExternalOAuthTokenUserMappingClaim: []TokenUserMappingClaim{{Claim: "sub"}},
+			ExternalOAuthSnowflakeUserMappingAttribute: ExternalOAuthSnowflakeUserMappingAttributeLoginName,
+			ExternalOAuthJWSKeysURL:                    []JWSKeysURL{{URL: "https://example.com/keys"}},
+			ExternalOAuthRSAPublicKey:                  String("MIIBIj..."),
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: blocked and allowed roles", func(t *testing.T) {
+		opts := &CreateExternalOAuthSecurityIntegrationOptions{
+			name:                               id,
+			ExternalOAuthType:                  ExternalOAuthTypePingFederate,
+			ExternalOAuthIssuer:                "https://example.com",
+			ExternalOAuthTokenUserMappingClaim: []TokenUserMappingClaim{{Claim: "sub"}},
+			ExternalOAuthSnowflakeUserMappingAttribute: ExternalOAuthSnowflakeUserMappingAttributeLoginName,
+			ExternalOAuthBlockedRolesList:              []OAuthRole{{Name: "SYSADMIN"}},
+			ExternalOAuthAllowedRolesList:              []OAuthRole{{Name: "ANALYST"}},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestSecurityIntegrationsAlterExternalOAuth(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterExternalOAuthSecurityIntegrationOptions{
+			name: id,
+			Set: &ExternalOAuthIntegrationSet{
+				ExternalOAuthRSAPublicKey: String("MIIBIj..."),
+				ExternalOAuthAnyRoleMode:  Pointer(ExternalOAuthAnyRoleModeDisable),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` SET EXTERNAL_OAUTH_RSA_PUBLIC_KEY = 'MIIBIj...' EXTERNAL_OAUTH_ANY_ROLE_MODE = DISABLE`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterExternalOAuthSecurityIntegrationOptions{
+			name: id,
+			Unset: &ExternalOAuthIntegrationUnset{
+				ExternalOAuthAudienceList: Bool(true),
+				Comment:                   Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SECURITY INTEGRATION ` + id.FullyQualifiedName() + ` UNSET EXTERNAL_OAUTH_AUDIENCE_LIST, COMMENT`
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestExternalOAuthIntegrationDetailsFromRows(t *testing.T) {
+	rows := []integrationPropertyRow{
+		{Property: "ENABLED", PropertyType: "Boolean", Value: "false"},
+		{Property: "EXTERNAL_OAUTH_TYPE", PropertyType: "String", Value: "OKTA"},
+		{Property: "EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM", PropertyType: "List", Value: "[sub]"},
+		{Property: "EXTERNAL_OAUTH_JWS_KEYS_URL", PropertyType: "Object", Value: "https://example.okta.com/oauth2/v1/keys"},
+		{Property: "EXTERNAL_OAUTH_ANY_ROLE_MODE", PropertyType: "String", Value: "DISABLE"},
+	}
+	details := externalOAuthIntegrationDetailsFromRows(rows)
+	assert.False(t, details.Enabled)
+	assert.Equal(t, "OKTA", details.ExternalOAuthType)
+	assert.Equal(t, []string{"sub"}, details.ExternalOAuthTokenUserMappingClaim)
+	assert.Equal(t, []string{"https://example.okta.com/oauth2/v1/keys"}, details.ExternalOAuthJWSKeysURL)
+	assert.Equal(t, "DISABLE", details.ExternalOAuthAnyRoleMode)
+}
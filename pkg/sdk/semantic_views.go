@@ -0,0 +1,332 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ SemanticViews = (*semanticViews)(nil)
+
+// SemanticViews describe the business concepts (facts, dimensions and metrics) on top of tables,
+// and are used by Cortex Analyst to answer questions in natural language.
+type SemanticViews interface {
+	// Create creates a new semantic view.
+	Create(ctx context.Context, id SchemaObjectIdentifier, tables []SemanticViewTable, opts *CreateSemanticViewOptions) error
+	// Drop removes a semantic view.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropSemanticViewOptions) error
+	// Show returns a list of semantic views.
+	Show(ctx context.Context, opts *ShowSemanticViewOptions) ([]*SemanticView, error)
+	// ShowByID returns a semantic view by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SemanticView, error)
+	// Describe returns the tables, relationships, facts, dimensions and metrics of a semantic view.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) ([]*SemanticViewDetails, error)
+}
+
+// semanticViews implements SemanticViews.
+type semanticViews struct {
+	client *Client
+}
+
+type CreateSemanticViewOptions struct {
+	create       bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace    *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	semanticView bool                   `ddl:"static" sql:"SEMANTIC VIEW"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists  *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+
+	// required
+	tables []SemanticViewTable `ddl:"keyword,parentheses" sql:"TABLES"`
+
+	// optional
+	Relationships []SemanticViewRelationship `ddl:"keyword,parentheses" sql:"RELATIONSHIPS"`
+	Facts         []SemanticExpression       `ddl:"keyword,parentheses" sql:"FACTS"`
+	Dimensions    []SemanticExpression       `ddl:"keyword,parentheses" sql:"DIMENSIONS"`
+	Metrics       []SemanticExpression       `ddl:"keyword,parentheses" sql:"METRICS"`
+	Comment       *string                    `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	CopyGrants    *bool                      `ddl:"keyword" sql:"COPY GRANTS"`
+}
+
+// SemanticViewTable is a logical table of a semantic view, e.g. `orders AS db.schema.orders PRIMARY KEY (id)`.
+type SemanticViewTable struct {
+	Alias      *string                 `ddl:"parameter,reverse" sql:"AS"`
+	Name       SchemaObjectIdentifier  `ddl:"identifier"`
+	PrimaryKey []SemanticViewColumn    `ddl:"keyword,parentheses" sql:"PRIMARY KEY"`
+	Unique     []SemanticViewUniqueKey `ddl:"list,no_comma"`
+	Synonyms   []SemanticViewSynonym   `ddl:"parameter,parentheses" sql:"WITH SYNONYMS"`
+	Comment    *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type SemanticViewColumn struct {
+	Name string `ddl:"keyword,double_quotes"`
+}
+
+type SemanticViewUniqueKey struct {
+	Columns []SemanticViewColumn `ddl:"keyword,parentheses" sql:"UNIQUE"`
+}
+
+type SemanticViewSynonym struct {
+	Synonym string `ddl:"keyword,single_quotes"`
+}
+
+// SemanticViewRelationship joins the columns of one logical table to the primary or unique key
+// of another one, e.g. `orders (customer_id) REFERENCES customers`.
+type SemanticViewRelationship struct {
+	Name       *string              `ddl:"parameter,reverse" sql:"AS"`
+	TableAlias string               `ddl:"keyword"`
+	Columns    []SemanticViewColumn `ddl:"list,parentheses"`
+	references bool                 `ddl:"static" sql:"REFERENCES"` //lint:ignore U1000 This is used in the ddl tag
+	// RefColumns can be omitted when the referenced table has a primary key.
+	RefTableAlias string               `ddl:"keyword"`
+	RefColumns    []SemanticViewColumn `ddl:"list,parentheses"`
+}
+
+// SemanticExpression is a fact, dimension or metric, e.g. `orders.order_count AS COUNT(o_orderkey)`.
+// Name must be qualified with the alias of the logical table it belongs to.
+type SemanticExpression struct {
+	// Private hides facts and metrics from queries, they can still be referenced by other expressions.
+	Private    *bool                 `ddl:"keyword" sql:"PRIVATE"`
+	Name       string                `ddl:"keyword"`
+	Expression string                `ddl:"parameter,no_equals" sql:"AS"`
+	Synonyms   []SemanticViewSynonym `ddl:"parameter,parentheses" sql:"WITH SYNONYMS"`
+	Comment    *string               `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateSemanticViewOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if len(opts.tables) == 0 {
+		return errors.New("at least one table must be set")
+	}
+	for _, table := range opts.tables {
+		if !validObjectidentifier(table.Name) {
+			return ErrInvalidObjectIdentifier
+		}
+	}
+	for _, relationship := range opts.Relationships {
+		if relationship.TableAlias == "" || relationship.RefTableAlias == "" || len(relationship.Columns) == 0 {
+			return errors.New("TableAlias, Columns and RefTableAlias are required for relationships")
+		}
+	}
+	if len(opts.Dimensions) == 0 && len(opts.Metrics) == 0 {
+		return errors.New("at least one dimension or metric must be set")
+	}
+	for _, expressions := range [][]SemanticExpression{opts.Facts, opts.Dimensions, opts.Metrics} {
+		for _, expression := range expressions {
+			if expression.Name == "" || expression.Expression == "" {
+				return errors.New("Name and Expression are required for facts, dimensions and metrics")
+			}
+		}
+	}
+	for _, dimension := range opts.Dimensions {
+		if valueSet(dimension.Private) && *dimension.Private {
+			return errors.New("dimensions cannot be private")
+		}
+	}
+	return nil
+}
+
+func (v *semanticViews) Create(ctx context.Context, id SchemaObjectIdentifier, tables []SemanticViewTable, opts *CreateSemanticViewOptions) error {
+	if opts == nil {
+		opts = &CreateSemanticViewOptions{}
+	}
+	opts.name = id
+	opts.tables = tables
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropSemanticViewOptions struct {
+	drop         bool                   `ddl:"static" sql:"DROP"`          //lint:ignore U1000 This is used in the ddl tag
+	semanticView bool                   `ddl:"static" sql:"SEMANTIC VIEW"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropSemanticViewOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *semanticViews) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropSemanticViewOptions) error {
+	if opts == nil {
+		opts = &DropSemanticViewOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowSemanticViewOptions struct {
+	show          bool `ddl:"static" sql:"SHOW"`           //lint:ignore U1000 This is used in the ddl tag
+	semanticViews bool `ddl:"static" sql:"SEMANTIC VIEWS"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	In   *In   `ddl:"keyword" sql:"IN"`
+	ShowOptions
+}
+
+func (opts *ShowSemanticViewOptions) validate() error {
+	return nil
+}
+
+type SemanticView struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Comment       string
+	Owner         string
+	OwnerRoleType string
+}
+
+func (v *SemanticView) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *SemanticView) ObjectType() ObjectType {
+	return ObjectTypeSemanticView
+}
+
+type semanticViewRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Comment       sql.NullString `db:"comment"`
+	Owner         sql.NullString `db:"owner"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+func (row semanticViewRow) toSemanticView() *SemanticView {
+	return &SemanticView{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		DatabaseName:  row.DatabaseName,
+		SchemaName:    row.SchemaName,
+		Comment:       row.Comment.String,
+		Owner:         row.Owner.String,
+		OwnerRoleType: row.OwnerRoleType.String,
+	}
+}
+
+func (v *semanticViews) Show(ctx context.Context, opts *ShowSemanticViewOptions) ([]*SemanticView, error) {
+	if opts == nil {
+		opts = &ShowSemanticViewOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []semanticViewRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*SemanticView, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toSemanticView()
+	}
+	return resultList, nil
+}
+
+func (v *semanticViews) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SemanticView, error) {
+	semanticViews, err := v.Show(ctx, &ShowSemanticViewOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, semanticView := range semanticViews {
+		if semanticView.Name == id.Name() {
+			return semanticView, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeSemanticViewOptions struct {
+	describe     bool                   `ddl:"static" sql:"DESCRIBE"`      //lint:ignore U1000 This is used in the ddl tag
+	semanticView bool                   `ddl:"static" sql:"SEMANTIC VIEW"` //lint:ignore U1000 This is used in the ddl tag
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeSemanticViewOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// SemanticViewDetails is a single property of an element of a semantic view. ObjectKind is e.g.
+// TABLE, RELATIONSHIP, FACT, DIMENSION or METRIC, and ParentEntity is the logical table the
+// element belongs to (empty for properties of the semantic view itself).
+type SemanticViewDetails struct {
+	ObjectKind    string
+	ObjectName    string
+	ParentEntity  string
+	Property      string
+	PropertyValue string
+}
+
+type semanticViewDetailsRow struct {
+	ObjectKind    sql.NullString `db:"object_kind"`
+	ObjectName    sql.NullString `db:"object_name"`
+	ParentEntity  sql.NullString `db:"parent_entity"`
+	Property      string         `db:"property"`
+	PropertyValue sql.NullString `db:"property_value"`
+}
+
+func (row semanticViewDetailsRow) toSemanticViewDetails() *SemanticViewDetails {
+	return &SemanticViewDetails{
+		ObjectKind:    row.ObjectKind.String,
+		ObjectName:    row.ObjectName.String,
+		ParentEntity:  row.ParentEntity.String,
+		Property:      row.Property,
+		PropertyValue: row.PropertyValue.String,
+	}
+}
+
+func (v *semanticViews) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]*SemanticViewDetails, error) {
+	opts := &describeSemanticViewOptions{
+		name: id,
+	}
+	dest, err := describeRows[semanticViewDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*SemanticViewDetails, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toSemanticViewDetails()
+	}
+	return resultList, nil
+}
@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_SemanticViews(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	tables := []SemanticViewTable{
+		{
+			Alias:      String("users"),
+			Name:       tableID,
+			PrimaryKey: []SemanticViewColumn{{Name: "ID"}},
+		},
+	}
+	err := client.SemanticViews.Create(ctx, id, tables, &CreateSemanticViewOptions{
+		Dimensions: []SemanticExpression{{Name: "users.email", Expression: "users.EMAIL"}},
+		Metrics:    []SemanticExpression{{Name: "users.user_count", Expression: "COUNT(users.ID)"}},
+		Comment:    String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.SemanticViews.Drop(ctx, id, &DropSemanticViewOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		semanticView, err := client.SemanticViews.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, semanticView.ID())
+		assert.Equal(t, "some comment", semanticView.Comment)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		details, err := client.SemanticViews.Describe(ctx, id)
+		require.NoError(t, err)
+		kinds := make([]string, 0, len(details))
+		for _, detail := range details {
+			kinds = append(kinds, detail.ObjectKind)
+		}
+		assert.Contains(t, kinds, "TABLE")
+		assert.Contains(t, kinds, "DIMENSION")
+		assert.Contains(t, kinds, "METRIC")
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		err := client.SemanticViews.Drop(ctx, id, nil)
+		require.NoError(t, err)
+		_, err = client.SemanticViews.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
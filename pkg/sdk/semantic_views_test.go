@@ -0,0 +1,175 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemanticViewCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "sales")
+	tables := []SemanticViewTable{
+		{
+			Alias:      String("orders"),
+			Name:       NewSchemaObjectIdentifier("db", "schema", "orders"),
+			PrimaryKey: []SemanticViewColumn{{Name: "id"}},
+			Synonyms:   []SemanticViewSynonym{{Synonym: "sales orders"}},
+			Comment:    String("all orders"),
+		},
+		{
+			Alias:      String("customers"),
+			Name:       NewSchemaObjectIdentifier("db", "schema", "customers"),
+			PrimaryKey: []SemanticViewColumn{{Name: "id"}},
+			Unique: []SemanticViewUniqueKey{
+				{Columns: []SemanticViewColumn{{Name: "email"}}},
+				{Columns: []SemanticViewColumn{{Name: "first_name"}, {Name: "last_name"}}},
+			},
+		},
+	}
+
+	t.Run("with minimal options", func(t *testing.T) {
+		opts := &CreateSemanticViewOptions{
+			name:       id,
+			tables:     []SemanticViewTable{{Name: NewSchemaObjectIdentifier("db", "schema", "orders")}},
+			Dimensions: []SemanticExpression{{Name: "orders.order_date", Expression: "o_orderdate"}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE SEMANTIC VIEW "db"."schema"."sales" TABLES ("db"."schema"."orders") DIMENSIONS (orders.order_date AS o_orderdate)`, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateSemanticViewOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			tables:    tables,
+			Relationships: []SemanticViewRelationship{
+				{
+					Name:          String("orders_to_customers"),
+					TableAlias:    "orders",
+					Columns:       []SemanticViewColumn{{Name: "customer_id"}},
+					RefTableAlias: "customers",
+				},
+			},
+			Facts: []SemanticExpression{
+				{Private: Bool(true), Name: "orders.amount", Expression: "o_amount"},
+			},
+			Dimensions: []SemanticExpression{
+				{Name: "customers.name", Expression: "c_name", Synonyms: []SemanticViewSynonym{{Synonym: "customer name"}, {Synonym: "client"}}},
+			},
+			Metrics: []SemanticExpression{
+				{Name: "orders.total_amount", Expression: "SUM(orders.amount)", Comment: String("total order amount")},
+			},
+			Comment:    String("sales model"),
+			CopyGrants: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE SEMANTIC VIEW "db"."schema"."sales"` +
+			` TABLES (orders AS "db"."schema"."orders" PRIMARY KEY ("id") WITH SYNONYMS = ('sales orders') COMMENT = 'all orders',` +
+			` customers AS "db"."schema"."customers" PRIMARY KEY ("id") UNIQUE ("email") UNIQUE ("first_name", "last_name"))` +
+			` RELATIONSHIPS (orders_to_customers AS orders ("customer_id") REFERENCES customers)` +
+			` FACTS (PRIVATE orders.amount AS o_amount)` +
+			` DIMENSIONS (customers.name AS c_name WITH SYNONYMS = ('customer name', 'client'))` +
+			` METRICS (orders.total_amount AS SUM(orders.amount) COMMENT = 'total order amount')` +
+			` COMMENT = 'sales model' COPY GRANTS`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with relationship to explicit columns", func(t *testing.T) {
+		opts := &CreateSemanticViewOptions{
+			name:   id,
+			tables: tables,
+			Relationships: []SemanticViewRelationship{
+				{
+					TableAlias:    "orders",
+					Columns:       []SemanticViewColumn{{Name: "customer_email"}},
+					RefTableAlias: "customers",
+					RefColumns:    []SemanticViewColumn{{Name: "email"}},
+				},
+			},
+			Metrics: []SemanticExpression{{Name: "orders.order_count", Expression: "COUNT(*)"}},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Contains(t, actual, `RELATIONSHIPS (orders ("customer_email") REFERENCES customers ("email"))`)
+	})
+
+	t.Run("validation: no tables", func(t *testing.T) {
+		opts := &CreateSemanticViewOptions{
+			name:       id,
+			Dimensions: []SemanticExpression{{Name: "orders.order_date", Expression: "o_orderdate"}},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no dimensions or metrics", func(t *testing.T) {
+		opts := &CreateSemanticViewOptions{
+			name:   id,
+			tables: tables,
+			Facts:  []SemanticExpression{{Name: "orders.amount", Expression: "o_amount"}},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: private dimension", func(t *testing.T) {
+		opts := &CreateSemanticViewOptions{
+			name:       id,
+			tables:     tables,
+			Dimensions: []SemanticExpression{{Private: Bool(true), Name: "orders.order_date", Expression: "o_orderdate"}},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: incomplete relationship", func(t *testing.T) {
+		opts := &CreateSemanticViewOptions{
+			name:          id,
+			tables:        tables,
+			Relationships: []SemanticViewRelationship{{TableAlias: "orders", RefTableAlias: "customers"}},
+			Metrics:       []SemanticExpression{{Name: "orders.order_count", Expression: "COUNT(*)"}},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSemanticViewDrop(t *testing.T) {
+	opts := &DropSemanticViewOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "sales"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP SEMANTIC VIEW IF EXISTS "db"."schema"."sales"`, actual)
+}
+
+func TestSemanticViewShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&ShowSemanticViewOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW SEMANTIC VIEWS`, actual)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		opts := &ShowSemanticViewOptions{
+			Like: &Like{Pattern: String("sales%")},
+			In:   &In{Schema: NewSchemaIdentifier("db", "schema")},
+			ShowOptions: ShowOptions{
+				StartsWith: String("sa"),
+				Limit:      &LimitFrom{Rows: Int(10)},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW SEMANTIC VIEWS LIKE 'sales%' IN SCHEMA "db"."schema" STARTS WITH 'sa' LIMIT 10`, actual)
+	})
+}
+
+func TestSemanticViewDescribe(t *testing.T) {
+	opts := &describeSemanticViewOptions{name: NewSchemaObjectIdentifier("db", "schema", "sales")}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE SEMANTIC VIEW "db"."schema"."sales"`, actual)
+}
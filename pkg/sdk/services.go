@@ -0,0 +1,553 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Services = (*services)(nil)
+
+// Services are Snowpark Container Services: long-running services and jobs that run containers in a compute pool.
+type Services interface {
+	// Create creates a new long-running service.
+	Create(ctx context.Context, id SchemaObjectIdentifier, computePool AccountObjectIdentifier, specification ServiceSpecification, opts *CreateServiceOptions) error
+	// ExecuteJob runs a job service, which exits when its containers finish.
+	ExecuteJob(ctx context.Context, id SchemaObjectIdentifier, computePool AccountObjectIdentifier, specification ServiceSpecification, opts *ExecuteJobServiceOptions) error
+	// Alter modifies an existing service.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterServiceOptions) error
+	// Drop removes a service.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropServiceOptions) error
+	// Show returns a list of services.
+	Show(ctx context.Context, opts *ShowServiceOptions) ([]*Service, error)
+	// ShowByID returns a service by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Service, error)
+	// Describe returns the details of a service, including its specification.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*Service, error)
+	// Status returns the status of the containers of the service, as returned by SYSTEM$GET_SERVICE_STATUS.
+	Status(ctx context.Context, id SchemaObjectIdentifier) ([]ServiceContainerStatus, error)
+	// Logs returns the most recent lines of the log of a container of the service instance, as returned by SYSTEM$GET_SERVICE_LOGS.
+	Logs(ctx context.Context, id SchemaObjectIdentifier, instanceID int, container string, lines *int) (string, error)
+}
+
+// services implements Services.
+type services struct {
+	client *Client
+}
+
+// ServiceSpecification is the specification of the containers of a service, either a file in a stage or inline YAML.
+type ServiceSpecification struct {
+	// Stage and SpecificationFile reference a file in a stage, e.g. @db.schema.stage and spec.yaml.
+	Stage             *string `ddl:"parameter,no_equals" sql:"FROM"`
+	SpecificationFile *string `ddl:"parameter,single_quotes" sql:"SPECIFICATION_FILE"`
+	// Specification is the inline YAML specification.
+	Specification *string `ddl:"parameter,dollar_quotes,no_equals" sql:"FROM SPECIFICATION"`
+}
+
+func (v *ServiceSpecification) validate() error {
+	if !exactlyOneValueSet(v.Stage, v.Specification) {
+		return errors.New("exactly one of Stage, Specification must be set")
+	}
+	if valueSet(v.Stage) != valueSet(v.SpecificationFile) {
+		return errors.New("Stage and SpecificationFile must be set together")
+	}
+	return nil
+}
+
+type CreateServiceOptions struct {
+	create        bool                    `ddl:"static" sql:"CREATE"`  //lint:ignore U1000 This is used in the ddl tag
+	service       bool                    `ddl:"static" sql:"SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists   *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name          SchemaObjectIdentifier  `ddl:"identifier"`
+	computePool   AccountObjectIdentifier `ddl:"identifier" sql:"IN COMPUTE POOL"`
+	specification *ServiceSpecification   `ddl:"-"`
+
+	// optional
+	AutoSuspendSecs            *int                      `ddl:"parameter" sql:"AUTO_SUSPEND_SECS"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	AutoResume                 *bool                     `ddl:"parameter" sql:"AUTO_RESUME"`
+	MinInstances               *int                      `ddl:"parameter" sql:"MIN_INSTANCES"`
+	MaxInstances               *int                      `ddl:"parameter" sql:"MAX_INSTANCES"`
+	QueryWarehouse             AccountObjectIdentifier   `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	Tag                        []TagAssociation          `ddl:"keyword,parentheses" sql:"TAG"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func validateServiceInstances(minInstances *int, maxInstances *int) error {
+	if valueSet(minInstances) && !validateIntGreaterThanOrEqual(*minInstances, 1) {
+		return errors.New("MIN_INSTANCES must be greater than or equal to 1")
+	}
+	if everyValueSet(minInstances, maxInstances) && *maxInstances < *minInstances {
+		return errors.New("MAX_INSTANCES must be greater than or equal to MIN_INSTANCES")
+	}
+	return nil
+}
+
+func (opts *CreateServiceOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !validObjectidentifier(opts.computePool) {
+		return ErrInvalidObjectIdentifier
+	}
+	if err := opts.specification.validate(); err != nil {
+		return err
+	}
+	return validateServiceInstances(opts.MinInstances, opts.MaxInstances)
+}
+
+func (v *services) Create(ctx context.Context, id SchemaObjectIdentifier, computePool AccountObjectIdentifier, specification ServiceSpecification, opts *CreateServiceOptions) error {
+	if opts == nil {
+		opts = &CreateServiceOptions{}
+	}
+	opts.name = id
+	opts.computePool = computePool
+	opts.specification = &specification
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ExecuteJobServiceOptions struct {
+	executeJobService bool                    `ddl:"static" sql:"EXECUTE JOB SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	computePool       AccountObjectIdentifier `ddl:"identifier" sql:"IN COMPUTE POOL"`
+	specification     *ServiceSpecification   `ddl:"-"`
+	name              SchemaObjectIdentifier  `ddl:"identifier,equals" sql:"NAME"`
+
+	// optional
+	// Async returns as soon as the job is started instead of waiting for it to finish.
+	Async                      *bool                     `ddl:"parameter" sql:"ASYNC"`
+	QueryWarehouse             AccountObjectIdentifier   `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+}
+
+func (opts *ExecuteJobServiceOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !validObjectidentifier(opts.computePool) {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.specification.validate()
+}
+
+func (v *services) ExecuteJob(ctx context.Context, id SchemaObjectIdentifier, computePool AccountObjectIdentifier, specification ServiceSpecification, opts *ExecuteJobServiceOptions) error {
+	if opts == nil {
+		opts = &ExecuteJobServiceOptions{}
+	}
+	opts.name = id
+	opts.computePool = computePool
+	opts.specification = &specification
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterServiceOptions struct {
+	alter    bool                   `ddl:"static" sql:"ALTER"`   //lint:ignore U1000 This is used in the ddl tag
+	service  bool                   `ddl:"static" sql:"SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	Suspend *bool `ddl:"keyword" sql:"SUSPEND"`
+	Resume  *bool `ddl:"keyword" sql:"RESUME"`
+	// Specification replaces the specification of the service, which restarts its containers.
+	Specification *ServiceSpecification `ddl:"-"`
+	Set           *ServiceSet           `ddl:"keyword" sql:"SET"`
+	Unset         *ServiceUnset         `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *AlterServiceOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Suspend, opts.Resume, opts.Specification, opts.Set, opts.Unset) {
+		return errors.New("exactly one of Suspend, Resume, Specification, Set, Unset must be set")
+	}
+	if valueSet(opts.Specification) {
+		if err := opts.Specification.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.MinInstances, opts.Set.MaxInstances, opts.Set.AutoSuspendSecs, opts.Set.AutoResume, opts.Set.QueryWarehouse, opts.Set.ExternalAccessIntegrations, opts.Set.Comment) {
+			return errors.New("at least one property must be set")
+		}
+		if err := validateServiceInstances(opts.Set.MinInstances, opts.Set.MaxInstances); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.MinInstances, opts.Unset.MaxInstances, opts.Unset.AutoSuspendSecs, opts.Unset.AutoResume, opts.Unset.QueryWarehouse, opts.Unset.ExternalAccessIntegrations, opts.Unset.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+type ServiceSet struct {
+	MinInstances               *int                      `ddl:"parameter" sql:"MIN_INSTANCES"`
+	MaxInstances               *int                      `ddl:"parameter" sql:"MAX_INSTANCES"`
+	AutoSuspendSecs            *int                      `ddl:"parameter" sql:"AUTO_SUSPEND_SECS"`
+	AutoResume                 *bool                     `ddl:"parameter" sql:"AUTO_RESUME"`
+	QueryWarehouse             AccountObjectIdentifier   `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ServiceUnset struct {
+	MinInstances               *bool `ddl:"keyword" sql:"MIN_INSTANCES"`
+	MaxInstances               *bool `ddl:"keyword" sql:"MAX_INSTANCES"`
+	AutoSuspendSecs            *bool `ddl:"keyword" sql:"AUTO_SUSPEND_SECS"`
+	AutoResume                 *bool `ddl:"keyword" sql:"AUTO_RESUME"`
+	QueryWarehouse             *bool `ddl:"keyword" sql:"QUERY_WAREHOUSE"`
+	ExternalAccessIntegrations *bool `ddl:"keyword" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *services) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterServiceOptions) error {
+	if opts == nil {
+		opts = &AlterServiceOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropServiceOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`    //lint:ignore U1000 This is used in the ddl tag
+	service  bool                   `ddl:"static" sql:"SERVICE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+	// Force drops the service even if it is still used, e.g. by a service function.
+	Force *bool `ddl:"keyword" sql:"FORCE"`
+}
+
+func (opts *DropServiceOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *services) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropServiceOptions) error {
+	if opts == nil {
+		opts = &DropServiceOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowServiceOptions struct {
+	show     bool  `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Job      *bool `ddl:"keyword" sql:"JOB"`
+	services bool  `ddl:"static" sql:"SERVICES"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	ExcludeJobs *bool `ddl:"keyword" sql:"EXCLUDE JOBS"`
+	Like        *Like `ddl:"keyword" sql:"LIKE"`
+	In          *In   `ddl:"keyword" sql:"IN"`
+	ShowOptions
+}
+
+func (opts *ShowServiceOptions) validate() error {
+	if everyValueSet(opts.Job, opts.ExcludeJobs) && *opts.Job && *opts.ExcludeJobs {
+		return errors.New("Job and ExcludeJobs are incompatible")
+	}
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ServiceStatus string
+
+const (
+	ServiceStatusPending       ServiceStatus = "PENDING"
+	ServiceStatusRunning       ServiceStatus = "RUNNING"
+	ServiceStatusFailed        ServiceStatus = "FAILED"
+	ServiceStatusDone          ServiceStatus = "DONE"
+	ServiceStatusSuspending    ServiceStatus = "SUSPENDING"
+	ServiceStatusSuspended     ServiceStatus = "SUSPENDED"
+	ServiceStatusDeleting      ServiceStatus = "DELETING"
+	ServiceStatusDeleted       ServiceStatus = "DELETED"
+	ServiceStatusInternalError ServiceStatus = "INTERNAL_ERROR"
+)
+
+var allServiceStatuses = []ServiceStatus{
+	ServiceStatusPending,
+	ServiceStatusRunning,
+	ServiceStatusFailed,
+	ServiceStatusDone,
+	ServiceStatusSuspending,
+	ServiceStatusSuspended,
+	ServiceStatusDeleting,
+	ServiceStatusDeleted,
+	ServiceStatusInternalError,
+}
+
+type Service struct {
+	Name                       string
+	Status                     ServiceStatus
+	DatabaseName               string
+	SchemaName                 string
+	Owner                      string
+	ComputePool                string
+	DNSName                    string
+	CurrentInstances           int
+	TargetInstances            int
+	MinReadyInstances          int
+	MinInstances               int
+	MaxInstances               int
+	AutoResume                 bool
+	ExternalAccessIntegrations []string
+	CreatedOn                  time.Time
+	UpdatedOn                  time.Time
+	ResumedOn                  time.Time
+	SuspendedOn                time.Time
+	AutoSuspendSecs            int
+	Comment                    string
+	OwnerRoleType              string
+	QueryWarehouse             string
+	IsJob                      bool
+	IsAsyncJob                 bool
+	SpecDigest                 string
+	// Spec is only returned by Describe.
+	Spec string
+}
+
+func (v *Service) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Service) ObjectType() ObjectType {
+	return ObjectTypeService
+}
+
+type serviceRow struct {
+	Name                       string         `db:"name"`
+	Status                     string         `db:"status"`
+	DatabaseName               string         `db:"database_name"`
+	SchemaName                 string         `db:"schema_name"`
+	Owner                      sql.NullString `db:"owner"`
+	ComputePool                sql.NullString `db:"compute_pool"`
+	DNSName                    sql.NullString `db:"dns_name"`
+	CurrentInstances           sql.NullInt64  `db:"current_instances"`
+	TargetInstances            sql.NullInt64  `db:"target_instances"`
+	MinReadyInstances          sql.NullInt64  `db:"min_ready_instances"`
+	MinInstances               sql.NullInt64  `db:"min_instances"`
+	MaxInstances               sql.NullInt64  `db:"max_instances"`
+	AutoResume                 bool           `db:"auto_resume"`
+	ExternalAccessIntegrations sql.NullString `db:"external_access_integrations"`
+	CreatedOn                  time.Time      `db:"created_on"`
+	UpdatedOn                  sql.NullTime   `db:"updated_on"`
+	ResumedOn                  sql.NullTime   `db:"resumed_on"`
+	SuspendedOn                sql.NullTime   `db:"suspended_on"`
+	AutoSuspendSecs            sql.NullInt64  `db:"auto_suspend_secs"`
+	Comment                    sql.NullString `db:"comment"`
+	OwnerRoleType              sql.NullString `db:"owner_role_type"`
+	QueryWarehouse             sql.NullString `db:"query_warehouse"`
+	IsJob                      bool           `db:"is_job"`
+	IsAsyncJob                 bool           `db:"is_async_job"`
+	SpecDigest                 sql.NullString `db:"spec_digest"`
+	Spec                       sql.NullString `db:"spec"`
+}
+
+func (row serviceRow) toService(strict bool) (*Service, error) {
+	service := &Service{
+		Name:                       row.Name,
+		DatabaseName:               row.DatabaseName,
+		SchemaName:                 row.SchemaName,
+		Owner:                      row.Owner.String,
+		ComputePool:                row.ComputePool.String,
+		DNSName:                    row.DNSName.String,
+		CurrentInstances:           int(row.CurrentInstances.Int64),
+		TargetInstances:            int(row.TargetInstances.Int64),
+		MinReadyInstances:          int(row.MinReadyInstances.Int64),
+		MinInstances:               int(row.MinInstances.Int64),
+		MaxInstances:               int(row.MaxInstances.Int64),
+		AutoResume:                 row.AutoResume,
+		ExternalAccessIntegrations: unquoteList(row.ExternalAccessIntegrations.String),
+		CreatedOn:                  row.CreatedOn,
+		AutoSuspendSecs:            int(row.AutoSuspendSecs.Int64),
+		Comment:                    row.Comment.String,
+		OwnerRoleType:              row.OwnerRoleType.String,
+		QueryWarehouse:             row.QueryWarehouse.String,
+		IsJob:                      row.IsJob,
+		IsAsyncJob:                 row.IsAsyncJob,
+		SpecDigest:                 row.SpecDigest.String,
+		Spec:                       row.Spec.String,
+	}
+	if row.UpdatedOn.Valid {
+		service.UpdatedOn = row.UpdatedOn.Time
+	}
+	if row.ResumedOn.Valid {
+		service.ResumedOn = row.ResumedOn.Time
+	}
+	if row.SuspendedOn.Valid {
+		service.SuspendedOn = row.SuspendedOn.Time
+	}
+	status, err := toEnum(strict, "service status", row.Status, allServiceStatuses)
+	if err != nil {
+		return nil, err
+	}
+	service.Status = status
+	return service, nil
+}
+
+func (v *services) Show(ctx context.Context, opts *ShowServiceOptions) ([]*Service, error) {
+	if opts == nil {
+		opts = &ShowServiceOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []serviceRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Service, len(dest))
+	for i, row := range dest {
+		resultList[i], err = row.toService(v.client.strictEnumParsing)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resultList, nil
+}
+
+func (v *services) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Service, error) {
+	services, err := v.Show(ctx, &ShowServiceOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services {
+		if service.Name == id.Name() {
+			return service, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeServiceOptions struct {
+	describe bool                   `ddl:"static" sql:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	service  bool                   `ddl:"static" sql:"SERVICE"`  //lint:ignore U1000 This is used in the ddl tag
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeServiceOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *services) Describe(ctx context.Context, id SchemaObjectIdentifier) (*Service, error) {
+	opts := &describeServiceOptions{
+		name: id,
+	}
+	dest, err := describeRow[serviceRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toService(v.client.strictEnumParsing)
+}
+
+// ServiceContainerStatus is the status of a single container of a service instance.
+type ServiceContainerStatus struct {
+	Status        string    `json:"status"`
+	Message       string    `json:"message"`
+	ContainerName string    `json:"containerName"`
+	InstanceID    string    `json:"instanceId"`
+	ServiceName   string    `json:"serviceName"`
+	Image         string    `json:"image"`
+	RestartCount  int       `json:"restartCount"`
+	StartTime     time.Time `json:"startTime"`
+}
+
+// parseServiceStatus parses the JSON array returned by SYSTEM$GET_SERVICE_STATUS.
+func parseServiceStatus(raw string) ([]ServiceContainerStatus, error) {
+	var statuses []ServiceContainerStatus
+	if err := json.Unmarshal([]byte(raw), &statuses); err != nil {
+		return nil, fmt.Errorf("parse service status: %w", err)
+	}
+	return statuses, nil
+}
+
+func (v *services) Status(ctx context.Context, id SchemaObjectIdentifier) ([]ServiceContainerStatus, error) {
+	if !validObjectidentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	s := &struct {
+		Status string `db:"STATUS"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$GET_SERVICE_STATUS('%s') AS "STATUS"`, escapeStringLiteral(id.FullyQualifiedName()))
+	if err := v.client.queryOne(ctx, s, sql); err != nil {
+		return nil, err
+	}
+	return parseServiceStatus(s.Status)
+}
+
+func (v *services) Logs(ctx context.Context, id SchemaObjectIdentifier, instanceID int, container string, lines *int) (string, error) {
+	if !validObjectidentifier(id) {
+		return "", ErrInvalidObjectIdentifier
+	}
+	if container == "" {
+		return "", errors.New("container is required")
+	}
+	args := fmt.Sprintf(`'%s', %d, '%s'`, escapeStringLiteral(id.FullyQualifiedName()), instanceID, escapeStringLiteral(container))
+	if lines != nil {
+		args = fmt.Sprintf("%s, %d", args, *lines)
+	}
+	s := &struct {
+		Logs string `db:"LOGS"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$GET_SERVICE_LOGS(%s) AS "LOGS"`, args)
+	if err := v.client.queryOne(ctx, s, sql); err != nil {
+		return "", err
+	}
+	return s.Logs, nil
+}
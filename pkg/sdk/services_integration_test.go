@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Services(t *testing.T) {
+	// The image has to be pushed to an image repository of the account beforehand, e.g. /db/schema/repo/image:latest.
+	image := os.Getenv("SNOWFLAKE_TEST_SERVICE_IMAGE")
+	if image == "" {
+		t.Skip("SNOWFLAKE_TEST_SERVICE_IMAGE is not set")
+	}
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	pool := randomAccountObjectIdentifier(t)
+	err := client.ComputePools.Create(ctx, pool, 1, 1, ComputePoolInstanceFamilyCPUX64XS, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.ComputePools.Alter(ctx, pool, &AlterComputePoolOptions{StopAll: Bool(true)})
+		require.NoError(t, err)
+		err = client.ComputePools.Drop(ctx, pool, nil)
+		require.NoError(t, err)
+	})
+
+	specification := ServiceSpecification{
+		Specification: String(fmt.Sprintf("spec:\n  containers:\n  - name: main\n    image: %s\n", image)),
+	}
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err = client.Services.Create(ctx, id, pool, specification, &CreateServiceOptions{Comment: String("some comment")})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Services.Drop(ctx, id, &DropServiceOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		service, err := client.Services.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, service.ID())
+		assert.Equal(t, pool.Name(), service.ComputePool)
+		assert.Equal(t, "some comment", service.Comment)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		service, err := client.Services.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, service.Spec, "main")
+	})
+
+	t.Run("status", func(t *testing.T) {
+		statuses, err := client.Services.Status(ctx, id)
+		require.NoError(t, err)
+		assert.NotEmpty(t, statuses)
+	})
+
+	t.Run("alter: suspend and resume", func(t *testing.T) {
+		err := client.Services.Alter(ctx, id, &AlterServiceOptions{Suspend: Bool(true)})
+		require.NoError(t, err)
+		err = client.Services.Alter(ctx, id, &AlterServiceOptions{Resume: Bool(true)})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,211 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "myservice")
+	pool := NewAccountObjectIdentifier("mypool")
+
+	t.Run("from stage", func(t *testing.T) {
+		opts := &CreateServiceOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			computePool: pool,
+			specification: &ServiceSpecification{
+				Stage:             String("@db.schema.specs"),
+				SpecificationFile: String("spec.yaml"),
+			},
+			ExternalAccessIntegrations: []AccountObjectIdentifier{NewAccountObjectIdentifier("eai")},
+			MinInstances:               Int(1),
+			MaxInstances:               Int(3),
+			Comment:                    String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE SERVICE IF NOT EXISTS "db"."schema"."myservice" IN COMPUTE POOL "mypool" FROM @db.schema.specs SPECIFICATION_FILE = 'spec.yaml' EXTERNAL_ACCESS_INTEGRATIONS = ("eai") MIN_INSTANCES = 1 MAX_INSTANCES = 3 COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("inline specification", func(t *testing.T) {
+		opts := &CreateServiceOptions{
+			name:        id,
+			computePool: pool,
+			specification: &ServiceSpecification{
+				Specification: String("spec:\n  containers:\n  - name: main\n    image: /db/schema/repo/image:latest"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE SERVICE \"db\".\"schema\".\"myservice\" IN COMPUTE POOL \"mypool\" FROM SPECIFICATION $$spec:\n  containers:\n  - name: main\n    image: /db/schema/repo/image:latest$$", actual)
+	})
+
+	t.Run("validation: stage without specification file", func(t *testing.T) {
+		opts := &CreateServiceOptions{
+			name:          id,
+			computePool:   pool,
+			specification: &ServiceSpecification{Stage: String("@db.schema.specs")},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: stage and inline specification", func(t *testing.T) {
+		opts := &CreateServiceOptions{
+			name:        id,
+			computePool: pool,
+			specification: &ServiceSpecification{
+				Stage:             String("@db.schema.specs"),
+				SpecificationFile: String("spec.yaml"),
+				Specification:     String("spec: {}"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: max instances lower than min instances", func(t *testing.T) {
+		opts := &CreateServiceOptions{
+			name:          id,
+			computePool:   pool,
+			specification: &ServiceSpecification{Specification: String("spec: {}")},
+			MinInstances:  Int(3),
+			MaxInstances:  Int(1),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestServiceExecuteJob(t *testing.T) {
+	opts := &ExecuteJobServiceOptions{
+		computePool: NewAccountObjectIdentifier("mypool"),
+		specification: &ServiceSpecification{
+			Stage:             String("@db.schema.specs"),
+			SpecificationFile: String("job.yaml"),
+		},
+		name:  NewSchemaObjectIdentifier("db", "schema", "myjob"),
+		Async: Bool(true),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `EXECUTE JOB SERVICE IN COMPUTE POOL "mypool" FROM @db.schema.specs SPECIFICATION_FILE = 'job.yaml' NAME = "db"."schema"."myjob" ASYNC = true`, actual)
+}
+
+func TestServiceAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "myservice")
+
+	t.Run("suspend", func(t *testing.T) {
+		opts := &AlterServiceOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Suspend:  Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER SERVICE IF EXISTS "db"."schema"."myservice" SUSPEND`, actual)
+	})
+
+	t.Run("set specification", func(t *testing.T) {
+		opts := &AlterServiceOptions{
+			name: id,
+			Specification: &ServiceSpecification{
+				Stage:             String("@db.schema.specs"),
+				SpecificationFile: String("spec_v2.yaml"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER SERVICE "db"."schema"."myservice" FROM @db.schema.specs SPECIFICATION_FILE = 'spec_v2.yaml'`, actual)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterServiceOptions{
+			name: id,
+			Set:  &ServiceSet{MinInstances: Int(2), AutoResume: Bool(false)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER SERVICE "db"."schema"."myservice" SET MIN_INSTANCES = 2 AUTO_RESUME = false`, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterServiceOptions{
+			name:  id,
+			Unset: &ServiceUnset{QueryWarehouse: Bool(true), Comment: Bool(true)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER SERVICE "db"."schema"."myservice" UNSET QUERY_WAREHOUSE, COMMENT`, actual)
+	})
+
+	t.Run("validation: suspend and resume", func(t *testing.T) {
+		opts := &AlterServiceOptions{
+			name:    id,
+			Suspend: Bool(true),
+			Resume:  Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestServiceDrop(t *testing.T) {
+	opts := &DropServiceOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "myservice"),
+		Force:    Bool(true),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP SERVICE IF EXISTS "db"."schema"."myservice" FORCE`, actual)
+}
+
+func TestServiceShow(t *testing.T) {
+	t.Run("job services", func(t *testing.T) {
+		opts := &ShowServiceOptions{
+			Job:  Bool(true),
+			Like: &Like{Pattern: String("myjob")},
+			In:   &In{Schema: NewSchemaIdentifier("db", "schema")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW JOB SERVICES LIKE 'myjob' IN SCHEMA "db"."schema"`, actual)
+	})
+
+	t.Run("exclude jobs", func(t *testing.T) {
+		opts := &ShowServiceOptions{
+			ExcludeJobs: Bool(true),
+			ShowOptions: ShowOptions{
+				Limit: &LimitFrom{Rows: Int(10)},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW SERVICES EXCLUDE JOBS LIMIT 10`, actual)
+	})
+
+	t.Run("validation: job and exclude jobs", func(t *testing.T) {
+		opts := &ShowServiceOptions{Job: Bool(true), ExcludeJobs: Bool(true)}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestServiceDescribe(t *testing.T) {
+	opts := &describeServiceOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "myservice"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE SERVICE "db"."schema"."myservice"`, actual)
+}
+
+func TestParseServiceStatus(t *testing.T) {
+	statuses, err := parseServiceStatus(`[{"status":"READY","message":"Running","containerName":"main","instanceId":"0","serviceName":"MYSERVICE","image":"repo/image:latest","restartCount":0,"startTime":"2023-11-01T10:00:00Z"}]`)
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "READY", statuses[0].Status)
+	assert.Equal(t, "main", statuses[0].ContainerName)
+
+	_, err = parseServiceStatus("not json")
+	assert.Error(t, err)
+}
@@ -0,0 +1,183 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SecondaryRoles are the roles activated in addition to the primary role of a session: all granted roles, the
+// listed ones or none.
+type SecondaryRoles struct {
+	All   bool
+	Roles []AccountObjectIdentifier
+}
+
+func (r SecondaryRoles) String() string {
+	if r.All {
+		return "ALL"
+	}
+	if len(r.Roles) == 0 {
+		return "NONE"
+	}
+	roles := make([]string, len(r.Roles))
+	for i, role := range r.Roles {
+		roles[i] = role.FullyQualifiedName()
+	}
+	return strings.Join(roles, ", ")
+}
+
+// parseSecondaryRoles parses the output of CURRENT_SECONDARY_ROLES, e.g. {"roles":"ROLE1,ROLE2","value":""}.
+func parseSecondaryRoles(s string) (SecondaryRoles, error) {
+	var output struct {
+		Roles string `json:"roles"`
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(s), &output); err != nil {
+		return SecondaryRoles{}, fmt.Errorf("parse secondary roles %s: %w", s, err)
+	}
+	if strings.EqualFold(output.Value, "ALL") {
+		return SecondaryRoles{All: true}, nil
+	}
+	var roles []AccountObjectIdentifier
+	for _, role := range strings.Split(output.Roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, NewAccountObjectIdentifier(role))
+		}
+	}
+	return SecondaryRoles{Roles: roles}, nil
+}
+
+// SessionContext is the role, secondary roles, warehouse, database and schema of a session. Unset parts are left
+// unchanged by UseContext.
+type SessionContext struct {
+	Role           AccountObjectIdentifier
+	SecondaryRoles *SecondaryRoles
+	Warehouse      AccountObjectIdentifier
+	Database       AccountObjectIdentifier
+	Schema         SchemaIdentifier
+}
+
+type sessionContextRow struct {
+	Role           sql.NullString `db:"ROLE"`
+	SecondaryRoles sql.NullString `db:"SECONDARY_ROLES"`
+	Warehouse      sql.NullString `db:"WAREHOUSE"`
+	Database       sql.NullString `db:"DATABASE"`
+	Schema         sql.NullString `db:"SCHEMA"`
+}
+
+func (row sessionContextRow) toSessionContext() (*SessionContext, error) {
+	sessionContext := &SessionContext{
+		Role:      NewAccountObjectIdentifier(row.Role.String),
+		Warehouse: NewAccountObjectIdentifier(row.Warehouse.String),
+		Database:  NewAccountObjectIdentifier(row.Database.String),
+	}
+	if row.SecondaryRoles.Valid && row.SecondaryRoles.String != "" {
+		secondaryRoles, err := parseSecondaryRoles(row.SecondaryRoles.String)
+		if err != nil {
+			return nil, err
+		}
+		sessionContext.SecondaryRoles = &secondaryRoles
+	}
+	if row.Database.String != "" && row.Schema.String != "" {
+		sessionContext.Schema = NewSchemaIdentifier(row.Database.String, row.Schema.String)
+	}
+	return sessionContext, nil
+}
+
+func (v *sessions) CurrentContext(ctx context.Context) (*SessionContext, error) {
+	row := &sessionContextRow{}
+	err := v.client.queryOne(ctx, row, `SELECT CURRENT_ROLE() AS "ROLE", CURRENT_SECONDARY_ROLES() AS "SECONDARY_ROLES", CURRENT_WAREHOUSE() AS "WAREHOUSE", CURRENT_DATABASE() AS "DATABASE", CURRENT_SCHEMA() AS "SCHEMA"`)
+	if err != nil {
+		return nil, err
+	}
+	return row.toSessionContext()
+}
+
+func (v *sessions) UseContext(ctx context.Context, sessionContext *SessionContext) error {
+	if sessionContext == nil {
+		return nil
+	}
+	if validObjectidentifier(sessionContext.Role) {
+		if err := v.UseRole(ctx, sessionContext.Role); err != nil {
+			return err
+		}
+	}
+	if sessionContext.SecondaryRoles != nil {
+		if err := v.UseSecondaryRoles(ctx, *sessionContext.SecondaryRoles); err != nil {
+			return err
+		}
+	}
+	if validObjectidentifier(sessionContext.Warehouse) {
+		if err := v.UseWarehouse(ctx, sessionContext.Warehouse); err != nil {
+			return err
+		}
+	}
+	if validObjectidentifier(sessionContext.Schema) {
+		return v.UseSchema(ctx, sessionContext.Schema)
+	}
+	if validObjectidentifier(sessionContext.Database) {
+		return v.UseDatabase(ctx, sessionContext.Database)
+	}
+	return nil
+}
+
+// restorableSessionContext reports whether the parts of target can be switched back to previous. Snowflake cannot
+// switch back to no warehouse, database or schema.
+func restorableSessionContext(previous *SessionContext, target *SessionContext) bool {
+	return (!validObjectidentifier(target.Warehouse) || validObjectidentifier(previous.Warehouse)) &&
+		(!validObjectidentifier(target.Database) || validObjectidentifier(previous.Database)) &&
+		(!validObjectidentifier(target.Schema) || validObjectidentifier(previous.Schema))
+}
+
+// WithSessionContext runs fn with a client bound to a single session that was switched to sessionContext, e.g. to
+// create an object with a specific role. The session is switched back to its previous context afterwards. Sessions
+// that cannot be switched back, e.g. as they had no warehouse before, are closed instead of being returned to the
+// connection pool. Clients in a transaction use its session.
+func (c *Client) WithSessionContext(ctx context.Context, sessionContext *SessionContext, fn func(c *Client) error) (err error) {
+	if sessionContext == nil {
+		sessionContext = &SessionContext{}
+	}
+	bound := *c
+	if c.tx == nil && c.conn == nil {
+		conn, err := c.db.Connx(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		bound.conn = conn
+	}
+	bound.initialize()
+	var discard bool
+	defer func() {
+		if discard && bound.conn != nil && c.conn == nil {
+			_ = bound.conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+	}()
+
+	previous, err := bound.Sessions.CurrentContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if !restorableSessionContext(previous, sessionContext) {
+			discard = true
+			return
+		}
+		// the session is switched back even if ctx is done, so that the connection can be reused
+		restoreCtx := context.WithValue(context.Background(), snowflakeAccountLocatorContextKey, c.accountLocator)
+		if restoreErr := bound.Sessions.UseContext(restoreCtx, previous); restoreErr != nil {
+			discard = true
+			if err == nil {
+				err = fmt.Errorf("restore session context: %w", restoreErr)
+			}
+		}
+	}()
+	if err := bound.Sessions.UseContext(ctx, sessionContext); err != nil {
+		return err
+	}
+	return fn(&bound)
+}
@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecondaryRoles(t *testing.T) {
+	assert.Equal(t, "ALL", SecondaryRoles{All: true}.String())
+	assert.Equal(t, "NONE", SecondaryRoles{}.String())
+	assert.Equal(t, `"R1", "R2"`, SecondaryRoles{Roles: []AccountObjectIdentifier{NewAccountObjectIdentifier("R1"), NewAccountObjectIdentifier("R2")}}.String())
+
+	roles, err := parseSecondaryRoles(`{"roles":"R1,R2","value":""}`)
+	require.NoError(t, err)
+	assert.Equal(t, SecondaryRoles{Roles: []AccountObjectIdentifier{NewAccountObjectIdentifier("R1"), NewAccountObjectIdentifier("R2")}}, roles)
+
+	roles, err = parseSecondaryRoles(`{"roles":"R1","value":"ALL"}`)
+	require.NoError(t, err)
+	assert.True(t, roles.All)
+
+	_, err = parseSecondaryRoles(`ALL`)
+	require.Error(t, err)
+}
+
+func TestWithSessionContext(t *testing.T) {
+	ctx := context.Background()
+	contextColumns := []string{"ROLE", "SECONDARY_ROLES", "WAREHOUSE", "DATABASE", "SCHEMA"}
+
+	t.Run("switches and restores", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(`SELECT CURRENT_ROLE\(\)`).WillReturnRows(sqlmock.NewRows(contextColumns).AddRow("SYSADMIN", `{"roles":"","value":""}`, "WH", "DB", "PUBLIC"))
+		mock.ExpectExec(`USE ROLE "SECURITYADMIN"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`CREATE ROLE "R1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE ROLE "SYSADMIN"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE SECONDARY ROLES NONE`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE WAREHOUSE "WH"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`USE SCHEMA "DB"."PUBLIC"`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := client.WithSessionContext(ctx, &SessionContext{Role: NewAccountObjectIdentifier("SECURITYADMIN")}, func(c *Client) error {
+			return c.Roles.Create(ctx, NewAccountObjectIdentifier("R1"), nil)
+		})
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("does not restore an empty warehouse", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectQuery(`SELECT CURRENT_ROLE\(\)`).WillReturnRows(sqlmock.NewRows(contextColumns).AddRow("SYSADMIN", nil, nil, nil, nil))
+		mock.ExpectExec(`USE WAREHOUSE "WH"`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := client.WithSessionContext(ctx, &SessionContext{Warehouse: NewAccountObjectIdentifier("WH")}, func(c *Client) error {
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
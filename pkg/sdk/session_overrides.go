@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+type sessionParametersContextKey struct{}
+
+// ContextWithSessionParameters returns a context whose statements run with the given session parameters, e.g.
+// STATEMENT_TIMEOUT_IN_SECONDS for a single long-running statement. Parameters already set on ctx are kept unless
+// overridden. The parameters are set with ALTER SESSION on a dedicated connection, or in the transaction of the
+// client, before every statement and reset afterwards, which costs two or three additional round trips.
+func ContextWithSessionParameters(ctx context.Context, parameters map[SessionParameter]string) context.Context {
+	merged := make(map[SessionParameter]string)
+	for parameter, value := range sessionParametersFromContext(ctx) {
+		merged[parameter] = value
+	}
+	for parameter, value := range parameters {
+		merged[parameter] = value
+	}
+	return context.WithValue(ctx, sessionParametersContextKey{}, merged)
+}
+
+func sessionParametersFromContext(ctx context.Context) map[SessionParameter]string {
+	parameters, _ := ctx.Value(sessionParametersContextKey{}).(map[SessionParameter]string)
+	return parameters
+}
+
+// sessionOverrides returns the session parameters the statements of ctx run with, including the per-call query tag.
+func (c *Client) sessionOverrides(ctx context.Context) map[SessionParameter]string {
+	overrides := make(map[SessionParameter]string)
+	for parameter, value := range sessionParametersFromContext(ctx) {
+		overrides[parameter] = value
+	}
+	if tag, ok := queryTagFromContext(ctx); ok {
+		overrides[SessionParameterQueryTag] = combinedQueryTag(c.queryTag, tag)
+	}
+	return overrides
+}
+
+// sessionDefault returns the value a session parameter is reset to, which is the query tag of the client or a
+// parameter of its config. Parameters without one are unset.
+func (c *Client) sessionDefault(parameter SessionParameter) (string, bool) {
+	if parameter == SessionParameterQueryTag && c.queryTag != "" {
+		return c.queryTag, true
+	}
+	if c.config != nil {
+		for key, value := range c.config.Params {
+			if strings.EqualFold(key, string(parameter)) && value != nil {
+				return *value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// sessionOverrideStatements renders the statement setting the overrides and the statements resetting them.
+func (c *Client) sessionOverrideStatements(overrides map[SessionParameter]string) (string, []string, error) {
+	set := &SessionParameters{}
+	reset := &SessionParameters{}
+	unset := &SessionParametersUnset{}
+	var hasReset, hasUnset bool
+	for parameter, value := range overrides {
+		if err := setParameterField(set, string(parameter), value); err != nil {
+			return "", nil, err
+		}
+		if value, ok := c.sessionDefault(parameter); ok {
+			if err := setParameterField(reset, string(parameter), value); err != nil {
+				return "", nil, err
+			}
+			hasReset = true
+		} else if !unsetParameterField(unset, string(parameter)) {
+			return "", nil, fmt.Errorf("unsupported session parameter %s", parameter)
+		} else {
+			hasUnset = true
+		}
+	}
+	statements := []*AlterSessionOptions{{Set: &SessionSet{SessionParameters: set}}}
+	if hasReset {
+		statements = append(statements, &AlterSessionOptions{Set: &SessionSet{SessionParameters: reset}})
+	}
+	if hasUnset {
+		statements = append(statements, &AlterSessionOptions{Unset: &SessionUnset{SessionParametersUnset: unset}})
+	}
+	sqls := make([]string, len(statements))
+	for i, opts := range statements {
+		if err := opts.validate(); err != nil {
+			return "", nil, err
+		}
+		sql, err := c.structToSQL(opts)
+		if err != nil {
+			return "", nil, err
+		}
+		sqls[i] = sql
+	}
+	return sqls[0], sqls[1:], nil
+}
+
+// withExecutor calls fn with the executor of the client. When ctx has session parameter overrides, fn runs on a
+// dedicated connection, or the transaction or connection of the client, whose session parameters are set for the duration of
+// the call. A connection that could not be reset is discarded instead of being returned to the pool.
+func (c *Client) withExecutor(ctx context.Context, fn func(e executor) error) (err error) {
+	overrides := c.sessionOverrides(ctx)
+	if len(overrides) == 0 {
+		return fn(c.executor())
+	}
+	set, reset, err := c.sessionOverrideStatements(overrides)
+	if err != nil {
+		return err
+	}
+	var resetFailed bool
+	e := c.executor()
+	if c.tx == nil && c.conn == nil {
+		conn, err := c.db.Connx(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		e = conn
+		defer func() {
+			if resetFailed {
+				_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+			}
+		}()
+	}
+	if _, err := e.ExecContext(ctx, set); err != nil {
+		return err
+	}
+	defer func() {
+		// the session is reset even if ctx is done, so that the connection can be reused
+		resetCtx := context.WithValue(context.Background(), snowflakeAccountLocatorContextKey, c.accountLocator)
+		for _, statement := range reset {
+			if _, resetErr := e.ExecContext(resetCtx, statement); resetErr != nil {
+				resetFailed = true
+				if err == nil {
+					err = resetErr
+				}
+			}
+		}
+	}()
+	return fn(e)
+}
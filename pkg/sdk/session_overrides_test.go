@@ -0,0 +1,65 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionParameterOverrides(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("set and unset around the statement", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION SET STATEMENT_TIMEOUT_IN_SECONDS = 3600, TIMEZONE = 'UTC'`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DROP ROLE "ROLE1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION UNSET STATEMENT_TIMEOUT_IN_SECONDS, TIMEZONE`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ctx := ContextWithSessionParameters(ctx, map[SessionParameter]string{SessionParameterTimezone: "Europe/Berlin"})
+		ctx = ContextWithSessionParameters(ctx, map[SessionParameter]string{
+			SessionParameterTimezone:                  "UTC",
+			SessionParameterStatementTimeoutInSeconds: "3600",
+		})
+		require.NoError(t, client.Roles.Drop(ctx, NewAccountObjectIdentifier("ROLE1"), nil))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("reset to the config of the client", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		timezone := "America/New_York"
+		client.config = &gosnowflake.Config{Params: map[string]*string{"timezone": &timezone}}
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION SET TIMEZONE = 'UTC'`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DROP ROLE "ROLE1"`).WillReturnError(errors.New("role is in use"))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION SET TIMEZONE = 'America/New_York'`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ctx := ContextWithSessionParameters(ctx, map[SessionParameter]string{SessionParameterTimezone: "UTC"})
+		require.ErrorContains(t, client.Roles.Drop(ctx, NewAccountObjectIdentifier("ROLE1"), nil), "role is in use")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query tag overrides QUERY_TAG", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION SET QUERY_TAG = 'call'`)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DROP ROLE "ROLE1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`ALTER SESSION UNSET QUERY_TAG`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ctx := ContextWithSessionParameters(ctx, map[SessionParameter]string{SessionParameterQueryTag: "parameter"})
+		ctx = ContextWithQueryTag(ctx, "call")
+		require.NoError(t, client.Roles.Drop(ctx, NewAccountObjectIdentifier("ROLE1"), nil))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		ctx := ContextWithSessionParameters(ctx, map[SessionParameter]string{SessionParameterStatementTimeoutInSeconds: "forever"})
+		err := client.Roles.Drop(ctx, NewAccountObjectIdentifier("ROLE1"), nil)
+		assert.ErrorContains(t, err, "STATEMENT_TIMEOUT_IN_SECONDS must be an integer")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
 )
 
 type SessionPolicies interface {
@@ -75,7 +76,7 @@ func (v *sessionPolicies) Create(ctx context.Context, id SchemaObjectIdentifier,
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (v *sessionPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier, o
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -136,7 +137,7 @@ func (v *sessionPolicies) Show(ctx context.Context) ([]*SessionPolicy, error) {
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -165,8 +166,45 @@ func (v *sessionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifie
 	return nil, ErrObjectNotExistOrAuthorized
 }
 
-type SessionPolicyDetails struct{}
+type describeSessionPolicyOptions struct {
+	describe      bool                   `ddl:"static" sql:"DESCRIBE"`       //lint:ignore U1000 This is used in the ddl tag
+	sessionPolicy bool                   `ddl:"static" sql:"SESSION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	name          SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeSessionPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type SessionPolicyDetails struct {
+	Name                     string
+	SessionIdleTimeoutMins   int
+	SessionUIIdleTimeoutMins int
+	Comment                  string
+}
+
+type sessionPolicyDetailsRow struct {
+	Name                     string         `db:"name"`
+	SessionIdleTimeoutMins   int            `db:"session_idle_timeout_mins"`
+	SessionUIIdleTimeoutMins int            `db:"session_ui_idle_timeout_mins"`
+	Comment                  sql.NullString `db:"comment"`
+}
 
 func (v *sessionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicyDetails, error) {
-	return nil, nil
+	opts := &describeSessionPolicyOptions{
+		name: id,
+	}
+	dest, err := describeRow[sessionPolicyDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionPolicyDetails{
+		Name:                     dest.Name,
+		SessionIdleTimeoutMins:   dest.SessionIdleTimeoutMins,
+		SessionUIIdleTimeoutMins: dest.SessionUIIdleTimeoutMins,
+		Comment:                  dest.Comment.String,
+	}, nil
 }
@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// WithSecondaryRoles activates secondary roles in every session of the client, e.g. SecondaryRoles{All: true}, so
+// that objects granted to the other roles of the user are visible as well. NewClient applies them to every
+// connection it opens, clients created with NewClientFromDB ignore them, as the connections of the pool are opened
+// by the caller. See Sessions.UseSecondaryRoles for changing them later.
+func WithSecondaryRoles(roles SecondaryRoles) ClientOption {
+	return func(c *Client) {
+		c.secondaryRoles = &roles
+	}
+}
+
+// WithSessionInitStatements runs the statements on every connection the client opens, after the secondary roles
+// (see WithSecondaryRoles), e.g. USE WAREHOUSE or ALTER SESSION statements. A failing statement fails the
+// connection. Like WithSecondaryRoles, they are ignored by clients created with NewClientFromDB.
+func WithSessionInitStatements(statements ...string) ClientOption {
+	return func(c *Client) {
+		c.sessionInitStatements = append(c.sessionInitStatements, statements...)
+	}
+}
+
+// sessionSetupStatements returns the statements run on every new connection of the client.
+func (c *Client) sessionSetupStatements() []string {
+	var statements []string
+	if c.secondaryRoles != nil {
+		statements = append(statements, fmt.Sprintf(`USE SECONDARY ROLES %s`, c.secondaryRoles.String()))
+	}
+	for _, statement := range c.sessionInitStatements {
+		if strings.TrimSpace(statement) != "" {
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
+
+// sessionSetupConnector opens connections with the driver and runs the setup statements on each of them before
+// it is added to the pool.
+type sessionSetupConnector struct {
+	driver     driver.Driver
+	dsn        string
+	statements []string
+}
+
+var _ driver.Connector = (*sessionSetupConnector)(nil)
+
+func (c *sessionSetupConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.driver.Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+	execer, ok := conn.(driver.ExecerContext)
+	if !ok {
+		conn.Close()
+		return nil, errors.New("the driver does not support statements without prepare")
+	}
+	for _, statement := range c.statements {
+		if _, err := execer.ExecContext(ctx, statement, nil); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("session setup %s: %w", statement, decodeDriverError(err))
+		}
+	}
+	return conn, nil
+}
+
+func (c *sessionSetupConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// openWithSessionSetup opens a pool whose connections run the statements first.
+func openWithSessionSetup(driverName string, dsn string, statements []string) (*sql.DB, error) {
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	drv := db.Driver()
+	if err := db.Close(); err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(&sessionSetupConnector{driver: drv, dsn: dsn, statements: statements}), nil
+}
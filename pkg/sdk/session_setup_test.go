@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionSetup(t *testing.T) {
+	t.Run("secondary roles statement", func(t *testing.T) {
+		client := NewClientFromDB(nil, WithSecondaryRoles(SecondaryRoles{All: true}))
+		assert.Equal(t, []string{"USE SECONDARY ROLES ALL"}, client.sessionSetupStatements())
+		assert.Empty(t, NewClientFromDB(nil).sessionSetupStatements())
+	})
+
+	t.Run("init statements after secondary roles", func(t *testing.T) {
+		client := NewClientFromDB(nil,
+			WithSessionInitStatements("ALTER SESSION SET TIMEZONE = 'UTC'", " "),
+			WithSecondaryRoles(SecondaryRoles{}),
+			WithSessionInitStatements(`USE WAREHOUSE "WH"`),
+		)
+		assert.Equal(t, []string{"USE SECONDARY ROLES NONE", "ALTER SESSION SET TIMEZONE = 'UTC'", `USE WAREHOUSE "WH"`}, client.sessionSetupStatements())
+	})
+
+	t.Run("runs the statements on new connections", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.NewWithDSN("session_setup")
+		require.NoError(t, err)
+		t.Cleanup(func() { mockDB.Close() })
+		mock.ExpectExec(`USE SECONDARY ROLES "R1", "R2"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT CURRENT_SECONDARY_ROLES\(\)`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_SECONDARY_ROLES"}).AddRow(`{"roles":"R1,R2","value":""}`))
+
+		roles := SecondaryRoles{Roles: []AccountObjectIdentifier{NewAccountObjectIdentifier("R1"), NewAccountObjectIdentifier("R2")}}
+		client := NewClientFromDB(nil, WithSecondaryRoles(roles))
+		db, err := openWithSessionSetup("sqlmock", "session_setup", client.sessionSetupStatements())
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		current, err := NewClientFromDB(db).ContextFunctions.CurrentSecondaryRoles(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, roles, current)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 )
 
 type Sessions interface {
@@ -16,9 +17,15 @@ type Sessions interface {
 	ShowObjectParameter(ctx context.Context, parameter ObjectParameter, objectType ObjectType, objectID Identifier) (*Parameter, error)
 
 	// Context
+	UseRole(ctx context.Context, role AccountObjectIdentifier) error
+	UseSecondaryRoles(ctx context.Context, roles SecondaryRoles) error
 	UseWarehouse(ctx context.Context, warehouse AccountObjectIdentifier) error
 	UseDatabase(ctx context.Context, database AccountObjectIdentifier) error
 	UseSchema(ctx context.Context, schema SchemaIdentifier) error
+	// CurrentContext returns the role, secondary roles, warehouse, database and schema of the session.
+	CurrentContext(ctx context.Context) (*SessionContext, error)
+	// UseContext switches to the parts of sessionContext that are set.
+	UseContext(ctx context.Context, sessionContext *SessionContext) error
 }
 
 var _ Sessions = (*sessions)(nil)
@@ -80,7 +87,7 @@ func (v *sessions) AlterSession(ctx context.Context, opts *AlterSessionOptions)
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -116,12 +123,34 @@ type ParametersIn struct {
 }
 
 func (v *ParametersIn) validate() error {
-	if ok := anyValueSet(v.Session, v.Account, v.User, v.Warehouse, v.Database, v.Schema, v.Task, v.Table); !ok {
-		return fmt.Errorf("at least one IN parameter must be set")
+	if ok := exactlyOneValueSet(v.Session, v.Account, v.User, v.Warehouse, v.Database, v.Schema, v.Task, v.Table); !ok {
+		return fmt.Errorf("exactly one IN parameter must be set")
 	}
 	return nil
 }
 
+// parametersInObject scopes SHOW PARAMETERS to a single user, warehouse, database, schema, task or table.
+func parametersInObject(objectType ObjectType, objectID Identifier) (*ParametersIn, error) {
+	in := &ParametersIn{}
+	switch objectType {
+	case ObjectTypeUser:
+		in.User = objectID.(AccountObjectIdentifier)
+	case ObjectTypeWarehouse:
+		in.Warehouse = objectID.(AccountObjectIdentifier)
+	case ObjectTypeDatabase:
+		in.Database = objectID.(AccountObjectIdentifier)
+	case ObjectTypeSchema:
+		in.Schema = objectID.(SchemaIdentifier)
+	case ObjectTypeTask:
+		in.Task = objectID.(SchemaObjectIdentifier)
+	case ObjectTypeTable:
+		in.Table = objectID.(SchemaObjectIdentifier)
+	default:
+		return nil, fmt.Errorf("unsupported object type %s", objectType)
+	}
+	return in, nil
+}
+
 type ParameterType string
 
 const (
@@ -131,12 +160,39 @@ const (
 	ParameterTypeObject  ParameterType = "OBJECT"
 )
 
+type ParameterValueType string
+
+const (
+	ParameterValueTypeBoolean ParameterValueType = "BOOLEAN"
+	ParameterValueTypeNumber  ParameterValueType = "NUMBER"
+	ParameterValueTypeString  ParameterValueType = "STRING"
+)
+
 type Parameter struct {
-	Key         string
-	Value       string
-	Default     string
+	Key     string
+	Value   string
+	Default string
+	// Level is where the value was set, it is empty when the parameter has its default value.
 	Level       ParameterType
 	Description string
+	Type        ParameterValueType
+}
+
+// IsDefault reports whether the parameter was not overridden on any level.
+func (v *Parameter) IsDefault() bool {
+	return v.Level == ""
+}
+
+func (v *Parameter) BoolValue() (bool, error) {
+	return strconv.ParseBool(v.Value)
+}
+
+func (v *Parameter) IntValue() (int, error) {
+	return strconv.Atoi(v.Value)
+}
+
+func (v *Parameter) FloatValue() (float64, error) {
+	return strconv.ParseFloat(v.Value, 64)
 }
 
 type parameterRow struct {
@@ -145,6 +201,7 @@ type parameterRow struct {
 	Default     sql.NullString `db:"default"`
 	Level       sql.NullString `db:"level"`
 	Description sql.NullString `db:"description"`
+	Type        sql.NullString `db:"type"`
 }
 
 func (row *parameterRow) toParameter() *Parameter {
@@ -154,6 +211,7 @@ func (row *parameterRow) toParameter() *Parameter {
 		Default:     row.Default.String,
 		Level:       ParameterType(row.Level.String),
 		Description: row.Description.String,
+		Type:        ParameterValueType(row.Type.String),
 	}
 }
 
@@ -164,7 +222,7 @@ func (v *sessions) ShowParameters(ctx context.Context, opts *ShowParametersOptio
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -182,9 +240,7 @@ func (v *sessions) ShowParameters(ctx context.Context, opts *ShowParametersOptio
 
 func (v *sessions) ShowAccountParameter(ctx context.Context, parameter AccountParameter) (*Parameter, error) {
 	opts := &ShowParametersOptions{
-		Like: &Like{
-			Pattern: String(string(parameter)),
-		},
+		Like: likeExactly(string(parameter)),
 		In: &ParametersIn{
 			Account: Bool(true),
 		},
@@ -201,9 +257,7 @@ func (v *sessions) ShowAccountParameter(ctx context.Context, parameter AccountPa
 
 func (v *sessions) ShowSessionParameter(ctx context.Context, parameter SessionParameter) (*Parameter, error) {
 	opts := &ShowParametersOptions{
-		Like: &Like{
-			Pattern: String(string(parameter)),
-		},
+		Like: likeExactly(string(parameter)),
 		In: &ParametersIn{
 			Session: Bool(true),
 		},
@@ -220,9 +274,7 @@ func (v *sessions) ShowSessionParameter(ctx context.Context, parameter SessionPa
 
 func (v *sessions) ShowUserParameter(ctx context.Context, parameter UserParameter, user AccountObjectIdentifier) (*Parameter, error) {
 	opts := &ShowParametersOptions{
-		Like: &Like{
-			Pattern: String(string(parameter)),
-		},
+		Like: likeExactly(string(parameter)),
 		In: &ParametersIn{
 			User: user,
 		},
@@ -238,25 +290,13 @@ func (v *sessions) ShowUserParameter(ctx context.Context, parameter UserParamete
 }
 
 func (v *sessions) ShowObjectParameter(ctx context.Context, key ObjectParameter, objectType ObjectType, objectID Identifier) (*Parameter, error) {
-	opts := &ShowParametersOptions{
-		Like: &Like{
-			Pattern: String(string(key)),
-		},
-		In: &ParametersIn{},
+	in, err := parametersInObject(objectType, objectID)
+	if err != nil {
+		return nil, err
 	}
-	switch objectType {
-	case ObjectTypeWarehouse:
-		opts.In.Warehouse = objectID.(AccountObjectIdentifier)
-	case ObjectTypeDatabase:
-		opts.In.Database = objectID.(AccountObjectIdentifier)
-	case ObjectTypeSchema:
-		opts.In.Schema = objectID.(SchemaIdentifier)
-	case ObjectTypeTask:
-		opts.In.Task = objectID.(SchemaObjectIdentifier)
-	case ObjectTypeTable:
-		opts.In.Table = objectID.(SchemaObjectIdentifier)
-	default:
-		return nil, fmt.Errorf("unsupported object type %s", objectType)
+	opts := &ShowParametersOptions{
+		Like: likeExactly(string(key)),
+		In:   in,
 	}
 	parameters, err := v.ShowParameters(ctx, opts)
 	if err != nil {
@@ -269,6 +309,18 @@ func (v *sessions) ShowObjectParameter(ctx context.Context, key ObjectParameter,
 }
 
 // Context
+func (v *sessions) UseRole(ctx context.Context, role AccountObjectIdentifier) error {
+	sql := fmt.Sprintf(`USE ROLE %s`, v.client.IdentifierQuoting().FullyQualifiedName(role))
+	_, err := v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *sessions) UseSecondaryRoles(ctx context.Context, roles SecondaryRoles) error {
+	sql := fmt.Sprintf(`USE SECONDARY ROLES %s`, roles.String())
+	_, err := v.client.exec(ctx, sql)
+	return err
+}
+
 func (v *sessions) UseWarehouse(ctx context.Context, warehouse AccountObjectIdentifier) error {
 	sql := fmt.Sprintf(`USE WAREHOUSE %s`, warehouse.FullyQualifiedName())
 	_, err := v.client.exec(ctx, sql)
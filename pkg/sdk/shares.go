@@ -22,6 +22,8 @@ type Shares interface {
 	DescribeProvider(ctx context.Context, id AccountObjectIdentifier) (*ShareDetails, error)
 	// Describe returns the details of an inbound share.
 	DescribeConsumer(ctx context.Context, id ExternalObjectIdentifier) (*ShareDetails, error)
+	// CreateDatabaseFromShare creates a database from an inbound share.
+	CreateDatabaseFromShare(ctx context.Context, id AccountObjectIdentifier, shareID ExternalObjectIdentifier, opts *CreateSharedDatabaseOptions) error
 }
 
 var _ Shares = (*shares)(nil)
@@ -37,6 +39,11 @@ const (
 	ShareKindOutbound ShareKind = "OUTBOUND"
 )
 
+var allShareKinds = []ShareKind{
+	ShareKindInbound,
+	ShareKindOutbound,
+}
+
 type Share struct {
 	CreatedOn    time.Time
 	Kind         ShareKind
@@ -69,7 +76,11 @@ type shareRow struct {
 	Comment      string    `db:"comment"`
 }
 
-func (r *shareRow) toShare() *Share {
+func (r *shareRow) toShare(strict bool) (*Share, error) {
+	kind, err := toEnum(strict, "share kind", r.Kind, allShareKinds)
+	if err != nil {
+		return nil, err
+	}
 	toAccounts := strings.Split(r.To, ",")
 	var to []AccountIdentifier
 	if len(toAccounts) != 0 {
@@ -90,13 +101,13 @@ func (r *shareRow) toShare() *Share {
 	}
 	return &Share{
 		CreatedOn:    r.CreatedOn,
-		Kind:         ShareKind(r.Kind),
+		Kind:         kind,
 		Name:         NewExternalObjectIdentifierFromFullyQualifiedName(r.Name),
 		DatabaseName: NewAccountObjectIdentifier(r.DatabaseName),
 		To:           to,
 		Owner:        r.Owner,
 		Comment:      r.Comment,
-	}
+	}, nil
 }
 
 type CreateShareOptions struct {
@@ -122,7 +133,7 @@ func (v *shares) Create(ctx context.Context, id AccountObjectIdentifier, opts *C
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -131,8 +142,8 @@ func (v *shares) Create(ctx context.Context, id AccountObjectIdentifier, opts *C
 }
 
 type shareDropOptions struct {
-	drop  bool                    `ddl:"static" sql:"DROP"` //lint:ignore U1000 This is used in the ddl tag
-	share bool                    `ddl:"static" sql:"SHARE"`
+	drop  bool                    `ddl:"static" sql:"DROP"`  //lint:ignore U1000 This is used in the ddl tag
+	share bool                    `ddl:"static" sql:"SHARE"` //lint:ignore U1000 This is used in the ddl tag
 	name  AccountObjectIdentifier `ddl:"identifier"`
 }
 
@@ -140,7 +151,7 @@ func (v *shares) Drop(ctx context.Context, id AccountObjectIdentifier) error {
 	opts := &shareDropOptions{
 		name: id,
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -245,7 +256,7 @@ func (v *shares) Alter(ctx context.Context, id AccountObjectIdentifier, opts *Al
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := v.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -254,14 +265,19 @@ func (v *shares) Alter(ctx context.Context, id AccountObjectIdentifier, opts *Al
 }
 
 type ShowShareOptions struct {
-	show       bool       `ddl:"static" sql:"SHOW"`   //lint:ignore U1000 This is used in the ddl tag
-	shares     bool       `ddl:"static" sql:"SHARES"` //lint:ignore U1000 This is used in the ddl tag
-	Like       *Like      `ddl:"keyword" sql:"LIKE"`
-	StartsWith *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
-	Limit      *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+	show   bool  `ddl:"static" sql:"SHOW"`   //lint:ignore U1000 This is used in the ddl tag
+	shares bool  `ddl:"static" sql:"SHARES"` //lint:ignore U1000 This is used in the ddl tag
+	Like   *Like `ddl:"keyword" sql:"LIKE"`
+	ShowOptions
+	// Kind limits the result to inbound or outbound shares. SHOW SHARES always returns both,
+	// so the filter is applied to the returned rows.
+	Kind *ShareKind `ddl:"-"`
 }
 
 func (opts *ShowShareOptions) validate() error {
+	if valueSet(opts.Kind) && *opts.Kind != ShareKindInbound && *opts.Kind != ShareKindOutbound {
+		return fmt.Errorf("Kind must be one of %s, %s", ShareKindInbound, ShareKindOutbound)
+	}
 	return nil
 }
 
@@ -272,7 +288,7 @@ func (s *shares) Show(ctx context.Context, opts *ShowShareOptions) ([]*Share, er
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := s.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -283,16 +299,21 @@ func (s *shares) Show(ctx context.Context, opts *ShowShareOptions) ([]*Share, er
 	}
 	shares := make([]*Share, 0, len(rows))
 	for _, row := range rows {
-		shares = append(shares, row.toShare())
+		share, err := row.toShare(s.client.strictEnumParsing)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Kind != nil && share.Kind != *opts.Kind {
+			continue
+		}
+		shares = append(shares, share)
 	}
 	return shares, nil
 }
 
 func (s *shares) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Share, error) {
 	shares, err := s.Show(ctx, &ShowShareOptions{
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+		Like: likeExactly(id.Name()),
 	})
 	if err != nil {
 		return nil, err
@@ -357,12 +378,7 @@ func (c *shares) DescribeProvider(ctx context.Context, id AccountObjectIdentifie
 	opts := &shareDescribeOptions{
 		name: id,
 	}
-	sql, err := structToSQL(opts)
-	if err != nil {
-		return nil, err
-	}
-	var rows []shareDetailsRow
-	err = c.client.query(ctx, &rows, sql)
+	rows, err := describeRows[shareDetailsRow](ctx, c.client, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -373,14 +389,29 @@ func (c *shares) DescribeConsumer(ctx context.Context, id ExternalObjectIdentifi
 	opts := &shareDescribeOptions{
 		name: id,
 	}
-	sql, err := structToSQL(opts)
+	rows, err := describeRows[shareDetailsRow](ctx, c.client, opts)
 	if err != nil {
 		return nil, err
 	}
-	var rows []shareDetailsRow
-	err = c.client.query(ctx, &rows, sql)
+	return shareDetailsFromRows(rows), nil
+}
+
+// CreateDatabaseFromShare checks that the share is visible to the current account as an inbound share
+// before creating the database, so that a share that was not shared with the account, or was not yet
+// shared, is reported as ErrObjectNotExistOrAuthorized. shareID has to use the same account identifier
+// as SHOW SHARES, i.e. <organization>.<account>.<share>.
+func (s *shares) CreateDatabaseFromShare(ctx context.Context, id AccountObjectIdentifier, shareID ExternalObjectIdentifier, opts *CreateSharedDatabaseOptions) error {
+	inbound, err := s.Show(ctx, &ShowShareOptions{
+		Like: likeExactly(shareID.Name()),
+		Kind: Pointer(ShareKindInbound),
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return shareDetailsFromRows(rows), nil
+	for _, share := range inbound {
+		if share.Name.FullyQualifiedName() == shareID.FullyQualifiedName() {
+			return s.client.Databases.CreateShared(ctx, id, shareID, opts)
+		}
+	}
+	return fmt.Errorf("inbound share %s: %w", shareID.FullyQualifiedName(), ErrObjectNotExistOrAuthorized)
 }
@@ -48,8 +48,10 @@ func TestInt_SharesShow(t *testing.T) {
 
 	t.Run("when limiting the number of results", func(t *testing.T) {
 		showOptions := &ShowShareOptions{
-			Limit: &LimitFrom{
-				Rows: Int(1),
+			ShowOptions: ShowOptions{
+				Limit: &LimitFrom{
+					Rows: Int(1),
+				},
 			},
 		}
 		shares, err := client.Shares.Show(ctx, showOptions)
@@ -73,8 +75,10 @@ func TestInt_SharesCreate(t *testing.T) {
 			Like: &Like{
 				Pattern: String(id.Name()),
 			},
-			Limit: &LimitFrom{
-				Rows: Int(1),
+			ShowOptions: ShowOptions{
+				Limit: &LimitFrom{
+					Rows: Int(1),
+				},
 			},
 		})
 		require.NoError(t, err)
@@ -408,3 +412,63 @@ func TestInt_ShareDescribeConsumer(t *testing.T) {
 		})
 	})
 }
+
+func TestInt_SharesCreateDatabaseFromShare(t *testing.T) {
+	consumerClient := testSecondaryClient(t)
+	ctx := context.Background()
+	providerClient := testClient(t)
+
+	shareTest, shareCleanup := createShare(t, providerClient)
+	t.Cleanup(shareCleanup)
+
+	databaseTest, databaseCleanup := createDatabase(t, providerClient)
+	t.Cleanup(databaseCleanup)
+
+	err := providerClient.Grants.GrantPrivilegeToShare(ctx, PrivilegeUsage, &GrantPrivilegeToShareOn{
+		Database: databaseTest.ID(),
+	}, shareTest.ID())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err = providerClient.Grants.RevokePrivilegeFromShare(ctx, PrivilegeUsage, &RevokePrivilegeFromShareOn{
+			Database: databaseTest.ID(),
+		}, shareTest.ID())
+		require.NoError(t, err)
+	})
+
+	t.Run("share not shared with the account", func(t *testing.T) {
+		err := consumerClient.Shares.CreateDatabaseFromShare(ctx, randomAccountObjectIdentifier(t), shareTest.ExternalID(), nil)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+
+	err = providerClient.Shares.Alter(ctx, shareTest.ID(), &AlterShareOptions{
+		Add: &ShareAdd{
+			Accounts: []AccountIdentifier{
+				getAccountIdentifier(t, consumerClient),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("show inbound shares", func(t *testing.T) {
+		shares, err := consumerClient.Shares.Show(ctx, &ShowShareOptions{
+			Like: &Like{Pattern: String(shareTest.Name.Name())},
+			Kind: Pointer(ShareKindInbound),
+		})
+		require.NoError(t, err)
+		require.Len(t, shares, 1)
+		assert.Equal(t, ShareKindInbound, shares[0].Kind)
+	})
+
+	t.Run("create database", func(t *testing.T) {
+		id := randomAccountObjectIdentifier(t)
+		err := consumerClient.Shares.CreateDatabaseFromShare(ctx, id, shareTest.ExternalID(), nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := consumerClient.Databases.Drop(ctx, id, nil)
+			require.NoError(t, err)
+		})
+		database, err := consumerClient.Databases.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "IMPORTED DATABASE", database.Kind)
+	})
+}
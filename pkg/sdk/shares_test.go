@@ -147,10 +147,12 @@ func TestShareShow(t *testing.T) {
 			Like: &Like{
 				Pattern: String("myshare"),
 			},
-			StartsWith: String("my"),
-			Limit: &LimitFrom{
-				Rows: Int(10),
-				From: String("my_other_share"),
+			ShowOptions: ShowOptions{
+				StartsWith: String("my"),
+				Limit: &LimitFrom{
+					Rows: Int(10),
+					From: String("my_other_share"),
+				},
 			},
 		}
 		actual, err := structToSQL(opts)
@@ -160,6 +162,25 @@ func TestShareShow(t *testing.T) {
 	})
 }
 
+func TestShareShowKind(t *testing.T) {
+	t.Run("kind is not rendered", func(t *testing.T) {
+		opts := &ShowShareOptions{
+			Kind: Pointer(ShareKindInbound),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW SHARES`, actual)
+	})
+
+	t.Run("validation: unknown kind", func(t *testing.T) {
+		opts := &ShowShareOptions{
+			Kind: Pointer(ShareKind("SIDEWAYS")),
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
 func TestShareDrop(t *testing.T) {
 	t.Run("only name", func(t *testing.T) {
 		opts := &shareDropOptions{
@@ -16,8 +16,15 @@ const (
 	commaModifierType   modifierType = "comma"
 	reverseModifierType modifierType = "reverse"
 	equalsModifierType  modifierType = "equals"
+
+	// element modifiers apply to every element of a slice of structs, e.g. element_parentheses
+	// wraps each element in its own parentheses and element_comma separates its clauses with commas.
+	elementParenModifierType modifierType = "element_paren"
+	elementCommaModifierType modifierType = "element_comma"
 )
 
+const elementModifierPrefix = "element_"
+
 type modifier interface {
 	Modify(v any) string
 }
@@ -47,6 +54,8 @@ const (
 	NoQuotes     quoteModifier = "no_quotes"
 	DoubleQuotes quoteModifier = "double_quotes"
 	SingleQuotes quoteModifier = "single_quotes"
+	// DollarQuotes is used for bodies that may contain quotes themselves, e.g. inline YAML specifications.
+	DollarQuotes quoteModifier = "dollar_quotes"
 )
 
 func (qm quoteModifier) Modify(v any) string {
@@ -62,11 +71,21 @@ func (qm quoteModifier) Modify(v any) string {
 		// replace all single quotes with \'
 		escapedString := strings.ReplaceAll(s, qm.String(), `\'`)
 		return fmt.Sprintf(`%v%v%v`, qm.String(), escapedString, qm.String())
+	case DollarQuotes:
+		return fmt.Sprintf(`%v%v%v`, qm.String(), s, qm.String())
 	default:
 		return s
 	}
 }
 
+// escapeStringLiteral escapes s for interpolation into a single-quoted string constant, e.g. an object name
+// passed to a table function. Backslashes are escaped too, so a trailing backslash cannot end the constant.
+func escapeStringLiteral(s string) string {
+	return stringLiteralReplacer.Replace(s)
+}
+
+var stringLiteralReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
 func (qm quoteModifier) String() string {
 	switch qm {
 	case NoQuotes:
@@ -75,6 +94,8 @@ func (qm quoteModifier) String() string {
 		return `"`
 	case SingleQuotes:
 		return `'`
+	case DollarQuotes:
+		return `$$`
 	default:
 		return ""
 	}
@@ -142,15 +163,19 @@ func (em equalsModifier) Modify(v any) string {
 	return strings.TrimLeft(fmt.Sprintf("%v ", v), " ")
 }
 
-func (b *sqlBuilder) getModifier(tag reflect.StructTag, tagName string, modType modifierType, defaultMod modifier) modifier {
+func (b sqlBuilder) getModifier(tag reflect.StructTag, tagName string, modType modifierType, defaultMod modifier) modifier {
 	tagValue := strings.ToLower(tag.Get(tagName))
 	if tagValue == "" {
 		return defaultMod
 	}
 	parts := strings.Split(tagValue, ",")
 	for _, part := range parts {
+		trimmedS := strings.TrimSpace(part)
+		// element modifiers contain the names of the regular ones, e.g. element_parentheses is no paren modifier
+		if strings.HasPrefix(trimmedS, elementModifierPrefix) != strings.HasPrefix(string(modType), elementModifierPrefix) {
+			continue
+		}
 		if strings.Contains(part, string(modType)) {
-			trimmedS := strings.TrimSpace(part)
 			switch modType {
 			case quoteModifierType:
 				return quoteModifier(trimmedS)
@@ -162,26 +187,35 @@ func (b *sqlBuilder) getModifier(tag reflect.StructTag, tagName string, modType
 				return reverseModifier(trimmedS)
 			case commaModifierType:
 				return commaModifier(trimmedS)
+			case elementParenModifierType:
+				return parenModifier(strings.TrimPrefix(trimmedS, elementModifierPrefix))
+			case elementCommaModifierType:
+				return commaModifier(strings.TrimPrefix(trimmedS, elementModifierPrefix))
 			}
 		}
 	}
 	return defaultMod
 }
 
+// structToSQL renders v with the default identifier quoting, see Client.structToSQL.
 func structToSQL(v interface{}) (string, error) {
-	clauses, err := builder.parseStruct(v)
+	return builder.structToSQL(v)
+}
+
+var builder = sqlBuilder{quoting: IdentifierQuotingAlways}
+
+type sqlBuilder struct {
+	quoting IdentifierQuoting
+}
+
+func (b sqlBuilder) structToSQL(v interface{}) (string, error) {
+	clauses, err := b.parseStruct(v)
 	if err != nil {
 		return "", err
 	}
-	return builder.sql(clauses...), nil
+	return b.sql(clauses...), nil
 }
 
-const (
-	builder sqlBuilder = "builder"
-)
-
-type sqlBuilder string
-
 func (b sqlBuilder) renderStaticClause(clauses ...sqlClause) sqlClause {
 	return sqlStaticClause(b.sql(clauses...))
 }
@@ -223,9 +257,10 @@ func (b sqlBuilder) parseInterface(v interface{}, tag reflect.StructTag) (sqlCla
 		}, nil
 	case "identifier":
 		return sqlIdentifierClause{
-			key:   sqlTag,
-			value: v.(Identifier),
-			em:    b.getModifier(tag, "ddl", equalsModifierType, NoEquals).(equalsModifier),
+			key:     sqlTag,
+			value:   v.(Identifier),
+			em:      b.getModifier(tag, "ddl", equalsModifierType, NoEquals).(equalsModifier),
+			quoting: b.quoting,
 		}, nil
 	}
 	return nil, nil
@@ -309,9 +344,10 @@ func (b sqlBuilder) parseFieldStruct(field reflect.StructField, value reflect.Va
 					return nil, nil
 				}
 				return sqlIdentifierClause{
-					key:   sqlTag,
-					value: reflectedValue.(Identifier),
-					em:    b.getModifier(field.Tag, "ddl", equalsModifierType, NoEquals).(equalsModifier),
+					key:     sqlTag,
+					value:   reflectedValue.(Identifier),
+					em:      b.getModifier(field.Tag, "ddl", equalsModifierType, NoEquals).(equalsModifier),
+					quoting: b.quoting,
 				}, nil
 			}
 		case "list":
@@ -363,8 +399,9 @@ func (b sqlBuilder) parseFieldSlice(field reflect.StructField, value reflect.Val
 		identifier, ok := reflectedValue.(Identifier)
 		if ok {
 			listClauses = append(listClauses, sqlIdentifierClause{
-				value: identifier,
-				em:    b.getModifier(field.Tag, "ddl", equalsModifierType, NoEquals).(equalsModifier),
+				value:   identifier,
+				em:      b.getModifier(field.Tag, "ddl", equalsModifierType, NoEquals).(equalsModifier),
+				quoting: b.quoting,
 			})
 			continue
 		}
@@ -393,7 +430,11 @@ func (b sqlBuilder) parseFieldSlice(field reflect.StructField, value reflect.Val
 				}
 			}
 			// each element of the slice needs to be pre-rendered before the commas are added.
-			sClause := b.renderStaticClause(structClauses...)
+			sClause := b.renderStaticClause(sqlListClause{
+				clauses: structClauses,
+				cm:      b.getModifier(field.Tag, "ddl", elementCommaModifierType, NoComma).(commaModifier),
+				pm:      b.getModifier(field.Tag, "ddl", elementParenModifierType, NoParentheses).(parenModifier),
+			})
 			listClauses = append(listClauses, sClause)
 		} else {
 			// if it is not a struct, then it is a primitive type and can be added directly.
@@ -487,9 +528,10 @@ func (b sqlBuilder) parseField(field reflect.StructField, value reflect.Value) (
 		}
 	case "identifier":
 		clause = sqlIdentifierClause{
-			key:   sqlTag,
-			value: reflectedValue.(Identifier),
-			em:    b.getModifier(field.Tag, "ddl", equalsModifierType, NoEquals).(equalsModifier),
+			key:     sqlTag,
+			value:   reflectedValue.(Identifier),
+			em:      b.getModifier(field.Tag, "ddl", equalsModifierType, NoEquals).(equalsModifier),
+			quoting: b.quoting,
 		}
 	case "parameter":
 		if _, ok := reflectedValue.(ObjectType); ok {
@@ -558,18 +600,19 @@ func (v sqlKeywordClause) String() string {
 }
 
 type sqlIdentifierClause struct {
-	key   string
-	value Identifier
-	em    equalsModifier
+	key     string
+	value   Identifier
+	em      equalsModifier
+	quoting IdentifierQuoting
 }
 
 func (v sqlIdentifierClause) String() string {
 	var name string
 	// object identifiers need to be fully qualified
 	if _, ok := v.value.(ObjectIdentifier); ok {
-		name = v.value.(ObjectIdentifier).FullyQualifiedName()
+		name = v.quoting.FullyQualifiedName(v.value.(ObjectIdentifier))
 	} else {
-		name = DoubleQuotes.Modify(v.value.Name())
+		name = v.quoting.Name(v.value.Name())
 	}
 	// else try to get the string value
 	if v.key != "" {
@@ -341,12 +341,24 @@ func TestQuoteModifier(t *testing.T) {
 		assert.Equal(t, `'example'`, result)
 	})
 
+	t.Run("test dollar quotes modifier", func(t *testing.T) {
+		result := DollarQuotes.Modify("it's an example")
+		assert.Equal(t, `$$it's an example$$`, result)
+	})
+
 	t.Run("test unknown modifier", func(t *testing.T) {
 		result := quoteModifier("unknown").Modify("example")
 		assert.Equal(t, `example`, result)
 	})
 }
 
+func TestEscapeStringLiteral(t *testing.T) {
+	assert.Equal(t, `example`, escapeStringLiteral("example"))
+	assert.Equal(t, `it\'s`, escapeStringLiteral("it's"))
+	assert.Equal(t, `C:\\dir\\`, escapeStringLiteral(`C:\dir\`))
+	assert.Equal(t, `\\\'`, escapeStringLiteral(`\'`))
+}
+
 type structTestHelper struct {
 	static bool                    `ddl:"static" sql:"EXAMPLE_STATIC"`
 	name   AccountObjectIdentifier `ddl:"identifier"`
@@ -418,6 +430,39 @@ func TestBuilder_parseStruct(t *testing.T) {
 		assert.Equal(t, "KEY = 'abc', KEY = '123'", clauses[0].String())
 	})
 
+	t.Run("struct with a slice field using element_parentheses", func(t *testing.T) {
+		type testListElement struct {
+			K  *string `ddl:"parameter,single_quotes" sql:"KEY"`
+			K2 *string `ddl:"parameter,single_quotes" sql:"KEY2"`
+		}
+		s := &struct {
+			List []testListElement `ddl:"parameter,element_parentheses,parentheses" sql:"LOCATIONS"`
+		}{
+			List: []testListElement{{K: String("abc"), K2: String("def")}, {K: String("123")}},
+		}
+		clauses, err := builder.parseStruct(s)
+		require.NoError(t, err)
+		assert.Len(t, clauses, 1)
+		assert.Equal(t, "LOCATIONS = ((KEY = 'abc' KEY2 = 'def'), (KEY = '123'))", clauses[0].String())
+	})
+
+	t.Run("struct with a slice field using element_parentheses and element_comma", func(t *testing.T) {
+		type testListElement struct {
+			Name     string  `ddl:"keyword,double_quotes"`
+			DataType string  `ddl:"keyword"`
+			Comment  *string `ddl:"parameter,single_quotes,no_equals" sql:"COMMENT"`
+		}
+		s := &struct {
+			List []testListElement `ddl:"keyword,no_comma,element_parentheses,element_comma" sql:"COLUMNS"`
+		}{
+			List: []testListElement{{Name: "a", DataType: "NUMBER", Comment: String("first")}, {Name: "b", DataType: "VARCHAR"}},
+		}
+		clauses, err := builder.parseStruct(s)
+		require.NoError(t, err)
+		assert.Len(t, clauses, 1)
+		assert.Equal(t, `COLUMNS ("a", NUMBER, COMMENT 'first') ("b", VARCHAR)`, clauses[0].String())
+	})
+
 	t.Run("struct with a struct list using ddl: list", func(t *testing.T) {
 		type testListElement struct {
 			A bool `ddl:"static" sql:"A"`
@@ -0,0 +1,435 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// Compile-time proof of interface implementation.
+var _ Stages = (*stages)(nil)
+
+// Stages describes all the stage related methods that the Snowflake API supports.
+type Stages interface {
+	// Create creates an internal stage.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateStageOptions) error
+	// Alter modifies an existing stage.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterStageOptions) error
+	// CreateClone creates a stage as a clone of opts.Clone.SourceObject. Stages can only be cloned in their current state.
+	CreateClone(ctx context.Context, id SchemaObjectIdentifier, opts *CreateStageCloneOptions) error
+	// Put uploads a local file to a stage location.
+	Put(ctx context.Context, localPath string, location StageLocation, opts *PutStageFileOptions) error
+	// PutStream uploads the content of reader to a stage location as a file named fileName.
+	PutStream(ctx context.Context, reader io.Reader, fileName string, location StageLocation, opts *PutStageFileOptions) error
+	// Get downloads the single file at a stage location and writes its content to w.
+	Get(ctx context.Context, location StageLocation, w io.Writer) error
+	// List returns the files at a stage location.
+	List(ctx context.Context, location StageLocation, opts *ListStageFilesOptions) ([]*StageFile, error)
+	// Remove removes the files at a stage location.
+	Remove(ctx context.Context, location StageLocation, opts *RemoveStageFilesOptions) error
+	// Directory returns the files registered in the directory table of a stage.
+	Directory(ctx context.Context, id SchemaObjectIdentifier) ([]*DirectoryTableFile, error)
+}
+
+// stages implements Stages.
+type stages struct {
+	client *Client
+}
+
+// StageDirectory configures the directory table of a stage.
+type StageDirectory struct {
+	Enable          bool  `ddl:"parameter" sql:"ENABLE"`
+	RefreshOnCreate *bool `ddl:"parameter" sql:"REFRESH_ON_CREATE"`
+	AutoRefresh     *bool `ddl:"parameter" sql:"AUTO_REFRESH"`
+}
+
+// CreateStageOptions contains options for creating an internal stage.
+type CreateStageOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	Temporary   *bool                  `ddl:"keyword" sql:"TEMPORARY"`
+	stage       bool                   `ddl:"static" sql:"STAGE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	Directory   *StageDirectory        `ddl:"list,parentheses,no_comma" sql:"DIRECTORY ="`
+	Comment     *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateStageOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	return nil
+}
+
+func (v *stages) Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateStageOptions) error {
+	if opts == nil {
+		opts = &CreateStageOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterStageOptions contains options for altering a stage. Exactly one action can be set.
+type AlterStageOptions struct {
+	alter        bool                   `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	stage        bool                   `ddl:"static" sql:"STAGE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+	SetDirectory *StageDirectorySet     `ddl:"list,parentheses,no_comma" sql:"SET DIRECTORY ="`
+	Refresh      *StageRefresh          `ddl:"keyword" sql:"REFRESH"`
+}
+
+type StageDirectorySet struct {
+	Enable bool `ddl:"parameter" sql:"ENABLE"`
+}
+
+// StageRefresh synchronizes the directory table with the files in the stage, optionally only below Subpath.
+type StageRefresh struct {
+	Subpath *string `ddl:"parameter,single_quotes" sql:"SUBPATH"`
+}
+
+func (opts *AlterStageOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.SetDirectory, opts.Refresh) {
+		return errors.New("exactly one of SET DIRECTORY or REFRESH must be set")
+	}
+	return nil
+}
+
+func (v *stages) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterStageOptions) error {
+	if opts == nil {
+		opts = &AlterStageOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type CreateStageCloneOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	stage       bool                   `ddl:"static" sql:"STAGE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	Clone       *Clone                 `ddl:"-"`
+}
+
+func (opts *CreateStageCloneOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if !valueSet(opts.Clone) {
+		return errors.New("Clone must be set")
+	}
+	return opts.Clone.validateWithoutTimeTravel(ObjectTypeStage)
+}
+
+func (v *stages) CreateClone(ctx context.Context, id SchemaObjectIdentifier, opts *CreateStageCloneOptions) error {
+	if opts == nil {
+		opts = &CreateStageCloneOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// StageLocation is a stage and an optional path in it, e.g. @"db"."schema"."stage"/path/to/file.py.
+type StageLocation struct {
+	Stage SchemaObjectIdentifier
+	Path  string
+}
+
+func (v StageLocation) String() string {
+	location := "@" + v.Stage.FullyQualifiedName()
+	if path := strings.TrimPrefix(v.Path, "/"); path != "" {
+		location += "/" + path
+	}
+	return location
+}
+
+// escaped escapes the location for rendering in single quotes, so that paths can contain spaces and special characters.
+func (v StageLocation) escaped() string {
+	return escapeStringLiteral(v.String())
+}
+
+func fileURI(path string) string {
+	uri := filepath.ToSlash(path)
+	if !strings.HasPrefix(uri, "/") {
+		uri = "/" + uri
+	}
+	return escapeStringLiteral("file://" + uri)
+}
+
+type PutStageFileOptions struct {
+	put          bool   `ddl:"static" sql:"PUT"` //lint:ignore U1000 This is used in the ddl tag
+	source       string `ddl:"keyword,single_quotes"`
+	location     string `ddl:"keyword,single_quotes"`
+	Parallel     *int   `ddl:"parameter" sql:"PARALLEL"`
+	AutoCompress *bool  `ddl:"parameter" sql:"AUTO_COMPRESS"`
+	Overwrite    *bool  `ddl:"parameter" sql:"OVERWRITE"`
+}
+
+func (opts *PutStageFileOptions) validate() error {
+	if opts.source == "" {
+		return errors.New("source file must be set")
+	}
+	if opts.Parallel != nil && !validateIntInRange(*opts.Parallel, 1, 99) {
+		return errors.New("PARALLEL must be between 1 and 99")
+	}
+	return nil
+}
+
+func (v *stages) put(ctx context.Context, source string, location StageLocation, opts *PutStageFileOptions) error {
+	if !validObjectidentifier(location.Stage) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts == nil {
+		opts = &PutStageFileOptions{}
+	}
+	opts.source = source
+	opts.location = location.escaped()
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *stages) Put(ctx context.Context, localPath string, location StageLocation, opts *PutStageFileOptions) error {
+	if localPath == "" {
+		return errors.New("local path must be set")
+	}
+	absolutePath, err := filepath.Abs(localPath)
+	if err != nil {
+		return err
+	}
+	return v.put(ctx, fileURI(absolutePath), location, opts)
+}
+
+func (v *stages) PutStream(ctx context.Context, reader io.Reader, fileName string, location StageLocation, opts *PutStageFileOptions) error {
+	if reader == nil {
+		return errors.New("reader must be set")
+	}
+	if fileName == "" || strings.ContainsAny(fileName, `/\`) {
+		return fmt.Errorf("invalid file name %q", fileName)
+	}
+	// The driver uploads the stream instead of the local file, the file name only names the staged file.
+	return v.put(gosnowflake.WithFileStream(ctx, reader), fileURI(fileName), location, opts)
+}
+
+type getStageFileOptions struct {
+	get      bool   `ddl:"static" sql:"GET"` //lint:ignore U1000 This is used in the ddl tag
+	location string `ddl:"keyword,single_quotes"`
+	target   string `ddl:"keyword,single_quotes"`
+}
+
+func (v *stages) Get(ctx context.Context, location StageLocation, w io.Writer) error {
+	if !validObjectidentifier(location.Stage) {
+		return ErrInvalidObjectIdentifier
+	}
+	if w == nil {
+		return errors.New("writer must be set")
+	}
+	// Downloads are not streamed by the driver, so the file is downloaded to a temporary directory first.
+	dir, err := os.MkdirTemp("", "snowflake-stage-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	opts := &getStageFileOptions{
+		location: location.escaped(),
+		target:   fileURI(dir) + "/",
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	if _, err := v.client.exec(ctx, sql); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if len(entries) != 1 {
+		return fmt.Errorf("expected one file at %s, found %d", location, len(entries))
+	}
+	f, err := os.Open(filepath.Join(dir, entries[0].Name()))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+type ListStageFilesOptions struct {
+	list     bool    `ddl:"static" sql:"LIST"` //lint:ignore U1000 This is used in the ddl tag
+	location string  `ddl:"keyword,single_quotes"`
+	Pattern  *string `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+type StageFile struct {
+	Name         string
+	Size         int
+	MD5          string
+	LastModified time.Time
+}
+
+type stageFileRow struct {
+	Name         string         `db:"name"`
+	Size         int            `db:"size"`
+	MD5          sql.NullString `db:"md5"`
+	LastModified sql.NullString `db:"last_modified"`
+}
+
+func (row stageFileRow) toStageFile() *StageFile {
+	stageFile := &StageFile{
+		Name: row.Name,
+		Size: row.Size,
+		MD5:  row.MD5.String,
+	}
+	// last_modified is returned like Tue, 7 May 2024 10:00:00 GMT.
+	if lastModified, err := time.Parse("Mon, 2 Jan 2006 15:04:05 MST", row.LastModified.String); err == nil {
+		stageFile.LastModified = lastModified
+	}
+	return stageFile
+}
+
+func (v *stages) List(ctx context.Context, location StageLocation, opts *ListStageFilesOptions) ([]*StageFile, error) {
+	if !validObjectidentifier(location.Stage) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	if opts == nil {
+		opts = &ListStageFilesOptions{}
+	}
+	opts.location = location.escaped()
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []stageFileRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*StageFile, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toStageFile()
+	}
+	return resultList, nil
+}
+
+type RemoveStageFilesOptions struct {
+	remove   bool    `ddl:"static" sql:"REMOVE"` //lint:ignore U1000 This is used in the ddl tag
+	location string  `ddl:"keyword,single_quotes"`
+	Pattern  *string `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (v *stages) Remove(ctx context.Context, location StageLocation, opts *RemoveStageFilesOptions) error {
+	if !validObjectidentifier(location.Stage) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts == nil {
+		opts = &RemoveStageFilesOptions{}
+	}
+	opts.location = location.escaped()
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DirectoryTableFile struct {
+	RelativePath string
+	Size         int
+	LastModified time.Time
+	MD5          string
+	ETag         string
+	FileURL      string
+}
+
+type directoryTableFileRow struct {
+	RelativePath string         `db:"RELATIVE_PATH"`
+	Size         int            `db:"SIZE"`
+	LastModified time.Time      `db:"LAST_MODIFIED"`
+	MD5          sql.NullString `db:"MD5"`
+	ETag         sql.NullString `db:"ETAG"`
+	FileURL      sql.NullString `db:"FILE_URL"`
+}
+
+func (row directoryTableFileRow) toDirectoryTableFile() *DirectoryTableFile {
+	return &DirectoryTableFile{
+		RelativePath: row.RelativePath,
+		Size:         row.Size,
+		LastModified: row.LastModified,
+		MD5:          row.MD5.String,
+		ETag:         row.ETag.String,
+		FileURL:      row.FileURL.String,
+	}
+}
+
+func directorySQL(id SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`SELECT * FROM DIRECTORY(%s) ORDER BY RELATIVE_PATH`, StageLocation{Stage: id})
+}
+
+func (v *stages) Directory(ctx context.Context, id SchemaObjectIdentifier) ([]*DirectoryTableFile, error) {
+	if !validObjectidentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	dest := []directoryTableFileRow{}
+	err := v.client.query(ctx, &dest, directorySQL(id))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*DirectoryTableFile, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toDirectoryTableFile()
+	}
+	return resultList, nil
+}
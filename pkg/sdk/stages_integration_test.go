@@ -0,0 +1,137 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_StagesCreateClone(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	sourceID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE STAGE %s", sourceID.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP STAGE %s", sourceID.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	err = client.Stages.CreateClone(ctx, id, &CreateStageCloneOptions{
+		Clone: &Clone{SourceObject: sourceID},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP STAGE %s", id.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+}
+
+func TestInt_StagesFiles(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	stageID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE STAGE %s", stageID.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP STAGE %s", stageID.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	location := StageLocation{Stage: stageID, Path: "udfs"}
+	content := "def handler():\n    return 1\n"
+
+	t.Run("put stream and get", func(t *testing.T) {
+		err := client.Stages.PutStream(ctx, strings.NewReader(content), "handler.py", location, &PutStageFileOptions{
+			AutoCompress: Bool(false),
+			Overwrite:    Bool(true),
+		})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = client.Stages.Get(ctx, StageLocation{Stage: stageID, Path: "udfs/handler.py"}, &buf)
+		require.NoError(t, err)
+		assert.Equal(t, content, buf.String())
+	})
+
+	t.Run("put file and list", func(t *testing.T) {
+		localPath := filepath.Join(t.TempDir(), "other.py")
+		require.NoError(t, os.WriteFile(localPath, []byte(content), 0o600))
+		err := client.Stages.Put(ctx, localPath, location, &PutStageFileOptions{AutoCompress: Bool(false)})
+		require.NoError(t, err)
+
+		files, err := client.Stages.List(ctx, location, &ListStageFilesOptions{Pattern: String(`.*other\\.py`)})
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.True(t, strings.HasSuffix(files[0].Name, "udfs/other.py"))
+		assert.Equal(t, len(content), files[0].Size)
+		assert.False(t, files[0].LastModified.IsZero())
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := client.Stages.Remove(ctx, location, nil)
+		require.NoError(t, err)
+		files, err := client.Stages.List(ctx, location, nil)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+}
+
+func TestInt_StagesDirectoryTable(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	stageID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	err := client.Stages.Create(ctx, stageID, &CreateStageOptions{
+		Directory: &StageDirectory{Enable: true},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP STAGE %s", stageID.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	err = client.Stages.PutStream(ctx, strings.NewReader("a,b\n"), "data.csv", StageLocation{Stage: stageID, Path: "raw"}, &PutStageFileOptions{AutoCompress: Bool(false)})
+	require.NoError(t, err)
+
+	files, err := client.Stages.Directory(ctx, stageID)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	err = client.Stages.Alter(ctx, stageID, &AlterStageOptions{Refresh: &StageRefresh{}})
+	require.NoError(t, err)
+
+	files, err = client.Stages.Directory(ctx, stageID)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "raw/data.csv", files[0].RelativePath)
+	assert.Equal(t, 4, files[0].Size)
+
+	err = client.Stages.Alter(ctx, stageID, &AlterStageOptions{SetDirectory: &StageDirectorySet{Enable: false}})
+	require.NoError(t, err)
+}
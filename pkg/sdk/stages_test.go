@@ -0,0 +1,233 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStagesCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreateStageOptions{
+			name: id,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE STAGE "db"."schema"."stage"`, actual)
+	})
+
+	t.Run("with directory table", func(t *testing.T) {
+		opts := &CreateStageOptions{
+			OrReplace: Bool(true),
+			Temporary: Bool(true),
+			name:      id,
+			Directory: &StageDirectory{
+				Enable:          true,
+				RefreshOnCreate: Bool(false),
+			},
+			Comment: String("artifacts"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE TEMPORARY STAGE "db"."schema"."stage" DIRECTORY = (ENABLE = true REFRESH_ON_CREATE = false) COMMENT = 'artifacts'`, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateStageOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestStagesAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	t.Run("set directory", func(t *testing.T) {
+		opts := &AlterStageOptions{
+			name:         id,
+			SetDirectory: &StageDirectorySet{Enable: true},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER STAGE "db"."schema"."stage" SET DIRECTORY = (ENABLE = true)`, actual)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := &AlterStageOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Refresh:  &StageRefresh{},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER STAGE IF EXISTS "db"."schema"."stage" REFRESH`, actual)
+	})
+
+	t.Run("refresh subpath", func(t *testing.T) {
+		opts := &AlterStageOptions{
+			name:    id,
+			Refresh: &StageRefresh{Subpath: String("udfs/")},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER STAGE "db"."schema"."stage" REFRESH SUBPATH = 'udfs/'`, actual)
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterStageOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: more than one action", func(t *testing.T) {
+		opts := &AlterStageOptions{
+			name:         id,
+			SetDirectory: &StageDirectorySet{Enable: true},
+			Refresh:      &StageRefresh{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestStagesDirectorySQL(t *testing.T) {
+	actual := directorySQL(NewSchemaObjectIdentifier("db", "schema", "stage"))
+	assert.Equal(t, `SELECT * FROM DIRECTORY(@"db"."schema"."stage") ORDER BY RELATIVE_PATH`, actual)
+}
+
+func TestStagesCreateClone(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "stage_clone")
+	sourceID := NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateStageCloneOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			Clone:       &Clone{SourceObject: sourceID},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE STAGE IF NOT EXISTS "db"."schema"."stage_clone" CLONE "db"."schema"."stage"`, actual)
+	})
+
+	t.Run("validation: time travel", func(t *testing.T) {
+		opts := &CreateStageCloneOptions{
+			name: id,
+			Clone: &Clone{
+				SourceObject: sourceID,
+				Before:       &TimeTravel{Offset: Int(-60)},
+			},
+		}
+		assert.EqualError(t, opts.validate(), "AT and BEFORE are not supported when cloning a stage")
+	})
+
+	t.Run("validation: clone not set", func(t *testing.T) {
+		opts := &CreateStageCloneOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestStageLocation(t *testing.T) {
+	stage := NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	assert.Equal(t, `@"db"."schema"."stage"`, StageLocation{Stage: stage}.String())
+	assert.Equal(t, `@"db"."schema"."stage"/udfs/handler.py`, StageLocation{Stage: stage, Path: "/udfs/handler.py"}.String())
+	assert.Equal(t, `@"db"."schema"."stage"/it\'s.py`, StageLocation{Stage: stage, Path: "it's.py"}.escaped())
+}
+
+func TestStagesPut(t *testing.T) {
+	location := StageLocation{Stage: NewSchemaObjectIdentifier("db", "schema", "stage"), Path: "udfs"}
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &PutStageFileOptions{
+			source:   fileURI("/tmp/handler.py"),
+			location: location.escaped(),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `PUT 'file:///tmp/handler.py' '@"db"."schema"."stage"/udfs'`, actual)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &PutStageFileOptions{
+			source:       fileURI("handler.py"),
+			location:     location.escaped(),
+			Parallel:     Int(4),
+			AutoCompress: Bool(false),
+			Overwrite:    Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `PUT 'file:///handler.py' '@"db"."schema"."stage"/udfs' PARALLEL = 4 AUTO_COMPRESS = false OVERWRITE = true`, actual)
+	})
+
+	t.Run("validation: parallel out of range", func(t *testing.T) {
+		opts := &PutStageFileOptions{
+			source:   fileURI("/tmp/handler.py"),
+			location: location.escaped(),
+			Parallel: Int(100),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestStagesGet(t *testing.T) {
+	opts := &getStageFileOptions{
+		location: StageLocation{Stage: NewSchemaObjectIdentifier("db", "schema", "stage"), Path: "handler.py"}.escaped(),
+		target:   fileURI("/tmp/download") + "/",
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `GET '@"db"."schema"."stage"/handler.py' 'file:///tmp/download/'`, actual)
+}
+
+func TestStagesList(t *testing.T) {
+	opts := &ListStageFilesOptions{
+		location: StageLocation{Stage: NewSchemaObjectIdentifier("db", "schema", "stage"), Path: "udfs/"}.escaped(),
+		Pattern:  String(`.*\.py`),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `LIST '@"db"."schema"."stage"/udfs/' PATTERN = '.*\.py'`, actual)
+}
+
+func TestStageFileRow(t *testing.T) {
+	row := stageFileRow{
+		Name:         "stage/udfs/handler.py.gz",
+		Size:         64,
+		MD5:          sql.NullString{String: "d41d8cd98f00b204e9800998ecf8427e", Valid: true},
+		LastModified: sql.NullString{String: "Tue, 7 May 2024 10:00:00 GMT", Valid: true},
+	}
+	stageFile := row.toStageFile()
+	assert.Equal(t, "stage/udfs/handler.py.gz", stageFile.Name)
+	assert.Equal(t, 64, stageFile.Size)
+	assert.Equal(t, time.Date(2024, 5, 7, 10, 0, 0, 0, time.UTC), stageFile.LastModified.UTC())
+}
+
+func TestStagesRemove(t *testing.T) {
+	opts := &RemoveStageFilesOptions{
+		location: StageLocation{Stage: NewSchemaObjectIdentifier("db", "schema", "stage")}.escaped(),
+		Pattern:  String(`.*\.tmp`),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `REMOVE '@"db"."schema"."stage"' PATTERN = '.*\.tmp'`, actual)
+}
@@ -0,0 +1,421 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ StorageIntegrations = (*storageIntegrations)(nil)
+
+// StorageIntegrations describes all the storage integration related methods that the
+// Snowflake API supports.
+type StorageIntegrations interface {
+	// Create creates a new storage integration.
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateStorageIntegrationOptions) error
+	// Alter modifies an existing storage integration.
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterStorageIntegrationOptions) error
+	// Drop removes a storage integration.
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropStorageIntegrationOptions) error
+	// Show returns a list of storage integrations.
+	Show(ctx context.Context, opts *ShowStorageIntegrationOptions) ([]*StorageIntegration, error)
+	// ShowByID returns a storage integration by ID.
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*StorageIntegration, error)
+	// Describe returns the details of a storage integration.
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*StorageIntegrationDetails, error)
+}
+
+// storageIntegrations implements StorageIntegrations.
+type storageIntegrations struct {
+	client *Client
+}
+
+type S3StorageProvider string
+
+const (
+	S3StorageProviderS3      S3StorageProvider = "S3"
+	S3StorageProviderS3GOV   S3StorageProvider = "S3GOV"
+	S3StorageProviderS3China S3StorageProvider = "S3CHINA"
+)
+
+type StorageLocation struct {
+	Path string `ddl:"keyword,single_quotes"`
+}
+
+type CreateStorageIntegrationOptions struct {
+	create             bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace          *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	storageIntegration bool                    `ddl:"static" sql:"STORAGE INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists        *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+	externalStageType  bool                    `ddl:"static" sql:"TYPE = EXTERNAL_STAGE"` //lint:ignore U1000 This is used in the ddl tag
+
+	S3StorageProviderParams    *S3StorageParams    `ddl:"keyword"`
+	GCSStorageProviderParams   *GCSStorageParams   `ddl:"keyword"`
+	AzureStorageProviderParams *AzureStorageParams `ddl:"keyword"`
+
+	Enabled                 bool              `ddl:"parameter" sql:"ENABLED"`
+	StorageAllowedLocations []StorageLocation `ddl:"parameter,parentheses" sql:"STORAGE_ALLOWED_LOCATIONS"`
+	StorageBlockedLocations []StorageLocation `ddl:"parameter,parentheses" sql:"STORAGE_BLOCKED_LOCATIONS"`
+	Comment                 *string           `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type S3StorageParams struct {
+	StorageProvider     S3StorageProvider `ddl:"parameter,single_quotes" sql:"STORAGE_PROVIDER"`
+	StorageAWSRoleARN   string            `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_ROLE_ARN"`
+	StorageAWSObjectACL *string           `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_OBJECT_ACL"`
+}
+
+type GCSStorageParams struct {
+	storageProvider string `ddl:"static" sql:"STORAGE_PROVIDER = 'GCS'"` //lint:ignore U1000 This is used in the ddl tag
+}
+
+type AzureStorageParams struct {
+	storageProvider string `ddl:"static" sql:"STORAGE_PROVIDER = 'AZURE'"` //lint:ignore U1000 This is used in the ddl tag
+	AzureTenantID   string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+}
+
+func (opts *CreateStorageIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if !exactlyOneValueSet(opts.S3StorageProviderParams, opts.GCSStorageProviderParams, opts.AzureStorageProviderParams) {
+		return errors.New("exactly one of S3StorageProviderParams, GCSStorageProviderParams, AzureStorageProviderParams must be set")
+	}
+	if valueSet(opts.S3StorageProviderParams) {
+		if err := opts.S3StorageProviderParams.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.AzureStorageProviderParams) && opts.AzureStorageProviderParams.AzureTenantID == "" {
+		return errors.New("AzureTenantID is required for Azure storage integrations")
+	}
+	if len(opts.StorageAllowedLocations) == 0 {
+		return errors.New("at least one StorageAllowedLocations entry must be set")
+	}
+	return nil
+}
+
+func (v *S3StorageParams) validate() error {
+	if v.StorageProvider == "" {
+		return errors.New("StorageProvider is required for S3 storage integrations")
+	}
+	if v.StorageAWSRoleARN == "" {
+		return errors.New("StorageAWSRoleARN is required for S3 storage integrations")
+	}
+	return nil
+}
+
+func (v *storageIntegrations) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateStorageIntegrationOptions) error {
+	if opts == nil {
+		opts = &CreateStorageIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterStorageIntegrationOptions struct {
+	alter              bool                     `ddl:"static" sql:"ALTER"`               //lint:ignore U1000 This is used in the ddl tag
+	storageIntegration bool                     `ddl:"static" sql:"STORAGE INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists           *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier  `ddl:"identifier"`
+	Set                *StorageIntegrationSet   `ddl:"keyword" sql:"SET"`
+	Unset              *StorageIntegrationUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTag             []TagAssociation         `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag           []ObjectIdentifier       `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterStorageIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type StorageIntegrationSet struct {
+	S3Params    *SetS3StorageParams    `ddl:"keyword"`
+	AzureParams *SetAzureStorageParams `ddl:"keyword"`
+
+	Enabled                 *bool             `ddl:"parameter" sql:"ENABLED"`
+	StorageAllowedLocations []StorageLocation `ddl:"parameter,parentheses" sql:"STORAGE_ALLOWED_LOCATIONS"`
+	StorageBlockedLocations []StorageLocation `ddl:"parameter,parentheses" sql:"STORAGE_BLOCKED_LOCATIONS"`
+	Comment                 *string           `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type SetS3StorageParams struct {
+	StorageAWSRoleARN   *string `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_ROLE_ARN"`
+	StorageAWSObjectACL *string `ddl:"parameter,single_quotes" sql:"STORAGE_AWS_OBJECT_ACL"`
+}
+
+type SetAzureStorageParams struct {
+	AzureTenantID *string `ddl:"parameter,single_quotes" sql:"AZURE_TENANT_ID"`
+}
+
+func (v *StorageIntegrationSet) validate() error {
+	if !anyValueSet(v.S3Params, v.AzureParams, v.Enabled, v.StorageAllowedLocations, v.StorageBlockedLocations, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	if everyValueSet(v.S3Params, v.AzureParams) {
+		return errors.New("S3Params and AzureParams cannot be set together")
+	}
+	return nil
+}
+
+type StorageIntegrationUnset struct {
+	StorageAWSExternalID    *bool `ddl:"keyword" sql:"STORAGE_AWS_EXTERNAL_ID"`
+	StorageAWSObjectACL     *bool `ddl:"keyword" sql:"STORAGE_AWS_OBJECT_ACL"`
+	Enabled                 *bool `ddl:"keyword" sql:"ENABLED"`
+	StorageBlockedLocations *bool `ddl:"keyword" sql:"STORAGE_BLOCKED_LOCATIONS"`
+	Comment                 *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *StorageIntegrationUnset) validate() error {
+	if !anyValueSet(v.StorageAWSExternalID, v.StorageAWSObjectACL, v.Enabled, v.StorageBlockedLocations, v.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+func (v *storageIntegrations) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterStorageIntegrationOptions) error {
+	if opts == nil {
+		opts = &AlterStorageIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropStorageIntegrationOptions struct {
+	drop               bool                    `ddl:"static" sql:"DROP"`                //lint:ignore U1000 This is used in the ddl tag
+	storageIntegration bool                    `ddl:"static" sql:"STORAGE INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists           *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropStorageIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *storageIntegrations) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropStorageIntegrationOptions) error {
+	if opts == nil {
+		opts = &DropStorageIntegrationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowStorageIntegrationOptions represents the options for listing storage integrations.
+type ShowStorageIntegrationOptions struct {
+	show                bool  `ddl:"static" sql:"SHOW"`                 //lint:ignore U1000 This is used in the ddl tag
+	storageIntegrations bool  `ddl:"static" sql:"STORAGE INTEGRATIONS"` //lint:ignore U1000 This is used in the ddl tag
+	Like                *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowStorageIntegrationOptions) validate() error {
+	return nil
+}
+
+type StorageIntegration struct {
+	Name        string
+	StorageType string
+	Category    string
+	Enabled     bool
+	Comment     string
+	CreatedOn   time.Time
+}
+
+func (v *StorageIntegration) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *StorageIntegration) ObjectType() ObjectType {
+	return ObjectTypeIntegration
+}
+
+type storageIntegrationRow struct {
+	Name      string         `db:"name"`
+	Type      string         `db:"type"`
+	Category  string         `db:"category"`
+	Enabled   bool           `db:"enabled"`
+	Comment   sql.NullString `db:"comment"`
+	CreatedOn time.Time      `db:"created_on"`
+}
+
+func (row *storageIntegrationRow) toStorageIntegration() *StorageIntegration {
+	v := &StorageIntegration{
+		Name:        row.Name,
+		StorageType: row.Type,
+		Category:    row.Category,
+		Enabled:     row.Enabled,
+		CreatedOn:   row.CreatedOn,
+	}
+	if row.Comment.Valid {
+		v.Comment = row.Comment.String
+	}
+	return v
+}
+
+func (v *storageIntegrations) Show(ctx context.Context, opts *ShowStorageIntegrationOptions) ([]*StorageIntegration, error) {
+	if opts == nil {
+		opts = &ShowStorageIntegrationOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []storageIntegrationRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*StorageIntegration, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toStorageIntegration()
+	}
+	return resultList, nil
+}
+
+func (v *storageIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*StorageIntegration, error) {
+	storageIntegrations, err := v.Show(ctx, &ShowStorageIntegrationOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, storageIntegration := range storageIntegrations {
+		if storageIntegration.Name == id.Name() {
+			return storageIntegration, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeStorageIntegrationOptions struct {
+	describe           bool                    `ddl:"static" sql:"DESCRIBE"`            //lint:ignore U1000 This is used in the ddl tag
+	storageIntegration bool                    `ddl:"static" sql:"STORAGE INTEGRATION"` //lint:ignore U1000 This is used in the ddl tag
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeStorageIntegrationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// StorageIntegrationDetails contains the typed output of DESCRIBE STORAGE INTEGRATION. Fields that
+// are not relevant to the integration's storage provider are left empty.
+type StorageIntegrationDetails struct {
+	Enabled                 bool
+	StorageProvider         string
+	StorageAllowedLocations []string
+	StorageBlockedLocations []string
+	Comment                 string
+
+	// S3
+	StorageAWSIAMUserARN string
+	StorageAWSRoleARN    string
+	StorageAWSExternalID string
+	StorageAWSObjectACL  string
+
+	// GCS
+	StorageGCPServiceAccount string
+
+	// Azure
+	AzureTenantID           string
+	AzureConsentURL         string
+	AzureMultiTenantAppName string
+}
+
+func storageIntegrationDetailsFromRows(rows []integrationPropertyRow) *StorageIntegrationDetails {
+	v := &StorageIntegrationDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "ENABLED":
+			v.Enabled = row.toBool()
+		case "STORAGE_PROVIDER":
+			v.StorageProvider = row.Value
+		case "STORAGE_ALLOWED_LOCATIONS":
+			v.StorageAllowedLocations = row.toList()
+		case "STORAGE_BLOCKED_LOCATIONS":
+			v.StorageBlockedLocations = row.toList()
+		case "COMMENT":
+			v.Comment = row.Value
+		case "STORAGE_AWS_IAM_USER_ARN":
+			v.StorageAWSIAMUserARN = row.Value
+		case "STORAGE_AWS_ROLE_ARN":
+			v.StorageAWSRoleARN = row.Value
+		case "STORAGE_AWS_EXTERNAL_ID":
+			v.StorageAWSExternalID = row.Value
+		case "STORAGE_AWS_OBJECT_ACL":
+			v.StorageAWSObjectACL = row.Value
+		case "STORAGE_GCP_SERVICE_ACCOUNT":
+			v.StorageGCPServiceAccount = row.Value
+		case "AZURE_TENANT_ID":
+			v.AzureTenantID = row.Value
+		case "AZURE_CONSENT_URL":
+			v.AzureConsentURL = row.Value
+		case "AZURE_MULTI_TENANT_APP_NAME":
+			v.AzureMultiTenantAppName = row.Value
+		}
+	}
+	return v
+}
+
+func (v *storageIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) (*StorageIntegrationDetails, error) {
+	opts := &describeStorageIntegrationOptions{
+		name: id,
+	}
+	dest, err := describeRows[integrationPropertyRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return storageIntegrationDetailsFromRows(dest), nil
+}
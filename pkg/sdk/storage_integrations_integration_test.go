@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_StorageIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	createS3StorageIntegration := func(t *testing.T) AccountObjectIdentifier {
+		t.Helper()
+		id := randomAccountObjectIdentifier(t)
+		err := client.StorageIntegrations.Create(ctx, id, &CreateStorageIntegrationOptions{
+			S3StorageProviderParams: &S3StorageParams{
+				StorageProvider:   S3StorageProviderS3,
+				StorageAWSRoleARN: "arn:aws:iam::000000000001:/role/test",
+			},
+			Enabled:                 true,
+			StorageAllowedLocations: []StorageLocation{{Path: "s3://foo/"}},
+			Comment:                 String("some comment"),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.StorageIntegrations.Drop(ctx, id, &DropStorageIntegrationOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("create, show and describe", func(t *testing.T) {
+		id := createS3StorageIntegration(t)
+
+		storageIntegration, err := client.StorageIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), storageIntegration.Name)
+		assert.Equal(t, "EXTERNAL_STAGE", storageIntegration.StorageType)
+		assert.Equal(t, "STORAGE", storageIntegration.Category)
+		assert.True(t, storageIntegration.Enabled)
+		assert.Equal(t, "some comment", storageIntegration.Comment)
+
+		details, err := client.StorageIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "S3", details.StorageProvider)
+		assert.Equal(t, []string{"s3://foo/"}, details.StorageAllowedLocations)
+		assert.NotEmpty(t, details.StorageAWSIAMUserARN)
+		assert.NotEmpty(t, details.StorageAWSExternalID)
+	})
+
+	t.Run("alter", func(t *testing.T) {
+		id := createS3StorageIntegration(t)
+
+		err := client.StorageIntegrations.Alter(ctx, id, &AlterStorageIntegrationOptions{
+			Set: &StorageIntegrationSet{
+				Enabled:                 Bool(false),
+				StorageBlockedLocations: []StorageLocation{{Path: "s3://foo/bar/"}},
+			},
+		})
+		require.NoError(t, err)
+		details, err := client.StorageIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, details.Enabled)
+		assert.Equal(t, []string{"s3://foo/bar/"}, details.StorageBlockedLocations)
+
+		err = client.StorageIntegrations.Alter(ctx, id, &AlterStorageIntegrationOptions{
+			Unset: &StorageIntegrationUnset{
+				StorageBlockedLocations: Bool(true),
+				Comment:                 Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		storageIntegration, err := client.StorageIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", storageIntegration.Comment)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		id := createS3StorageIntegration(t)
+
+		err := client.StorageIntegrations.Drop(ctx, id, nil)
+		require.NoError(t, err)
+		_, err = client.StorageIntegrations.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,190 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageIntegrationsCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("s3", func(t *testing.T) {
+		opts := &CreateStorageIntegrationOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			S3StorageProviderParams: &S3StorageParams{
+				StorageProvider:     S3StorageProviderS3,
+				StorageAWSRoleARN:   "arn:aws:iam::001234567890:role/myrole",
+				StorageAWSObjectACL: String("bucket-owner-full-control"),
+			},
+			Enabled:                 true,
+			StorageAllowedLocations: []StorageLocation{{Path: "s3://bucket/a/"}, {Path: "s3://bucket/b/"}},
+			StorageBlockedLocations: []StorageLocation{{Path: "s3://bucket/a/secret/"}},
+			Comment:                 String("some comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE STORAGE INTEGRATION ` + id.FullyQualifiedName() + ` TYPE = EXTERNAL_STAGE STORAGE_PROVIDER = 'S3' STORAGE_AWS_ROLE_ARN = 'arn:aws:iam::001234567890:role/myrole' STORAGE_AWS_OBJECT_ACL = 'bucket-owner-full-control' ENABLED = true STORAGE_ALLOWED_LOCATIONS = ('s3://bucket/a/', 's3://bucket/b/') STORAGE_BLOCKED_LOCATIONS = ('s3://bucket/a/secret/') COMMENT = 'some comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("gcs", func(t *testing.T) {
+		opts := &CreateStorageIntegrationOptions{
+			IfNotExists:              Bool(true),
+			name:                     id,
+			GCSStorageProviderParams: &GCSStorageParams{},
+			StorageAllowedLocations:  []StorageLocation{{Path: "gcs://bucket/path/"}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE STORAGE INTEGRATION IF NOT EXISTS ` + id.FullyQualifiedName() + ` TYPE = EXTERNAL_STAGE STORAGE_PROVIDER = 'GCS' ENABLED = false STORAGE_ALLOWED_LOCATIONS = ('gcs://bucket/path/')`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("azure", func(t *testing.T) {
+		opts := &CreateStorageIntegrationOptions{
+			name: id,
+			AzureStorageProviderParams: &AzureStorageParams{
+				AzureTenantID: "a123b4c5-1234-123a-a12b-1a23b45678c9",
+			},
+			Enabled:                 true,
+			StorageAllowedLocations: []StorageLocation{{Path: "azure://account.blob.core.windows.net/container/"}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE STORAGE INTEGRATION ` + id.FullyQualifiedName() + ` TYPE = EXTERNAL_STAGE STORAGE_PROVIDER = 'AZURE' AZURE_TENANT_ID = 'a123b4c5-1234-123a-a12b-1a23b45678c9' ENABLED = true STORAGE_ALLOWED_LOCATIONS = ('azure://account.blob.core.windows.net/container/')`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: exactly one provider", func(t *testing.T) {
+		opts := &CreateStorageIntegrationOptions{
+			name:                     id,
+			GCSStorageProviderParams: &GCSStorageParams{},
+			AzureStorageProviderParams: &AzureStorageParams{
+				AzureTenantID: "tenant",
+			},
+			StorageAllowedLocations: []StorageLocation{{Path: "gcs://bucket/path/"}},
+		}
+		require.Error(t, opts.validate())
+	})
+
+	t.Run("validation: allowed locations", func(t *testing.T) {
+		opts := &CreateStorageIntegrationOptions{
+			name:                     id,
+			GCSStorageProviderParams: &GCSStorageParams{},
+		}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestStorageIntegrationsAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterStorageIntegrationOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &StorageIntegrationSet{
+				S3Params: &SetS3StorageParams{
+					StorageAWSRoleARN: String("arn:aws:iam::001234567890:role/other"),
+				},
+				Enabled:                 Bool(false),
+				StorageAllowedLocations: []StorageLocation{{Path: "s3://bucket/c/"}},
+				Comment:                 String("changed"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER STORAGE INTEGRATION IF EXISTS ` + id.FullyQualifiedName() + ` SET STORAGE_AWS_ROLE_ARN = 'arn:aws:iam::001234567890:role/other' ENABLED = false STORAGE_ALLOWED_LOCATIONS = ('s3://bucket/c/') COMMENT = 'changed'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterStorageIntegrationOptions{
+			name: id,
+			Unset: &StorageIntegrationUnset{
+				StorageBlockedLocations: Bool(true),
+				Comment:                 Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER STORAGE INTEGRATION ` + id.FullyQualifiedName() + ` UNSET STORAGE_BLOCKED_LOCATIONS, COMMENT`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set tag", func(t *testing.T) {
+		opts := &AlterStorageIntegrationOptions{
+			name: id,
+			SetTag: []TagAssociation{
+				{Name: NewSchemaObjectIdentifier("db", "schema", "tag"), Value: "v1"},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER STORAGE INTEGRATION ` + id.FullyQualifiedName() + ` SET TAG "db"."schema"."tag" = 'v1'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: nothing set", func(t *testing.T) {
+		opts := &AlterStorageIntegrationOptions{name: id}
+		require.Error(t, opts.validate())
+	})
+}
+
+func TestStorageIntegrationsDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &DropStorageIntegrationOptions{
+		IfExists: Bool(true),
+		name:     id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP STORAGE INTEGRATION IF EXISTS `+id.FullyQualifiedName(), actual)
+}
+
+func TestStorageIntegrationsShow(t *testing.T) {
+	opts := &ShowStorageIntegrationOptions{
+		Like: &Like{Pattern: String("my_integration")},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW STORAGE INTEGRATIONS LIKE 'my_integration'`, actual)
+}
+
+func TestStorageIntegrationsDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &describeStorageIntegrationOptions{name: id}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE STORAGE INTEGRATION `+id.FullyQualifiedName(), actual)
+
+	t.Run("details from rows", func(t *testing.T) {
+		rows := []integrationPropertyRow{
+			{Property: "ENABLED", PropertyType: "Boolean", Value: "true"},
+			{Property: "STORAGE_PROVIDER", PropertyType: "String", Value: "S3"},
+			{Property: "STORAGE_ALLOWED_LOCATIONS", PropertyType: "List", Value: "s3://bucket/a/,s3://bucket/b/"},
+			{Property: "STORAGE_BLOCKED_LOCATIONS", PropertyType: "List", Value: ""},
+			{Property: "STORAGE_AWS_IAM_USER_ARN", PropertyType: "String", Value: "arn:aws:iam::123456789001:user/abc"},
+			{Property: "STORAGE_AWS_ROLE_ARN", PropertyType: "String", Value: "arn:aws:iam::001234567890:role/myrole"},
+			{Property: "STORAGE_AWS_EXTERNAL_ID", PropertyType: "String", Value: "MYACCOUNT_SFCRole=2_abc"},
+			{Property: "COMMENT", PropertyType: "String", Value: "some comment"},
+		}
+		details := storageIntegrationDetailsFromRows(rows)
+		assert.True(t, details.Enabled)
+		assert.Equal(t, "S3", details.StorageProvider)
+		assert.Equal(t, []string{"s3://bucket/a/", "s3://bucket/b/"}, details.StorageAllowedLocations)
+		assert.Empty(t, details.StorageBlockedLocations)
+		assert.Equal(t, "arn:aws:iam::123456789001:user/abc", details.StorageAWSIAMUserARN)
+		assert.Equal(t, "arn:aws:iam::001234567890:role/myrole", details.StorageAWSRoleARN)
+		assert.Equal(t, "MYACCOUNT_SFCRole=2_abc", details.StorageAWSExternalID)
+		assert.Equal(t, "some comment", details.Comment)
+	})
+}
@@ -0,0 +1,367 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Streamlits = (*streamlits)(nil)
+
+// Streamlits are Streamlit apps served from files in a stage.
+type Streamlits interface {
+	// Create creates a new Streamlit app.
+	Create(ctx context.Context, id SchemaObjectIdentifier, rootLocation string, mainFile string, opts *CreateStreamlitOptions) error
+	// Alter modifies an existing Streamlit app.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterStreamlitOptions) error
+	// Drop removes a Streamlit app.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropStreamlitOptions) error
+	// Show returns a list of Streamlit apps.
+	Show(ctx context.Context, opts *ShowStreamlitOptions) ([]*Streamlit, error)
+	// ShowByID returns a Streamlit app by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Streamlit, error)
+	// Describe returns the details of a Streamlit app.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*StreamlitDetails, error)
+}
+
+// streamlits implements Streamlits.
+type streamlits struct {
+	client *Client
+}
+
+type CreateStreamlitOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	streamlit   bool                   `ddl:"static" sql:"STREAMLIT"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+
+	// required, e.g. @db.schema.stage/app
+	rootLocation string `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
+	mainFile     string `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
+
+	// optional
+	QueryWarehouse             AccountObjectIdentifier   `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Title                      *string                   `ddl:"parameter,single_quotes" sql:"TITLE"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateStreamlitOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if opts.rootLocation == "" {
+		return errors.New("root location is required")
+	}
+	if opts.mainFile == "" {
+		return errors.New("main file is required")
+	}
+	return nil
+}
+
+func (v *streamlits) Create(ctx context.Context, id SchemaObjectIdentifier, rootLocation string, mainFile string, opts *CreateStreamlitOptions) error {
+	if opts == nil {
+		opts = &CreateStreamlitOptions{}
+	}
+	opts.name = id
+	opts.rootLocation = rootLocation
+	opts.mainFile = mainFile
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type AlterStreamlitOptions struct {
+	alter     bool                   `ddl:"static" sql:"ALTER"`     //lint:ignore U1000 This is used in the ddl tag
+	streamlit bool                   `ddl:"static" sql:"STREAMLIT"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists  *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name      SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	NewName SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set     *StreamlitSet          `ddl:"keyword" sql:"SET"`
+	Unset   *StreamlitUnset        `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *AlterStreamlitOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Unset) {
+		return errors.New("exactly one of NewName, Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type StreamlitSet struct {
+	RootLocation               *string                   `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
+	MainFile                   *string                   `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
+	QueryWarehouse             AccountObjectIdentifier   `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Title                      *string                   `ddl:"parameter,single_quotes" sql:"TITLE"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *StreamlitSet) validate() error {
+	if !anyValueSet(v.RootLocation, v.MainFile, v.QueryWarehouse, v.ExternalAccessIntegrations, v.Title, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	return nil
+}
+
+type StreamlitUnset struct {
+	QueryWarehouse *bool `ddl:"keyword" sql:"QUERY_WAREHOUSE"`
+	Title          *bool `ddl:"keyword" sql:"TITLE"`
+	Comment        *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *StreamlitUnset) validate() error {
+	if !anyValueSet(v.QueryWarehouse, v.Title, v.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+func (v *streamlits) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterStreamlitOptions) error {
+	if opts == nil {
+		opts = &AlterStreamlitOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type DropStreamlitOptions struct {
+	drop      bool                   `ddl:"static" sql:"DROP"`      //lint:ignore U1000 This is used in the ddl tag
+	streamlit bool                   `ddl:"static" sql:"STREAMLIT"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists  *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name      SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropStreamlitOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *streamlits) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropStreamlitOptions) error {
+	if opts == nil {
+		opts = &DropStreamlitOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowStreamlitOptions struct {
+	show       bool  `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Terse      *bool `ddl:"keyword" sql:"TERSE"`
+	streamlits bool  `ddl:"static" sql:"STREAMLITS"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like  *Like      `ddl:"keyword" sql:"LIKE"`
+	In    *In        `ddl:"keyword" sql:"IN"`
+	Limit *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+func (opts *ShowStreamlitOptions) validate() error {
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type Streamlit struct {
+	CreatedOn      time.Time
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	Title          string
+	Owner          string
+	Comment        string
+	QueryWarehouse string
+	URLID          string
+	OwnerRoleType  string
+}
+
+func (v *Streamlit) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Streamlit) ObjectType() ObjectType {
+	return ObjectTypeStreamlit
+}
+
+type streamlitRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	Title          sql.NullString `db:"title"`
+	Owner          sql.NullString `db:"owner"`
+	Comment        sql.NullString `db:"comment"`
+	QueryWarehouse sql.NullString `db:"query_warehouse"`
+	URLID          sql.NullString `db:"url_id"`
+	OwnerRoleType  sql.NullString `db:"owner_role_type"`
+}
+
+func (row streamlitRow) toStreamlit() *Streamlit {
+	return &Streamlit{
+		CreatedOn:      row.CreatedOn,
+		Name:           row.Name,
+		DatabaseName:   row.DatabaseName,
+		SchemaName:     row.SchemaName,
+		Title:          row.Title.String,
+		Owner:          row.Owner.String,
+		Comment:        row.Comment.String,
+		QueryWarehouse: row.QueryWarehouse.String,
+		URLID:          row.URLID.String,
+		OwnerRoleType:  row.OwnerRoleType.String,
+	}
+}
+
+func (v *streamlits) Show(ctx context.Context, opts *ShowStreamlitOptions) ([]*Streamlit, error) {
+	if opts == nil {
+		opts = &ShowStreamlitOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []streamlitRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Streamlit, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toStreamlit()
+	}
+	return resultList, nil
+}
+
+func (v *streamlits) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Streamlit, error) {
+	streamlits, err := v.Show(ctx, &ShowStreamlitOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, streamlit := range streamlits {
+		if streamlit.Name == id.Name() {
+			return streamlit, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeStreamlitOptions struct {
+	describe  bool                   `ddl:"static" sql:"DESCRIBE"`  //lint:ignore U1000 This is used in the ddl tag
+	streamlit bool                   `ddl:"static" sql:"STREAMLIT"` //lint:ignore U1000 This is used in the ddl tag
+	name      SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeStreamlitOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type StreamlitDetails struct {
+	Title          string
+	RootLocation   string
+	MainFile       string
+	QueryWarehouse string
+	// URLID identifies the app in its URL in Snowsight.
+	URLID                      string
+	DefaultPackages            string
+	UserPackages               []string
+	ImportURLs                 []string
+	ExternalAccessIntegrations []string
+	ExternalAccessSecrets      string
+}
+
+type streamlitDetailsRow struct {
+	Title                      sql.NullString `db:"title"`
+	RootLocation               sql.NullString `db:"root_location"`
+	MainFile                   sql.NullString `db:"main_file"`
+	QueryWarehouse             sql.NullString `db:"query_warehouse"`
+	URLID                      sql.NullString `db:"url_id"`
+	DefaultPackages            sql.NullString `db:"default_packages"`
+	UserPackages               sql.NullString `db:"user_packages"`
+	ImportURLs                 sql.NullString `db:"import_urls"`
+	ExternalAccessIntegrations sql.NullString `db:"external_access_integrations"`
+	ExternalAccessSecrets      sql.NullString `db:"external_access_secrets"`
+}
+
+func (row streamlitDetailsRow) toStreamlitDetails() *StreamlitDetails {
+	return &StreamlitDetails{
+		Title:                      row.Title.String,
+		RootLocation:               row.RootLocation.String,
+		MainFile:                   row.MainFile.String,
+		QueryWarehouse:             row.QueryWarehouse.String,
+		URLID:                      row.URLID.String,
+		DefaultPackages:            row.DefaultPackages.String,
+		UserPackages:               unquoteList(row.UserPackages.String),
+		ImportURLs:                 unquoteList(row.ImportURLs.String),
+		ExternalAccessIntegrations: unquoteList(row.ExternalAccessIntegrations.String),
+		ExternalAccessSecrets:      row.ExternalAccessSecrets.String,
+	}
+}
+
+func (v *streamlits) Describe(ctx context.Context, id SchemaObjectIdentifier) (*StreamlitDetails, error) {
+	opts := &describeStreamlitOptions{
+		name: id,
+	}
+	dest, err := describeRow[streamlitDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toStreamlitDetails(), nil
+}
@@ -0,0 +1,71 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Streamlits(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	stage, stageCleanup := createStage(t, client, database, schema)
+	t.Cleanup(stageCleanup)
+	warehouse, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+
+	rootLocation := fmt.Sprintf("@%s", stage.FullyQualifiedName())
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	err := client.Streamlits.Create(ctx, id, rootLocation, "streamlit_app.py", &CreateStreamlitOptions{
+		QueryWarehouse: warehouse.ID(),
+		Comment:        String("some comment"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Streamlits.Drop(ctx, id, &DropStreamlitOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		streamlit, err := client.Streamlits.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, streamlit.ID())
+		assert.Equal(t, warehouse.Name, streamlit.QueryWarehouse)
+		assert.Equal(t, "some comment", streamlit.Comment)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		details, err := client.Streamlits.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "streamlit_app.py", details.MainFile)
+		assert.NotEmpty(t, details.URLID)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		err := client.Streamlits.Alter(ctx, id, &AlterStreamlitOptions{Set: &StreamlitSet{Title: String("My app")}})
+		require.NoError(t, err)
+		streamlit, err := client.Streamlits.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "My app", streamlit.Title)
+
+		err = client.Streamlits.Alter(ctx, id, &AlterStreamlitOptions{Unset: &StreamlitUnset{Comment: Bool(true)}})
+		require.NoError(t, err)
+		streamlit, err = client.Streamlits.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", streamlit.Comment)
+	})
+
+	t.Run("show in schema", func(t *testing.T) {
+		streamlits, err := client.Streamlits.Show(ctx, &ShowStreamlitOptions{In: &In{Schema: schema.ID()}})
+		require.NoError(t, err)
+		assert.Len(t, streamlits, 1)
+	})
+}
@@ -0,0 +1,123 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamlitCreate(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "myapp")
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateStreamlitOptions{
+			OrReplace:                  Bool(true),
+			name:                       id,
+			rootLocation:               "@db.schema.stage/app",
+			mainFile:                   "streamlit_app.py",
+			QueryWarehouse:             NewAccountObjectIdentifier("wh"),
+			ExternalAccessIntegrations: []AccountObjectIdentifier{NewAccountObjectIdentifier("eai")},
+			Title:                      String("My app"),
+			Comment:                    String("some comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE STREAMLIT "db"."schema"."myapp" ROOT_LOCATION = '@db.schema.stage/app' MAIN_FILE = 'streamlit_app.py' QUERY_WAREHOUSE = "wh" EXTERNAL_ACCESS_INTEGRATIONS = ("eai") TITLE = 'My app' COMMENT = 'some comment'`, actual)
+	})
+
+	t.Run("validation: missing main file", func(t *testing.T) {
+		opts := &CreateStreamlitOptions{
+			name:         id,
+			rootLocation: "@db.schema.stage/app",
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestStreamlitAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "myapp")
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterStreamlitOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  NewSchemaObjectIdentifier("db", "schema", "newapp"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER STREAMLIT IF EXISTS "db"."schema"."myapp" RENAME TO "db"."schema"."newapp"`, actual)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterStreamlitOptions{
+			name: id,
+			Set: &StreamlitSet{
+				MainFile:       String("app.py"),
+				QueryWarehouse: NewAccountObjectIdentifier("wh"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER STREAMLIT "db"."schema"."myapp" SET MAIN_FILE = 'app.py' QUERY_WAREHOUSE = "wh"`, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterStreamlitOptions{
+			name:  id,
+			Unset: &StreamlitUnset{QueryWarehouse: Bool(true), Comment: Bool(true)},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER STREAMLIT "db"."schema"."myapp" UNSET QUERY_WAREHOUSE, COMMENT`, actual)
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterStreamlitOptions{
+			name: id,
+			Set:  &StreamlitSet{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestStreamlitDrop(t *testing.T) {
+	opts := &DropStreamlitOptions{
+		IfExists: Bool(true),
+		name:     NewSchemaObjectIdentifier("db", "schema", "myapp"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP STREAMLIT IF EXISTS "db"."schema"."myapp"`, actual)
+}
+
+func TestStreamlitShow(t *testing.T) {
+	opts := &ShowStreamlitOptions{
+		Terse: Bool(true),
+		Like:  &Like{Pattern: String("myapp")},
+		In:    &In{Schema: NewSchemaIdentifier("db", "schema")},
+		Limit: &LimitFrom{Rows: Int(5)},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `SHOW TERSE STREAMLITS LIKE 'myapp' IN SCHEMA "db"."schema" LIMIT 5`, actual)
+}
+
+func TestStreamlitDescribe(t *testing.T) {
+	opts := &describeStreamlitOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "myapp"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE STREAMLIT "db"."schema"."myapp"`, actual)
+
+	details := streamlitDetailsRow{
+		URLID:                      sql.NullString{String: "abc123", Valid: true},
+		ExternalAccessIntegrations: sql.NullString{String: `["EAI_1","EAI_2"]`, Valid: true},
+		ImportURLs:                 sql.NullString{String: "[]", Valid: true},
+	}.toStreamlitDetails()
+	assert.Equal(t, "abc123", details.URLID)
+	assert.Equal(t, []string{"EAI_1", "EAI_2"}, details.ExternalAccessIntegrations)
+	assert.Empty(t, details.ImportURLs)
+}
@@ -0,0 +1,191 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Streams = (*streams)(nil)
+
+// Streams describes all the stream related methods that the Snowflake API supports.
+type Streams interface {
+	// CreateClone creates a stream as a clone of opts.Clone.SourceObject. The clone starts at the current offset
+	// of the source stream, so AT and BEFORE are not supported.
+	CreateClone(ctx context.Context, id SchemaObjectIdentifier, opts *CreateStreamCloneOptions) error
+	// Show returns a list of streams.
+	Show(ctx context.Context, opts *ShowStreamOptions) ([]*Stream, error)
+	// ShowByID returns a stream by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Stream, error)
+}
+
+// streams implements Streams.
+type streams struct {
+	client *Client
+}
+
+type CreateStreamCloneOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	stream      bool                   `ddl:"static" sql:"STREAM"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	Clone       *Clone                 `ddl:"-"`
+	CopyGrants  *bool                  `ddl:"keyword" sql:"COPY GRANTS"`
+}
+
+func (opts *CreateStreamCloneOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if !valueSet(opts.Clone) {
+		return errors.New("Clone must be set")
+	}
+	return opts.Clone.validateWithoutTimeTravel(ObjectTypeStream)
+}
+
+func (v *streams) CreateClone(ctx context.Context, id SchemaObjectIdentifier, opts *CreateStreamCloneOptions) error {
+	if opts == nil {
+		opts = &CreateStreamCloneOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowStreamOptions struct {
+	show    bool  `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Terse   *bool `ddl:"keyword" sql:"TERSE"`
+	streams bool  `ddl:"static" sql:"STREAMS"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	In   *In   `ddl:"keyword" sql:"IN"`
+	ShowOptions
+}
+
+func (opts *ShowStreamOptions) validate() error {
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type Stream struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Owner         string
+	Comment       string
+	TableName     string
+	SourceType    string
+	BaseTables    string
+	Type          string
+	Stale         bool
+	Mode          string
+	StaleAfter    time.Time
+	InvalidReason string
+	OwnerRoleType string
+}
+
+func (v *Stream) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Stream) ObjectType() ObjectType {
+	return ObjectTypeStream
+}
+
+type streamRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Owner         sql.NullString `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	TableName     sql.NullString `db:"table_name"`
+	SourceType    sql.NullString `db:"source_type"`
+	BaseTables    sql.NullString `db:"base_tables"`
+	Type          sql.NullString `db:"type"`
+	Stale         sql.NullString `db:"stale"`
+	Mode          sql.NullString `db:"mode"`
+	StaleAfter    sql.NullTime   `db:"stale_after"`
+	InvalidReason sql.NullString `db:"invalid_reason"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+func (row streamRow) toStream() *Stream {
+	return &Stream{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		DatabaseName:  row.DatabaseName,
+		SchemaName:    row.SchemaName,
+		Owner:         row.Owner.String,
+		Comment:       row.Comment.String,
+		TableName:     row.TableName.String,
+		SourceType:    row.SourceType.String,
+		BaseTables:    row.BaseTables.String,
+		Type:          row.Type.String,
+		Stale:         row.Stale.String == "true",
+		Mode:          row.Mode.String,
+		StaleAfter:    row.StaleAfter.Time,
+		InvalidReason: row.InvalidReason.String,
+		OwnerRoleType: row.OwnerRoleType.String,
+	}
+}
+
+func (v *streams) Show(ctx context.Context, opts *ShowStreamOptions) ([]*Stream, error) {
+	if opts == nil {
+		opts = &ShowStreamOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []streamRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Stream, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toStream()
+	}
+	return resultList, nil
+}
+
+func (v *streams) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Stream, error) {
+	streams, err := v.Show(ctx, &ShowStreamOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, stream := range streams {
+		if stream.Name == id.Name() {
+			return stream, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
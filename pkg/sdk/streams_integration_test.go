@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_StreamsCreateClone(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	sourceID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE STREAM %s ON TABLE %s", sourceID.FullyQualifiedName(), tableID.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP STREAM %s", sourceID.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	err = client.Streams.CreateClone(ctx, id, &CreateStreamCloneOptions{
+		Clone:      &Clone{SourceObject: sourceID},
+		CopyGrants: Bool(true),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP STREAM %s", id.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+	stream, err := client.Streams.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, stream.ID())
+
+	streams, err := client.Streams.Show(ctx, &ShowStreamOptions{In: &In{Schema: schema.ID()}})
+	require.NoError(t, err)
+	assert.Len(t, streams, 2)
+}
@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamsCreateClone(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "stream_clone")
+	sourceID := NewSchemaObjectIdentifier("db", "schema", "stream")
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateStreamCloneOptions{
+			OrReplace:  Bool(true),
+			name:       id,
+			Clone:      &Clone{SourceObject: sourceID},
+			CopyGrants: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE STREAM "db"."schema"."stream_clone" CLONE "db"."schema"."stream" COPY GRANTS`, actual)
+	})
+
+	t.Run("validation: time travel", func(t *testing.T) {
+		opts := &CreateStreamCloneOptions{
+			name: id,
+			Clone: &Clone{
+				SourceObject: sourceID,
+				At:           &TimeTravel{Offset: Int(-60)},
+			},
+		}
+		assert.EqualError(t, opts.validate(), "AT and BEFORE are not supported when cloning a stream")
+	})
+
+	t.Run("validation: clone not set", func(t *testing.T) {
+		opts := &CreateStreamCloneOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestStreamsShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&ShowStreamOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW STREAMS`, actual)
+	})
+
+	t.Run("in schema", func(t *testing.T) {
+		opts := &ShowStreamOptions{
+			Terse: Bool(true),
+			Like:  &Like{Pattern: String("my%")},
+			In:    &In{Schema: NewSchemaIdentifier("db", "schema")},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW TERSE STREAMS LIKE 'my%' IN SCHEMA "db"."schema"`, actual)
+	})
+
+	t.Run("in application", func(t *testing.T) {
+		opts := &ShowStreamOptions{
+			In: &In{Application: NewAccountObjectIdentifier("app")},
+			ShowOptions: ShowOptions{
+				StartsWith: String("my"),
+				Limit:      &LimitFrom{Rows: Int(10)},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW STREAMS IN APPLICATION "app" STARTS WITH 'my' LIMIT 10`, actual)
+	})
+
+	t.Run("in application package", func(t *testing.T) {
+		opts := &ShowStreamOptions{
+			In: &In{ApplicationPackage: NewAccountObjectIdentifier("pkg")},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW STREAMS IN APPLICATION PACKAGE "pkg"`, actual)
+	})
+
+	t.Run("validation: more than one scope", func(t *testing.T) {
+		opts := &ShowStreamOptions{
+			In: &In{Database: NewAccountObjectIdentifier("db"), Application: NewAccountObjectIdentifier("app")},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
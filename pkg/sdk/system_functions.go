@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 type SystemFunctions interface {
@@ -19,10 +20,41 @@ func (c *systemFunctions) GetTag(ctx context.Context, tagID ObjectIdentifier, ob
 	s := &struct {
 		Tag string `db:"TAG"`
 	}{}
-	sql := fmt.Sprintf(`SELECT SYSTEM$GET_TAG('%s', '%s', '%v') AS "TAG"`, tagID.FullyQualifiedName(), objectID.FullyQualifiedName(), objectType)
+	sql := fmt.Sprintf(`SELECT SYSTEM$GET_TAG('%s', '%s', '%v') AS "TAG"`, escapeStringLiteral(tagID.FullyQualifiedName()), escapeStringLiteral(objectID.FullyQualifiedName()), objectType)
 	err := c.client.queryOne(ctx, s, sql)
 	if err != nil {
 		return "", err
 	}
 	return s.Tag, nil
 }
+
+// getDDLObjectType returns the object type as accepted by GET_DDL, which uses underscores instead of
+// spaces (e.g. FILE_FORMAT) and a single POLICY type for all kinds of policies.
+func getDDLObjectType(objectType ObjectType) string {
+	if strings.HasSuffix(string(objectType), " POLICY") {
+		return "POLICY"
+	}
+	return strings.ReplaceAll(string(objectType), " ", "_")
+}
+
+// getDDLStatement passes the fully qualified name as a string literal, so single quotes in identifiers
+// have to be escaped.
+func getDDLStatement(objectType ObjectType, id ObjectIdentifier) string {
+	return fmt.Sprintf(`SELECT GET_DDL(%s, %s) AS "DDL"`, SingleQuotes.Modify(getDDLObjectType(objectType)), SingleQuotes.Modify(id.FullyQualifiedName()))
+}
+
+// GetDDL returns the DDL statement that recreates the object, e.g. for drift detection or export.
+// For containers like databases and schemas the DDL contains all objects inside them.
+func (c *Client) GetDDL(ctx context.Context, objectType ObjectType, id ObjectIdentifier) (string, error) {
+	if id == nil || !validObjectidentifier(id) {
+		return "", ErrInvalidObjectIdentifier
+	}
+	s := &struct {
+		DDL string `db:"DDL"`
+	}{}
+	err := c.queryOne(ctx, s, getDDLStatement(objectType, id))
+	if err != nil {
+		return "", err
+	}
+	return s.DDL, nil
+}
@@ -50,3 +50,35 @@ func TestInt_GetTag(t *testing.T) {
 		assert.Equal(t, "", s)
 	})
 }
+
+func TestInt_GetDDL(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, databaseTest, schemaTest)
+	t.Cleanup(tableCleanup)
+
+	t.Run("table", func(t *testing.T) {
+		ddl, err := client.GetDDL(ctx, ObjectTypeTable, tableID)
+		require.NoError(t, err)
+		assert.Contains(t, ddl, "create or replace TABLE")
+		assert.Contains(t, ddl, "EMAIL VARCHAR")
+	})
+
+	t.Run("masking policy", func(t *testing.T) {
+		maskingPolicyTest, maskingPolicyCleanup := createMaskingPolicy(t, client, databaseTest, schemaTest)
+		t.Cleanup(maskingPolicyCleanup)
+
+		ddl, err := client.GetDDL(ctx, ObjectTypeMaskingPolicy, maskingPolicyTest.ID())
+		require.NoError(t, err)
+		assert.Contains(t, ddl, "masking policy")
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		_, err := client.GetDDL(ctx, ObjectTypeTable, NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomString(t)))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,29 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDDLStatement(t *testing.T) {
+	t.Run("schema object", func(t *testing.T) {
+		actual := getDDLStatement(ObjectTypeTable, NewSchemaObjectIdentifier("db", "schema", "table"))
+		assert.Equal(t, `SELECT GET_DDL('TABLE', '"db"."schema"."table"') AS "DDL"`, actual)
+	})
+
+	t.Run("multi-word object type", func(t *testing.T) {
+		actual := getDDLStatement(ObjectTypeGitRepository, NewSchemaObjectIdentifier("db", "schema", "repo"))
+		assert.Equal(t, `SELECT GET_DDL('GIT_REPOSITORY', '"db"."schema"."repo"') AS "DDL"`, actual)
+	})
+
+	t.Run("policy", func(t *testing.T) {
+		actual := getDDLStatement(ObjectTypeMaskingPolicy, NewSchemaObjectIdentifier("db", "schema", "policy"))
+		assert.Equal(t, `SELECT GET_DDL('POLICY', '"db"."schema"."policy"') AS "DDL"`, actual)
+	})
+
+	t.Run("identifier with quotes", func(t *testing.T) {
+		actual := getDDLStatement(ObjectTypeDatabase, NewAccountObjectIdentifier("it's"))
+		assert.Equal(t, `SELECT GET_DDL('DATABASE', '"it\'s"') AS "DDL"`, actual)
+	})
+}
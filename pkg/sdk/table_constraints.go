@@ -0,0 +1,271 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Compile-time proof of interface implementation.
+var _ TableConstraints = (*tableConstraints)(nil)
+
+// TableConstraints manages out-of-line table constraints. Snowflake only enforces NOT NULL,
+// the other constraints are informational unless the table is a hybrid table.
+type TableConstraints interface {
+	// Add adds a PRIMARY KEY, UNIQUE or FOREIGN KEY constraint to a table.
+	Add(ctx context.Context, table SchemaObjectIdentifier, opts *AddTableConstraintOptions) error
+	// Drop removes a constraint from a table, either by name or by its type and columns.
+	Drop(ctx context.Context, table SchemaObjectIdentifier, opts *DropTableConstraintOptions) error
+	// Rename renames a constraint of a table.
+	Rename(ctx context.Context, table SchemaObjectIdentifier, name string, newName string) error
+	// Show returns the constraints of a table from INFORMATION_SCHEMA.TABLE_CONSTRAINTS.
+	Show(ctx context.Context, table SchemaObjectIdentifier) ([]*TableConstraint, error)
+}
+
+// tableConstraints implements TableConstraints.
+type tableConstraints struct {
+	client *Client
+}
+
+type TableConstraintType string
+
+const (
+	TableConstraintTypePrimaryKey TableConstraintType = "PRIMARY KEY"
+	TableConstraintTypeUnique     TableConstraintType = "UNIQUE"
+	TableConstraintTypeForeignKey TableConstraintType = "FOREIGN KEY"
+)
+
+type TableConstraintColumn struct {
+	Name string `ddl:"keyword,double_quotes"`
+}
+
+type TableConstraintReference struct {
+	Table   SchemaObjectIdentifier  `ddl:"identifier"`
+	Columns []TableConstraintColumn `ddl:"keyword,parentheses"`
+}
+
+// TableConstraintProperties are the optional properties of a constraint. At most one of each pair can be set.
+type TableConstraintProperties struct {
+	Enforced           *bool `ddl:"keyword" sql:"ENFORCED"`
+	NotEnforced        *bool `ddl:"keyword" sql:"NOT ENFORCED"`
+	Deferrable         *bool `ddl:"keyword" sql:"DEFERRABLE"`
+	NotDeferrable      *bool `ddl:"keyword" sql:"NOT DEFERRABLE"`
+	InitiallyDeferred  *bool `ddl:"keyword" sql:"INITIALLY DEFERRED"`
+	InitiallyImmediate *bool `ddl:"keyword" sql:"INITIALLY IMMEDIATE"`
+	Enable             *bool `ddl:"keyword" sql:"ENABLE"`
+	Disable            *bool `ddl:"keyword" sql:"DISABLE"`
+	Validate           *bool `ddl:"keyword" sql:"VALIDATE"`
+	NoValidate         *bool `ddl:"keyword" sql:"NOVALIDATE"`
+	Rely               *bool `ddl:"keyword" sql:"RELY"`
+	NoRely             *bool `ddl:"keyword" sql:"NORELY"`
+}
+
+func (v *TableConstraintProperties) validate() error {
+	pairs := [][2]*bool{
+		{v.Enforced, v.NotEnforced},
+		{v.Deferrable, v.NotDeferrable},
+		{v.InitiallyDeferred, v.InitiallyImmediate},
+		{v.Enable, v.Disable},
+		{v.Validate, v.NoValidate},
+		{v.Rely, v.NoRely},
+	}
+	for _, pair := range pairs {
+		if everyValueSet(pair[0], pair[1]) {
+			return errors.New("only one of ENFORCED/NOT ENFORCED, DEFERRABLE/NOT DEFERRABLE, INITIALLY DEFERRED/INITIALLY IMMEDIATE, ENABLE/DISABLE, VALIDATE/NOVALIDATE and RELY/NORELY can be set")
+		}
+	}
+	return nil
+}
+
+// AddTableConstraintOptions contains options for adding a constraint to a table.
+type AddTableConstraintOptions struct {
+	alterTable bool                       `ddl:"static" sql:"ALTER TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	table      SchemaObjectIdentifier     `ddl:"identifier"`
+	add        bool                       `ddl:"static" sql:"ADD"` //lint:ignore U1000 This is used in the ddl tag
+	Name       *string                    `ddl:"parameter,double_quotes,no_equals" sql:"CONSTRAINT"`
+	Type       TableConstraintType        `ddl:"keyword"`
+	Columns    []TableConstraintColumn    `ddl:"keyword,parentheses"`
+	References *TableConstraintReference  `ddl:"keyword" sql:"REFERENCES"`
+	Properties *TableConstraintProperties `ddl:"-"`
+}
+
+func (opts *AddTableConstraintOptions) validate() error {
+	if !validObjectidentifier(opts.table) {
+		return ErrInvalidObjectIdentifier
+	}
+	switch opts.Type {
+	case TableConstraintTypePrimaryKey, TableConstraintTypeUnique:
+		if valueSet(opts.References) {
+			return fmt.Errorf("REFERENCES can only be set for %s constraints", TableConstraintTypeForeignKey)
+		}
+	case TableConstraintTypeForeignKey:
+		if !valueSet(opts.References) || !validObjectidentifier(opts.References.Table) {
+			return fmt.Errorf("REFERENCES must be set for %s constraints", TableConstraintTypeForeignKey)
+		}
+	default:
+		return fmt.Errorf("invalid constraint type %q", opts.Type)
+	}
+	if len(opts.Columns) == 0 {
+		return errors.New("at least one column must be set")
+	}
+	if valueSet(opts.Properties) {
+		if err := opts.Properties.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *tableConstraints) Add(ctx context.Context, table SchemaObjectIdentifier, opts *AddTableConstraintOptions) error {
+	if opts == nil {
+		opts = &AddTableConstraintOptions{}
+	}
+	opts.table = table
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropTableConstraintOptions contains options for dropping a constraint of a table. Exactly one of
+// Name, PrimaryKey, Unique or ForeignKey identifies the constraint.
+type DropTableConstraintOptions struct {
+	alterTable bool                    `ddl:"static" sql:"ALTER TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	table      SchemaObjectIdentifier  `ddl:"identifier"`
+	drop       bool                    `ddl:"static" sql:"DROP"` //lint:ignore U1000 This is used in the ddl tag
+	Name       *string                 `ddl:"parameter,double_quotes,no_equals" sql:"CONSTRAINT" validate:"exactly_one_of=Name,PrimaryKey,Unique,ForeignKey"`
+	PrimaryKey *bool                   `ddl:"keyword" sql:"PRIMARY KEY"`
+	Unique     []TableConstraintColumn `ddl:"keyword,parentheses" sql:"UNIQUE"`
+	ForeignKey []TableConstraintColumn `ddl:"keyword,parentheses" sql:"FOREIGN KEY"`
+	Cascade    *bool                   `ddl:"keyword" sql:"CASCADE" validate:"at_most_one_of=Cascade,Restrict"`
+	Restrict   *bool                   `ddl:"keyword" sql:"RESTRICT"`
+}
+
+func (opts *DropTableConstraintOptions) validate() error {
+	if !validObjectidentifier(opts.table) {
+		return ErrInvalidObjectIdentifier
+	}
+	return validateTags(opts)
+}
+
+func (v *tableConstraints) Drop(ctx context.Context, table SchemaObjectIdentifier, opts *DropTableConstraintOptions) error {
+	if opts == nil {
+		opts = &DropTableConstraintOptions{}
+	}
+	opts.table = table
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type renameTableConstraintOptions struct {
+	alterTable       bool                   `ddl:"static" sql:"ALTER TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	table            SchemaObjectIdentifier `ddl:"identifier"`
+	renameConstraint string                 `ddl:"parameter,double_quotes,no_equals" sql:"RENAME CONSTRAINT"`
+	newName          string                 `ddl:"parameter,double_quotes,no_equals" sql:"TO"`
+}
+
+func (opts *renameTableConstraintOptions) validate() error {
+	if !validObjectidentifier(opts.table) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.renameConstraint == "" || opts.newName == "" {
+		return errors.New("constraint name and new name must be set")
+	}
+	return nil
+}
+
+func (v *tableConstraints) Rename(ctx context.Context, table SchemaObjectIdentifier, name string, newName string) error {
+	opts := &renameTableConstraintOptions{
+		table:            table,
+		renameConstraint: name,
+		newName:          newName,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type TableConstraint struct {
+	Name              string
+	Type              TableConstraintType
+	DatabaseName      string
+	SchemaName        string
+	TableName         string
+	IsDeferrable      bool
+	InitiallyDeferred bool
+	Enforced          bool
+	Rely              bool
+	Comment           string
+}
+
+type tableConstraintRow struct {
+	ConstraintName    string         `db:"CONSTRAINT_NAME"`
+	ConstraintType    string         `db:"CONSTRAINT_TYPE"`
+	TableCatalog      string         `db:"TABLE_CATALOG"`
+	TableSchema       string         `db:"TABLE_SCHEMA"`
+	TableName         string         `db:"TABLE_NAME"`
+	IsDeferrable      sql.NullString `db:"IS_DEFERRABLE"`
+	InitiallyDeferred sql.NullString `db:"INITIALLY_DEFERRED"`
+	Enforced          sql.NullString `db:"ENFORCED"`
+	Rely              sql.NullString `db:"RELY"`
+	Comment           sql.NullString `db:"COMMENT"`
+}
+
+func (row tableConstraintRow) toTableConstraint() *TableConstraint {
+	return &TableConstraint{
+		Name:              row.ConstraintName,
+		Type:              TableConstraintType(row.ConstraintType),
+		DatabaseName:      row.TableCatalog,
+		SchemaName:        row.TableSchema,
+		TableName:         row.TableName,
+		IsDeferrable:      row.IsDeferrable.String == "YES",
+		InitiallyDeferred: row.InitiallyDeferred.String == "YES",
+		Enforced:          row.Enforced.String == "YES",
+		Rely:              row.Rely.String == "YES",
+		Comment:           row.Comment.String,
+	}
+}
+
+// tableConstraintsSQL builds the query for the constraints of a table.
+// The view lives in the information schema of the database of the table.
+func tableConstraintsSQL(table SchemaObjectIdentifier) string {
+	database := NewAccountObjectIdentifier(table.DatabaseName())
+	schemaName := escapeStringLiteral(table.SchemaName())
+	tableName := escapeStringLiteral(table.Name())
+	return fmt.Sprintf(`SELECT * FROM %s.INFORMATION_SCHEMA.TABLE_CONSTRAINTS WHERE TABLE_SCHEMA = '%s' AND TABLE_NAME = '%s' ORDER BY CONSTRAINT_NAME`, database.FullyQualifiedName(), schemaName, tableName)
+}
+
+func (v *tableConstraints) Show(ctx context.Context, table SchemaObjectIdentifier) ([]*TableConstraint, error) {
+	if !validObjectidentifier(table) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	dest := []tableConstraintRow{}
+	err := v.client.query(ctx, &dest, tableConstraintsSQL(table))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*TableConstraint, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTableConstraint()
+	}
+	return resultList, nil
+}
@@ -0,0 +1,77 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_TableConstraints(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	customersID, customersCleanup := createTable(t, client, database, schema)
+	t.Cleanup(customersCleanup)
+
+	ordersID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE TABLE %s (ID NUMBER, CUSTOMER_ID NUMBER)", ordersID.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP TABLE %s", ordersID.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	err = client.TableConstraints.Add(ctx, customersID, &AddTableConstraintOptions{
+		Name:    String("CUSTOMERS_PK"),
+		Type:    TableConstraintTypePrimaryKey,
+		Columns: []TableConstraintColumn{{Name: "ID"}},
+	})
+	require.NoError(t, err)
+	err = client.TableConstraints.Add(ctx, ordersID, &AddTableConstraintOptions{
+		Name:    String("ORDERS_CUSTOMERS_FK"),
+		Type:    TableConstraintTypeForeignKey,
+		Columns: []TableConstraintColumn{{Name: "CUSTOMER_ID"}},
+		References: &TableConstraintReference{
+			Table:   customersID,
+			Columns: []TableConstraintColumn{{Name: "ID"}},
+		},
+		Properties: &TableConstraintProperties{Rely: Bool(true)},
+	})
+	require.NoError(t, err)
+
+	t.Run("show", func(t *testing.T) {
+		constraints, err := client.TableConstraints.Show(ctx, ordersID)
+		require.NoError(t, err)
+		require.Len(t, constraints, 1)
+		assert.Equal(t, "ORDERS_CUSTOMERS_FK", constraints[0].Name)
+		assert.Equal(t, TableConstraintTypeForeignKey, constraints[0].Type)
+		assert.True(t, constraints[0].Rely)
+		assert.False(t, constraints[0].Enforced)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		err := client.TableConstraints.Rename(ctx, ordersID, "ORDERS_CUSTOMERS_FK", "ORDERS_FK")
+		require.NoError(t, err)
+		constraints, err := client.TableConstraints.Show(ctx, ordersID)
+		require.NoError(t, err)
+		require.Len(t, constraints, 1)
+		assert.Equal(t, "ORDERS_FK", constraints[0].Name)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		err := client.TableConstraints.Drop(ctx, ordersID, &DropTableConstraintOptions{Name: String("ORDERS_FK")})
+		require.NoError(t, err)
+		err = client.TableConstraints.Drop(ctx, customersID, &DropTableConstraintOptions{PrimaryKey: Bool(true)})
+		require.NoError(t, err)
+		constraints, err := client.TableConstraints.Show(ctx, customersID)
+		require.NoError(t, err)
+		assert.Empty(t, constraints)
+	})
+}
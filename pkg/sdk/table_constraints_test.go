@@ -0,0 +1,197 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableConstraintsAdd(t *testing.T) {
+	table := NewSchemaObjectIdentifier("db", "schema", "orders")
+
+	t.Run("primary key", func(t *testing.T) {
+		opts := &AddTableConstraintOptions{
+			table:   table,
+			Type:    TableConstraintTypePrimaryKey,
+			Columns: []TableConstraintColumn{{Name: "id"}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."orders" ADD PRIMARY KEY ("id")`, actual)
+	})
+
+	t.Run("unique with name and properties", func(t *testing.T) {
+		opts := &AddTableConstraintOptions{
+			table:   table,
+			Name:    String("orders_uq"),
+			Type:    TableConstraintTypeUnique,
+			Columns: []TableConstraintColumn{{Name: "customer_id"}, {Name: "order_number"}},
+			Properties: &TableConstraintProperties{
+				NotEnforced:   Bool(true),
+				NotDeferrable: Bool(true),
+				Rely:          Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."orders" ADD CONSTRAINT "orders_uq" UNIQUE ("customer_id", "order_number") NOT ENFORCED NOT DEFERRABLE RELY`, actual)
+	})
+
+	t.Run("foreign key", func(t *testing.T) {
+		opts := &AddTableConstraintOptions{
+			table:   table,
+			Name:    String("orders_customers_fk"),
+			Type:    TableConstraintTypeForeignKey,
+			Columns: []TableConstraintColumn{{Name: "customer_id"}},
+			References: &TableConstraintReference{
+				Table:   NewSchemaObjectIdentifier("db", "schema", "customers"),
+				Columns: []TableConstraintColumn{{Name: "id"}},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."orders" ADD CONSTRAINT "orders_customers_fk" FOREIGN KEY ("customer_id") REFERENCES "db"."schema"."customers" ("id")`, actual)
+	})
+
+	t.Run("validation: foreign key without references", func(t *testing.T) {
+		opts := &AddTableConstraintOptions{
+			table:   table,
+			Type:    TableConstraintTypeForeignKey,
+			Columns: []TableConstraintColumn{{Name: "customer_id"}},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: references for primary key", func(t *testing.T) {
+		opts := &AddTableConstraintOptions{
+			table:   table,
+			Type:    TableConstraintTypePrimaryKey,
+			Columns: []TableConstraintColumn{{Name: "id"}},
+			References: &TableConstraintReference{
+				Table: NewSchemaObjectIdentifier("db", "schema", "customers"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no type", func(t *testing.T) {
+		opts := &AddTableConstraintOptions{
+			table:   table,
+			Columns: []TableConstraintColumn{{Name: "id"}},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no columns", func(t *testing.T) {
+		opts := &AddTableConstraintOptions{
+			table: table,
+			Type:  TableConstraintTypeUnique,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: conflicting properties", func(t *testing.T) {
+		opts := &AddTableConstraintOptions{
+			table:      table,
+			Type:       TableConstraintTypeUnique,
+			Columns:    []TableConstraintColumn{{Name: "id"}},
+			Properties: &TableConstraintProperties{Rely: Bool(true), NoRely: Bool(true)},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestTableConstraintsDrop(t *testing.T) {
+	table := NewSchemaObjectIdentifier("db", "schema", "orders")
+
+	t.Run("by name", func(t *testing.T) {
+		opts := &DropTableConstraintOptions{
+			table:   table,
+			Name:    String("orders_uq"),
+			Cascade: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."orders" DROP CONSTRAINT "orders_uq" CASCADE`, actual)
+	})
+
+	t.Run("primary key", func(t *testing.T) {
+		opts := &DropTableConstraintOptions{
+			table:      table,
+			PrimaryKey: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."orders" DROP PRIMARY KEY`, actual)
+	})
+
+	t.Run("unique by columns", func(t *testing.T) {
+		opts := &DropTableConstraintOptions{
+			table:  table,
+			Unique: []TableConstraintColumn{{Name: "customer_id"}, {Name: "order_number"}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."orders" DROP UNIQUE ("customer_id", "order_number")`, actual)
+	})
+
+	t.Run("validation: nothing to drop", func(t *testing.T) {
+		opts := &DropTableConstraintOptions{
+			table: table,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: cascade and restrict", func(t *testing.T) {
+		opts := &DropTableConstraintOptions{
+			table:      table,
+			PrimaryKey: Bool(true),
+			Cascade:    Bool(true),
+			Restrict:   Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestTableConstraintsRename(t *testing.T) {
+	opts := &renameTableConstraintOptions{
+		table:            NewSchemaObjectIdentifier("db", "schema", "orders"),
+		renameConstraint: "orders_uq",
+		newName:          "orders_number_uq",
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `ALTER TABLE "db"."schema"."orders" RENAME CONSTRAINT "orders_uq" TO "orders_number_uq"`, actual)
+}
+
+func TestTableConstraintsSQL(t *testing.T) {
+	actual := tableConstraintsSQL(NewSchemaObjectIdentifier("db", "schema", "o'rders"))
+	assert.Equal(t, `SELECT * FROM "db".INFORMATION_SCHEMA.TABLE_CONSTRAINTS WHERE TABLE_SCHEMA = 'schema' AND TABLE_NAME = 'o\'rders' ORDER BY CONSTRAINT_NAME`, actual)
+}
+
+func TestTableConstraintRow(t *testing.T) {
+	row := tableConstraintRow{
+		ConstraintName: "ORDERS_UQ",
+		ConstraintType: "UNIQUE",
+		TableCatalog:   "DB",
+		TableSchema:    "SCHEMA",
+		TableName:      "ORDERS",
+		IsDeferrable:   sql.NullString{String: "NO", Valid: true},
+		Enforced:       sql.NullString{String: "NO", Valid: true},
+		Rely:           sql.NullString{String: "YES", Valid: true},
+	}
+	constraint := row.toTableConstraint()
+	assert.Equal(t, TableConstraintTypeUnique, constraint.Type)
+	assert.False(t, constraint.IsDeferrable)
+	assert.False(t, constraint.Enforced)
+	assert.True(t, constraint.Rely)
+}
@@ -0,0 +1,516 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// Compile-time proof of interface implementation.
+var _ Tables = (*tables)(nil)
+
+// Tables describes all the table related methods that the Snowflake API supports.
+type Tables interface {
+	// CreateClone creates a table as a clone of opts.Clone.SourceObject, optionally at or before a point in time.
+	CreateClone(ctx context.Context, id SchemaObjectIdentifier, opts *CreateTableCloneOptions) error
+	// Alter modifies an existing table.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterTableOptions) error
+	// Undrop restores the most recent version of a dropped table.
+	Undrop(ctx context.Context, id SchemaObjectIdentifier) error
+	// Show returns a list of tables.
+	Show(ctx context.Context, opts *ShowTableOptions) ([]*Table, error)
+	// ShowByID returns a table by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error)
+	// ShowTerse returns a list of tables with only the columns of SHOW TERSE TABLES.
+	ShowTerse(ctx context.Context, opts *ShowTableOptions) ([]*TerseTable, error)
+	// DescribeSearchOptimization returns the search access paths of a table.
+	DescribeSearchOptimization(ctx context.Context, id SchemaObjectIdentifier) ([]*TableSearchOptimizationDetails, error)
+}
+
+// tables implements Tables.
+type tables struct {
+	client *Client
+}
+
+type CreateTableCloneOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	table       bool                   `ddl:"static" sql:"TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	Clone       *Clone                 `ddl:"-"`
+	CopyGrants  *bool                  `ddl:"keyword" sql:"COPY GRANTS"`
+}
+
+func (opts *CreateTableCloneOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if !valueSet(opts.Clone) {
+		return errors.New("Clone must be set")
+	}
+	return opts.Clone.validate()
+}
+
+func (v *tables) CreateClone(ctx context.Context, id SchemaObjectIdentifier, opts *CreateTableCloneOptions) error {
+	if opts == nil {
+		opts = &CreateTableCloneOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterTableOptions contains options for altering a table. Exactly one action can be set.
+// The elements of ClusterBy are column names or expressions and are rendered as is.
+type AlterTableOptions struct {
+	alter                  bool                     `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	table                  bool                     `ddl:"static" sql:"TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists               *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name                   SchemaObjectIdentifier   `ddl:"identifier"`
+	NewName                SchemaObjectIdentifier   `ddl:"identifier" sql:"RENAME TO"`
+	SwapWith               SchemaObjectIdentifier   `ddl:"identifier" sql:"SWAP WITH"`
+	Set                    *TableSet                `ddl:"keyword" sql:"SET"`
+	Unset                  *TableUnset              `ddl:"list,no_parentheses" sql:"UNSET"`
+	AddSearchOptimization  *TableSearchOptimization `ddl:"keyword" sql:"ADD SEARCH OPTIMIZATION"`
+	DropSearchOptimization *TableSearchOptimization `ddl:"keyword" sql:"DROP SEARCH OPTIMIZATION"`
+	ClusterBy              []string                 `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	DropClusteringKey      *bool                    `ddl:"keyword" sql:"DROP CLUSTERING KEY"`
+	ResumeRecluster        *bool                    `ddl:"keyword" sql:"RESUME RECLUSTER"`
+	SuspendRecluster       *bool                    `ddl:"keyword" sql:"SUSPEND RECLUSTER"`
+}
+
+func (opts *AlterTableOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.SwapWith, opts.Set, opts.Unset, opts.AddSearchOptimization, opts.DropSearchOptimization, opts.ClusterBy, opts.DropClusteringKey, opts.ResumeRecluster, opts.SuspendRecluster) {
+		return errors.New("exactly one action must be set")
+	}
+	if valueSet(opts.AddSearchOptimization) {
+		if err := opts.AddSearchOptimization.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.DropSearchOptimization) {
+		if err := opts.DropSearchOptimization.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type TableSet struct {
+	DataRetentionTimeInDays *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	ChangeTracking          *bool   `ddl:"parameter" sql:"CHANGE_TRACKING"`
+	Comment                 *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type TableUnset struct {
+	DataRetentionTimeInDays *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	ChangeTracking          *bool `ddl:"keyword" sql:"CHANGE_TRACKING"`
+	Comment                 *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// TableProperties is the state of the alterable properties of a table. A nil field stands for a property that
+// is not set. Columns and constraints are not part of it, see TableConstraints for the latter.
+type TableProperties struct {
+	Name SchemaObjectIdentifier
+	// ClusterBy are the clustering key expressions, the table has no clustering key when it is empty.
+	ClusterBy               []string
+	DataRetentionTimeInDays *int
+	ChangeTracking          *bool
+	Comment                 *string
+}
+
+// ComputeTableAlterations returns the statements that alter a table from the current to the desired state:
+// a rename, followed by a change of the clustering key, a single SET of all changed properties and a single
+// UNSET of all properties that are no longer set.
+func ComputeTableAlterations(current *TableProperties, desired *TableProperties) []Statement {
+	var statements []Statement
+	id := current.Name
+	if desired.Name.Name() != "" && desired.Name.FullyQualifiedName() != id.FullyQualifiedName() {
+		statements = append(statements, &AlterTableOptions{name: id, NewName: desired.Name})
+		id = desired.Name
+	}
+	if !slices.Equal(current.ClusterBy, desired.ClusterBy) {
+		if len(desired.ClusterBy) > 0 {
+			statements = append(statements, &AlterTableOptions{name: id, ClusterBy: desired.ClusterBy})
+		} else {
+			statements = append(statements, &AlterTableOptions{name: id, DropClusteringKey: Bool(true)})
+		}
+	}
+
+	var alterations propertyAlterations
+	set := &TableSet{}
+	unset := &TableUnset{}
+	alterations.alter(propertyChanged(current.DataRetentionTimeInDays, desired.DataRetentionTimeInDays), desired.DataRetentionTimeInDays != nil, func() { set.DataRetentionTimeInDays = desired.DataRetentionTimeInDays }, &unset.DataRetentionTimeInDays)
+	alterations.alter(propertyChanged(current.ChangeTracking, desired.ChangeTracking), desired.ChangeTracking != nil, func() { set.ChangeTracking = desired.ChangeTracking }, &unset.ChangeTracking)
+	alterations.alter(propertyChanged(current.Comment, desired.Comment), desired.Comment != nil, func() { set.Comment = desired.Comment }, &unset.Comment)
+
+	if alterations.runSet {
+		statements = append(statements, &AlterTableOptions{name: id, Set: set})
+	}
+	if alterations.runUnset {
+		statements = append(statements, &AlterTableOptions{name: id, Unset: unset})
+	}
+	return statements
+}
+
+type SearchOptimizationMethod string
+
+const (
+	SearchOptimizationMethodEquality  SearchOptimizationMethod = "EQUALITY"
+	SearchOptimizationMethodSubstring SearchOptimizationMethod = "SUBSTRING"
+	SearchOptimizationMethodGeo       SearchOptimizationMethod = "GEO"
+)
+
+// TableSearchOptimization adds or drops search optimization. Without On it applies to the whole table.
+type TableSearchOptimization struct {
+	On []SearchOptimizationOn `ddl:"keyword" sql:"ON"`
+}
+
+func (v *TableSearchOptimization) validate() error {
+	for _, on := range v.On {
+		if on.Method == "" {
+			return errors.New("search optimization method must be set")
+		}
+		if len(on.Columns) == 0 {
+			return fmt.Errorf("at least one column must be set for search optimization method %s", on.Method)
+		}
+	}
+	return nil
+}
+
+// SearchOptimizationOn is a search method applied to columns, e.g. EQUALITY(c1, c2).
+// Columns are rendered as is, so they can also be VARIANT paths like c1:user.id or *.
+type SearchOptimizationOn struct {
+	Method  SearchOptimizationMethod `ddl:"keyword"`
+	Columns []string                 `ddl:"keyword,parentheses"`
+}
+
+func (v *tables) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterTableOptions) error {
+	if opts == nil {
+		opts = &AlterTableOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type undropTableOptions struct {
+	undrop bool                   `ddl:"static" sql:"UNDROP"` //lint:ignore U1000 This is used in the ddl tag
+	table  bool                   `ddl:"static" sql:"TABLE"`  //lint:ignore U1000 This is used in the ddl tag
+	name   SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *undropTableOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *tables) Undrop(ctx context.Context, id SchemaObjectIdentifier) error {
+	opts := &undropTableOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type ShowTableOptions struct {
+	show    bool  `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Terse   *bool `ddl:"keyword" sql:"TERSE"`
+	tables  bool  `ddl:"static" sql:"TABLES"` //lint:ignore U1000 This is used in the ddl tag
+	History *bool `ddl:"keyword" sql:"HISTORY"`
+	Like    *Like `ddl:"keyword" sql:"LIKE"`
+	In      *In   `ddl:"keyword" sql:"IN"`
+	ShowOptions
+}
+
+func (opts *ShowTableOptions) validate() error {
+	return nil
+}
+
+type Table struct {
+	CreatedOn                  time.Time
+	Name                       string
+	DatabaseName               string
+	SchemaName                 string
+	Kind                       string
+	Comment                    string
+	ClusterBy                  string
+	ClusteringKey              []string // The expressions of ClusterBy, e.g. [ID, SUBSTRING(EMAIL, 1, 3)].
+	Rows                       int
+	Bytes                      int
+	Owner                      string
+	RetentionTime              int
+	AutomaticClustering        bool
+	ChangeTracking             bool
+	SearchOptimization         bool
+	SearchOptimizationProgress int
+	SearchOptimizationBytes    int
+	IsExternal                 bool
+	OwnerRoleType              string
+}
+
+func (v *Table) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Table) ObjectType() ObjectType {
+	return ObjectTypeTable
+}
+
+type tableRow struct {
+	CreatedOn                  time.Time      `db:"created_on"`
+	Name                       string         `db:"name"`
+	DatabaseName               string         `db:"database_name"`
+	SchemaName                 string         `db:"schema_name"`
+	Kind                       sql.NullString `db:"kind"`
+	Comment                    sql.NullString `db:"comment"`
+	ClusterBy                  sql.NullString `db:"cluster_by"`
+	Rows                       sql.NullInt64  `db:"rows"`
+	Bytes                      sql.NullInt64  `db:"bytes"`
+	Owner                      sql.NullString `db:"owner"`
+	RetentionTime              sql.NullInt64  `db:"retention_time"`
+	AutomaticClustering        sql.NullString `db:"automatic_clustering"`
+	ChangeTracking             sql.NullString `db:"change_tracking"`
+	SearchOptimization         sql.NullString `db:"search_optimization"`
+	SearchOptimizationProgress sql.NullInt64  `db:"search_optimization_progress"`
+	SearchOptimizationBytes    sql.NullInt64  `db:"search_optimization_bytes"`
+	IsExternal                 sql.NullString `db:"is_external"`
+	OwnerRoleType              sql.NullString `db:"owner_role_type"`
+}
+
+func (row tableRow) toTable() *Table {
+	return &Table{
+		CreatedOn:                  row.CreatedOn,
+		Name:                       row.Name,
+		DatabaseName:               row.DatabaseName,
+		SchemaName:                 row.SchemaName,
+		Kind:                       row.Kind.String,
+		Comment:                    row.Comment.String,
+		ClusterBy:                  row.ClusterBy.String,
+		ClusteringKey:              parseClusteringKey(row.ClusterBy.String),
+		Rows:                       int(row.Rows.Int64),
+		Bytes:                      int(row.Bytes.Int64),
+		Owner:                      row.Owner.String,
+		RetentionTime:              int(row.RetentionTime.Int64),
+		AutomaticClustering:        row.AutomaticClustering.String == "ON",
+		ChangeTracking:             row.ChangeTracking.String == "ON",
+		SearchOptimization:         row.SearchOptimization.String == "ON",
+		SearchOptimizationProgress: int(row.SearchOptimizationProgress.Int64),
+		SearchOptimizationBytes:    int(row.SearchOptimizationBytes.Int64),
+		IsExternal:                 row.IsExternal.String == "Y",
+		OwnerRoleType:              row.OwnerRoleType.String,
+	}
+}
+
+type TerseTable struct {
+	CreatedOn    time.Time
+	Name         string
+	Kind         string
+	DatabaseName string
+	SchemaName   string
+}
+
+func (v *TerseTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (row terseRow) toTerseTable() *TerseTable {
+	return &TerseTable{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		Kind:         row.Kind.String,
+		DatabaseName: row.DatabaseName.String,
+		SchemaName:   row.SchemaName.String,
+	}
+}
+
+// parseClusteringKey splits a cluster_by value, e.g. LINEAR(ID, SUBSTRING(EMAIL, 1, 3)), into its expressions.
+// Commas inside parentheses or quotes do not separate expressions.
+func parseClusteringKey(raw string) []string {
+	value := strings.TrimSpace(raw)
+	if len(value) > len("LINEAR(") && strings.EqualFold(value[:len("LINEAR(")], "LINEAR(") && strings.HasSuffix(value, ")") {
+		value = value[len("LINEAR(") : len(value)-1]
+	}
+	if value == "" {
+		return nil
+	}
+	var expressions []string
+	var current strings.Builder
+	depth := 0
+	var quote rune
+	for _, r := range value {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			expressions = append(expressions, strings.TrimSpace(current.String()))
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	return append(expressions, strings.TrimSpace(current.String()))
+}
+
+func (v *tables) Show(ctx context.Context, opts *ShowTableOptions) ([]*Table, error) {
+	if opts == nil {
+		opts = &ShowTableOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []tableRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Table, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTable()
+	}
+	return resultList, nil
+}
+
+func (v *tables) ShowTerse(ctx context.Context, opts *ShowTableOptions) ([]*TerseTable, error) {
+	if opts == nil {
+		opts = &ShowTableOptions{}
+	}
+	opts.Terse = Bool(true)
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []terseRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*TerseTable, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTerseTable()
+	}
+	return resultList, nil
+}
+
+func (v *tables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error) {
+	tables, err := v.Show(ctx, &ShowTableOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		if table.Name == id.Name() {
+			return table, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeSearchOptimizationOptions struct {
+	describe           bool                   `ddl:"static" sql:"DESCRIBE"`               //lint:ignore U1000 This is used in the ddl tag
+	searchOptimization bool                   `ddl:"static" sql:"SEARCH OPTIMIZATION ON"` //lint:ignore U1000 This is used in the ddl tag
+	name               SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeSearchOptimizationOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// TableSearchOptimizationDetails is a search access path, e.g. the EQUALITY method on column C1.
+type TableSearchOptimizationDetails struct {
+	ExpressionID   int
+	Method         SearchOptimizationMethod
+	Target         string
+	TargetDataType string
+	Active         bool
+}
+
+type tableSearchOptimizationDetailsRow struct {
+	ExpressionID   int            `db:"expression_id"`
+	Method         string         `db:"method"`
+	Target         string         `db:"target"`
+	TargetDataType sql.NullString `db:"target_data_type"`
+	Active         sql.NullBool   `db:"active"`
+}
+
+func (row tableSearchOptimizationDetailsRow) toTableSearchOptimizationDetails() *TableSearchOptimizationDetails {
+	return &TableSearchOptimizationDetails{
+		ExpressionID:   row.ExpressionID,
+		Method:         SearchOptimizationMethod(row.Method),
+		Target:         row.Target,
+		TargetDataType: row.TargetDataType.String,
+		Active:         row.Active.Bool,
+	}
+}
+
+func (v *tables) DescribeSearchOptimization(ctx context.Context, id SchemaObjectIdentifier) ([]*TableSearchOptimizationDetails, error) {
+	opts := &describeSearchOptimizationOptions{
+		name: id,
+	}
+	dest, err := describeRows[tableSearchOptimizationDetailsRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*TableSearchOptimizationDetails, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTableSearchOptimizationDetails()
+	}
+	return resultList, nil
+}
@@ -0,0 +1,161 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_TablesUndrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	_, err := client.exec(ctx, fmt.Sprintf("DROP TABLE %s", tableID.FullyQualifiedName()))
+	require.NoError(t, err)
+	err = client.Tables.Undrop(ctx, tableID)
+	require.NoError(t, err)
+	_, err = client.exec(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s", tableID.FullyQualifiedName()))
+	require.NoError(t, err)
+}
+
+func TestInt_TablesCreateClone(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	sourceID, sourceCleanup := createTable(t, client, database, schema)
+	t.Cleanup(sourceCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	err := client.Tables.CreateClone(ctx, id, &CreateTableCloneOptions{
+		Clone: &Clone{
+			SourceObject: sourceID,
+			At:           &TimeTravel{Offset: Int(0)},
+		},
+		CopyGrants: Bool(true),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP TABLE %s", id.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+	_, err = client.exec(ctx, fmt.Sprintf("SELECT ID, EMAIL FROM %s", id.FullyQualifiedName()))
+	require.NoError(t, err)
+}
+
+func TestInt_TablesAlterSwapWith(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	stagingID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE TABLE %s (ID NUMBER)", stagingID.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP TABLE %s", stagingID.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	err = client.Tables.Alter(ctx, tableID, &AlterTableOptions{SwapWith: stagingID})
+	require.NoError(t, err)
+	// After the swap the original name refers to the table without the EMAIL column.
+	_, err = client.exec(ctx, fmt.Sprintf("SELECT EMAIL FROM %s", tableID.FullyQualifiedName()))
+	require.Error(t, err)
+	_, err = client.exec(ctx, fmt.Sprintf("SELECT EMAIL FROM %s", stagingID.FullyQualifiedName()))
+	require.NoError(t, err)
+}
+
+func TestInt_TablesSearchOptimization(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	err := client.Tables.Alter(ctx, tableID, &AlterTableOptions{
+		AddSearchOptimization: &TableSearchOptimization{
+			On: []SearchOptimizationOn{
+				{Method: SearchOptimizationMethodEquality, Columns: []string{"ID"}},
+				{Method: SearchOptimizationMethodSubstring, Columns: []string{"EMAIL"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	table, err := client.Tables.ShowByID(ctx, tableID)
+	require.NoError(t, err)
+	assert.True(t, table.SearchOptimization)
+
+	details, err := client.Tables.DescribeSearchOptimization(ctx, tableID)
+	require.NoError(t, err)
+	require.Len(t, details, 2)
+	methods := []SearchOptimizationMethod{details[0].Method, details[1].Method}
+	assert.ElementsMatch(t, []SearchOptimizationMethod{SearchOptimizationMethodEquality, SearchOptimizationMethodSubstring}, methods)
+
+	err = client.Tables.Alter(ctx, tableID, &AlterTableOptions{
+		DropSearchOptimization: &TableSearchOptimization{},
+	})
+	require.NoError(t, err)
+	table, err = client.Tables.ShowByID(ctx, tableID)
+	require.NoError(t, err)
+	assert.False(t, table.SearchOptimization)
+}
+
+func TestInt_TablesClustering(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	err := client.Tables.Alter(ctx, tableID, &AlterTableOptions{
+		ClusterBy: []string{"ID", "SUBSTRING(EMAIL, 1, 3)"},
+	})
+	require.NoError(t, err)
+	table, err := client.Tables.ShowByID(ctx, tableID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ID", "SUBSTRING(EMAIL, 1, 3)"}, table.ClusteringKey)
+	assert.True(t, table.AutomaticClustering)
+
+	err = client.Tables.Alter(ctx, tableID, &AlterTableOptions{SuspendRecluster: Bool(true)})
+	require.NoError(t, err)
+	table, err = client.Tables.ShowByID(ctx, tableID)
+	require.NoError(t, err)
+	assert.False(t, table.AutomaticClustering)
+
+	err = client.Tables.Alter(ctx, tableID, &AlterTableOptions{ResumeRecluster: Bool(true)})
+	require.NoError(t, err)
+
+	err = client.Tables.Alter(ctx, tableID, &AlterTableOptions{DropClusteringKey: Bool(true)})
+	require.NoError(t, err)
+	table, err = client.Tables.ShowByID(ctx, tableID)
+	require.NoError(t, err)
+	assert.Empty(t, table.ClusteringKey)
+}
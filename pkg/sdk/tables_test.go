@@ -0,0 +1,385 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTablesUndrop(t *testing.T) {
+	t.Run("minimal", func(t *testing.T) {
+		opts := &undropTableOptions{
+			name: NewSchemaObjectIdentifier("db", "schema", "table"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `UNDROP TABLE "db"."schema"."table"`, actual)
+	})
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &undropTableOptions{
+			name: NewSchemaObjectIdentifier("", "", ""),
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestTablesCreateClone(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "table_clone")
+	sourceID := NewSchemaObjectIdentifier("db", "schema", "table")
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreateTableCloneOptions{
+			name:  id,
+			Clone: &Clone{SourceObject: sourceID},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE TABLE "db"."schema"."table_clone" CLONE "db"."schema"."table"`, actual)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateTableCloneOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			Clone: &Clone{
+				SourceObject: sourceID,
+				At:           &TimeTravel{Statement: String("8e5d0ca9-005e-44e6-b858-a8f5b37c5726")},
+			},
+			CopyGrants: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE OR REPLACE TABLE "db"."schema"."table_clone" CLONE "db"."schema"."table" AT (STATEMENT => '8e5d0ca9-005e-44e6-b858-a8f5b37c5726') COPY GRANTS`, actual)
+	})
+
+	t.Run("validation: clone not set", func(t *testing.T) {
+		opts := &CreateTableCloneOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: invalid source", func(t *testing.T) {
+		opts := &CreateTableCloneOptions{
+			name:  id,
+			Clone: &Clone{},
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: at and before", func(t *testing.T) {
+		opts := &CreateTableCloneOptions{
+			name: id,
+			Clone: &Clone{
+				SourceObject: sourceID,
+				At:           &TimeTravel{Offset: Int(-60)},
+				Before:       &TimeTravel{Offset: Int(-60)},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateTableCloneOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        id,
+			Clone:       &Clone{SourceObject: sourceID},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestTablesAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "table")
+
+	t.Run("swap with", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name:     id,
+			SwapWith: NewSchemaObjectIdentifier("db", "schema", "table_staging"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" SWAP WITH "db"."schema"."table_staging"`, actual)
+	})
+
+	t.Run("add search optimization", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name:                  id,
+			AddSearchOptimization: &TableSearchOptimization{},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" ADD SEARCH OPTIMIZATION`, actual)
+	})
+
+	t.Run("add search optimization on columns", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name: id,
+			AddSearchOptimization: &TableSearchOptimization{
+				On: []SearchOptimizationOn{
+					{Method: SearchOptimizationMethodEquality, Columns: []string{"c1", "c2"}},
+					{Method: SearchOptimizationMethodSubstring, Columns: []string{"c3:user.name"}},
+					{Method: SearchOptimizationMethodGeo, Columns: []string{"c4"}},
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" ADD SEARCH OPTIMIZATION ON EQUALITY (c1, c2), SUBSTRING (c3:user.name), GEO (c4)`, actual)
+	})
+
+	t.Run("drop search optimization on columns", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			IfExists: Bool(true),
+			name:     id,
+			DropSearchOptimization: &TableSearchOptimization{
+				On: []SearchOptimizationOn{
+					{Method: SearchOptimizationMethodEquality, Columns: []string{"*"}},
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE IF EXISTS "db"."schema"."table" DROP SEARCH OPTIMIZATION ON EQUALITY (*)`, actual)
+	})
+
+	t.Run("cluster by", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name:      id,
+			ClusterBy: []string{"ID", "SUBSTRING(EMAIL, 1, 3)"},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" CLUSTER BY (ID, SUBSTRING(EMAIL, 1, 3))`, actual)
+	})
+
+	t.Run("drop clustering key", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name:              id,
+			DropClusteringKey: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" DROP CLUSTERING KEY`, actual)
+	})
+
+	t.Run("resume recluster", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name:            id,
+			ResumeRecluster: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" RESUME RECLUSTER`, actual)
+	})
+
+	t.Run("suspend recluster", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name:             id,
+			SuspendRecluster: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE "db"."schema"."table" SUSPEND RECLUSTER`, actual)
+	})
+
+	t.Run("validation: cluster by and drop clustering key", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name:              id,
+			ClusterBy:         []string{"ID"},
+			DropClusteringKey: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: more than one action", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name:                   id,
+			AddSearchOptimization:  &TableSearchOptimization{},
+			DropSearchOptimization: &TableSearchOptimization{},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: search optimization method without columns", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name: id,
+			AddSearchOptimization: &TableSearchOptimization{
+				On: []SearchOptimizationOn{{Method: SearchOptimizationMethodEquality}},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &AlterTableOptions{
+			name:     NewSchemaObjectIdentifier("", "", ""),
+			SwapWith: NewSchemaObjectIdentifier("db", "schema", "table_staging"),
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestTablesShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&ShowTableOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW TABLES`, actual)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		opts := &ShowTableOptions{
+			History: Bool(true),
+			Like:    &Like{Pattern: String("events%")},
+			In:      &In{Schema: NewSchemaIdentifier("db", "schema")},
+			ShowOptions: ShowOptions{
+				StartsWith: String("ev"),
+				Limit:      &LimitFrom{Rows: Int(10), From: String("events_1")},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW TABLES HISTORY LIKE 'events%' IN SCHEMA "db"."schema" STARTS WITH 'ev' LIMIT 10 FROM 'events_1'`, actual)
+	})
+
+	t.Run("terse", func(t *testing.T) {
+		opts := &ShowTableOptions{
+			Terse: Bool(true),
+			In:    &In{Database: NewAccountObjectIdentifier("db")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW TERSE TABLES IN DATABASE "db"`, actual)
+	})
+}
+
+func TestTerseTableRow(t *testing.T) {
+	row := terseRow{
+		Name:         "EVENTS",
+		Kind:         sql.NullString{String: "TABLE", Valid: true},
+		DatabaseName: sql.NullString{String: "DB", Valid: true},
+		SchemaName:   sql.NullString{String: "SCHEMA", Valid: true},
+	}
+	table := row.toTerseTable()
+	assert.Equal(t, "TABLE", table.Kind)
+	assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "EVENTS"), table.ID())
+}
+
+func TestTableRow(t *testing.T) {
+	row := tableRow{
+		Name:                       "EVENTS",
+		DatabaseName:               "DB",
+		SchemaName:                 "SCHEMA",
+		Kind:                       sql.NullString{String: "TABLE", Valid: true},
+		Rows:                       sql.NullInt64{Int64: 42, Valid: true},
+		AutomaticClustering:        sql.NullString{String: "OFF", Valid: true},
+		ChangeTracking:             sql.NullString{String: "ON", Valid: true},
+		SearchOptimization:         sql.NullString{String: "ON", Valid: true},
+		SearchOptimizationProgress: sql.NullInt64{Int64: 100, Valid: true},
+		IsExternal:                 sql.NullString{String: "N", Valid: true},
+		ClusterBy:                  sql.NullString{String: "LINEAR(ID, EMAIL)", Valid: true},
+	}
+	table := row.toTable()
+	assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "EVENTS"), table.ID())
+	assert.Equal(t, 42, table.Rows)
+	assert.False(t, table.AutomaticClustering)
+	assert.True(t, table.ChangeTracking)
+	assert.True(t, table.SearchOptimization)
+	assert.Equal(t, 100, table.SearchOptimizationProgress)
+	assert.False(t, table.IsExternal)
+	assert.Equal(t, []string{"ID", "EMAIL"}, table.ClusteringKey)
+}
+
+func TestParseClusteringKey(t *testing.T) {
+	testCases := []struct {
+		raw      string
+		expected []string
+	}{
+		{raw: "", expected: nil},
+		{raw: "LINEAR(ID)", expected: []string{"ID"}},
+		{raw: "LINEAR(ID, EMAIL)", expected: []string{"ID", "EMAIL"}},
+		{raw: "LINEAR(ID, SUBSTRING(EMAIL, 1, 3))", expected: []string{"ID", "SUBSTRING(EMAIL, 1, 3)"}},
+		{raw: `LINEAR("a,b", TO_DATE(CREATED_AT))`, expected: []string{`"a,b"`, "TO_DATE(CREATED_AT)"}},
+		{raw: "linear(c1:user.id::STRING, 'x,y')", expected: []string{"c1:user.id::STRING", "'x,y'"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.raw, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parseClusteringKey(tc.raw))
+		})
+	}
+}
+
+func TestTablesDescribeSearchOptimization(t *testing.T) {
+	opts := &describeSearchOptimizationOptions{
+		name: NewSchemaObjectIdentifier("db", "schema", "table"),
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE SEARCH OPTIMIZATION ON "db"."schema"."table"`, actual)
+}
+
+func TestComputeTableAlterations(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "table")
+	current := func() *TableProperties {
+		return &TableProperties{
+			Name:                    id,
+			ClusterBy:               []string{"ID"},
+			DataRetentionTimeInDays: Int(1),
+			Comment:                 String("comment"),
+		}
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		assert.Empty(t, ComputeTableAlterations(current(), current()))
+	})
+
+	t.Run("rename, clustering key, set and unset", func(t *testing.T) {
+		desired := current()
+		desired.Name = NewSchemaObjectIdentifier("db", "schema", "renamed")
+		desired.ClusterBy = []string{"ID", "CREATED_AT"}
+		desired.ChangeTracking = Bool(true)
+		desired.Comment = nil
+
+		actual, err := StatementsToSQL(ComputeTableAlterations(current(), desired))
+		require.NoError(t, err)
+		expected := []string{
+			`ALTER TABLE "db"."schema"."table" RENAME TO "db"."schema"."renamed"`,
+			`ALTER TABLE "db"."schema"."renamed" CLUSTER BY (ID, CREATED_AT)`,
+			`ALTER TABLE "db"."schema"."renamed" SET CHANGE_TRACKING = true`,
+			`ALTER TABLE "db"."schema"."renamed" UNSET COMMENT`,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("drop clustering key", func(t *testing.T) {
+		desired := current()
+		desired.ClusterBy = nil
+
+		actual, err := StatementsToSQL(ComputeTableAlterations(current(), desired))
+		require.NoError(t, err)
+		assert.Equal(t, []string{`ALTER TABLE "db"."schema"."table" DROP CLUSTERING KEY`}, actual)
+	})
+}
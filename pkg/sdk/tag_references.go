@@ -0,0 +1,139 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Compile-time proof of interface implementation.
+var _ TagReferences = (*tagReferences)(nil)
+
+// TagReferences reads the INFORMATION_SCHEMA.TAG_REFERENCES and TAG_REFERENCES_ALL_COLUMNS table functions,
+// which list the tags set on an object, including the tags it inherits.
+type TagReferences interface {
+	// Show returns the tags set on or inherited by an object.
+	Show(ctx context.Context, object ObjectIdentifier, domain TagReferenceDomain) ([]*TagReference, error)
+	// ShowAllColumns returns the tags set on or inherited by every column of a table.
+	ShowAllColumns(ctx context.Context, table SchemaObjectIdentifier) ([]*TagReference, error)
+}
+
+// tagReferences implements TagReferences.
+type tagReferences struct {
+	client *Client
+}
+
+// TagReferenceDomain is the type of the object a tag is set on.
+type TagReferenceDomain string
+
+const (
+	TagReferenceDomainAccount     TagReferenceDomain = "ACCOUNT"
+	TagReferenceDomainColumn      TagReferenceDomain = "COLUMN"
+	TagReferenceDomainDatabase    TagReferenceDomain = "DATABASE"
+	TagReferenceDomainIntegration TagReferenceDomain = "INTEGRATION"
+	TagReferenceDomainRole        TagReferenceDomain = "ROLE"
+	TagReferenceDomainSchema      TagReferenceDomain = "SCHEMA"
+	TagReferenceDomainTable       TagReferenceDomain = "TABLE"
+	TagReferenceDomainUser        TagReferenceDomain = "USER"
+	TagReferenceDomainWarehouse   TagReferenceDomain = "WAREHOUSE"
+)
+
+type TagReference struct {
+	Tag      SchemaObjectIdentifier
+	TagValue string
+	// Level is the domain of the object the tag is set on, which differs from Domain for inherited tags.
+	Level          TagReferenceDomain
+	ObjectDatabase string
+	ObjectSchema   string
+	ObjectName     string
+	Domain         TagReferenceDomain
+	ColumnName     string
+}
+
+type tagReferenceRow struct {
+	TagDatabase    string         `db:"TAG_DATABASE"`
+	TagSchema      string         `db:"TAG_SCHEMA"`
+	TagName        string         `db:"TAG_NAME"`
+	TagValue       sql.NullString `db:"TAG_VALUE"`
+	Level          sql.NullString `db:"LEVEL"`
+	ObjectDatabase sql.NullString `db:"OBJECT_DATABASE"`
+	ObjectSchema   sql.NullString `db:"OBJECT_SCHEMA"`
+	ObjectName     string         `db:"OBJECT_NAME"`
+	Domain         string         `db:"DOMAIN"`
+	ColumnName     sql.NullString `db:"COLUMN_NAME"`
+}
+
+func (row tagReferenceRow) toTagReference() *TagReference {
+	return &TagReference{
+		Tag:            NewSchemaObjectIdentifier(row.TagDatabase, row.TagSchema, row.TagName),
+		TagValue:       row.TagValue.String,
+		Level:          TagReferenceDomain(row.Level.String),
+		ObjectDatabase: row.ObjectDatabase.String,
+		ObjectSchema:   row.ObjectSchema.String,
+		ObjectName:     row.ObjectName,
+		Domain:         TagReferenceDomain(row.Domain),
+		ColumnName:     row.ColumnName.String,
+	}
+}
+
+// tagReferencesSQL builds the query for the tag references of an object. The table function is called from the
+// information schema of the database of the object, or of the current database for account level objects.
+func tagReferencesSQL(object ObjectIdentifier, domain TagReferenceDomain) string {
+	var database AccountObjectIdentifier
+	switch id := object.(type) {
+	case TableColumnIdentifier:
+		database = NewAccountObjectIdentifier(id.DatabaseName())
+	case SchemaObjectIdentifier:
+		database = NewAccountObjectIdentifier(id.DatabaseName())
+	case SchemaIdentifier:
+		database = NewAccountObjectIdentifier(id.DatabaseName())
+	case AccountObjectIdentifier:
+		if domain == TagReferenceDomainDatabase {
+			database = id
+		}
+	}
+	function := "INFORMATION_SCHEMA.TAG_REFERENCES"
+	if validObjectidentifier(database) {
+		function = database.FullyQualifiedName() + "." + function
+	}
+	objectName := escapeStringLiteral(object.FullyQualifiedName())
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s('%s', '%s'))`, function, objectName, domain)
+}
+
+// tagReferencesAllColumnsSQL builds the query for the tag references of all columns of a table.
+func tagReferencesAllColumnsSQL(table SchemaObjectIdentifier) string {
+	database := NewAccountObjectIdentifier(table.DatabaseName())
+	tableName := escapeStringLiteral(table.FullyQualifiedName())
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s.INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS('%s', '%s'))`, database.FullyQualifiedName(), tableName, TagReferenceDomainTable)
+}
+
+func (v *tagReferences) show(ctx context.Context, sql string) ([]*TagReference, error) {
+	dest := []tagReferenceRow{}
+	err := v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*TagReference, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTagReference()
+	}
+	return resultList, nil
+}
+
+func (v *tagReferences) Show(ctx context.Context, object ObjectIdentifier, domain TagReferenceDomain) ([]*TagReference, error) {
+	if object == nil || !validObjectidentifier(object) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	if domain == "" {
+		return nil, errors.New("domain must be set")
+	}
+	return v.show(ctx, tagReferencesSQL(object, domain))
+}
+
+func (v *tagReferences) ShowAllColumns(ctx context.Context, table SchemaObjectIdentifier) ([]*TagReference, error) {
+	if !validObjectidentifier(table) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	return v.show(ctx, tagReferencesAllColumnsSQL(table))
+}
@@ -0,0 +1,47 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_TagReferences(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+	tag, tagCleanup := createTag(t, client, database, schema)
+	t.Cleanup(tagCleanup)
+
+	_, err := client.exec(ctx, fmt.Sprintf("ALTER TABLE %s SET TAG %s = 'some value'", tableID.FullyQualifiedName(), tag.ID().FullyQualifiedName()))
+	require.NoError(t, err)
+
+	t.Run("table", func(t *testing.T) {
+		references, err := client.TagReferences.Show(ctx, tableID, TagReferenceDomainTable)
+		require.NoError(t, err)
+		require.Len(t, references, 1)
+		assert.Equal(t, tag.ID(), references[0].Tag)
+		assert.Equal(t, "some value", references[0].TagValue)
+		assert.Equal(t, TagReferenceDomainTable, references[0].Domain)
+	})
+
+	t.Run("all columns", func(t *testing.T) {
+		references, err := client.TagReferences.ShowAllColumns(ctx, tableID)
+		require.NoError(t, err)
+		require.Len(t, references, 2)
+		for _, reference := range references {
+			assert.Equal(t, tag.ID(), reference.Tag)
+			assert.Equal(t, TagReferenceDomainColumn, reference.Domain)
+			assert.Equal(t, TagReferenceDomainTable, reference.Level)
+		}
+	})
+}
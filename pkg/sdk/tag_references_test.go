@@ -0,0 +1,56 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagReferencesSQL(t *testing.T) {
+	t.Run("table", func(t *testing.T) {
+		actual := tagReferencesSQL(NewSchemaObjectIdentifier("db", "schema", "table"), TagReferenceDomainTable)
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES('"db"."schema"."table"', 'TABLE'))`, actual)
+	})
+
+	t.Run("column", func(t *testing.T) {
+		actual := tagReferencesSQL(NewTableColumnIdentifier("db", "schema", "table", "column"), TagReferenceDomainColumn)
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES('"db"."schema"."table"."column"', 'COLUMN'))`, actual)
+	})
+
+	t.Run("database", func(t *testing.T) {
+		actual := tagReferencesSQL(NewAccountObjectIdentifier("db"), TagReferenceDomainDatabase)
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES('"db"', 'DATABASE'))`, actual)
+	})
+
+	t.Run("warehouse", func(t *testing.T) {
+		actual := tagReferencesSQL(NewAccountObjectIdentifier("wh"), TagReferenceDomainWarehouse)
+		assert.Equal(t, `SELECT * FROM TABLE(INFORMATION_SCHEMA.TAG_REFERENCES('"wh"', 'WAREHOUSE'))`, actual)
+	})
+
+	t.Run("all columns", func(t *testing.T) {
+		actual := tagReferencesAllColumnsSQL(NewSchemaObjectIdentifier("db", "schema", "table"))
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS('"db"."schema"."table"', 'TABLE'))`, actual)
+	})
+}
+
+func TestTagReferenceRow(t *testing.T) {
+	row := tagReferenceRow{
+		TagDatabase:    "DB",
+		TagSchema:      "SCHEMA",
+		TagName:        "PII",
+		TagValue:       sql.NullString{String: "email", Valid: true},
+		Level:          sql.NullString{String: "TABLE", Valid: true},
+		ObjectDatabase: sql.NullString{String: "DB", Valid: true},
+		ObjectSchema:   sql.NullString{String: "SCHEMA", Valid: true},
+		ObjectName:     "USERS",
+		Domain:         "COLUMN",
+		ColumnName:     sql.NullString{String: "EMAIL", Valid: true},
+	}
+	reference := row.toTagReference()
+	assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "PII"), reference.Tag)
+	assert.Equal(t, "email", reference.TagValue)
+	assert.Equal(t, TagReferenceDomainTable, reference.Level)
+	assert.Equal(t, TagReferenceDomainColumn, reference.Domain)
+	assert.Equal(t, "EMAIL", reference.ColumnName)
+}
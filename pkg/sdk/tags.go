@@ -1,5 +1,21 @@
 package sdk
 
+import "context"
+
+// Compile-time proof of interface implementation.
+var _ Tags = (*tags)(nil)
+
+// Tags describes all the tag related methods that the Snowflake API supports.
+type Tags interface {
+	// Undrop restores the most recent version of a dropped tag.
+	Undrop(ctx context.Context, id SchemaObjectIdentifier) error
+}
+
+// tags implements Tags.
+type tags struct {
+	client *Client
+}
+
 // placeholder for the real implementation.
 type TagCreateOptions struct{}
 
@@ -16,3 +32,31 @@ func (v *Tag) ID() SchemaObjectIdentifier {
 func (v *Tag) ObjectType() ObjectType {
 	return ObjectTypeTag
 }
+
+type undropTagOptions struct {
+	undrop bool                   `ddl:"static" sql:"UNDROP"` //lint:ignore U1000 This is used in the ddl tag
+	tag    bool                   `ddl:"static" sql:"TAG"`    //lint:ignore U1000 This is used in the ddl tag
+	name   SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *undropTagOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *tags) Undrop(ctx context.Context, id SchemaObjectIdentifier) error {
+	opts := &undropTagOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
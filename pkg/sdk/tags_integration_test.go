@@ -0,0 +1,28 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_TagsUndrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tag, tagCleanup := createTag(t, client, database, schema)
+	t.Cleanup(tagCleanup)
+
+	_, err := client.exec(ctx, fmt.Sprintf("DROP TAG %s", tag.ID().FullyQualifiedName()))
+	require.NoError(t, err)
+	err = client.Tags.Undrop(ctx, tag.ID())
+	require.NoError(t, err)
+	_, err = client.exec(ctx, fmt.Sprintf("ALTER TAG %s SET COMMENT = 'restored'", tag.ID().FullyQualifiedName()))
+	require.NoError(t, err)
+}
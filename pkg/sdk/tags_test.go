@@ -0,0 +1,27 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagsUndrop(t *testing.T) {
+	t.Run("minimal", func(t *testing.T) {
+		opts := &undropTagOptions{
+			name: NewSchemaObjectIdentifier("db", "schema", "tag"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `UNDROP TAG "db"."schema"."tag"`, actual)
+	})
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &undropTagOptions{
+			name: NewSchemaObjectIdentifier("", "", ""),
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
@@ -0,0 +1,453 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Tasks = (*tasks)(nil)
+
+// Tasks run SQL on a schedule. Tasks can be chained into a graph, which is started by its root task.
+type Tasks interface {
+	// Alter modifies an existing task.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterTaskOptions) error
+	// Dependents returns the root task followed by its child tasks. Without recursive only the direct children are returned.
+	Dependents(ctx context.Context, root SchemaObjectIdentifier, recursive bool) ([]*TaskDependent, error)
+	// ResumeGraph resumes every task of the graph of a root task, resuming the root task last.
+	ResumeGraph(ctx context.Context, root SchemaObjectIdentifier) error
+	// SuspendGraph suspends every task of the graph of a root task, suspending the root task first.
+	SuspendGraph(ctx context.Context, root SchemaObjectIdentifier) error
+	// EnableDependents resumes all the dependent tasks of a root task with SYSTEM$TASK_DEPENDENTS_ENABLE.
+	EnableDependents(ctx context.Context, root SchemaObjectIdentifier) error
+	// History returns the runs of a task, most recent first.
+	History(ctx context.Context, id SchemaObjectIdentifier, opts *TaskHistoryOptions) ([]*TaskHistoryEntry, error)
+	// CompleteGraphs returns the completed runs of the graph of a root task, most recent first.
+	CompleteGraphs(ctx context.Context, root SchemaObjectIdentifier, opts *CompleteTaskGraphsOptions) ([]*TaskGraphRun, error)
+}
+
+// tasks implements Tasks.
+type tasks struct {
+	client *Client
+}
+
+type TaskAction string
+
+const (
+	TaskActionResume  TaskAction = "RESUME"
+	TaskActionSuspend TaskAction = "SUSPEND"
+)
+
+type AlterTaskOptions struct {
+	alter    bool                   `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	task     bool                   `ddl:"static" sql:"TASK"`  //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+	Action   *TaskAction            `ddl:"keyword"`
+}
+
+func (opts *AlterTaskOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.Action) {
+		return errors.New("Action must be set")
+	}
+	return nil
+}
+
+func (v *tasks) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterTaskOptions) error {
+	if opts == nil {
+		opts = &AlterTaskOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type TaskDependent struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Owner        string
+	Warehouse    string
+	Schedule     string
+	// Predecessors are the fully qualified names of the tasks that run before this task.
+	Predecessors []string
+	State        string
+	Definition   string
+	Condition    string
+}
+
+func (v *TaskDependent) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+type taskDependentRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Owner        sql.NullString `db:"owner"`
+	Warehouse    sql.NullString `db:"warehouse"`
+	Schedule     sql.NullString `db:"schedule"`
+	Predecessors sql.NullString `db:"predecessors"`
+	State        sql.NullString `db:"state"`
+	Definition   sql.NullString `db:"definition"`
+	Condition    sql.NullString `db:"condition"`
+}
+
+func (row taskDependentRow) toTaskDependent() (*TaskDependent, error) {
+	dependent := &TaskDependent{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Owner:        row.Owner.String,
+		Warehouse:    row.Warehouse.String,
+		Schedule:     row.Schedule.String,
+		State:        row.State.String,
+		Definition:   row.Definition.String,
+		Condition:    row.Condition.String,
+	}
+	if row.Predecessors.Valid && row.Predecessors.String != "" {
+		if err := json.Unmarshal([]byte(row.Predecessors.String), &dependent.Predecessors); err != nil {
+			return nil, fmt.Errorf("parse predecessors of task %s: %w", row.Name, err)
+		}
+	}
+	return dependent, nil
+}
+
+// taskDependentsSQL builds the query for the dependents of a root task. The table function lives in the
+// information schema of the database of the root task.
+func taskDependentsSQL(root SchemaObjectIdentifier, recursive bool) string {
+	database := NewAccountObjectIdentifier(root.DatabaseName())
+	taskName := escapeStringLiteral(root.FullyQualifiedName())
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s.INFORMATION_SCHEMA.TASK_DEPENDENTS(TASK_NAME => '%s', RECURSIVE => %t))`, database.FullyQualifiedName(), taskName, recursive)
+}
+
+func (v *tasks) Dependents(ctx context.Context, root SchemaObjectIdentifier, recursive bool) ([]*TaskDependent, error) {
+	if !validObjectidentifier(root) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	dest := []taskDependentRow{}
+	err := v.client.query(ctx, &dest, taskDependentsSQL(root, recursive))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*TaskDependent, len(dest))
+	for i, row := range dest {
+		resultList[i], err = row.toTaskDependent()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resultList, nil
+}
+
+// ResumeGraph resumes the tasks in the reverse order of TASK_DEPENDENTS, because child tasks can only be
+// resumed while the root task is suspended.
+func (v *tasks) ResumeGraph(ctx context.Context, root SchemaObjectIdentifier) error {
+	dependents, err := v.Dependents(ctx, root, true)
+	if err != nil {
+		return err
+	}
+	for i := len(dependents) - 1; i >= 0; i-- {
+		if err := v.Alter(ctx, dependents[i].ID(), &AlterTaskOptions{Action: Pointer(TaskActionResume)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SuspendGraph suspends the tasks in the order of TASK_DEPENDENTS, so that the root task stops
+// scheduling new runs before its children are suspended.
+func (v *tasks) SuspendGraph(ctx context.Context, root SchemaObjectIdentifier) error {
+	dependents, err := v.Dependents(ctx, root, true)
+	if err != nil {
+		return err
+	}
+	for _, dependent := range dependents {
+		if err := v.Alter(ctx, dependent.ID(), &AlterTaskOptions{Action: Pointer(TaskActionSuspend)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *tasks) EnableDependents(ctx context.Context, root SchemaObjectIdentifier) error {
+	if !validObjectidentifier(root) {
+		return ErrInvalidObjectIdentifier
+	}
+	taskName := escapeStringLiteral(root.FullyQualifiedName())
+	_, err := v.client.exec(ctx, fmt.Sprintf(`SELECT SYSTEM$TASK_DEPENDENTS_ENABLE('%s')`, taskName))
+	return err
+}
+
+// TaskHistoryOptions filters the result of the INFORMATION_SCHEMA.TASK_HISTORY table function.
+type TaskHistoryOptions struct {
+	ScheduledTimeRangeStart *time.Time
+	ScheduledTimeRangeEnd   *time.Time
+	// ResultLimit is at most 10000, Snowflake returns 100 rows by default.
+	ResultLimit *int
+	// ErrorOnly returns only the runs that failed or were cancelled.
+	ErrorOnly *bool
+}
+
+func (opts *TaskHistoryOptions) validate() error {
+	if valueSet(opts.ResultLimit) && !validateIntInRange(*opts.ResultLimit, 1, 10000) {
+		return errors.New("ResultLimit must be between 1 and 10000")
+	}
+	return nil
+}
+
+type TaskHistoryEntry struct {
+	QueryID           string
+	Name              string
+	DatabaseName      string
+	SchemaName        string
+	QueryText         string
+	ConditionText     string
+	State             string
+	ErrorCode         string
+	ErrorMessage      string
+	ScheduledTime     time.Time
+	QueryStartTime    time.Time
+	NextScheduledTime time.Time
+	CompletedTime     time.Time
+	RootTaskID        string
+	GraphVersion      int
+	RunID             int
+	ReturnValue       string
+	ScheduledFrom     string
+	AttemptNumber     int
+}
+
+type taskHistoryRow struct {
+	QueryID           sql.NullString `db:"QUERY_ID"`
+	Name              string         `db:"NAME"`
+	DatabaseName      string         `db:"DATABASE_NAME"`
+	SchemaName        string         `db:"SCHEMA_NAME"`
+	QueryText         sql.NullString `db:"QUERY_TEXT"`
+	ConditionText     sql.NullString `db:"CONDITION_TEXT"`
+	State             sql.NullString `db:"STATE"`
+	ErrorCode         sql.NullString `db:"ERROR_CODE"`
+	ErrorMessage      sql.NullString `db:"ERROR_MESSAGE"`
+	ScheduledTime     sql.NullTime   `db:"SCHEDULED_TIME"`
+	QueryStartTime    sql.NullTime   `db:"QUERY_START_TIME"`
+	NextScheduledTime sql.NullTime   `db:"NEXT_SCHEDULED_TIME"`
+	CompletedTime     sql.NullTime   `db:"COMPLETED_TIME"`
+	RootTaskID        sql.NullString `db:"ROOT_TASK_ID"`
+	GraphVersion      sql.NullInt64  `db:"GRAPH_VERSION"`
+	RunID             sql.NullInt64  `db:"RUN_ID"`
+	ReturnValue       sql.NullString `db:"RETURN_VALUE"`
+	ScheduledFrom     sql.NullString `db:"SCHEDULED_FROM"`
+	AttemptNumber     sql.NullInt64  `db:"ATTEMPT_NUMBER"`
+}
+
+func (row taskHistoryRow) toTaskHistoryEntry() *TaskHistoryEntry {
+	entry := &TaskHistoryEntry{
+		QueryID:       row.QueryID.String,
+		Name:          row.Name,
+		DatabaseName:  row.DatabaseName,
+		SchemaName:    row.SchemaName,
+		QueryText:     row.QueryText.String,
+		ConditionText: row.ConditionText.String,
+		State:         row.State.String,
+		ErrorCode:     row.ErrorCode.String,
+		ErrorMessage:  row.ErrorMessage.String,
+		RootTaskID:    row.RootTaskID.String,
+		GraphVersion:  int(row.GraphVersion.Int64),
+		RunID:         int(row.RunID.Int64),
+		ReturnValue:   row.ReturnValue.String,
+		ScheduledFrom: row.ScheduledFrom.String,
+		AttemptNumber: int(row.AttemptNumber.Int64),
+	}
+	if row.ScheduledTime.Valid {
+		entry.ScheduledTime = row.ScheduledTime.Time
+	}
+	if row.QueryStartTime.Valid {
+		entry.QueryStartTime = row.QueryStartTime.Time
+	}
+	if row.NextScheduledTime.Valid {
+		entry.NextScheduledTime = row.NextScheduledTime.Time
+	}
+	if row.CompletedTime.Valid {
+		entry.CompletedTime = row.CompletedTime.Time
+	}
+	return entry
+}
+
+// taskHistorySQL builds the query for the task history. The table function lives in the
+// information schema of the database of the task and only returns tasks the current role can see.
+func taskHistorySQL(id SchemaObjectIdentifier, opts *TaskHistoryOptions) string {
+	args := []string{fmt.Sprintf("TASK_NAME => '%s'", escapeStringLiteral(id.Name()))}
+	if opts.ScheduledTimeRangeStart != nil {
+		args = append(args, fmt.Sprintf("SCHEDULED_TIME_RANGE_START => TO_TIMESTAMP_LTZ('%s')", opts.ScheduledTimeRangeStart.Format(time.RFC3339)))
+	}
+	if opts.ScheduledTimeRangeEnd != nil {
+		args = append(args, fmt.Sprintf("SCHEDULED_TIME_RANGE_END => TO_TIMESTAMP_LTZ('%s')", opts.ScheduledTimeRangeEnd.Format(time.RFC3339)))
+	}
+	if opts.ResultLimit != nil {
+		args = append(args, fmt.Sprintf("RESULT_LIMIT => %d", *opts.ResultLimit))
+	}
+	if opts.ErrorOnly != nil {
+		args = append(args, fmt.Sprintf("ERROR_ONLY => %t", *opts.ErrorOnly))
+	}
+	database := NewAccountObjectIdentifier(id.DatabaseName())
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s.INFORMATION_SCHEMA.TASK_HISTORY(%s)) WHERE SCHEMA_NAME = '%s' ORDER BY SCHEDULED_TIME DESC`, database.FullyQualifiedName(), strings.Join(args, ", "), escapeStringLiteral(id.SchemaName()))
+}
+
+func (v *tasks) History(ctx context.Context, id SchemaObjectIdentifier, opts *TaskHistoryOptions) ([]*TaskHistoryEntry, error) {
+	if opts == nil {
+		opts = &TaskHistoryOptions{}
+	}
+	if !validObjectidentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	dest := []taskHistoryRow{}
+	err := v.client.query(ctx, &dest, taskHistorySQL(id, opts))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*TaskHistoryEntry, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTaskHistoryEntry()
+	}
+	return resultList, nil
+}
+
+// CompleteTaskGraphsOptions filters the result of the INFORMATION_SCHEMA.COMPLETE_TASK_GRAPHS table function.
+type CompleteTaskGraphsOptions struct {
+	// ResultLimit is at most 10000, Snowflake returns 1000 rows by default.
+	ResultLimit *int
+	// ErrorOnly returns only the graph runs that failed or were cancelled.
+	ErrorOnly *bool
+}
+
+func (opts *CompleteTaskGraphsOptions) validate() error {
+	if valueSet(opts.ResultLimit) && !validateIntInRange(*opts.ResultLimit, 1, 10000) {
+		return errors.New("ResultLimit must be between 1 and 10000")
+	}
+	return nil
+}
+
+type TaskGraphRun struct {
+	RootTaskName       string
+	DatabaseName       string
+	SchemaName         string
+	State              string
+	ScheduledFrom      string
+	FirstErrorTaskName string
+	FirstErrorCode     string
+	FirstErrorMessage  string
+	ScheduledTime      time.Time
+	QueryStartTime     time.Time
+	NextScheduledTime  time.Time
+	CompletedTime      time.Time
+	RootTaskID         string
+	GraphVersion       int
+	RunID              int
+	AttemptNumber      int
+}
+
+type taskGraphRunRow struct {
+	RootTaskName       string         `db:"ROOT_TASK_NAME"`
+	DatabaseName       string         `db:"DATABASE_NAME"`
+	SchemaName         string         `db:"SCHEMA_NAME"`
+	State              sql.NullString `db:"STATE"`
+	ScheduledFrom      sql.NullString `db:"SCHEDULED_FROM"`
+	FirstErrorTaskName sql.NullString `db:"FIRST_ERROR_TASK_NAME"`
+	FirstErrorCode     sql.NullString `db:"FIRST_ERROR_CODE"`
+	FirstErrorMessage  sql.NullString `db:"FIRST_ERROR_MESSAGE"`
+	ScheduledTime      sql.NullTime   `db:"SCHEDULED_TIME"`
+	QueryStartTime     sql.NullTime   `db:"QUERY_START_TIME"`
+	NextScheduledTime  sql.NullTime   `db:"NEXT_SCHEDULED_TIME"`
+	CompletedTime      sql.NullTime   `db:"COMPLETED_TIME"`
+	RootTaskID         sql.NullString `db:"ROOT_TASK_ID"`
+	GraphVersion       sql.NullInt64  `db:"GRAPH_VERSION"`
+	RunID              sql.NullInt64  `db:"RUN_ID"`
+	AttemptNumber      sql.NullInt64  `db:"ATTEMPT_NUMBER"`
+}
+
+func (row taskGraphRunRow) toTaskGraphRun() *TaskGraphRun {
+	run := &TaskGraphRun{
+		RootTaskName:       row.RootTaskName,
+		DatabaseName:       row.DatabaseName,
+		SchemaName:         row.SchemaName,
+		State:              row.State.String,
+		ScheduledFrom:      row.ScheduledFrom.String,
+		FirstErrorTaskName: row.FirstErrorTaskName.String,
+		FirstErrorCode:     row.FirstErrorCode.String,
+		FirstErrorMessage:  row.FirstErrorMessage.String,
+		RootTaskID:         row.RootTaskID.String,
+		GraphVersion:       int(row.GraphVersion.Int64),
+		RunID:              int(row.RunID.Int64),
+		AttemptNumber:      int(row.AttemptNumber.Int64),
+	}
+	if row.ScheduledTime.Valid {
+		run.ScheduledTime = row.ScheduledTime.Time
+	}
+	if row.QueryStartTime.Valid {
+		run.QueryStartTime = row.QueryStartTime.Time
+	}
+	if row.NextScheduledTime.Valid {
+		run.NextScheduledTime = row.NextScheduledTime.Time
+	}
+	if row.CompletedTime.Valid {
+		run.CompletedTime = row.CompletedTime.Time
+	}
+	return run
+}
+
+// completeTaskGraphsSQL builds the query for the completed graph runs of a root task. The table function
+// lives in the information schema of the database of the root task.
+func completeTaskGraphsSQL(root SchemaObjectIdentifier, opts *CompleteTaskGraphsOptions) string {
+	args := []string{fmt.Sprintf("ROOT_TASK_NAME => '%s'", escapeStringLiteral(root.Name()))}
+	if opts.ResultLimit != nil {
+		args = append(args, fmt.Sprintf("RESULT_LIMIT => %d", *opts.ResultLimit))
+	}
+	if opts.ErrorOnly != nil {
+		args = append(args, fmt.Sprintf("ERROR_ONLY => %t", *opts.ErrorOnly))
+	}
+	database := NewAccountObjectIdentifier(root.DatabaseName())
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s.INFORMATION_SCHEMA.COMPLETE_TASK_GRAPHS(%s)) WHERE SCHEMA_NAME = '%s' ORDER BY SCHEDULED_TIME DESC`, database.FullyQualifiedName(), strings.Join(args, ", "), escapeStringLiteral(root.SchemaName()))
+}
+
+func (v *tasks) CompleteGraphs(ctx context.Context, root SchemaObjectIdentifier, opts *CompleteTaskGraphsOptions) ([]*TaskGraphRun, error) {
+	if opts == nil {
+		opts = &CompleteTaskGraphsOptions{}
+	}
+	if !validObjectidentifier(root) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	dest := []taskGraphRunRow{}
+	err := v.client.query(ctx, &dest, completeTaskGraphsSQL(root, opts))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*TaskGraphRun, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTaskGraphRun()
+	}
+	return resultList, nil
+}
@@ -0,0 +1,70 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_TaskGraphs(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	rootID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	childID := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringN(t, 12))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE TASK %s SCHEDULE = '60 MINUTE' AS SELECT 1", rootID.FullyQualifiedName()))
+	require.NoError(t, err)
+	_, err = client.exec(ctx, fmt.Sprintf("CREATE TASK %s AFTER %s AS SELECT 2", childID.FullyQualifiedName(), rootID.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		for _, id := range []SchemaObjectIdentifier{rootID, childID} {
+			_, err := client.exec(ctx, fmt.Sprintf("DROP TASK IF EXISTS %s", id.FullyQualifiedName()))
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("dependents", func(t *testing.T) {
+		dependents, err := client.Tasks.Dependents(ctx, rootID, true)
+		require.NoError(t, err)
+		require.Len(t, dependents, 2)
+		assert.Equal(t, rootID, dependents[0].ID())
+		assert.Equal(t, childID, dependents[1].ID())
+		assert.Len(t, dependents[1].Predecessors, 1)
+	})
+
+	t.Run("resume and suspend graph", func(t *testing.T) {
+		require.NoError(t, client.Tasks.ResumeGraph(ctx, rootID))
+		dependents, err := client.Tasks.Dependents(ctx, rootID, true)
+		require.NoError(t, err)
+		for _, dependent := range dependents {
+			assert.Equal(t, "started", dependent.State)
+		}
+
+		require.NoError(t, client.Tasks.SuspendGraph(ctx, rootID))
+		dependents, err = client.Tasks.Dependents(ctx, rootID, true)
+		require.NoError(t, err)
+		for _, dependent := range dependents {
+			assert.Equal(t, "suspended", dependent.State)
+		}
+	})
+
+	t.Run("enable dependents", func(t *testing.T) {
+		require.NoError(t, client.Tasks.EnableDependents(ctx, rootID))
+		require.NoError(t, client.Tasks.SuspendGraph(ctx, rootID))
+	})
+
+	t.Run("history", func(t *testing.T) {
+		_, err := client.Tasks.History(ctx, rootID, &TaskHistoryOptions{ResultLimit: Int(10)})
+		require.NoError(t, err)
+		_, err = client.Tasks.CompleteGraphs(ctx, rootID, &CompleteTaskGraphsOptions{ResultLimit: Int(10)})
+		require.NoError(t, err)
+	})
+}
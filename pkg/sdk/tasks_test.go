@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskAlter(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "mytask")
+
+	t.Run("resume", func(t *testing.T) {
+		opts := &AlterTaskOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Action:   Pointer(TaskActionResume),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TASK IF EXISTS "db"."schema"."mytask" RESUME`, actual)
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterTaskOptions{name: id}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestTaskDependentsSQL(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "root")
+	actual := taskDependentsSQL(id, true)
+	assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.TASK_DEPENDENTS(TASK_NAME => '"db"."schema"."root"', RECURSIVE => true))`, actual)
+}
+
+func TestTaskDependentRowToTaskDependent(t *testing.T) {
+	row := taskDependentRow{
+		Name:         "CHILD",
+		DatabaseName: "DB",
+		SchemaName:   "SCHEMA",
+		Predecessors: sql.NullString{String: `["\"DB\".\"SCHEMA\".\"ROOT\""]`, Valid: true},
+		State:        sql.NullString{String: "suspended", Valid: true},
+	}
+	dependent, err := row.toTaskDependent()
+	require.NoError(t, err)
+	assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "CHILD"), dependent.ID())
+	assert.Equal(t, []string{`"DB"."SCHEMA"."ROOT"`}, dependent.Predecessors)
+	assert.Equal(t, "suspended", dependent.State)
+
+	row.Predecessors = sql.NullString{String: `[`, Valid: true}
+	_, err = row.toTaskDependent()
+	assert.Error(t, err)
+}
+
+func TestTaskHistorySQL(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "mytask")
+
+	t.Run("without options", func(t *testing.T) {
+		actual := taskHistorySQL(id, &TaskHistoryOptions{})
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.TASK_HISTORY(TASK_NAME => 'mytask')) WHERE SCHEMA_NAME = 'schema' ORDER BY SCHEDULED_TIME DESC`, actual)
+	})
+
+	t.Run("with all options", func(t *testing.T) {
+		start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+		actual := taskHistorySQL(id, &TaskHistoryOptions{
+			ScheduledTimeRangeStart: &start,
+			ScheduledTimeRangeEnd:   &end,
+			ResultLimit:             Int(50),
+			ErrorOnly:               Bool(true),
+		})
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.TASK_HISTORY(TASK_NAME => 'mytask', SCHEDULED_TIME_RANGE_START => TO_TIMESTAMP_LTZ('2023-01-01T00:00:00Z'), SCHEDULED_TIME_RANGE_END => TO_TIMESTAMP_LTZ('2023-01-02T00:00:00Z'), RESULT_LIMIT => 50, ERROR_ONLY => true)) WHERE SCHEMA_NAME = 'schema' ORDER BY SCHEDULED_TIME DESC`, actual)
+	})
+
+	t.Run("validation: result limit out of range", func(t *testing.T) {
+		opts := &TaskHistoryOptions{ResultLimit: Int(0)}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestCompleteTaskGraphsSQL(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "root")
+
+	t.Run("without options", func(t *testing.T) {
+		actual := completeTaskGraphsSQL(id, &CompleteTaskGraphsOptions{})
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.COMPLETE_TASK_GRAPHS(ROOT_TASK_NAME => 'root')) WHERE SCHEMA_NAME = 'schema' ORDER BY SCHEDULED_TIME DESC`, actual)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		actual := completeTaskGraphsSQL(id, &CompleteTaskGraphsOptions{ResultLimit: Int(10), ErrorOnly: Bool(false)})
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.COMPLETE_TASK_GRAPHS(ROOT_TASK_NAME => 'root', RESULT_LIMIT => 10, ERROR_ONLY => false)) WHERE SCHEMA_NAME = 'schema' ORDER BY SCHEDULED_TIME DESC`, actual)
+	})
+
+	t.Run("validation: result limit out of range", func(t *testing.T) {
+		opts := &CompleteTaskGraphsOptions{ResultLimit: Int(10001)}
+		assert.Error(t, opts.validate())
+	})
+}
@@ -0,0 +1,71 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Begin starts a transaction and returns a client whose statements run in it until Commit or Rollback is called.
+// Snowflake commits the open transaction implicitly before and after every DDL statement, so only the DML
+// statements in between are atomic, see https://docs.snowflake.com/en/sql-reference/transactions.
+func (c *Client) Begin(ctx context.Context) (*Client, error) {
+	if c.tx != nil {
+		return nil, errors.New("client is already in a transaction")
+	}
+	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
+	var tx *sqlx.Tx
+	var err error
+	if c.conn != nil {
+		tx, err = c.conn.BeginTxx(ctx, nil)
+	} else {
+		tx, err = c.db.BeginTxx(ctx, nil)
+	}
+	if err != nil {
+		return nil, decodeDriverError(err)
+	}
+	txClient := *c
+	txClient.tx = tx
+	txClient.initialize()
+	return &txClient, nil
+}
+
+// Commit commits the transaction of a client returned by Begin.
+func (c *Client) Commit() error {
+	if c.tx == nil {
+		return ErrNotInTransaction
+	}
+	return decodeDriverError(c.tx.Commit())
+}
+
+// Rollback rolls back the transaction of a client returned by Begin.
+func (c *Client) Rollback() error {
+	if c.tx == nil {
+		return ErrNotInTransaction
+	}
+	return decodeDriverError(c.tx.Rollback())
+}
+
+// WithTransaction runs fn in a transaction, which is committed when fn returns nil and rolled back when it returns
+// an error or panics. See Begin for the statements that can be rolled back.
+func (c *Client) WithTransaction(ctx context.Context, fn func(tx *Client) error) error {
+	tx, err := c.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rollbackErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,70 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactions(t *testing.T) {
+	ctx := context.Background()
+	id := NewAccountObjectIdentifier("ROLE1")
+
+	t.Run("commit", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectBegin()
+		mock.ExpectExec(`DROP ROLE "ROLE1"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := client.WithTransaction(ctx, func(tx *Client) error {
+			return tx.Roles.Drop(ctx, id, nil)
+		})
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rollback on error", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+		expected := errors.New("copy grants failed")
+
+		err := client.WithTransaction(ctx, func(tx *Client) error {
+			return expected
+		})
+		require.ErrorIs(t, err, expected)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rollback on panic", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		assert.Panics(t, func() {
+			_ = client.WithTransaction(ctx, func(tx *Client) error {
+				panic("boom")
+			})
+		})
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not in a transaction", func(t *testing.T) {
+		client, _ := newMockClient(t)
+		require.ErrorIs(t, client.Commit(), ErrNotInTransaction)
+		require.ErrorIs(t, client.Rollback(), ErrNotInTransaction)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		client, mock := newMockClient(t)
+		mock.ExpectBegin()
+		tx, err := client.Begin(ctx)
+		require.NoError(t, err)
+		_, err = tx.Begin(ctx)
+		require.Error(t, err)
+	})
+}
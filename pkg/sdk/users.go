@@ -2,6 +2,9 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 )
 
 type Users interface {
@@ -17,59 +20,636 @@ type Users interface {
 	Show(ctx context.Context, opts *ShowUserOptions) ([]*User, error)
 	// ShowByID returns a user by ID
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User, error)
+	// ShowByIDs returns the users with the given IDs using a single SHOW, IDs that do not exist are skipped.
+	ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*User, error)
 }
 
+// Compile-time proof of interface implementation.
 var _ Users = (*users)(nil)
 
 type users struct {
 	client *Client
 }
 
-type User struct{}
+type UserType string
+
+const (
+	UserTypePerson        UserType = "PERSON"
+	UserTypeService       UserType = "SERVICE"
+	UserTypeLegacyService UserType = "LEGACY_SERVICE"
+)
+
+var allUserTypes = []UserType{
+	UserTypePerson,
+	UserTypeService,
+	UserTypeLegacyService,
+}
+
+type User struct {
+	Name                  string
+	CreatedOn             time.Time
+	LoginName             string
+	DisplayName           string
+	FirstName             string
+	LastName              string
+	Email                 string
+	MinsToUnlock          string
+	DaysToExpiry          string
+	Comment               string
+	Disabled              bool
+	MustChangePassword    bool
+	SnowflakeLock         bool
+	DefaultWarehouse      string
+	DefaultNamespace      string
+	DefaultRole           string
+	DefaultSecondaryRoles string
+	ExtAuthnDuo           bool
+	ExtAuthnUID           string
+	MinsToBypassMFA       string
+	Owner                 string
+	LastSuccessLogin      time.Time
+	ExpiresAtTime         time.Time
+	LockedUntilTime       time.Time
+	HasPassword           bool
+	HasRSAPublicKey       bool
+	Type                  UserType
+	HasMFA                bool
+}
 
 func (v *User) ID() AccountObjectIdentifier {
-	return AccountObjectIdentifier{}
+	return NewAccountObjectIdentifier(v.Name)
 }
 
 func (v *User) ObjectType() ObjectType {
 	return ObjectTypeUser
 }
 
+type userDBRow struct {
+	Name                  string         `db:"name"`
+	CreatedOn             time.Time      `db:"created_on"`
+	LoginName             string         `db:"login_name"`
+	DisplayName           sql.NullString `db:"display_name"`
+	FirstName             sql.NullString `db:"first_name"`
+	LastName              sql.NullString `db:"last_name"`
+	Email                 sql.NullString `db:"email"`
+	MinsToUnlock          sql.NullString `db:"mins_to_unlock"`
+	DaysToExpiry          sql.NullString `db:"days_to_expiry"`
+	Comment               sql.NullString `db:"comment"`
+	Disabled              bool           `db:"disabled"`
+	MustChangePassword    bool           `db:"must_change_password"`
+	SnowflakeLock         bool           `db:"snowflake_lock"`
+	DefaultWarehouse      sql.NullString `db:"default_warehouse"`
+	DefaultNamespace      sql.NullString `db:"default_namespace"`
+	DefaultRole           sql.NullString `db:"default_role"`
+	DefaultSecondaryRoles sql.NullString `db:"default_secondary_roles"`
+	ExtAuthnDuo           bool           `db:"ext_authn_duo"`
+	ExtAuthnUID           sql.NullString `db:"ext_authn_uid"`
+	MinsToBypassMFA       sql.NullString `db:"mins_to_bypass_mfa"`
+	Owner                 sql.NullString `db:"owner"`
+	LastSuccessLogin      sql.NullTime   `db:"last_success_login"`
+	ExpiresAtTime         sql.NullTime   `db:"expires_at_time"`
+	LockedUntilTime       sql.NullTime   `db:"locked_until_time"`
+	HasPassword           bool           `db:"has_password"`
+	HasRSAPublicKey       bool           `db:"has_rsa_public_key"`
+	Type                  sql.NullString `db:"type"`
+	HasMFA                bool           `db:"has_mfa"`
+}
+
+func (row userDBRow) toUser(strict bool) (*User, error) {
+	user := &User{
+		Name:                  row.Name,
+		CreatedOn:             row.CreatedOn,
+		LoginName:             row.LoginName,
+		DisplayName:           row.DisplayName.String,
+		FirstName:             row.FirstName.String,
+		LastName:              row.LastName.String,
+		Email:                 row.Email.String,
+		MinsToUnlock:          row.MinsToUnlock.String,
+		DaysToExpiry:          row.DaysToExpiry.String,
+		Comment:               row.Comment.String,
+		Disabled:              row.Disabled,
+		MustChangePassword:    row.MustChangePassword,
+		SnowflakeLock:         row.SnowflakeLock,
+		DefaultWarehouse:      row.DefaultWarehouse.String,
+		DefaultNamespace:      row.DefaultNamespace.String,
+		DefaultRole:           row.DefaultRole.String,
+		DefaultSecondaryRoles: row.DefaultSecondaryRoles.String,
+		ExtAuthnDuo:           row.ExtAuthnDuo,
+		ExtAuthnUID:           row.ExtAuthnUID.String,
+		MinsToBypassMFA:       row.MinsToBypassMFA.String,
+		Owner:                 row.Owner.String,
+		HasPassword:           row.HasPassword,
+		HasRSAPublicKey:       row.HasRSAPublicKey,
+		HasMFA:                row.HasMFA,
+	}
+	if row.LastSuccessLogin.Valid {
+		user.LastSuccessLogin = row.LastSuccessLogin.Time
+	}
+	if row.ExpiresAtTime.Valid {
+		user.ExpiresAtTime = row.ExpiresAtTime.Time
+	}
+	if row.LockedUntilTime.Valid {
+		user.LockedUntilTime = row.LockedUntilTime.Time
+	}
+	if row.Type.Valid && row.Type.String != "" {
+		userType, err := toEnum(strict, "user type", row.Type.String, allUserTypes)
+		if err != nil {
+			return nil, err
+		}
+		user.Type = userType
+	}
+	return user, nil
+}
+
+// SecondaryRole is an element of DEFAULT_SECONDARY_ROLES, e.g. ALL.
+type SecondaryRole struct {
+	Value string `ddl:"keyword,single_quotes"`
+}
+
+// UserObjectProperties are the user properties that can be set on CREATE USER and ALTER USER ... SET.
+type UserObjectProperties struct {
+	Password              *string                 `ddl:"parameter,single_quotes" sql:"PASSWORD"`
+	LoginName             *string                 `ddl:"parameter,single_quotes" sql:"LOGIN_NAME"`
+	DisplayName           *string                 `ddl:"parameter,single_quotes" sql:"DISPLAY_NAME"`
+	FirstName             *string                 `ddl:"parameter,single_quotes" sql:"FIRST_NAME"`
+	MiddleName            *string                 `ddl:"parameter,single_quotes" sql:"MIDDLE_NAME"`
+	LastName              *string                 `ddl:"parameter,single_quotes" sql:"LAST_NAME"`
+	Email                 *string                 `ddl:"parameter,single_quotes" sql:"EMAIL"`
+	MustChangePassword    *bool                   `ddl:"parameter" sql:"MUST_CHANGE_PASSWORD"`
+	Disabled              *bool                   `ddl:"parameter" sql:"DISABLED"`
+	DaysToExpiry          *int                    `ddl:"parameter" sql:"DAYS_TO_EXPIRY"`
+	MinsToUnlock          *int                    `ddl:"parameter" sql:"MINS_TO_UNLOCK"`
+	DefaultWarehouse      AccountObjectIdentifier `ddl:"identifier,equals" sql:"DEFAULT_WAREHOUSE"`
+	DefaultNamespace      *string                 `ddl:"parameter,single_quotes" sql:"DEFAULT_NAMESPACE"`
+	DefaultRole           AccountObjectIdentifier `ddl:"identifier,equals" sql:"DEFAULT_ROLE"`
+	DefaultSecondaryRoles []SecondaryRole         `ddl:"parameter,parentheses" sql:"DEFAULT_SECONDARY_ROLES"`
+	MinsToBypassMFA       *int                    `ddl:"parameter" sql:"MINS_TO_BYPASS_MFA"`
+	RSAPublicKey          *string                 `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY"`
+	RSAPublicKey2         *string                 `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY_2"`
+	Type                  *UserType               `ddl:"parameter" sql:"TYPE"`
+	Comment               *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *UserObjectProperties) validate() error {
+	if valueSet(v.DaysToExpiry) && !validateIntGreaterThanOrEqual(*v.DaysToExpiry, 0) {
+		return errors.New("DaysToExpiry must be greater than or equal to 0")
+	}
+	if valueSet(v.MinsToUnlock) && !validateIntGreaterThanOrEqual(*v.MinsToUnlock, 0) {
+		return errors.New("MinsToUnlock must be greater than or equal to 0")
+	}
+	if valueSet(v.MinsToBypassMFA) && !validateIntGreaterThanOrEqual(*v.MinsToBypassMFA, 0) {
+		return errors.New("MinsToBypassMFA must be greater than or equal to 0")
+	}
+	// Service users authenticate with key pairs only.
+	if valueSet(v.Type) && *v.Type == UserTypeService && anyValueSet(v.Password, v.FirstName, v.MiddleName, v.LastName, v.MustChangePassword, v.MinsToBypassMFA) {
+		return errors.New("Password, FirstName, MiddleName, LastName, MustChangePassword and MinsToBypassMFA cannot be set for SERVICE users")
+	}
+	return nil
+}
+
 // CreateUserOptions contains options for creating a user.
-type CreateUserOptions struct{}
+type CreateUserOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	user        bool                    `ddl:"static" sql:"USER"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
 
-func (v *users) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateUserOptions) error {
+	ObjectProperties  *UserObjectProperties `ddl:"list,no_parentheses,no_comma"`
+	SessionParameters *SessionParameters    `ddl:"list,no_parentheses,no_comma"`
+	Tag               []TagAssociation      `ddl:"keyword,parentheses" sql:"WITH TAG"`
+}
+
+func (opts *CreateUserOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if valueSet(opts.ObjectProperties) {
+		if err := opts.ObjectProperties.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.SessionParameters) {
+		if err := opts.SessionParameters.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (v *users) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateUserOptions) error {
+	if opts == nil {
+		opts = &CreateUserOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
 // AlterUserOptions contains options for altering a user.
-type AlterUserOptions struct{}
+type AlterUserOptions struct {
+	alter    bool                    `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	user     bool                    `ddl:"static" sql:"USER"`  //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
 
-func (v *users) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterUserOptions) error {
+	NewName AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	// ResetPassword generates a URL through which the user can set a new password.
+	ResetPassword   *bool              `ddl:"keyword" sql:"RESET PASSWORD"`
+	AbortAllQueries *bool              `ddl:"keyword" sql:"ABORT ALL QUERIES"`
+	Set             *UserSet           `ddl:"keyword" sql:"SET"`
+	Unset           *UserUnset         `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTag          []TagAssociation   `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag        []ObjectIdentifier `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *AlterUserOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.ResetPassword, opts.AbortAllQueries, opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		return errors.New("exactly one of NewName, ResetPassword, AbortAllQueries, Set, Unset, SetTag, UnsetTag must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type UserSet struct {
+	PasswordPolicy    SchemaObjectIdentifier `ddl:"identifier" sql:"PASSWORD POLICY"`
+	SessionPolicy     SchemaObjectIdentifier `ddl:"identifier" sql:"SESSION POLICY"`
+	ObjectProperties  *UserObjectProperties  `ddl:"list,no_parentheses,no_comma"`
+	SessionParameters *SessionParameters     `ddl:"list,no_parentheses,no_comma"`
+	UserParameters    *UserParameters        `ddl:"list,no_parentheses,no_comma"`
+}
+
+func (v *UserSet) validate() error {
+	if !exactlyOneValueSet(v.PasswordPolicy, v.SessionPolicy, v.ObjectProperties, v.SessionParameters, v.UserParameters) {
+		return errors.New("exactly one of PasswordPolicy, SessionPolicy, ObjectProperties, SessionParameters, UserParameters must be set")
+	}
+	if valueSet(v.ObjectProperties) {
+		return v.ObjectProperties.validate()
+	}
+	if valueSet(v.SessionParameters) {
+		return v.SessionParameters.validate()
+	}
+	if valueSet(v.UserParameters) {
+		return v.UserParameters.validate()
+	}
+	return nil
+}
+
+type UserObjectPropertiesUnset struct {
+	Password              *bool `ddl:"keyword" sql:"PASSWORD"`
+	LoginName             *bool `ddl:"keyword" sql:"LOGIN_NAME"`
+	DisplayName           *bool `ddl:"keyword" sql:"DISPLAY_NAME"`
+	FirstName             *bool `ddl:"keyword" sql:"FIRST_NAME"`
+	MiddleName            *bool `ddl:"keyword" sql:"MIDDLE_NAME"`
+	LastName              *bool `ddl:"keyword" sql:"LAST_NAME"`
+	Email                 *bool `ddl:"keyword" sql:"EMAIL"`
+	MustChangePassword    *bool `ddl:"keyword" sql:"MUST_CHANGE_PASSWORD"`
+	Disabled              *bool `ddl:"keyword" sql:"DISABLED"`
+	DaysToExpiry          *bool `ddl:"keyword" sql:"DAYS_TO_EXPIRY"`
+	MinsToUnlock          *bool `ddl:"keyword" sql:"MINS_TO_UNLOCK"`
+	DefaultWarehouse      *bool `ddl:"keyword" sql:"DEFAULT_WAREHOUSE"`
+	DefaultNamespace      *bool `ddl:"keyword" sql:"DEFAULT_NAMESPACE"`
+	DefaultRole           *bool `ddl:"keyword" sql:"DEFAULT_ROLE"`
+	DefaultSecondaryRoles *bool `ddl:"keyword" sql:"DEFAULT_SECONDARY_ROLES"`
+	MinsToBypassMFA       *bool `ddl:"keyword" sql:"MINS_TO_BYPASS_MFA"`
+	RSAPublicKey          *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY"`
+	RSAPublicKey2         *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY_2"`
+	Type                  *bool `ddl:"keyword" sql:"TYPE"`
+	Comment               *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+type UserUnset struct {
+	PasswordPolicy    *bool                      `ddl:"keyword" sql:"PASSWORD POLICY"`
+	SessionPolicy     *bool                      `ddl:"keyword" sql:"SESSION POLICY"`
+	ObjectProperties  *UserObjectPropertiesUnset `ddl:"list,no_parentheses"`
+	SessionParameters *SessionParametersUnset    `ddl:"list,no_parentheses"`
+	UserParameters    *UserParametersUnset       `ddl:"list,no_parentheses"`
+}
+
+func (v *UserUnset) validate() error {
+	if !exactlyOneValueSet(v.PasswordPolicy, v.SessionPolicy, v.ObjectProperties, v.SessionParameters, v.UserParameters) {
+		return errors.New("exactly one of PasswordPolicy, SessionPolicy, ObjectProperties, SessionParameters, UserParameters must be set")
+	}
+	if valueSet(v.SessionParameters) {
+		return v.SessionParameters.validate()
+	}
 	return nil
 }
 
+func (v *users) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterUserOptions) error {
+	if opts == nil {
+		opts = &AlterUserOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// UserProperties is the state of the alterable object properties of a user. A nil field stands for a property
+// that is not set. Passwords are not part of it, as they cannot be read back.
+type UserProperties struct {
+	Name               AccountObjectIdentifier
+	LoginName          *string
+	DisplayName        *string
+	FirstName          *string
+	MiddleName         *string
+	LastName           *string
+	Email              *string
+	MustChangePassword *bool
+	Disabled           *bool
+	DefaultWarehouse   *string
+	DefaultNamespace   *string
+	DefaultRole        *string
+	RSAPublicKey       *string
+	RSAPublicKey2      *string
+	Comment            *string
+}
+
+// ComputeUserAlterations returns the statements that alter a user from the current to the desired state:
+// a rename, followed by a single SET of all changed properties and a single UNSET of all properties that
+// are no longer set.
+func ComputeUserAlterations(current *UserProperties, desired *UserProperties) []Statement {
+	var statements []Statement
+	id := current.Name
+	if desired.Name.Name() != "" && desired.Name.Name() != id.Name() {
+		statements = append(statements, &AlterUserOptions{name: id, NewName: desired.Name})
+		id = desired.Name
+	}
+
+	var alterations propertyAlterations
+	set := &UserObjectProperties{}
+	unset := &UserObjectPropertiesUnset{}
+	alterations.alter(propertyChanged(current.LoginName, desired.LoginName), desired.LoginName != nil, func() { set.LoginName = desired.LoginName }, &unset.LoginName)
+	alterations.alter(propertyChanged(current.DisplayName, desired.DisplayName), desired.DisplayName != nil, func() { set.DisplayName = desired.DisplayName }, &unset.DisplayName)
+	alterations.alter(propertyChanged(current.FirstName, desired.FirstName), desired.FirstName != nil, func() { set.FirstName = desired.FirstName }, &unset.FirstName)
+	alterations.alter(propertyChanged(current.MiddleName, desired.MiddleName), desired.MiddleName != nil, func() { set.MiddleName = desired.MiddleName }, &unset.MiddleName)
+	alterations.alter(propertyChanged(current.LastName, desired.LastName), desired.LastName != nil, func() { set.LastName = desired.LastName }, &unset.LastName)
+	alterations.alter(propertyChanged(current.Email, desired.Email), desired.Email != nil, func() { set.Email = desired.Email }, &unset.Email)
+	alterations.alter(propertyChanged(current.MustChangePassword, desired.MustChangePassword), desired.MustChangePassword != nil, func() { set.MustChangePassword = desired.MustChangePassword }, &unset.MustChangePassword)
+	alterations.alter(propertyChanged(current.Disabled, desired.Disabled), desired.Disabled != nil, func() { set.Disabled = desired.Disabled }, &unset.Disabled)
+	alterations.alter(propertyChanged(current.DefaultWarehouse, desired.DefaultWarehouse), desired.DefaultWarehouse != nil, func() { set.DefaultWarehouse = NewAccountObjectIdentifier(*desired.DefaultWarehouse) }, &unset.DefaultWarehouse)
+	alterations.alter(propertyChanged(current.DefaultNamespace, desired.DefaultNamespace), desired.DefaultNamespace != nil, func() { set.DefaultNamespace = desired.DefaultNamespace }, &unset.DefaultNamespace)
+	alterations.alter(propertyChanged(current.DefaultRole, desired.DefaultRole), desired.DefaultRole != nil, func() { set.DefaultRole = NewAccountObjectIdentifier(*desired.DefaultRole) }, &unset.DefaultRole)
+	alterations.alter(propertyChanged(current.RSAPublicKey, desired.RSAPublicKey), desired.RSAPublicKey != nil, func() { set.RSAPublicKey = desired.RSAPublicKey }, &unset.RSAPublicKey)
+	alterations.alter(propertyChanged(current.RSAPublicKey2, desired.RSAPublicKey2), desired.RSAPublicKey2 != nil, func() { set.RSAPublicKey2 = desired.RSAPublicKey2 }, &unset.RSAPublicKey2)
+	alterations.alter(propertyChanged(current.Comment, desired.Comment), desired.Comment != nil, func() { set.Comment = desired.Comment }, &unset.Comment)
+
+	if alterations.runSet {
+		statements = append(statements, &AlterUserOptions{name: id, Set: &UserSet{ObjectProperties: set}})
+	}
+	if alterations.runUnset {
+		statements = append(statements, &AlterUserOptions{name: id, Unset: &UserUnset{ObjectProperties: unset}})
+	}
+	return statements
+}
+
 // DropUserOptions contains options for dropping a user.
-type DropUserOptions struct{}
+type DropUserOptions struct {
+	drop     bool                    `ddl:"static" sql:"DROP"` //lint:ignore U1000 This is used in the ddl tag
+	user     bool                    `ddl:"static" sql:"USER"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropUserOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
 
 func (v *users) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropUserOptions) error {
+	if opts == nil {
+		opts = &DropUserOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type describeUserOptions struct {
+	describe bool                    `ddl:"static" sql:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	user     bool                    `ddl:"static" sql:"USER"`     //lint:ignore U1000 This is used in the ddl tag
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeUserOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
 	return nil
 }
 
 // UserDetails contains details about a user.
-type UserDetails struct{}
+// Properties the user does not set carry the default value in Value as well as in DefaultValue.
+type UserDetails struct {
+	Name                  *StringProperty
+	Comment               *StringProperty
+	DisplayName           *StringProperty
+	LoginName             *StringProperty
+	FirstName             *StringProperty
+	MiddleName            *StringProperty
+	LastName              *StringProperty
+	Email                 *StringProperty
+	Password              *StringProperty
+	MustChangePassword    *BoolProperty
+	Disabled              *BoolProperty
+	SnowflakeLock         *BoolProperty
+	SnowflakeSupport      *BoolProperty
+	DaysToExpiry          *StringProperty
+	MinsToUnlock          *IntProperty
+	DefaultWarehouse      *StringProperty
+	DefaultNamespace      *StringProperty
+	DefaultRole           *StringProperty
+	DefaultSecondaryRoles *StringProperty
+	ExtAuthnDuo           *BoolProperty
+	ExtAuthnUID           *StringProperty
+	MinsToBypassMFA       *IntProperty
+	RSAPublicKeyFP        *StringProperty
+	RSAPublicKey2FP       *StringProperty
+	PasswordLastSetTime   *StringProperty
+	CustomLandingPageURL  *StringProperty
+	Type                  *StringProperty
+	HasMFA                *BoolProperty
+}
+
+func userDetailsFromRows(rows []propertyRow) *UserDetails {
+	v := &UserDetails{}
+	for _, row := range rows {
+		if row.Value == "null" || row.Value == "" {
+			row.Value = row.DefaultValue
+		}
+		switch row.Property {
+		case "NAME":
+			v.Name = row.toStringProperty()
+		case "COMMENT":
+			v.Comment = row.toStringProperty()
+		case "DISPLAY_NAME":
+			v.DisplayName = row.toStringProperty()
+		case "LOGIN_NAME":
+			v.LoginName = row.toStringProperty()
+		case "FIRST_NAME":
+			v.FirstName = row.toStringProperty()
+		case "MIDDLE_NAME":
+			v.MiddleName = row.toStringProperty()
+		case "LAST_NAME":
+			v.LastName = row.toStringProperty()
+		case "EMAIL":
+			v.Email = row.toStringProperty()
+		case "PASSWORD":
+			v.Password = row.toStringProperty()
+		case "MUST_CHANGE_PASSWORD":
+			v.MustChangePassword = row.toBoolProperty()
+		case "DISABLED":
+			v.Disabled = row.toBoolProperty()
+		case "SNOWFLAKE_LOCK":
+			v.SnowflakeLock = row.toBoolProperty()
+		case "SNOWFLAKE_SUPPORT":
+			v.SnowflakeSupport = row.toBoolProperty()
+		case "DAYS_TO_EXPIRY":
+			v.DaysToExpiry = row.toStringProperty()
+		case "MINS_TO_UNLOCK":
+			v.MinsToUnlock = row.toIntProperty()
+		case "DEFAULT_WAREHOUSE":
+			v.DefaultWarehouse = row.toStringProperty()
+		case "DEFAULT_NAMESPACE":
+			v.DefaultNamespace = row.toStringProperty()
+		case "DEFAULT_ROLE":
+			v.DefaultRole = row.toStringProperty()
+		case "DEFAULT_SECONDARY_ROLES":
+			v.DefaultSecondaryRoles = row.toStringProperty()
+		case "EXT_AUTHN_DUO":
+			v.ExtAuthnDuo = row.toBoolProperty()
+		case "EXT_AUTHN_UID":
+			v.ExtAuthnUID = row.toStringProperty()
+		case "MINS_TO_BYPASS_MFA":
+			v.MinsToBypassMFA = row.toIntProperty()
+		case "RSA_PUBLIC_KEY_FP":
+			v.RSAPublicKeyFP = row.toStringProperty()
+		case "RSA_PUBLIC_KEY_2_FP":
+			v.RSAPublicKey2FP = row.toStringProperty()
+		case "PASSWORD_LAST_SET_TIME":
+			v.PasswordLastSetTime = row.toStringProperty()
+		case "CUSTOM_LANDING_PAGE_URL":
+			v.CustomLandingPageURL = row.toStringProperty()
+		case "TYPE":
+			v.Type = row.toStringProperty()
+		case "HAS_MFA":
+			v.HasMFA = row.toBoolProperty()
+		}
+	}
+	return v
+}
 
 func (v *users) Describe(ctx context.Context, id AccountObjectIdentifier) (*UserDetails, error) {
-	return nil, nil
+	opts := &describeUserOptions{
+		name: id,
+	}
+	rows, err := describeRows[propertyRow](ctx, v.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return userDetailsFromRows(rows), nil
 }
 
 // ShowUserOptions contains options for listing users.
-type ShowUserOptions struct{}
+type ShowUserOptions struct {
+	show  bool  `ddl:"static" sql:"SHOW"`  //lint:ignore U1000 This is used in the ddl tag
+	users bool  `ddl:"static" sql:"USERS"` //lint:ignore U1000 This is used in the ddl tag
+	Like  *Like `ddl:"keyword" sql:"LIKE"`
+	ShowOptions
+}
+
+func (opts *ShowUserOptions) validate() error {
+	return nil
+}
 
 func (v *users) Show(ctx context.Context, opts *ShowUserOptions) ([]*User, error) {
-	return nil, nil
+	if opts == nil {
+		opts = &ShowUserOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []userDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*User, len(dest))
+	for i, row := range dest {
+		resultList[i], err = row.toUser(v.client.strictEnumParsing)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resultList, nil
 }
 
 func (v *users) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User, error) {
-	return nil, nil
+	users, err := v.Show(ctx, &ShowUserOptions{
+		Like: likeExactly(id.Name()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.Name == id.Name() {
+			return user, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+func (v *users) ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	users, err := v.Show(ctx, &ShowUserOptions{
+		Like: likeIDs(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filterByIDs(users, ids), nil
 }
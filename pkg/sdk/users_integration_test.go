@@ -0,0 +1,97 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Users(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	id := randomAccountObjectIdentifier(t)
+	err := client.Users.Create(ctx, id, &CreateUserOptions{
+		ObjectProperties: &UserObjectProperties{
+			LoginName:    String(id.Name() + "_login"),
+			DisplayName:  String("some display name"),
+			Email:        String("someone@example.com"),
+			DaysToExpiry: Int(5),
+			Comment:      String("some comment"),
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Users.Drop(ctx, id, &DropUserOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		user, err := client.Users.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), user.Name)
+		assert.Equal(t, "some display name", user.DisplayName)
+		assert.Equal(t, "some comment", user.Comment)
+		assert.False(t, user.Disabled)
+	})
+
+	t.Run("show with like", func(t *testing.T) {
+		users, err := client.Users.Show(ctx, &ShowUserOptions{Like: &Like{Pattern: String(id.Name())}})
+		require.NoError(t, err)
+		assert.Len(t, users, 1)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		details, err := client.Users.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), details.Name.Value)
+		assert.Equal(t, "someone@example.com", details.Email.Value)
+		assert.False(t, details.Disabled.Value)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		err := client.Users.Alter(ctx, id, &AlterUserOptions{
+			Set: &UserSet{
+				ObjectProperties: &UserObjectProperties{
+					Disabled: Bool(true),
+					Comment:  String("new comment"),
+				},
+			},
+		})
+		require.NoError(t, err)
+		user, err := client.Users.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, user.Disabled)
+		assert.Equal(t, "new comment", user.Comment)
+
+		err = client.Users.Alter(ctx, id, &AlterUserOptions{
+			Unset: &UserUnset{
+				ObjectProperties: &UserObjectPropertiesUnset{
+					Disabled: Bool(true),
+					Comment:  Bool(true),
+				},
+			},
+		})
+		require.NoError(t, err)
+		user, err = client.Users.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, user.Disabled)
+		assert.Equal(t, "", user.Comment)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		newID := randomAccountObjectIdentifier(t)
+		err := client.Users.Alter(ctx, id, &AlterUserOptions{NewName: newID})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Users.Drop(ctx, newID, &DropUserOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+		_, err = client.Users.ShowByID(ctx, newID)
+		require.NoError(t, err)
+		_, err = client.Users.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,300 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserCreate(t *testing.T) {
+	t.Run("only name", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: NewAccountObjectIdentifier("myuser"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE USER "myuser"`, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		userType := UserTypePerson
+		opts := &CreateUserOptions{
+			OrReplace: Bool(true),
+			name:      NewAccountObjectIdentifier("myuser"),
+			ObjectProperties: &UserObjectProperties{
+				Password:              String("secret"),
+				LoginName:             String("jdoe"),
+				DisplayName:           String("John Doe"),
+				FirstName:             String("John"),
+				LastName:              String("Doe"),
+				Email:                 String("jdoe@example.com"),
+				MustChangePassword:    Bool(true),
+				Disabled:              Bool(false),
+				DaysToExpiry:          Int(30),
+				MinsToUnlock:          Int(10),
+				DefaultWarehouse:      NewAccountObjectIdentifier("wh"),
+				DefaultNamespace:      String("db.schema"),
+				DefaultRole:           NewAccountObjectIdentifier("analyst"),
+				DefaultSecondaryRoles: []SecondaryRole{{Value: "ALL"}},
+				MinsToBypassMFA:       Int(5),
+				RSAPublicKey:          String("MIIB"),
+				Type:                  &userType,
+				Comment:               String("some comment"),
+			},
+			SessionParameters: &SessionParameters{
+				Autocommit: Bool(false),
+			},
+			Tag: []TagAssociation{
+				{
+					Name:  NewSchemaObjectIdentifier("db", "schema", "tag"),
+					Value: "v1",
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE USER "myuser" PASSWORD = 'secret' LOGIN_NAME = 'jdoe' DISPLAY_NAME = 'John Doe' FIRST_NAME = 'John' LAST_NAME = 'Doe' EMAIL = 'jdoe@example.com' MUST_CHANGE_PASSWORD = true DISABLED = false DAYS_TO_EXPIRY = 30 MINS_TO_UNLOCK = 10 DEFAULT_WAREHOUSE = "wh" DEFAULT_NAMESPACE = 'db.schema' DEFAULT_ROLE = "analyst" DEFAULT_SECONDARY_ROLES = ('ALL') MINS_TO_BYPASS_MFA = 5 RSA_PUBLIC_KEY = 'MIIB' TYPE = PERSON COMMENT = 'some comment' AUTOCOMMIT = false WITH TAG ("db"."schema"."tag" = 'v1')`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name:        NewAccountObjectIdentifier("myuser"),
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: password for service user", func(t *testing.T) {
+		userType := UserTypeService
+		opts := &CreateUserOptions{
+			name: NewAccountObjectIdentifier("myuser"),
+			ObjectProperties: &UserObjectProperties{
+				Password: String("secret"),
+				Type:     &userType,
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestUserAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("myuser")
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  NewAccountObjectIdentifier("newuser"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER USER IF EXISTS "myuser" RENAME TO "newuser"`, actual)
+	})
+
+	t.Run("reset password", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name:          id,
+			ResetPassword: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER USER "myuser" RESET PASSWORD`, actual)
+	})
+
+	t.Run("abort all queries", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name:            id,
+			AbortAllQueries: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER USER "myuser" ABORT ALL QUERIES`, actual)
+	})
+
+	t.Run("set properties", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				ObjectProperties: &UserObjectProperties{
+					RSAPublicKey2: String("MIIB"),
+					Disabled:      Bool(true),
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER USER "myuser" SET DISABLED = true RSA_PUBLIC_KEY_2 = 'MIIB'`, actual)
+	})
+
+	t.Run("set password policy", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				PasswordPolicy: NewSchemaObjectIdentifier("db", "schema", "policy"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER USER "myuser" SET PASSWORD POLICY "db"."schema"."policy"`, actual)
+	})
+
+	t.Run("unset properties", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				ObjectProperties: &UserObjectPropertiesUnset{
+					RSAPublicKey: Bool(true),
+					Comment:      Bool(true),
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER USER "myuser" UNSET RSA_PUBLIC_KEY, COMMENT`, actual)
+	})
+
+	t.Run("unset session parameters", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				SessionParameters: &SessionParametersUnset{
+					Autocommit: Bool(true),
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER USER "myuser" UNSET AUTOCOMMIT`, actual)
+	})
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: more than one alter action", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name:            id,
+			ResetPassword:   Bool(true),
+			AbortAllQueries: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: set policy and properties", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				SessionPolicy:    NewSchemaObjectIdentifier("db", "schema", "policy"),
+				ObjectProperties: &UserObjectProperties{Comment: String("c")},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestUserDrop(t *testing.T) {
+	opts := &DropUserOptions{
+		IfExists: Bool(true),
+		name:     NewAccountObjectIdentifier("myuser"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DROP USER IF EXISTS "myuser"`, actual)
+}
+
+func TestUserShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&ShowUserOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW USERS`, actual)
+	})
+
+	t.Run("with like, starts with and limit", func(t *testing.T) {
+		opts := &ShowUserOptions{
+			Like: &Like{Pattern: String("my%")},
+			ShowOptions: ShowOptions{
+				StartsWith: String("my"),
+				Limit:      &LimitFrom{Rows: Int(10), From: String("myuser")},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW USERS LIKE 'my%' STARTS WITH 'my' LIMIT 10 FROM 'myuser'`, actual)
+	})
+}
+
+func TestUserDescribe(t *testing.T) {
+	opts := &describeUserOptions{
+		name: NewAccountObjectIdentifier("myuser"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, `DESCRIBE USER "myuser"`, actual)
+}
+
+func TestUserDetailsFromRows(t *testing.T) {
+	rows := []propertyRow{
+		{Property: "NAME", Value: "MYUSER", DefaultValue: "null"},
+		{Property: "DISPLAY_NAME", Value: "null", DefaultValue: "MYUSER"},
+		{Property: "DISABLED", Value: "true", DefaultValue: "false"},
+		{Property: "MUST_CHANGE_PASSWORD", Value: "null", DefaultValue: "false"},
+		{Property: "DAYS_TO_EXPIRY", Value: "null", DefaultValue: "null"},
+		{Property: "MINS_TO_UNLOCK", Value: "null", DefaultValue: "null"},
+		{Property: "MINS_TO_BYPASS_MFA", Value: "5", DefaultValue: "null"},
+		{Property: "RSA_PUBLIC_KEY_FP", Value: "SHA256:abc", DefaultValue: "null"},
+		{Property: "RSA_PUBLIC_KEY_2_FP", Value: "null", DefaultValue: "null"},
+		{Property: "TYPE", Value: "SERVICE", DefaultValue: "null"},
+	}
+	details := userDetailsFromRows(rows)
+
+	assert.Equal(t, "MYUSER", details.Name.Value)
+	assert.Equal(t, "MYUSER", details.DisplayName.Value)
+	assert.True(t, details.Disabled.Value)
+	assert.False(t, details.MustChangePassword.Value)
+	assert.Equal(t, "", details.DaysToExpiry.Value)
+	assert.Equal(t, 0, details.MinsToUnlock.Value)
+	assert.Equal(t, 5, details.MinsToBypassMFA.Value)
+	assert.Equal(t, "SHA256:abc", details.RSAPublicKeyFP.Value)
+	assert.Equal(t, "", details.RSAPublicKey2FP.Value)
+	assert.Equal(t, "SERVICE", details.Type.Value)
+}
+
+func TestComputeUserAlterations(t *testing.T) {
+	id := NewAccountObjectIdentifier("user")
+	current := func() *UserProperties {
+		return &UserProperties{
+			Name:             id,
+			LoginName:        String("login"),
+			Email:            String("user@example.com"),
+			Disabled:         Bool(false),
+			DefaultWarehouse: String("wh"),
+		}
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		assert.Empty(t, ComputeUserAlterations(current(), current()))
+	})
+
+	t.Run("rename, set and unset", func(t *testing.T) {
+		desired := current()
+		desired.Name = NewAccountObjectIdentifier("renamed")
+		desired.Disabled = Bool(true)
+		desired.DefaultRole = String("role")
+		desired.Email = nil
+
+		actual, err := StatementsToSQL(ComputeUserAlterations(current(), desired))
+		require.NoError(t, err)
+		expected := []string{
+			`ALTER USER "user" RENAME TO "renamed"`,
+			`ALTER USER "renamed" SET DISABLED = true DEFAULT_ROLE = "role"`,
+			`ALTER USER "renamed" UNSET EMAIL`,
+		}
+		assert.Equal(t, expected, actual)
+	})
+}
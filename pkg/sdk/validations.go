@@ -1,7 +1,9 @@
 package sdk
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 )
 
 func IsValidDataType(v string) bool {
@@ -92,3 +94,80 @@ func validateIntInRange(value int, min int, max int) bool {
 func validateIntGreaterThanOrEqual(value int, min int) bool {
 	return value >= min
 }
+
+// validateTags checks the rules of the validate tags of a struct, so that options structs do not have to
+// hand-roll the checks of their optional fields. A tag holds rules separated by semicolons, each with the names
+// of the exported fields it applies to, e.g. `validate:"at_least_one_of=Set,Unset;at_most_one_of=Set,Unset"`:
+//   - exactly_one_of: exactly one of the fields must be set
+//   - at_least_one_of: at least one of the fields must be set
+//   - at_most_one_of: at most one of the fields can be set
+//   - all_of: the fields must be set together or not at all
+func validateTags(v any) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("expected struct, got %s", value.Kind())
+	}
+	t := value.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("validate")
+		if tag == "" {
+			continue
+		}
+		for _, rule := range strings.Split(tag, ";") {
+			if err := validateTagRule(value, rule); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateTagRule(value reflect.Value, rule string) error {
+	name, fieldList, ok := strings.Cut(rule, "=")
+	if !ok {
+		return fmt.Errorf("invalid validate rule %q", rule)
+	}
+	fields := strings.Split(fieldList, ",")
+	set := 0
+	for _, field := range fields {
+		fieldValue := value.FieldByName(field)
+		if !fieldValue.IsValid() || !fieldValue.CanInterface() {
+			return fmt.Errorf("validate rule %q: no exported field %s", rule, field)
+		}
+		if valueSet(fieldValue.Interface()) {
+			set++
+		}
+	}
+	switch name {
+	case "exactly_one_of":
+		if set != 1 {
+			return fmt.Errorf("exactly one of %s must be set", joinFieldNames(fields, "or"))
+		}
+	case "at_least_one_of":
+		if set == 0 {
+			return fmt.Errorf("at least one of %s must be set", joinFieldNames(fields, "or"))
+		}
+	case "at_most_one_of":
+		if set > 1 {
+			return fmt.Errorf("only one of %s can be set", joinFieldNames(fields, "or"))
+		}
+	case "all_of":
+		if set != 0 && set != len(fields) {
+			return fmt.Errorf("%s must be set together", joinFieldNames(fields, "and"))
+		}
+	default:
+		return fmt.Errorf("unknown validate rule %q", name)
+	}
+	return nil
+}
+
+// joinFieldNames joins the names as in "A, B or C".
+func joinFieldNames(names []string, conjunction string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " " + conjunction + " " + names[len(names)-1]
+}
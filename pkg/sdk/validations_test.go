@@ -1,9 +1,16 @@
 package sdk
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsValidDataType(t *testing.T) {
@@ -193,3 +200,99 @@ func TestValidateIntGreaterThanOrEqual(t *testing.T) {
 		assert.Equal(t, ok, false)
 	})
 }
+
+func TestValidateTags(t *testing.T) {
+	type testOptions struct {
+		A *bool   `validate:"exactly_one_of=A,B"`
+		B *bool   `validate:"at_most_one_of=B,C;all_of=B,D"`
+		C *string `validate:"at_least_one_of=C,D"`
+		D []string
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, validateTags(&testOptions{A: Bool(true), C: String("c")}))
+		assert.NoError(t, validateTags(&testOptions{B: Bool(true), D: []string{"d"}}))
+	})
+
+	t.Run("exactly one of", func(t *testing.T) {
+		err := validateTags(&testOptions{C: String("c")})
+		assert.EqualError(t, err, "exactly one of A or B must be set")
+	})
+
+	t.Run("at most one of", func(t *testing.T) {
+		err := validateTags(&testOptions{B: Bool(true), C: String("c"), D: []string{"d"}})
+		assert.EqualError(t, err, "only one of B or C can be set")
+	})
+
+	t.Run("all of", func(t *testing.T) {
+		err := validateTags(&testOptions{A: Bool(true), D: []string{"d"}})
+		assert.EqualError(t, err, "B and D must be set together")
+	})
+
+	t.Run("at least one of", func(t *testing.T) {
+		err := validateTags(&testOptions{A: Bool(true)})
+		assert.EqualError(t, err, "at least one of C or D must be set")
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		err := validateTags(&struct {
+			A *bool `validate:"exactly_one_of=A,missing"`
+		}{})
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown rule", func(t *testing.T) {
+		err := validateTags(&struct {
+			A *bool `validate:"none_of=A"`
+		}{})
+		assert.Error(t, err)
+	})
+}
+
+// TestValidateTagsInOptions checks the validate tags of every struct in the package, so that misspelled field
+// and rule names fail here instead of at runtime in validateTags.
+func TestValidateTagsInOptions(t *testing.T) {
+	rules := map[string]bool{"exactly_one_of": true, "at_least_one_of": true, "at_most_one_of": true, "all_of": true}
+	packages, err := parser.ParseDir(token.NewFileSet(), ".", nil, 0)
+	require.NoError(t, err)
+	checked := 0
+	for _, file := range packages["sdk"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			structType, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			fields := map[string]bool{}
+			for _, field := range structType.Fields.List {
+				for _, name := range field.Names {
+					fields[name.Name] = name.IsExported()
+				}
+			}
+			for _, field := range structType.Fields.List {
+				if field.Tag == nil {
+					continue
+				}
+				tag, err := strconv.Unquote(field.Tag.Value)
+				require.NoError(t, err)
+				validate := reflect.StructTag(tag).Get("validate")
+				if validate == "" {
+					continue
+				}
+				checked++
+				for _, rule := range strings.Split(validate, ";") {
+					name, fieldList, ok := strings.Cut(rule, "=")
+					assert.True(t, ok && rules[name], "%s: invalid validate rule %q", spec.Name.Name, rule)
+					for _, name := range strings.Split(fieldList, ",") {
+						assert.True(t, fields[name], "%s: validate rule %q refers to %s, which is not an exported field", spec.Name.Name, rule, name)
+					}
+				}
+			}
+			return true
+		})
+	}
+	assert.NotZero(t, checked)
+}
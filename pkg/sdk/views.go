@@ -0,0 +1,187 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Views = (*views)(nil)
+
+// Views describes all the view related methods that the Snowflake API supports.
+type Views interface {
+	// Show returns a list of views.
+	Show(ctx context.Context, opts *ShowViewOptions) ([]*View, error)
+	// ShowByID returns a view by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*View, error)
+	// ShowTerse returns a list of views with only the columns of SHOW TERSE VIEWS.
+	ShowTerse(ctx context.Context, opts *ShowViewOptions) ([]*TerseView, error)
+}
+
+// views implements Views.
+type views struct {
+	client *Client
+}
+
+type ShowViewOptions struct {
+	show  bool  `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Terse *bool `ddl:"keyword" sql:"TERSE"`
+	views bool  `ddl:"static" sql:"VIEWS"` //lint:ignore U1000 This is used in the ddl tag
+
+	// optional
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	In   *In   `ddl:"keyword" sql:"IN"`
+	ShowOptions
+}
+
+func (opts *ShowViewOptions) validate() error {
+	if valueSet(opts.In) {
+		if err := opts.In.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type View struct {
+	CreatedOn      time.Time
+	Name           string
+	Kind           string
+	DatabaseName   string
+	SchemaName     string
+	Owner          string
+	Comment        string
+	Text           string
+	IsSecure       bool
+	IsMaterialized bool
+	OwnerRoleType  string
+	ChangeTracking bool
+}
+
+func (v *View) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *View) ObjectType() ObjectType {
+	return ObjectTypeView
+}
+
+type viewRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	Kind           sql.NullString `db:"kind"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	Owner          sql.NullString `db:"owner"`
+	Comment        sql.NullString `db:"comment"`
+	Text           sql.NullString `db:"text"`
+	IsSecure       sql.NullBool   `db:"is_secure"`
+	IsMaterialized sql.NullBool   `db:"is_materialized"`
+	OwnerRoleType  sql.NullString `db:"owner_role_type"`
+	ChangeTracking sql.NullString `db:"change_tracking"`
+}
+
+func (row viewRow) toView() *View {
+	return &View{
+		CreatedOn:      row.CreatedOn,
+		Name:           row.Name,
+		Kind:           row.Kind.String,
+		DatabaseName:   row.DatabaseName,
+		SchemaName:     row.SchemaName,
+		Owner:          row.Owner.String,
+		Comment:        row.Comment.String,
+		Text:           row.Text.String,
+		IsSecure:       row.IsSecure.Bool,
+		IsMaterialized: row.IsMaterialized.Bool,
+		OwnerRoleType:  row.OwnerRoleType.String,
+		ChangeTracking: row.ChangeTracking.String == "ON",
+	}
+}
+
+func (v *views) Show(ctx context.Context, opts *ShowViewOptions) ([]*View, error) {
+	if opts == nil {
+		opts = &ShowViewOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []viewRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*View, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toView()
+	}
+	return resultList, nil
+}
+
+func (v *views) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*View, error) {
+	views, err := v.Show(ctx, &ShowViewOptions{
+		Like: likeExactly(id.Name()),
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, view := range views {
+		if view.Name == id.Name() {
+			return view, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type TerseView struct {
+	CreatedOn    time.Time
+	Name         string
+	Kind         string
+	DatabaseName string
+	SchemaName   string
+}
+
+func (v *TerseView) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (row terseRow) toTerseView() *TerseView {
+	return &TerseView{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		Kind:         row.Kind.String,
+		DatabaseName: row.DatabaseName.String,
+		SchemaName:   row.SchemaName.String,
+	}
+}
+
+func (v *views) ShowTerse(ctx context.Context, opts *ShowViewOptions) ([]*TerseView, error) {
+	if opts == nil {
+		opts = &ShowViewOptions{}
+	}
+	opts.Terse = Bool(true)
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := v.client.structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []terseRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*TerseView, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTerseView()
+	}
+	return resultList, nil
+}
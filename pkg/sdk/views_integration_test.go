@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Views(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	tableID, tableCleanup := createTable(t, client, database, schema)
+	t.Cleanup(tableCleanup)
+
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE VIEW %s COMMENT = 'some comment' AS SELECT * FROM %s", id.FullyQualifiedName(), tableID.FullyQualifiedName()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP VIEW %s", id.FullyQualifiedName()))
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		view, err := client.Views.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, view.ID())
+		assert.Equal(t, "some comment", view.Comment)
+		assert.False(t, view.IsSecure)
+	})
+
+	t.Run("show terse", func(t *testing.T) {
+		views, err := client.Views.ShowTerse(ctx, &ShowViewOptions{In: &In{Schema: schema.ID()}})
+		require.NoError(t, err)
+		require.Len(t, views, 1)
+		assert.Equal(t, id, views[0].ID())
+		assert.Equal(t, "VIEW", views[0].Kind)
+	})
+}
@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewsShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		actual, err := structToSQL(&ShowViewOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW VIEWS`, actual)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		opts := &ShowViewOptions{
+			Terse: Bool(true),
+			Like:  &Like{Pattern: String("my%")},
+			In:    &In{Schema: NewSchemaIdentifier("db", "schema")},
+			ShowOptions: ShowOptions{
+				StartsWith: String("my"),
+				Limit:      &LimitFrom{Rows: Int(10), From: String("my_view")},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `SHOW TERSE VIEWS LIKE 'my%' IN SCHEMA "db"."schema" STARTS WITH 'my' LIMIT 10 FROM 'my_view'`, actual)
+	})
+
+	t.Run("validation: more than one scope", func(t *testing.T) {
+		opts := &ShowViewOptions{
+			In: &In{Account: Bool(true), Schema: NewSchemaIdentifier("db", "schema")},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestViewRow(t *testing.T) {
+	row := viewRow{
+		Name:           "MY_VIEW",
+		DatabaseName:   "DB",
+		SchemaName:     "SCHEMA",
+		IsSecure:       sql.NullBool{Bool: true, Valid: true},
+		ChangeTracking: sql.NullString{String: "ON", Valid: true},
+	}
+	view := row.toView()
+	assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "MY_VIEW"), view.ID())
+	assert.True(t, view.IsSecure)
+	assert.False(t, view.IsMaterialized)
+	assert.True(t, view.ChangeTracking)
+}
@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -20,8 +21,14 @@ type Warehouses interface {
 	Show(ctx context.Context, opts *ShowWarehouseOptions) ([]*Warehouse, error)
 	// ShowByID returns a warehouse by ID
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error)
+	// ShowByIDs returns the warehouses with the given IDs using a single SHOW, IDs that do not exist are skipped.
+	ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*Warehouse, error)
 	// Describe returns the details of a warehouse.
 	Describe(ctx context.Context, id AccountObjectIdentifier) (*WarehouseDetails, error)
+	// MeteringHistory returns the hourly credit usage of warehouses since start, most recent first.
+	MeteringHistory(ctx context.Context, start time.Time, opts *WarehouseHistoryOptions) ([]*WarehouseMeteringHistoryEntry, error)
+	// LoadHistory returns the load of warehouses since start in 5 minute intervals, most recent first.
+	LoadHistory(ctx context.Context, start time.Time, opts *WarehouseHistoryOptions) ([]*WarehouseLoadHistoryEntry, error)
 }
 
 var _ Warehouses = (*warehouses)(nil)
@@ -37,6 +44,11 @@ var (
 	WarehouseTypeSnowparkOptimized WarehouseType = "SNOWPARK-OPTIMIZED"
 )
 
+var allWarehouseTypes = []WarehouseType{
+	WarehouseTypeStandard,
+	WarehouseTypeSnowparkOptimized,
+}
+
 type WarehouseSize string
 
 var (
@@ -87,6 +99,11 @@ var (
 	ScalingPolicyEconomy  ScalingPolicy = "ECONOMY"
 )
 
+var allScalingPolicies = []ScalingPolicy{
+	ScalingPolicyStandard,
+	ScalingPolicyEconomy,
+}
+
 type CreateWarehouseOptions struct {
 	create      bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
 	OrReplace   *bool                   `ddl:"keyword" sql:"OR REPLACE"`
@@ -142,7 +159,7 @@ func (c *warehouses) Create(ctx context.Context, id AccountObjectIdentifier, opt
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	stmt, err := structToSQL(opts)
+	stmt, err := c.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -288,7 +305,7 @@ func (c *warehouses) Alter(ctx context.Context, id AccountObjectIdentifier, opts
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := c.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -320,7 +337,7 @@ func (c *warehouses) Drop(ctx context.Context, id AccountObjectIdentifier, opts
 	if err := opts.validate(); err != nil {
 		return err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := c.client.structToSQL(opts)
 	if err != nil {
 		return err
 	}
@@ -351,6 +368,72 @@ const (
 	WarehouseStateResuming   WarehouseState = "RESUMING"
 )
 
+var allWarehouseStates = []WarehouseState{
+	WarehouseStateSuspended,
+	WarehouseStateSuspending,
+	WarehouseStateStarted,
+	WarehouseStateResizing,
+	WarehouseStateResuming,
+}
+
+// WarehouseProperties is the state of the alterable properties of a warehouse. A nil field stands for
+// a property that is not set, i.e. has its default value.
+type WarehouseProperties struct {
+	Name                            AccountObjectIdentifier
+	WarehouseType                   *WarehouseType
+	WarehouseSize                   *WarehouseSize
+	MaxClusterCount                 *int
+	MinClusterCount                 *int
+	ScalingPolicy                   *ScalingPolicy
+	AutoSuspend                     *int
+	AutoResume                      *bool
+	ResourceMonitor                 *string
+	Comment                         *string
+	EnableQueryAcceleration         *bool
+	QueryAccelerationMaxScaleFactor *int
+	MaxConcurrencyLevel             *int
+	StatementQueuedTimeoutInSeconds *int
+	StatementTimeoutInSeconds       *int
+}
+
+// ComputeWarehouseAlterations returns the statements that alter a warehouse from the current to the
+// desired state: a rename, followed by a single SET of all changed properties and a single UNSET of
+// all properties that are no longer set.
+func ComputeWarehouseAlterations(current *WarehouseProperties, desired *WarehouseProperties) []Statement {
+	var statements []Statement
+	id := current.Name
+	if desired.Name.Name() != "" && desired.Name.Name() != id.Name() {
+		statements = append(statements, &AlterWarehouseOptions{name: id, NewName: desired.Name})
+		id = desired.Name
+	}
+
+	var alterations propertyAlterations
+	set := &WarehouseSet{}
+	unset := &WarehouseUnset{}
+	alterations.alter(propertyChanged(current.WarehouseType, desired.WarehouseType), desired.WarehouseType != nil, func() { set.WarehouseType = desired.WarehouseType }, &unset.WarehouseType)
+	alterations.alter(propertyChanged(current.WarehouseSize, desired.WarehouseSize), desired.WarehouseSize != nil, func() { set.WarehouseSize = desired.WarehouseSize }, &unset.WarehouseSize)
+	alterations.alter(propertyChanged(current.MaxClusterCount, desired.MaxClusterCount), desired.MaxClusterCount != nil, func() { set.MaxClusterCount = desired.MaxClusterCount }, &unset.MaxClusterCount)
+	alterations.alter(propertyChanged(current.MinClusterCount, desired.MinClusterCount), desired.MinClusterCount != nil, func() { set.MinClusterCount = desired.MinClusterCount }, &unset.MinClusterCount)
+	alterations.alter(propertyChanged(current.ScalingPolicy, desired.ScalingPolicy), desired.ScalingPolicy != nil, func() { set.ScalingPolicy = desired.ScalingPolicy }, &unset.ScalingPolicy)
+	alterations.alter(propertyChanged(current.AutoSuspend, desired.AutoSuspend), desired.AutoSuspend != nil, func() { set.AutoSuspend = desired.AutoSuspend }, &unset.AutoSuspend)
+	alterations.alter(propertyChanged(current.AutoResume, desired.AutoResume), desired.AutoResume != nil, func() { set.AutoResume = desired.AutoResume }, &unset.AutoResume)
+	alterations.alter(propertyChanged(current.ResourceMonitor, desired.ResourceMonitor), desired.ResourceMonitor != nil, func() { set.ResourceMonitor = NewAccountObjectIdentifier(*desired.ResourceMonitor) }, &unset.ResourceMonitor)
+	alterations.alter(propertyChanged(current.Comment, desired.Comment), desired.Comment != nil, func() { set.Comment = desired.Comment }, &unset.Comment)
+	alterations.alter(propertyChanged(current.EnableQueryAcceleration, desired.EnableQueryAcceleration), desired.EnableQueryAcceleration != nil, func() { set.EnableQueryAcceleration = desired.EnableQueryAcceleration }, &unset.EnableQueryAcceleration)
+	alterations.alter(propertyChanged(current.QueryAccelerationMaxScaleFactor, desired.QueryAccelerationMaxScaleFactor), desired.QueryAccelerationMaxScaleFactor != nil, func() { set.QueryAccelerationMaxScaleFactor = desired.QueryAccelerationMaxScaleFactor }, &unset.QueryAccelerationMaxScaleFactor)
+	alterations.alter(propertyChanged(current.MaxConcurrencyLevel, desired.MaxConcurrencyLevel), desired.MaxConcurrencyLevel != nil, func() { set.MaxConcurrencyLevel = desired.MaxConcurrencyLevel }, &unset.MaxConcurrencyLevel)
+	alterations.alter(propertyChanged(current.StatementQueuedTimeoutInSeconds, desired.StatementQueuedTimeoutInSeconds), desired.StatementQueuedTimeoutInSeconds != nil, func() { set.StatementQueuedTimeoutInSeconds = desired.StatementQueuedTimeoutInSeconds }, &unset.StatementQueuedTimeoutInSeconds)
+	alterations.alter(propertyChanged(current.StatementTimeoutInSeconds, desired.StatementTimeoutInSeconds), desired.StatementTimeoutInSeconds != nil, func() { set.StatementTimeoutInSeconds = desired.StatementTimeoutInSeconds }, &unset.StatementTimeoutInSeconds)
+
+	if alterations.runSet {
+		statements = append(statements, &AlterWarehouseOptions{name: id, Set: set})
+	}
+	if alterations.runUnset {
+		statements = append(statements, &AlterWarehouseOptions{name: id, Unset: unset})
+	}
+	return statements
+}
+
 type Warehouse struct {
 	Name                            string
 	State                           WarehouseState
@@ -414,12 +497,9 @@ type warehouseDBRow struct {
 	ScalingPolicy                   string        `db:"scaling_policy"`
 }
 
-func (row warehouseDBRow) toWarehouse() *Warehouse {
+func (row warehouseDBRow) toWarehouse(strict bool) (*Warehouse, error) {
 	wh := &Warehouse{
 		Name:                            row.Name,
-		State:                           WarehouseState(row.State),
-		Type:                            WarehouseType(row.Type),
-		Size:                            WarehouseSize(strings.ReplaceAll(strings.ToUpper(row.Size), "-", "")),
 		MinClusterCount:                 row.MinClusterCount,
 		MaxClusterCount:                 row.MaxClusterCount,
 		StartedClusters:                 row.StartedClusters,
@@ -436,7 +516,23 @@ func (row warehouseDBRow) toWarehouse() *Warehouse {
 		EnableQueryAcceleration:         row.EnableQueryAcceleration,
 		QueryAccelerationMaxScaleFactor: row.QueryAccelerationMaxScaleFactor,
 		ResourceMonitor:                 row.ResourceMonitor,
-		ScalingPolicy:                   ScalingPolicy(row.ScalingPolicy),
+	}
+	var err error
+	if wh.State, err = toEnum(strict, "warehouse state", row.State, allWarehouseStates); err != nil {
+		return nil, err
+	}
+	if wh.Type, err = toEnum(strict, "warehouse type", row.Type, allWarehouseTypes); err != nil {
+		return nil, err
+	}
+	if wh.ScalingPolicy, err = toEnum(strict, "scaling policy", row.ScalingPolicy, allScalingPolicies); err != nil {
+		return nil, err
+	}
+	// sizes are returned in their display form (e.g. X-Small), so they need to be normalized first
+	wh.Size = WarehouseSize(row.Size)
+	if size, err := ToWarehouseSize(row.Size); err == nil {
+		wh.Size = size
+	} else if strict && row.Size != "" {
+		return nil, fmt.Errorf("%w: warehouse size %q", ErrUnknownEnumValue, row.Size)
 	}
 	if val, err := strconv.ParseFloat(row.Available, 64); err != nil {
 		wh.Available = val
@@ -453,7 +549,7 @@ func (row warehouseDBRow) toWarehouse() *Warehouse {
 	if row.AutoSuspend.Valid {
 		wh.AutoSuspend = int(row.AutoSuspend.Int64)
 	}
-	return wh
+	return wh, nil
 }
 
 func (c *warehouses) Show(ctx context.Context, opts *ShowWarehouseOptions) ([]*Warehouse, error) {
@@ -463,7 +559,7 @@ func (c *warehouses) Show(ctx context.Context, opts *ShowWarehouseOptions) ([]*W
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
-	sql, err := structToSQL(opts)
+	sql, err := c.client.structToSQL(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -474,7 +570,10 @@ func (c *warehouses) Show(ctx context.Context, opts *ShowWarehouseOptions) ([]*W
 	}
 	resultList := make([]*Warehouse, len(dest))
 	for i, row := range dest {
-		resultList[i] = row.toWarehouse()
+		resultList[i], err = row.toWarehouse(c.client.strictEnumParsing)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return resultList, nil
@@ -482,9 +581,7 @@ func (c *warehouses) Show(ctx context.Context, opts *ShowWarehouseOptions) ([]*W
 
 func (c *warehouses) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Warehouse, error) {
 	warehouses, err := c.Show(ctx, &ShowWarehouseOptions{
-		Like: &Like{
-			Pattern: String(id.Name()),
-		},
+		Like: likeExactly(id.Name()),
 	})
 	if err != nil {
 		return nil, err
@@ -498,6 +595,19 @@ func (c *warehouses) ShowByID(ctx context.Context, id AccountObjectIdentifier) (
 	return nil, ErrObjectNotExistOrAuthorized
 }
 
+func (c *warehouses) ShowByIDs(ctx context.Context, ids []AccountObjectIdentifier) ([]*Warehouse, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	warehouses, err := c.Show(ctx, &ShowWarehouseOptions{
+		Like: likeIDs(ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filterByIDs(warehouses, ids), nil
+}
+
 type warehouseDescribeOptions struct {
 	describe  bool                    `ddl:"static" sql:"DESCRIBE"`  //lint:ignore U1000 This is used in the ddl tag
 	warehouse bool                    `ddl:"static" sql:"WAREHOUSE"` //lint:ignore U1000 This is used in the ddl tag
@@ -535,27 +645,153 @@ func (c *warehouses) Describe(ctx context.Context, id AccountObjectIdentifier) (
 	opts := &warehouseDescribeOptions{
 		name: id,
 	}
-	if err := opts.validate(); err != nil {
+	dest, err := describeRow[warehouseDetailsRow](ctx, c.client, opts)
+	if err != nil {
 		return nil, err
 	}
+	return dest.toWarehouseDetails(), nil
+}
 
-	sql, err := structToSQL(opts)
-	if err != nil {
+func (v *Warehouse) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *Warehouse) ObjectType() ObjectType {
+	return ObjectTypeWarehouse
+}
+
+// WarehouseHistoryOptions filters the result of the INFORMATION_SCHEMA.WAREHOUSE_METERING_HISTORY and
+// INFORMATION_SCHEMA.WAREHOUSE_LOAD_HISTORY table functions. Without a warehouse all warehouses are returned.
+// Database selects the information schema the table function is called from, by default the one of the current database.
+type WarehouseHistoryOptions struct {
+	Database     AccountObjectIdentifier
+	Warehouse    AccountObjectIdentifier
+	DateRangeEnd *time.Time
+}
+
+func (opts *WarehouseHistoryOptions) validate(start time.Time) error {
+	if start.IsZero() {
+		return errors.New("start must be set")
+	}
+	if valueSet(opts.DateRangeEnd) && opts.DateRangeEnd.Before(start) {
+		return errors.New("DateRangeEnd must not be before start")
+	}
+	return nil
+}
+
+// warehouseHistorySQL builds the query for one of the warehouse history table functions. The warehouse
+// name is passed as a string argument, so it is unquoted and must match the case stored by Snowflake.
+func warehouseHistorySQL(function string, start time.Time, opts *WarehouseHistoryOptions) string {
+	args := []string{fmt.Sprintf("DATE_RANGE_START => TO_TIMESTAMP_LTZ('%s')", start.Format(time.RFC3339))}
+	if opts.DateRangeEnd != nil {
+		args = append(args, fmt.Sprintf("DATE_RANGE_END => TO_TIMESTAMP_LTZ('%s')", opts.DateRangeEnd.Format(time.RFC3339)))
+	}
+	if valueSet(opts.Warehouse) {
+		args = append(args, fmt.Sprintf("WAREHOUSE_NAME => '%s'", escapeStringLiteral(opts.Warehouse.Name())))
+	}
+	schema := "INFORMATION_SCHEMA"
+	if valueSet(opts.Database) {
+		schema = fmt.Sprintf("%s.INFORMATION_SCHEMA", opts.Database.FullyQualifiedName())
+	}
+	return fmt.Sprintf(`SELECT * FROM TABLE(%s.%s(%s)) ORDER BY START_TIME DESC, WAREHOUSE_NAME`, schema, function, strings.Join(args, ", "))
+}
+
+type WarehouseMeteringHistoryEntry struct {
+	StartTime                time.Time
+	EndTime                  time.Time
+	WarehouseName            string
+	CreditsUsed              float64
+	CreditsUsedCompute       float64
+	CreditsUsedCloudServices float64
+}
+
+type warehouseMeteringHistoryRow struct {
+	StartTime                time.Time       `db:"START_TIME"`
+	EndTime                  time.Time       `db:"END_TIME"`
+	WarehouseName            string          `db:"WAREHOUSE_NAME"`
+	CreditsUsed              sql.NullFloat64 `db:"CREDITS_USED"`
+	CreditsUsedCompute       sql.NullFloat64 `db:"CREDITS_USED_COMPUTE"`
+	CreditsUsedCloudServices sql.NullFloat64 `db:"CREDITS_USED_CLOUD_SERVICES"`
+}
+
+func (row warehouseMeteringHistoryRow) toWarehouseMeteringHistoryEntry() *WarehouseMeteringHistoryEntry {
+	return &WarehouseMeteringHistoryEntry{
+		StartTime:                row.StartTime,
+		EndTime:                  row.EndTime,
+		WarehouseName:            row.WarehouseName,
+		CreditsUsed:              row.CreditsUsed.Float64,
+		CreditsUsedCompute:       row.CreditsUsedCompute.Float64,
+		CreditsUsedCloudServices: row.CreditsUsedCloudServices.Float64,
+	}
+}
+
+func (c *warehouses) MeteringHistory(ctx context.Context, start time.Time, opts *WarehouseHistoryOptions) ([]*WarehouseMeteringHistoryEntry, error) {
+	if opts == nil {
+		opts = &WarehouseHistoryOptions{}
+	}
+	if err := opts.validate(start); err != nil {
 		return nil, err
 	}
-	dest := warehouseDetailsRow{}
-	err = c.client.queryOne(ctx, &dest, sql)
+	dest := []warehouseMeteringHistoryRow{}
+	err := c.client.query(ctx, &dest, warehouseHistorySQL("WAREHOUSE_METERING_HISTORY", start, opts))
 	if err != nil {
 		return nil, err
 	}
+	resultList := make([]*WarehouseMeteringHistoryEntry, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toWarehouseMeteringHistoryEntry()
+	}
+	return resultList, nil
+}
 
-	return dest.toWarehouseDetails(), nil
+// WarehouseLoadHistoryEntry is the average number of queries running, queued or blocked during an interval.
+type WarehouseLoadHistoryEntry struct {
+	StartTime             time.Time
+	EndTime               time.Time
+	WarehouseName         string
+	AvgRunning            float64
+	AvgQueuedLoad         float64
+	AvgQueuedProvisioning float64
+	AvgBlocked            float64
 }
 
-func (v *Warehouse) ID() AccountObjectIdentifier {
-	return NewAccountObjectIdentifier(v.Name)
+type warehouseLoadHistoryRow struct {
+	StartTime             time.Time       `db:"START_TIME"`
+	EndTime               time.Time       `db:"END_TIME"`
+	WarehouseName         string          `db:"WAREHOUSE_NAME"`
+	AvgRunning            sql.NullFloat64 `db:"AVG_RUNNING"`
+	AvgQueuedLoad         sql.NullFloat64 `db:"AVG_QUEUED_LOAD"`
+	AvgQueuedProvisioning sql.NullFloat64 `db:"AVG_QUEUED_PROVISIONING"`
+	AvgBlocked            sql.NullFloat64 `db:"AVG_BLOCKED"`
 }
 
-func (v *Warehouse) ObjectType() ObjectType {
-	return ObjectTypeWarehouse
+func (row warehouseLoadHistoryRow) toWarehouseLoadHistoryEntry() *WarehouseLoadHistoryEntry {
+	return &WarehouseLoadHistoryEntry{
+		StartTime:             row.StartTime,
+		EndTime:               row.EndTime,
+		WarehouseName:         row.WarehouseName,
+		AvgRunning:            row.AvgRunning.Float64,
+		AvgQueuedLoad:         row.AvgQueuedLoad.Float64,
+		AvgQueuedProvisioning: row.AvgQueuedProvisioning.Float64,
+		AvgBlocked:            row.AvgBlocked.Float64,
+	}
+}
+
+func (c *warehouses) LoadHistory(ctx context.Context, start time.Time, opts *WarehouseHistoryOptions) ([]*WarehouseLoadHistoryEntry, error) {
+	if opts == nil {
+		opts = &WarehouseHistoryOptions{}
+	}
+	if err := opts.validate(start); err != nil {
+		return nil, err
+	}
+	dest := []warehouseLoadHistoryRow{}
+	err := c.client.query(ctx, &dest, warehouseHistorySQL("WAREHOUSE_LOAD_HISTORY", start, opts))
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*WarehouseLoadHistoryEntry, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toWarehouseLoadHistoryEntry()
+	}
+	return resultList, nil
 }
@@ -183,6 +183,35 @@ func TestInt_WarehouseDescribe(t *testing.T) {
 	})
 }
 
+func TestInt_WarehouseHistory(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	warehouse, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+
+	opts := &WarehouseHistoryOptions{Database: database.ID(), Warehouse: warehouse.ID()}
+	start := time.Now().Add(-24 * time.Hour)
+
+	t.Run("metering history", func(t *testing.T) {
+		entries, err := client.Warehouses.MeteringHistory(ctx, start, opts)
+		require.NoError(t, err)
+		for _, entry := range entries {
+			assert.Equal(t, warehouse.Name, entry.WarehouseName)
+		}
+	})
+
+	t.Run("load history", func(t *testing.T) {
+		entries, err := client.Warehouses.LoadHistory(ctx, start, opts)
+		require.NoError(t, err)
+		for _, entry := range entries {
+			assert.Equal(t, warehouse.Name, entry.WarehouseName)
+		}
+	})
+}
+
 func TestInt_WarehouseAlter(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
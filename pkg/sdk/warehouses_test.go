@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -322,3 +323,134 @@ func TestToWarehouseSize(t *testing.T) {
 		})
 	}
 }
+
+func TestWarehouseRowToWarehouse(t *testing.T) {
+	row := warehouseDBRow{
+		Name:          "mywarehouse",
+		State:         "STARTED",
+		Type:          "STANDARD",
+		Size:          "2X-Large",
+		ScalingPolicy: "ECONOMY",
+	}
+
+	t.Run("known values", func(t *testing.T) {
+		wh, err := row.toWarehouse(true)
+		require.NoError(t, err)
+		assert.Equal(t, WarehouseStateStarted, wh.State)
+		assert.Equal(t, WarehouseTypeStandard, wh.Type)
+		assert.Equal(t, WarehouseSizeXXLarge, wh.Size)
+		assert.Equal(t, ScalingPolicyEconomy, wh.ScalingPolicy)
+	})
+
+	t.Run("unknown values in lenient mode", func(t *testing.T) {
+		r := row
+		r.Size = "7X-Large"
+		r.State = "HIBERNATING"
+		wh, err := r.toWarehouse(false)
+		require.NoError(t, err)
+		assert.Equal(t, WarehouseSize("7X-Large"), wh.Size)
+		assert.Equal(t, WarehouseState("HIBERNATING"), wh.State)
+	})
+
+	t.Run("unknown size in strict mode", func(t *testing.T) {
+		r := row
+		r.Size = "7X-Large"
+		_, err := r.toWarehouse(true)
+		require.ErrorIs(t, err, ErrUnknownEnumValue)
+	})
+
+	t.Run("unknown state in strict mode", func(t *testing.T) {
+		r := row
+		r.State = "HIBERNATING"
+		_, err := r.toWarehouse(true)
+		require.ErrorIs(t, err, ErrUnknownEnumValue)
+	})
+}
+
+func TestComputeWarehouseAlterations(t *testing.T) {
+	id := NewAccountObjectIdentifier("mywarehouse")
+	current := func() *WarehouseProperties {
+		size := WarehouseSizeXSmall
+		return &WarehouseProperties{
+			Name:            id,
+			WarehouseSize:   &size,
+			MaxClusterCount: Int(2),
+			AutoSuspend:     Int(60),
+			ResourceMonitor: String("monitor"),
+			Comment:         String("comment"),
+		}
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		statements := ComputeWarehouseAlterations(current(), current())
+		assert.Empty(t, statements)
+	})
+
+	t.Run("set and unset", func(t *testing.T) {
+		desired := current()
+		size := WarehouseSizeLarge
+		desired.WarehouseSize = &size
+		desired.AutoSuspend = Int(120)
+		desired.MaxClusterCount = nil
+		desired.ResourceMonitor = nil
+
+		actual, err := StatementsToSQL(ComputeWarehouseAlterations(current(), desired))
+		require.NoError(t, err)
+		expected := []string{
+			`ALTER WAREHOUSE "mywarehouse" SET WAREHOUSE_SIZE = 'LARGE' AUTO_SUSPEND = 120`,
+			`ALTER WAREHOUSE "mywarehouse" UNSET MAX_CLUSTER_COUNT, RESOURCE_MONITOR`,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("rename applies the following changes to the new name", func(t *testing.T) {
+		desired := current()
+		desired.Name = NewAccountObjectIdentifier("newname")
+		desired.Comment = String("")
+
+		actual, err := StatementsToSQL(ComputeWarehouseAlterations(current(), desired))
+		require.NoError(t, err)
+		expected := []string{
+			`ALTER WAREHOUSE "mywarehouse" RENAME TO "newname"`,
+			`ALTER WAREHOUSE "newname" SET COMMENT = ''`,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("invalid desired state", func(t *testing.T) {
+		desired := current()
+		desired.MaxClusterCount = Int(11)
+
+		_, err := StatementsToSQL(ComputeWarehouseAlterations(current(), desired))
+		require.Error(t, err)
+	})
+}
+
+func TestWarehouseHistorySQL(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("metering history without options", func(t *testing.T) {
+		actual := warehouseHistorySQL("WAREHOUSE_METERING_HISTORY", start, &WarehouseHistoryOptions{})
+		assert.Equal(t, `SELECT * FROM TABLE(INFORMATION_SCHEMA.WAREHOUSE_METERING_HISTORY(DATE_RANGE_START => TO_TIMESTAMP_LTZ('2023-01-01T00:00:00Z'))) ORDER BY START_TIME DESC, WAREHOUSE_NAME`, actual)
+	})
+
+	t.Run("load history with all options", func(t *testing.T) {
+		actual := warehouseHistorySQL("WAREHOUSE_LOAD_HISTORY", start, &WarehouseHistoryOptions{
+			Database:     NewAccountObjectIdentifier("db"),
+			Warehouse:    NewAccountObjectIdentifier("COMPUTE_WH"),
+			DateRangeEnd: &end,
+		})
+		assert.Equal(t, `SELECT * FROM TABLE("db".INFORMATION_SCHEMA.WAREHOUSE_LOAD_HISTORY(DATE_RANGE_START => TO_TIMESTAMP_LTZ('2023-01-01T00:00:00Z'), DATE_RANGE_END => TO_TIMESTAMP_LTZ('2023-01-02T00:00:00Z'), WAREHOUSE_NAME => 'COMPUTE_WH')) ORDER BY START_TIME DESC, WAREHOUSE_NAME`, actual)
+	})
+
+	t.Run("validation: no start", func(t *testing.T) {
+		opts := &WarehouseHistoryOptions{}
+		assert.Error(t, opts.validate(time.Time{}))
+	})
+
+	t.Run("validation: end before start", func(t *testing.T) {
+		opts := &WarehouseHistoryOptions{DateRangeEnd: &start}
+		assert.Error(t, opts.validate(end))
+	})
+}
@@ -0,0 +1,96 @@
+package snowflake
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// PrivilegeGrant is a single privilege on a single object, e.g. USAGE on DATABASE "db".
+type PrivilegeGrant struct {
+	Privilege  string
+	ObjectType string
+	ObjectName string
+}
+
+// key normalizes the grant so that the desired grants written by users can be compared with
+// the SHOW GRANTS output, which uses upper case with spaces and quotes only some identifiers.
+// Object names are resolved like Snowflake does, so "events" and EVENTS stay different objects.
+func (g PrivilegeGrant) key() string {
+	objectType := strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(g.ObjectType)), " ", "_")
+	objectName, err := sdk.ResolveFullyQualifiedName(strings.TrimSpace(g.ObjectName))
+	if err != nil {
+		objectName = strings.TrimSpace(g.ObjectName)
+	}
+	privilege := strings.ToUpper(strings.TrimSpace(g.Privilege))
+	return privilege + "|" + objectType + "|" + objectName
+}
+
+// GrantsDiffOptions controls which of the desired and actual grants are compared.
+type GrantsDiffOptions struct {
+	// ObjectTypes limits the comparison to the given object types. All object types are compared when empty.
+	ObjectTypes []string
+	// IncludeOwnership compares OWNERSHIP grants as well. They are ignored by default because
+	// ownership is usually managed separately from the privileges of a role.
+	IncludeOwnership bool
+}
+
+func (opts GrantsDiffOptions) includes(g PrivilegeGrant) bool {
+	if !opts.IncludeOwnership && strings.EqualFold(g.Privilege, "OWNERSHIP") {
+		return false
+	}
+	if len(opts.ObjectTypes) == 0 {
+		return true
+	}
+	objectType := PrivilegeGrant{ObjectType: g.ObjectType}.key()
+	for _, t := range opts.ObjectTypes {
+		if (PrivilegeGrant{ObjectType: t}).key() == objectType {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffGrants compares the desired privileges of a role with the grants returned by SHOW GRANTS TO ROLE.
+// Additions are the desired grants that are missing, removals are the actual grants that are not desired.
+// Both are sorted so the result is stable between runs.
+func DiffGrants(desired []PrivilegeGrant, actual []GrantDetail, opts GrantsDiffOptions) (additions []PrivilegeGrant, removals []PrivilegeGrant) {
+	actualByKey := make(map[string]PrivilegeGrant, len(actual))
+	for _, detail := range actual {
+		g := PrivilegeGrant{
+			Privilege:  detail.Privilege.String,
+			ObjectType: detail.GrantedOn.String,
+			ObjectName: detail.Name.String,
+		}
+		if opts.includes(g) {
+			actualByKey[g.key()] = g
+		}
+	}
+	desiredByKey := make(map[string]PrivilegeGrant, len(desired))
+	for _, g := range desired {
+		if opts.includes(g) {
+			desiredByKey[g.key()] = g
+		}
+	}
+
+	for k, g := range desiredByKey {
+		if _, ok := actualByKey[k]; !ok {
+			additions = append(additions, g)
+		}
+	}
+	for k, g := range actualByKey {
+		if _, ok := desiredByKey[k]; !ok {
+			removals = append(removals, g)
+		}
+	}
+	sortPrivilegeGrants(additions)
+	sortPrivilegeGrants(removals)
+	return additions, removals
+}
+
+func sortPrivilegeGrants(grants []PrivilegeGrant) {
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].key() < grants[j].key()
+	})
+}
@@ -0,0 +1,87 @@
+package snowflake_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func grantDetail(privilege, grantedOn, name string) snowflake.GrantDetail {
+	return snowflake.GrantDetail{
+		Privilege: sql.NullString{String: privilege, Valid: true},
+		GrantedOn: sql.NullString{String: grantedOn, Valid: true},
+		Name:      sql.NullString{String: name, Valid: true},
+	}
+}
+
+func TestDiffGrants(t *testing.T) {
+	actual := []snowflake.GrantDetail{
+		grantDetail("USAGE", "DATABASE", "DB"),
+		grantDetail("USAGE", "SCHEMA", "DB.PUBLIC"),
+		grantDetail("SELECT", "TABLE", `DB.PUBLIC."events"`),
+		grantDetail("OWNERSHIP", "TABLE", "DB.PUBLIC.OWNED"),
+		grantDetail("USAGE", "WAREHOUSE", "WH"),
+	}
+
+	t.Run("additions and removals", func(t *testing.T) {
+		r := require.New(t)
+		desired := []snowflake.PrivilegeGrant{
+			{Privilege: "usage", ObjectType: "database", ObjectName: "db"},
+			{Privilege: "SELECT", ObjectType: "TABLE", ObjectName: `"DB"."PUBLIC"."events"`},
+			{Privilege: "MONITOR", ObjectType: "WAREHOUSE", ObjectName: "WH"},
+		}
+		additions, removals := snowflake.DiffGrants(desired, actual, snowflake.GrantsDiffOptions{})
+		r.Equal([]snowflake.PrivilegeGrant{
+			{Privilege: "MONITOR", ObjectType: "WAREHOUSE", ObjectName: "WH"},
+		}, additions)
+		r.Equal([]snowflake.PrivilegeGrant{
+			{Privilege: "USAGE", ObjectType: "SCHEMA", ObjectName: "DB.PUBLIC"},
+			{Privilege: "USAGE", ObjectType: "WAREHOUSE", ObjectName: "WH"},
+		}, removals)
+	})
+
+	t.Run("object type filter", func(t *testing.T) {
+		r := require.New(t)
+		desired := []snowflake.PrivilegeGrant{
+			{Privilege: "USAGE", ObjectType: "WAREHOUSE", ObjectName: "WH"},
+		}
+		additions, removals := snowflake.DiffGrants(desired, actual, snowflake.GrantsDiffOptions{ObjectTypes: []string{"warehouse"}})
+		r.Empty(additions)
+		r.Empty(removals)
+	})
+
+	t.Run("filters desired grants", func(t *testing.T) {
+		r := require.New(t)
+		desired := []snowflake.PrivilegeGrant{
+			{Privilege: "USAGE", ObjectType: "WAREHOUSE", ObjectName: "WH"},
+			{Privilege: "USAGE", ObjectType: "DATABASE", ObjectName: "OTHER"},
+			{Privilege: "OWNERSHIP", ObjectType: "WAREHOUSE", ObjectName: "OTHER_WH"},
+		}
+		additions, removals := snowflake.DiffGrants(desired, actual, snowflake.GrantsDiffOptions{ObjectTypes: []string{"WAREHOUSE"}})
+		r.Empty(additions)
+		r.Empty(removals)
+	})
+
+	t.Run("case-sensitive names", func(t *testing.T) {
+		r := require.New(t)
+		desired := []snowflake.PrivilegeGrant{
+			{Privilege: "SELECT", ObjectType: "TABLE", ObjectName: "db.public.events"},
+		}
+		additions, removals := snowflake.DiffGrants(desired, actual, snowflake.GrantsDiffOptions{ObjectTypes: []string{"TABLE"}})
+		r.Equal(desired, additions)
+		r.Equal([]snowflake.PrivilegeGrant{
+			{Privilege: "SELECT", ObjectType: "TABLE", ObjectName: `DB.PUBLIC."events"`},
+		}, removals)
+	})
+
+	t.Run("ownership", func(t *testing.T) {
+		r := require.New(t)
+		_, removals := snowflake.DiffGrants(nil, actual, snowflake.GrantsDiffOptions{ObjectTypes: []string{"TABLE"}, IncludeOwnership: true})
+		r.Equal([]snowflake.PrivilegeGrant{
+			{Privilege: "OWNERSHIP", ObjectType: "TABLE", ObjectName: "DB.PUBLIC.OWNED"},
+			{Privilege: "SELECT", ObjectType: "TABLE", ObjectName: `DB.PUBLIC."events"`},
+		}, removals)
+	})
+}